@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	result, _, err := jamfClient.JamfProAPI.Dashboard.GetV1(context.Background())
+	if err != nil {
+		fmt.Printf("Error retrieving dashboard: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Dashboard:\n%s\n", string(out))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dashboard"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	request := &dashboard.RequestToggle{
+		ObjectType: dashboard.ObjectTypePolicy,
+		ObjectID:   "1",
+		Enabled:    true,
+	}
+
+	result, _, err := jamfClient.JamfProAPI.Dashboard.ToggleV1(context.Background(), request)
+	if err != nil {
+		fmt.Printf("Error toggling dashboard object: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Dashboard toggle accepted:\n%s\n", string(out))
+}
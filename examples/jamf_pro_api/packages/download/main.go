@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/tools/upload_counter"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig, jamfpro.WithProgressReporter(upload_counter.New(nil)))
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	ctx := context.Background()
+	downloader := jamfClient.JamfProAPI.PackageDownloader
+
+	// Single package to a file. Re-running after an interruption resumes
+	// from the .part file left next to the destination.
+	result, err := downloader.DownloadToFile(ctx, "1", "/tmp/package.pkg")
+	if err != nil {
+		fmt.Printf("Error downloading package: %v\n", err)
+		return
+	}
+	fmt.Printf("Downloaded %s (%d bytes) to %s, verified=%t (%s)\n",
+		result.FileName, result.Size, result.Path, result.Verified, result.HashType)
+
+	// Many packages into a directory, a few at a time.
+	results, err := downloader.DownloadAll(ctx, []string{"1", "2", "3"}, "/tmp/packages")
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("  %s: %v\n", r.PackageID, r.Err)
+			continue
+		}
+		fmt.Printf("  %s: %s verified=%t\n", r.PackageID, r.Path, r.Verified)
+	}
+	if err != nil {
+		fmt.Printf("Some downloads failed: %v\n", err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	filePath := "/path/to/your/package.pkg"
+
+	req := &packages.RequestPackage{
+		PackageName:          "go-sdk-v2-Package-Upload",
+		CategoryID:           "-1",
+		Info:                 "Example package uploaded to JCDS",
+		Notes:                "Created or updated via PackageUploader",
+		Priority:             10,
+		FillUserTemplate:     packages.BoolPtr(true),
+		FillExistingUsers:    packages.BoolPtr(false),
+		RebootRequired:       packages.BoolPtr(false),
+		OSInstall:            packages.BoolPtr(false),
+		SuppressUpdates:      packages.BoolPtr(false),
+		SuppressFromDock:     packages.BoolPtr(false),
+		SuppressEula:         packages.BoolPtr(false),
+		SuppressRegistration: packages.BoolPtr(false),
+	}
+
+	// Safe to re-run: an interrupted upload resumes, and an identical file
+	// already in JCDS is not uploaded again.
+	result, err := jamfClient.JamfProAPI.PackageUploader.Upload(context.Background(), filePath, req)
+	if err != nil {
+		fmt.Printf("Error uploading package: %v\n", err)
+		return
+	}
+	fmt.Printf("Package %s (%s): created=%t uploaded=%t resumed_parts=%d %s=%s\n",
+		result.PackageID, result.FileName, result.Created, result.Uploaded, result.ResumedParts,
+		result.HashType, result.HashValue)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	settings, _, err := jamfClient.JamfProAPI.ParentApp.GetV1(context.Background())
+	if err != nil {
+		fmt.Printf("Error retrieving Jamf Parent app settings: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(settings, "", "    ")
+	fmt.Println("Jamf Parent app settings:\n" + string(out))
+}
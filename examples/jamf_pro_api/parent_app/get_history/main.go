@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	rsqlQuery := map[string]string{
+		"page":      "0",
+		"page-size": "100",
+		"sort":      "date:desc",
+	}
+
+	history, _, err := jamfClient.JamfProAPI.ParentApp.GetHistoryV1(context.Background(), rsqlQuery)
+	if err != nil {
+		fmt.Printf("Error retrieving Jamf Parent app history: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(history, "", "    ")
+	fmt.Println("Jamf Parent app history:\n" + string(out))
+}
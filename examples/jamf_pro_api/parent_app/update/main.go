@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/parent_app"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	request := &parent_app.ResourceParentApp{
+		TimezoneID:    "Europe/London",
+		DeviceGroupID: 1,
+		IsEnabled:     true,
+		RestrictedTimes: map[string]parent_app.TimeFrame{
+			parent_app.DayOfWeekMonday:    {BeginTime: "08:30", EndTime: "15:30"},
+			parent_app.DayOfWeekTuesday:   {BeginTime: "08:30", EndTime: "15:30"},
+			parent_app.DayOfWeekWednesday: {BeginTime: "08:30", EndTime: "15:30"},
+			parent_app.DayOfWeekThursday:  {BeginTime: "08:30", EndTime: "15:30"},
+			parent_app.DayOfWeekFriday:    {BeginTime: "08:30", EndTime: "13:00"},
+		},
+		AllowTemplates:     true,
+		AllowClearPasscode: true,
+		SafelistedApps: []parent_app.SafelistedApp{
+			{Name: "Safari", BundleID: "com.apple.mobilesafari"},
+		},
+	}
+
+	settings, _, err := jamfClient.JamfProAPI.ParentApp.UpdateV1(context.Background(), request)
+	if err != nil {
+		fmt.Printf("Error updating Jamf Parent app settings: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(settings, "", "    ")
+	fmt.Println("Updated Jamf Parent app settings:\n" + string(out))
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	result, _, err := jamfClient.JamfProAPI.PatchPolicyLogs.GetEligibleRetryCountV2(context.Background(), "1")
+	if err != nil {
+		fmt.Printf("Error retrieving eligible retry count: %v\n", err)
+		return
+	}
+	fmt.Printf("Eligible retry count: %d\n", result.Count)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	patchPolicyID := "1"
+	rsqlQuery := map[string]string{
+		"filter": "statusCode!=1",
+		"sort":   "deviceName:asc",
+	}
+
+	result, _, err := jamfClient.JamfProAPI.PatchPolicyLogs.ListV2(context.Background(), patchPolicyID, rsqlQuery)
+	if err != nil {
+		fmt.Printf("Error listing patch policy logs: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Patch policy logs:\n%s\n", string(out))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policy_logs"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	request := &patch_policy_logs.RequestRetry{
+		DeviceIDs: []string{"1", "2"},
+	}
+
+	_, err = jamfClient.JamfProAPI.PatchPolicyLogs.RetryV2(context.Background(), "1", request)
+	if err != nil {
+		fmt.Printf("Error retrying patch policy: %v\n", err)
+		return
+	}
+	fmt.Println("Patch policy retry requested")
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	ctx := context.Background()
+	configurationID := "1"
+	sessionID := "1"
+
+	status, _, err := jamfClient.JamfProAPI.RemoteAdministration.GetTeamViewerSessionStatusPreview(ctx, configurationID, sessionID)
+	if err != nil {
+		fmt.Printf("Error retrieving TeamViewer session status: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(status, "", "    ")
+	fmt.Printf("Session status before closing:\n%s\n", string(out))
+
+	if _, err := jamfClient.JamfProAPI.RemoteAdministration.CloseTeamViewerSessionPreview(ctx, configurationID, sessionID); err != nil {
+		fmt.Printf("Error closing TeamViewer session: %v\n", err)
+		return
+	}
+	fmt.Printf("Closed TeamViewer session %s\n", sessionID)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	req := &remote_administration.RequestTeamViewerConfiguration{
+		SiteID:         "-1",
+		DisplayName:    "TeamViewer",
+		ScriptToken:    "your-teamviewer-script-token",
+		Enabled:        true,
+		SessionTimeout: 15,
+	}
+
+	result, _, err := jamfClient.JamfProAPI.RemoteAdministration.CreateTeamViewerConfigurationPreview(context.Background(), req)
+	if err != nil {
+		fmt.Printf("Error creating TeamViewer configuration: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Created TeamViewer configuration:\n%s\n", string(out))
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	ctx := context.Background()
+	configurationID := "1"
+
+	req := &remote_administration.RequestTeamViewerSession{
+		DeviceID:    "42",
+		DeviceType:  remote_administration.DeviceTypeComputer,
+		Description: "User reports application X fails to install",
+	}
+
+	created, _, err := jamfClient.JamfProAPI.RemoteAdministration.CreateTeamViewerSessionPreview(ctx, configurationID, req)
+	if err != nil {
+		fmt.Printf("Error creating TeamViewer session: %v\n", err)
+		return
+	}
+
+	session, _, err := jamfClient.JamfProAPI.RemoteAdministration.GetTeamViewerSessionByIDPreview(ctx, configurationID, created.ID)
+	if err != nil {
+		fmt.Printf("Error retrieving TeamViewer session: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(session, "", "    ")
+	fmt.Printf("TeamViewer session (share endUserLink with the user):\n%s\n", string(out))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	jobKey := "EXAMPLE_JOB"
+	rsqlQuery := map[string]string{
+		"sort": "nextFireTime:asc",
+	}
+
+	result, _, err := jamfClient.JamfProAPI.Scheduler.GetJobTriggersV1(context.Background(), jobKey, rsqlQuery)
+	if err != nil {
+		fmt.Printf("Error retrieving scheduler job triggers: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Triggers:\n%s\n", string(out))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	groupID := "1"
+
+	result, _, err := jamfClient.JamfProAPI.SmartUserGroups.RecalculateByIDV1(context.Background(), groupID)
+	if err != nil {
+		fmt.Printf("Error recalculating smart user group: %v\n", err)
+		return
+	}
+	fmt.Printf("Smart user group %s now has %d member(s)\n", groupID, result.Count)
+}
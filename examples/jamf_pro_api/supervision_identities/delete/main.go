@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	id := "1"
+
+	_, err = jamfClient.JamfProAPI.SupervisionIdentities.DeleteByIDV1(context.Background(), id)
+	if err != nil {
+		fmt.Printf("Error deleting supervision identity: %v\n", err)
+		return
+	}
+	fmt.Printf("Supervision identity %s deleted\n", id)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	id := "1"
+	outputPath := "supervision_identity.p12"
+
+	data, _, err := jamfClient.JamfProAPI.SupervisionIdentities.DownloadByIDV1(context.Background(), id)
+	if err != nil {
+		fmt.Printf("Error downloading supervision identity: %v\n", err)
+		return
+	}
+	if err := os.WriteFile(outputPath, data, 0o600); err != nil {
+		log.Fatalf("Failed to write %s: %v", outputPath, err)
+	}
+	fmt.Printf("Wrote %d bytes to %s\n", len(data), outputPath)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/supervision_identities"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	p12, err := os.ReadFile("supervision_identity.p12")
+	if err != nil {
+		log.Fatalf("Failed to read .p12 file: %v", err)
+	}
+
+	request := &supervision_identities.RequestUpload{
+		DisplayName:     "Imported Supervision Identity",
+		Password:        "change-me",
+		CertificateData: p12,
+	}
+
+	result, _, err := jamfClient.JamfProAPI.SupervisionIdentities.UploadV1(context.Background(), request)
+	if err != nil {
+		fmt.Printf("Error uploading supervision identity: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(result, "", "    ")
+	fmt.Printf("Uploaded supervision identity:\n%s\n", string(out))
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/teacher_app"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	autoClear := "05:30"
+	request := &teacher_app.RequestTeacherApp{
+		IsEnabled:                   true,
+		TimezoneID:                  "Europe/London",
+		AutoClear:                   &autoClear,
+		MaxRestrictionLengthSeconds: 600,
+		SafelistedApps: []teacher_app.SafelistedApp{
+			{Name: "Safari", BundleID: "com.apple.mobilesafari"},
+		},
+	}
+
+	settings, _, err := jamfClient.JamfProAPI.TeacherApp.UpdateV1(context.Background(), request)
+	if err != nil {
+		fmt.Printf("Error updating Jamf Teacher app settings: %v\n", err)
+		return
+	}
+	out, _ := json.MarshalIndent(settings, "", "    ")
+	fmt.Println("Updated Jamf Teacher app settings:\n" + string(out))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	result, _, err := jamfClient.JamfProAPI.UserSession.GetCurrentUser(context.Background())
+	if err != nil {
+		fmt.Printf("Error retrieving current session user: %v\n", err)
+		return
+	}
+	fmt.Printf("User: %s (%s, %s)\n", result.Username, result.AccessLevel, result.PrivilegeSet)
+	fmt.Printf("Current site: %d\n", result.CurrentSiteID)
+	for _, privilege := range result.PrivilegesForSite(result.CurrentSiteID) {
+		fmt.Printf("  - %s\n", privilege)
+	}
+	fmt.Printf("Can read computers: %v\n", result.HasPrivilege(result.CurrentSiteID, "Read Computers"))
+}
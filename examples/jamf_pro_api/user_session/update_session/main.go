@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_session"
+)
+
+func main() {
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+	authConfig, err := jamfpro.LoadAuthConfigFromFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	jamfClient, err := jamfpro.NewClient(authConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	request := &user_session.RequestUpdateSession{
+		CurrentSiteID: 1,
+	}
+
+	result, _, err := jamfClient.JamfProAPI.UserSession.UpdateSession(context.Background(), request)
+	if err != nil {
+		fmt.Printf("Error updating session: %v\n", err)
+		return
+	}
+	fmt.Printf("Current site is now %d\n", result.CurrentSiteID)
+}
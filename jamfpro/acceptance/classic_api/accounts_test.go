@@ -15,7 +15,7 @@ import (
 // uniqueName returns a name unique to the test run to avoid conflicts with
 // existing data and between parallel test runs.
 func uniqueAccountName(prefix string) string {
-	return fmt.Sprintf("%s-%d", prefix, time.Now().UnixMilli())
+	return acc.UniqueName(prefix)
 }
 
 // =============================================================================
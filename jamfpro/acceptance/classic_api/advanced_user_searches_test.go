@@ -14,7 +14,7 @@ import (
 
 // uniqueUserSearchName generates a unique name for test user searches to avoid conflicts.
 func uniqueUserSearchName(prefix string) string {
-	return fmt.Sprintf("%s-%d", prefix, time.Now().UnixMilli())
+	return acc.UniqueName(prefix)
 }
 
 // =============================================================================
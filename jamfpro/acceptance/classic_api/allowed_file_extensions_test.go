@@ -27,7 +27,7 @@ func TestAcceptance_AllowedFileExtensions_lifecycle(t *testing.T) {
 
 	// Use a unique extension suffix so we don't conflict with existing entries.
 	// Extensions must be simple strings (no spaces or special chars).
-	extension := fmt.Sprintf("acc%d", time.Now().UnixMilli()%100000)
+	extension := acc.RecordedValue(func() string { return fmt.Sprintf("acc%d", time.Now().UnixMilli()%100000) })
 
 	// ------------------------------------------------------------------
 	// 1. Create
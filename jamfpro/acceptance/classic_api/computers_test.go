@@ -38,7 +38,7 @@ func TestAcceptance_Computers_lifecycle(t *testing.T) {
 		General: computers.ComputerSubsetGeneral{
 			Name:         computerName,
 			MacAddress:   "00:11:22:33:44:55",
-			SerialNumber: fmt.Sprintf("ACC%d", time.Now().UnixMilli()),
+			SerialNumber: acc.RecordedValue(func() string { return fmt.Sprintf("ACC%d", time.Now().UnixMilli()) }),
 			Site: models.SharedResourceSite{
 				ID:   -1,
 				Name: "none",
@@ -232,7 +232,7 @@ func TestAcceptance_Computers_delete_by_name(t *testing.T) {
 		General: computers.ComputerSubsetGeneral{
 			Name:         computerName,
 			MacAddress:   "00:11:22:33:44:66",
-			SerialNumber: fmt.Sprintf("ACC%d", time.Now().UnixMilli()),
+			SerialNumber: acc.RecordedValue(func() string { return fmt.Sprintf("ACC%d", time.Now().UnixMilli()) }),
 			Site:         models.SharedResourceSite{ID: -1, Name: "none"},
 		},
 	}
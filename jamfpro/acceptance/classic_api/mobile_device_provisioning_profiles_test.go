@@ -30,7 +30,9 @@ func TestAcceptance_MobileDeviceProvisioningProfiles_lifecycle(t *testing.T) {
 	acc.LogTestStage(t, "Create", "Creating test mobile device provisioning profile")
 
 	profileName := acc.UniqueName("sdkv2_acc_acc-test-md-provisioning-profile")
-	profileUUID := "550e8400-e29b-41d4-a716-" + fmt.Sprintf("%012d", time.Now().Unix()%1000000000000)
+	profileUUID := acc.RecordedValue(func() string {
+		return "550e8400-e29b-41d4-a716-" + fmt.Sprintf("%012d", time.Now().Unix()%1000000000000)
+	})
 	createReq := &mobile_device_provisioning_profiles.RequestResource{
 		General: mobile_device_provisioning_profiles.SubsetGeneral{
 			Name:        profileName,
@@ -230,7 +232,9 @@ func TestAcceptance_MobileDeviceProvisioningProfiles_delete_by_name(t *testing.T
 	ctx := context.Background()
 
 	profileName := acc.UniqueName("sdkv2_acc_acc-test-md-provisioning-profile-dbn")
-	profileUUID := "550e8400-e29b-41d4-a716-" + fmt.Sprintf("%012d", time.Now().Unix()%1000000000000)
+	profileUUID := acc.RecordedValue(func() string {
+		return "550e8400-e29b-41d4-a716-" + fmt.Sprintf("%012d", time.Now().Unix()%1000000000000)
+	})
 	createReq := &mobile_device_provisioning_profiles.RequestResource{
 		General: mobile_device_provisioning_profiles.SubsetGeneral{
 			Name:        profileName,
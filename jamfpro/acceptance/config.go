@@ -6,9 +6,19 @@ import (
 	"time"
 
 	jamfpro "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamfprotest"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/environment"
 )
 
+// Cassette modes accepted in JAMF_CASSETTE_MODE.
+const (
+	// CassetteModeRecord runs against the tenant and writes one cassette per test.
+	CassetteModeRecord = "record"
+	// CassetteModeReplay serves every test from its cassette, with no tenant.
+	CassetteModeReplay = "replay"
+)
+
 // TestConfig holds configuration for acceptance tests driven by environment variables.
 // All credential variables mirror the names read by jamfpro.AuthConfigFromEnv().
 type TestConfig struct {
@@ -28,6 +38,11 @@ type TestConfig struct {
 	RequestTimeout time.Duration
 	SkipCleanup    bool
 	Verbose        bool
+
+	// Cassettes (see jamfprotest.Recorder). CassetteDir is relative to the
+	// test package directory.
+	CassetteMode string
+	CassetteDir  string
 }
 
 var (
@@ -35,6 +50,9 @@ var (
 	Config *TestConfig
 	// Client is the shared Jamf Pro SDK client for acceptance tests.
 	Client *jamfpro.Client
+	// Recorder is the cassette transport behind Client, or nil when
+	// JAMF_CASSETTE_MODE is unset.
+	Recorder *jamfprotest.Recorder
 )
 
 func init() {
@@ -48,20 +66,48 @@ func init() {
 		RequestTimeout: environment.GetDurationEnv("JAMF_REQUEST_TIMEOUT", 30*time.Second),
 		SkipCleanup:    environment.GetEnvAsBool("JAMF_SKIP_CLEANUP", false),
 		Verbose:        environment.GetEnvAsBool("JAMF_VERBOSE", false),
+		CassetteMode:   environment.GetEnv("JAMF_CASSETTE_MODE", ""),
+		CassetteDir:    environment.GetEnv("JAMF_CASSETTE_DIR", "testdata/cassettes"),
 	}
 }
 
 // InitClient creates the shared Jamf Pro client from environment variables.
-// Returns an error if required credentials are absent.
+// Returns an error if required credentials are absent. When replaying
+// cassettes no credentials are needed.
 func InitClient() error {
 	authConfig := jamfpro.AuthConfigFromEnv()
+	if Config.CassetteMode == CassetteModeReplay {
+		authConfig = &jamfpro.AuthConfig{
+			InstanceDomain: "https://replay.invalid",
+			AuthMethod:     constants.AuthMethodOAuth2,
+			ClientID:       "replay",
+			ClientSecret:   "replay",
+		}
+	}
 	if err := authConfig.Validate(); err != nil {
 		return fmt.Errorf("invalid acceptance test credentials: %w", err)
 	}
 
-	var err error
 	transportTimeout := environment.GetDurationEnv("JAMF_TRANSPORT_TIMEOUT", 10*time.Minute)
-	Client, err = jamfpro.NewClient(authConfig, jamfpro.WithTimeout(transportTimeout))
+	opts := []jamfpro.ClientOption{jamfpro.WithTimeout(transportTimeout)}
+
+	switch Config.CassetteMode {
+	case "":
+	case CassetteModeRecord, CassetteModeReplay:
+		if Recorder == nil {
+			mode := jamfprotest.ModeReplay
+			if Config.CassetteMode == CassetteModeRecord {
+				mode = jamfprotest.ModeRecord
+			}
+			Recorder = jamfprotest.NewRecorder(mode)
+		}
+		opts = append(opts, jamfpro.WithTransport(Recorder))
+	default:
+		return fmt.Errorf("invalid JAMF_CASSETTE_MODE %q: must be %q or %q", Config.CassetteMode, CassetteModeRecord, CassetteModeReplay)
+	}
+
+	var err error
+	Client, err = jamfpro.NewClient(authConfig, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create Jamf Pro client: %w", err)
 	}
@@ -72,7 +118,11 @@ func InitClient() error {
 	return nil
 }
 
-// IsConfigured returns true if the minimum required credentials are set.
+// IsConfigured returns true if the minimum required credentials are set, or
+// if tests are replayed from cassettes.
 func IsConfigured() bool {
+	if Config.CassetteMode == CassetteModeReplay {
+		return true
+	}
 	return Config.InstanceDomain != "" && Config.AuthMethod != ""
 }
@@ -2,8 +2,11 @@ package acceptance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -23,16 +26,58 @@ func SkipIfNotConfigured(t *testing.T) {
 
 // RequireClient ensures the shared client is initialised, skipping if
 // credentials are absent or initialisation fails.
+//
+// With JAMF_CASSETTE_MODE set, each test gets a fresh client (so nothing the
+// transport caches, such as the server version, leaks between cassettes) and
+// its own cassette named after the test. Replaying a test that has no
+// cassette skips it.
 func RequireClient(t *testing.T) {
 	t.Helper()
 	SkipIfNotConfigured(t)
 
+	if Config.CassetteMode != "" {
+		useCassette(t)
+		return
+	}
+
 	if Client == nil {
 		err := InitClient()
 		require.NoError(t, err, "Failed to initialise Jamf Pro client")
 	}
 }
 
+// useCassette creates the client for t and loads t's cassette until t ends.
+func useCassette(t *testing.T) {
+	t.Helper()
+	require.NoError(t, InitClient(), "Failed to initialise Jamf Pro client")
+	c := Client
+	t.Cleanup(func() { _ = c.Close(context.Background()) })
+
+	path := CassettePath(t)
+	eject, err := Recorder.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("No cassette recorded at %s, skipping", path)
+	}
+	require.NoError(t, err, "Failed to load cassette")
+	t.Cleanup(func() {
+		if err := eject(); err != nil {
+			t.Errorf("Failed to save cassette %s: %v", path, err)
+		}
+	})
+}
+
+// CassettePath returns the cassette file for t under JAMF_CASSETTE_DIR.
+func CassettePath(t *testing.T) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '/':
+			return r
+		}
+		return '_'
+	}, t.Name())
+	return filepath.Join(Config.CassetteDir, filepath.FromSlash(name)+".yaml")
+}
+
 // NewContext creates a context with the configured request timeout.
 func NewContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), Config.RequestTimeout)
@@ -156,9 +201,23 @@ func isGitHubActions() bool {
 }
 
 // UniqueName returns a category name that is unique per test run to avoid
-// conflicts with pre-existing data.
+// conflicts with pre-existing data. When replaying, it returns the name that
+// was recorded so request bodies match the cassette.
 func UniqueName(base string) string {
-	return fmt.Sprintf("%s-%d", base, time.Now().UnixMilli())
+	return RecordedValue(func() string {
+		return fmt.Sprintf("%s-%d", base, time.Now().UnixMilli())
+	})
+}
+
+// RecordedValue returns generate() for values that differ between runs
+// (timestamps, serial numbers, passwords). When recording it is saved to the
+// test's cassette, and when replaying the saved value is returned instead.
+// Call it after RequireClient.
+func RecordedValue(generate func() string) string {
+	if Recorder == nil {
+		return generate()
+	}
+	return Recorder.Value(generate)
 }
 
 // GreaterThanJamfProVersion skips the test if the Jamf Pro server version is
@@ -2,7 +2,6 @@ package jamf_pro_api
 
 import (
 	"context"
-	"fmt"
 	"testing"
 	"time"
 
@@ -30,7 +29,7 @@ func TestAcceptance_AdvancedMobileDeviceSearches_lifecycle(t *testing.T) {
 	svc := acc.Client.JamfProAPI.AdvancedMobileDeviceSearches
 	ctx := context.Background()
 
-	name := fmt.Sprintf("acc-adv-md-search-%d", time.Now().UnixMilli())
+	name := acc.UniqueName("acc-adv-md-search")
 	// Use valid criterion for mobile device searches
 	falseBool := false
 	siteId := "-1"
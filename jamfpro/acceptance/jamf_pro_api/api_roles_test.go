@@ -124,7 +124,7 @@ func TestAcceptance_APIRoles_list_with_rsql_filter(t *testing.T) {
 	svc := acc.Client.JamfProAPI.ApiRoles
 	ctx := context.Background()
 
-	name := fmt.Sprintf("acc-rsql-role-%d", time.Now().UnixMilli())
+	name := acc.UniqueName("acc-rsql-role")
 	createReq := &api_roles.RequestAPIRole{
 		DisplayName: name,
 		Privileges:  []string{"Read Computers"},
@@ -171,7 +171,7 @@ func TestAcceptance_APIRoles_lifecycle(t *testing.T) {
 	svc := acc.Client.JamfProAPI.ApiRoles
 	ctx := context.Background()
 
-	name := fmt.Sprintf("acc-api-role-%d", time.Now().UnixMilli())
+	name := acc.UniqueName("acc-api-role")
 	createReq := &api_roles.RequestAPIRole{
 		DisplayName: name,
 		Privileges:  []string{"Read Computers"},
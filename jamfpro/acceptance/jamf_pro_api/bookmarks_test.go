@@ -74,7 +74,7 @@ func TestAcceptance_Bookmarks_list_with_rsql_filter(t *testing.T) {
 	svc := acc.Client.JamfProAPI.Bookmarks
 	ctx := context.Background()
 
-	name := fmt.Sprintf("acc-rsql-bookmark-%d", time.Now().UnixMilli())
+	name := acc.UniqueName("acc-rsql-bookmark")
 	displayInBrowser := true
 	bm := &bookmarks.ResourceBookmark{
 		Name:             name,
@@ -174,7 +174,7 @@ func TestAcceptance_Bookmarks_lifecycle(t *testing.T) {
 	svc := acc.Client.JamfProAPI.Bookmarks
 	ctx := context.Background()
 
-	name := fmt.Sprintf("acc-bookmark-%d", time.Now().UnixMilli())
+	name := acc.UniqueName("acc-bookmark")
 	displayInBrowser := true
 	bm := &bookmarks.ResourceBookmark{
 		Name:             name,
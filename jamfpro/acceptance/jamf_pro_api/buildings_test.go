@@ -175,7 +175,7 @@ func TestAcceptance_Buildings_lifecycle(t *testing.T) {
 	acc.LogTestStage(t, "History", "Adding history note and fetching history for ID=%s", buildingID)
 
 	noteReq := &buildings.AddHistoryNotesRequest{
-		Note: fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)),
+		Note: acc.RecordedValue(func() string { return fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)) }),
 	}
 	noteResp, err := svc.AddBuildingHistoryNotesV1(ctx, buildingID, noteReq)
 	require.NoError(t, err)
@@ -201,7 +201,7 @@ func TestAcceptance_Categories_lifecycle(t *testing.T) {
 	// ------------------------------------------------------------------
 	acc.LogTestStage(t, "AddHistoryNotes", "Adding history note to category ID=%s", categoryID)
 
-	noteText := fmt.Sprintf("Acceptance test note at %s", time.Now().UTC().Format(time.RFC3339))
+	noteText := acc.RecordedValue(func() string { return fmt.Sprintf("Acceptance test note at %s", time.Now().UTC().Format(time.RFC3339)) })
 	noteReq := &categories.AddCategoryHistoryNotesRequest{Note: noteText}
 
 	ctx6, cancel6 := context.WithTimeout(ctx, acc.Config.RequestTimeout)
@@ -0,0 +1,134 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Dashboard
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • GetV1(ctx) - Gets dashboard widgets and setup tasks
+//   • ToggleV1(ctx, request) - Adds or removes an object on the dashboard
+//   • AddObjectV1 / RemoveObjectV1 - ToggleV1 shorthands
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 4: Read-Only with Existing Data
+//     -- Tests: TestAcceptance_Dashboard_read_only
+//
+//   ✓ Pattern 2: Settings/Configuration
+//     -- Tests: TestAcceptance_Dashboard_toggle_existing_widget
+//     -- Flow: pick a widget already on the dashboard → Remove → Verify →
+//        Add back → Verify
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_Dashboard_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • The toggle test only touches a widget that is already present and puts
+//     it back, skipping when the dashboard is empty
+//
+// =============================================================================
+
+func TestAcceptance_Dashboard_read_only(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.Dashboard
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "GetV1", "Reading dashboard")
+	result, resp, err := svc.GetV1(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 200, resp.StatusCode())
+
+	for task, opt := range result.SetupTaskOptions {
+		acc.LogTestSuccess(t, "Setup task %s available=%t", task, opt.Available)
+	}
+	for objectType, items := range result.FeatureOptions {
+		acc.LogTestSuccess(t, "%s: %d widget(s)", objectType, len(items))
+	}
+}
+
+func TestAcceptance_Dashboard_toggle_existing_widget(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.Dashboard
+	ctx := context.Background()
+
+	before, _, err := svc.GetV1(ctx)
+	require.NoError(t, err)
+
+	var objectType, objectID string
+	for _, candidate := range []string{
+		dashboard.ObjectTypePolicy,
+		dashboard.ObjectTypeComputerGroup,
+		dashboard.ObjectTypeMobileDeviceGroup,
+		dashboard.ObjectTypePatchSoftwareTitleConfiguration,
+	} {
+		if items := before.FeatureOptions[candidate]; len(items) > 0 {
+			objectType, objectID = candidate, items[0].ID
+			break
+		}
+	}
+	if objectID == "" {
+		t.Skip("No policy, group or patch title widgets on the dashboard to toggle")
+	}
+
+	onDashboard := func() bool {
+		current, _, err := svc.GetV1(ctx)
+		require.NoError(t, err)
+		for _, item := range current.FeatureOptions[objectType] {
+			if item.ID == objectID {
+				return true
+			}
+		}
+		return false
+	}
+
+	acc.Cleanup(t, func() {
+		_, _, _ = svc.AddObjectV1(context.Background(), objectType, objectID)
+	})
+
+	acc.LogTestStage(t, "RemoveObjectV1", "Removing %s %s from dashboard", objectType, objectID)
+	_, resp, err := svc.RemoveObjectV1(ctx, objectType, objectID)
+	require.NoError(t, err)
+	assert.Equal(t, 202, resp.StatusCode())
+	assert.False(t, onDashboard(), "widget should be removed")
+
+	acc.LogTestStage(t, "AddObjectV1", "Adding %s %s back to dashboard", objectType, objectID)
+	_, resp, err = svc.AddObjectV1(ctx, objectType, objectID)
+	require.NoError(t, err)
+	assert.Equal(t, 202, resp.StatusCode())
+	assert.True(t, onDashboard(), "widget should be restored")
+	acc.LogTestSuccess(t, "Toggled %s %s off and on", objectType, objectID)
+}
+
+func TestAcceptance_Dashboard_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.Dashboard
+	ctx := context.Background()
+
+	t.Run("ToggleV1_NilRequest", func(t *testing.T) {
+		_, _, err := svc.ToggleV1(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "request is required")
+	})
+
+	t.Run("AddObjectV1_InvalidType", func(t *testing.T) {
+		_, _, err := svc.AddObjectV1(ctx, "TYPE_SCRIPT", "1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid object type")
+	})
+}
@@ -160,7 +160,7 @@ func TestAcceptance_Departments_lifecycle(t *testing.T) {
 	acc.LogTestStage(t, "History", "Adding history note and fetching history for ID=%s", departmentID)
 
 	noteReq := &departments.AddHistoryNotesRequest{
-		Note: fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)),
+		Note: acc.RecordedValue(func() string { return fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)) }),
 	}
 	noteResp, err := svc.AddDepartmentHistoryNotesV1(ctx, departmentID, noteReq)
 	require.NoError(t, err)
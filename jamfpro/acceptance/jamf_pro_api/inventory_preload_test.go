@@ -58,7 +58,7 @@ func TestAcceptance_InventoryPreload_record_lifecycle(t *testing.T) {
 	// 1. Create record
 	acc.LogTestStage(t, "CreateRecord", "Creating inventory preload record")
 
-	serial := fmt.Sprintf("SDKV2-%d", time.Now().UnixNano()%10000000)
+	serial := acc.RecordedValue(func() string { return fmt.Sprintf("SDKV2-%d", time.Now().UnixNano()%10000000) })
 	createReq := &inventory_preload.InventoryPreloadRecord{
 		SerialNumber: serial,
 		DeviceType:   "Computer",
@@ -100,7 +100,7 @@ func TestAcceptance_InventoryPreload_record_lifecycle(t *testing.T) {
 	// 3. UpdateRecord
 	acc.LogTestStage(t, "UpdateRecord", "Updating record ID=%s", recordID)
 
-	updatedSerial := fmt.Sprintf("SDKV2U-%d", time.Now().UnixNano()%10000000)
+	updatedSerial := acc.RecordedValue(func() string { return fmt.Sprintf("SDKV2U-%d", time.Now().UnixNano()%10000000) })
 	updateReq := &inventory_preload.InventoryPreloadRecord{
 		SerialNumber: updatedSerial,
 		DeviceType:   "Computer",
@@ -14,7 +14,7 @@ import (
 )
 
 func uniqueNameMDEA(base string) string {
-	return fmt.Sprintf("%s-%d", base, time.Now().UnixMilli())
+	return acc.UniqueName(base)
 }
 
 // =============================================================================
@@ -105,7 +105,7 @@ const (
 )
 
 func uniquePackageName(base string) string {
-	return fmt.Sprintf("%s-%d", base, time.Now().UnixMilli())
+	return acc.UniqueName(base)
 }
 
 // =============================================================================
@@ -257,7 +257,7 @@ func TestAcceptance_Packages_lifecycle(t *testing.T) {
 	acc.LogTestStage(t, "History", "Adding history note and fetching history for ID=%s", packageID)
 
 	noteReq := &packages.AddHistoryNotesRequest{
-		Note: fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)),
+		Note: acc.RecordedValue(func() string { return fmt.Sprintf("Acceptance test note at %s", time.Now().Format(time.RFC3339)) }),
 	}
 	noteResp, err := svc.AddHistoryNotesV1(ctx, packageID, noteReq)
 	require.NoError(t, err)
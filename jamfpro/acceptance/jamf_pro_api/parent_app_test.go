@@ -0,0 +1,127 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/parent_app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Jamf Parent App Settings
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • GetV1(ctx) - Retrieves the Jamf Parent app settings
+//   • UpdateV1(ctx, request) - Replaces the Jamf Parent app settings (PUT)
+//   • GetHistoryV1(ctx, rsqlQuery) - Lists settings history with RSQL filtering
+//   • AddHistoryNoteV1(ctx, request) - Adds a note to the settings history
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 2: Settings/Configuration
+//     -- Tests: TestAcceptance_ParentApp_settings_round_trip
+//     -- Flow: Get original → Update with the same values → Verify unchanged
+//
+//   ✓ Pattern 4: Read-Only with Existing Data
+//     -- Tests: TestAcceptance_ParentApp_history
+//     -- Flow: AddHistoryNoteV1 → GetHistoryV1 sorted by date
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_ParentApp_validation_errors
+//     -- Cases: nil update request, nil and empty history note
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • Singleton settings; the round trip writes back what was read so the
+//     tenant is left as it was found
+//   • Restricted times are keyed by day (parent_app.DayOfWeek* constants)
+//
+// =============================================================================
+
+func TestAcceptance_ParentApp_settings_round_trip(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.ParentApp
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "Get", "Getting current Jamf Parent app settings")
+	original, resp, err := svc.GetV1(ctx)
+	if err != nil {
+		t.Skipf("Jamf Parent app settings may not be available on this tenant: %v", err)
+	}
+	require.NotNil(t, original)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "Jamf Parent enabled=%t timezone=%s deviceGroupId=%d restrictedDays=%d",
+		original.IsEnabled, original.TimezoneID, original.DeviceGroupID, len(original.RestrictedTimes))
+
+	acc.LogTestStage(t, "Update", "Writing back the current settings")
+	updated, resp, err := svc.UpdateV1(ctx, original)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, 200, resp.StatusCode())
+
+	acc.LogTestStage(t, "Verify", "Verifying settings are unchanged")
+	verify, _, err := svc.GetV1(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, original.IsEnabled, verify.IsEnabled)
+	assert.Equal(t, original.TimezoneID, verify.TimezoneID)
+	assert.Equal(t, original.DeviceGroupID, verify.DeviceGroupID)
+	assert.Equal(t, len(original.RestrictedTimes), len(verify.RestrictedTimes))
+	acc.LogTestSuccess(t, "Jamf Parent app settings round-tripped")
+}
+
+func TestAcceptance_ParentApp_history(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.ParentApp
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "AddHistoryNoteV1", "Adding a history note")
+	note, resp, err := svc.AddHistoryNoteV1(ctx, &parent_app.AddHistoryNoteRequest{
+		Note: "Acceptance test history note for Jamf Parent",
+	})
+	if err != nil {
+		t.Skipf("Adding Jamf Parent history notes may not be supported on this tenant: %v", err)
+	}
+	require.NotNil(t, note)
+	assert.Equal(t, 201, resp.StatusCode())
+	acc.LogTestSuccess(t, "Added history note with ID: %d", note.ID)
+
+	acc.LogTestStage(t, "GetHistoryV1", "Listing history sorted by date")
+	history, resp, err := svc.GetHistoryV1(ctx, map[string]string{"sort": "date:desc"})
+	require.NoError(t, err)
+	require.NotNil(t, history)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.GreaterOrEqual(t, history.TotalCount, 1)
+	acc.LogTestSuccess(t, "Found %d history entries", history.TotalCount)
+}
+
+func TestAcceptance_ParentApp_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.ParentApp
+	ctx := context.Background()
+
+	t.Run("UpdateV1_NilRequest", func(t *testing.T) {
+		_, _, err := svc.UpdateV1(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "request is required")
+	})
+
+	t.Run("AddHistoryNoteV1_NilRequest", func(t *testing.T) {
+		_, _, err := svc.AddHistoryNoteV1(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "request is required")
+	})
+
+	t.Run("AddHistoryNoteV1_EmptyNote", func(t *testing.T) {
+		_, _, err := svc.AddHistoryNoteV1(ctx, &parent_app.AddHistoryNoteRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "note is required")
+	})
+}
@@ -0,0 +1,112 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policy_logs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Patch Policy Logs
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • ListV2(ctx, patchPolicyID, rsqlQuery) - Lists per-device logs with RSQL filtering
+//   • GetByDeviceIDV2(ctx, patchPolicyID, deviceID) - Gets the log for one device
+//   • GetDetailsByDeviceIDV2(ctx, patchPolicyID, deviceID) - Gets install attempts for one device
+//   • GetEligibleRetryCountV2(ctx, patchPolicyID) - Counts logs that can be retried
+//   • RetryV2(ctx, patchPolicyID, request) - Retries the policy on specific devices
+//   • RetryAllV2(ctx, patchPolicyID) - Retries the policy on every eligible device
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 3: Read-Only Operations
+//     -- Tests: TestAcceptance_PatchPolicyLogs_read_v2
+//     -- Flow: Find patch policy → List logs → Get by device → Get details → Retry count
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_PatchPolicyLogs_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • Patch policies cannot be created through the Jamf Pro API, so the read
+//     test uses the first existing policy and skips if there is none
+//   • RetryV2 and RetryAllV2 are not called; they redeploy software to real devices
+//
+// =============================================================================
+
+func TestAcceptance_PatchPolicyLogs_read_v2(t *testing.T) {
+	acc.RequireClient(t)
+	svc := acc.Client.JamfProAPI.PatchPolicyLogs
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "Setup", "Finding a patch policy")
+	policies, _, err := acc.Client.JamfProAPI.PatchPolicies.ListV2(ctx)
+	require.NoError(t, err)
+	if policies.TotalCount == 0 {
+		t.Skip("No patch policies available to read logs from")
+	}
+	policyID := policies.Results[0].ID
+
+	acc.LogTestStage(t, "List", "Listing logs for patch policy %s", policyID)
+	logs, resp, err := svc.ListV2(ctx, policyID, map[string]string{"sort": "deviceName:asc"})
+	require.NoError(t, err)
+	require.NotNil(t, logs)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "Patch policy %s has %d device logs", policyID, logs.TotalCount)
+
+	acc.LogTestStage(t, "Read", "Getting eligible retry count")
+	count, resp, err := svc.GetEligibleRetryCountV2(ctx, policyID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.GreaterOrEqual(t, count.Count, 0)
+	acc.LogTestSuccess(t, "Eligible retry count: %d", count.Count)
+
+	if logs.TotalCount == 0 {
+		acc.LogTestWarning(t, "No device logs; skipping per-device reads")
+		return
+	}
+	deviceID := logs.Results[0].DeviceID
+
+	acc.LogTestStage(t, "Read", "Getting log for device %s", deviceID)
+	log, resp, err := svc.GetByDeviceIDV2(ctx, policyID, deviceID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, deviceID, log.DeviceID)
+	acc.LogTestSuccess(t, "Device %s status: %s (attempt %d)", log.DeviceName, log.StatusEnum, log.AttemptNumber)
+
+	acc.LogTestStage(t, "Read", "Getting log details for device %s", deviceID)
+	details, resp, err := svc.GetDetailsByDeviceIDV2(ctx, policyID, deviceID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "Device %s has %d install attempts", deviceID, len(details))
+}
+
+func TestAcceptance_PatchPolicyLogs_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+	svc := acc.Client.JamfProAPI.PatchPolicyLogs
+	ctx := context.Background()
+
+	t.Run("ListV2_EmptyPolicyID", func(t *testing.T) {
+		_, _, err := svc.ListV2(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "patch policy ID is required")
+	})
+
+	t.Run("GetByDeviceIDV2_EmptyDeviceID", func(t *testing.T) {
+		_, _, err := svc.GetByDeviceIDV2(ctx, "1", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "device ID is required")
+	})
+
+	t.Run("RetryV2_NoDevices", func(t *testing.T) {
+		_, err := svc.RetryV2(ctx, "1", &patch_policy_logs.RequestRetry{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one device ID is required")
+	})
+}
@@ -0,0 +1,208 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/environment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Remote Administration (TeamViewer)
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • ListConfigurationsPreview(ctx, rsqlQuery) - Lists remote administration configurations
+//   • CreateTeamViewerConfigurationPreview(ctx, request) - Creates a TeamViewer configuration
+//   • GetTeamViewerConfigurationByIDPreview(ctx, id) - Gets a TeamViewer configuration
+//   • UpdateTeamViewerConfigurationByIDPreview(ctx, id, request) - Updates a TeamViewer configuration
+//   • DeleteTeamViewerConfigurationByIDPreview(ctx, id) - Deletes a TeamViewer configuration
+//   • GetTeamViewerConfigurationStatusPreview(ctx, id) - Verifies the TeamViewer connection
+//   • ListTeamViewerSessionsPreview(ctx, configurationID, rsqlQuery) - Lists sessions
+//   • CreateTeamViewerSessionPreview(ctx, configurationID, request) - Creates a session
+//   • GetTeamViewerSessionByIDPreview(ctx, configurationID, sessionID) - Gets a session
+//   • GetTeamViewerSessionStatusPreview(ctx, configurationID, sessionID) - Gets session status
+//   • CloseTeamViewerSessionPreview(ctx, configurationID, sessionID) - Closes a session
+//   • ResendTeamViewerSessionNotificationPreview(ctx, configurationID, sessionID) - Resends notification
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 4: Read-Only with Existing Data
+//     -- Tests: TestAcceptance_RemoteAdministration_read_only
+//     -- Flow: ListConfigurationsPreview → Get/Status for first TeamViewer
+//        configuration → ListTeamViewerSessionsPreview → Get/Status for first session
+//
+//   ✓ Pattern 1: Full CRUD Lifecycle (opt-in)
+//     -- Tests: TestAcceptance_RemoteAdministration_teamviewer_lifecycle
+//     -- Flow: Create → Get → Update → Delete
+//     -- Requires: TEAMVIEWER_SCRIPT_TOKEN (a valid TeamViewer script token)
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_RemoteAdministration_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • Endpoints are in preview; session endpoints return 403 when TeamViewer is
+//     not enabled
+//   • Sessions are not created here: doing so notifies real end users
+//   • RSQL filter keys for sessions: deviceId, deviceType, state
+//
+// =============================================================================
+
+// TestAcceptance_RemoteAdministration_read_only verifies configurations and
+// sessions can be read.
+func TestAcceptance_RemoteAdministration_read_only(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.RemoteAdministration
+	ctx := context.Background()
+
+	// 1. ListConfigurationsPreview
+	acc.LogTestStage(t, "ListConfigurationsPreview", "Listing remote administration configurations")
+
+	list, resp, err := svc.ListConfigurationsPreview(ctx, nil)
+	require.NoError(t, err)
+	require.NotNil(t, list)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "ListConfigurationsPreview: %d configuration(s)", list.TotalCount)
+
+	var configID string
+	for _, c := range list.Results {
+		if c.Type == remote_administration.ConfigurationTypeTeamViewer {
+			configID = c.ID
+			break
+		}
+	}
+	if configID == "" {
+		acc.LogTestWarning(t, "No TeamViewer configuration found; skipping configuration and session reads")
+		return
+	}
+
+	// 2. GetTeamViewerConfigurationByIDPreview
+	acc.LogTestStage(t, "GetTeamViewerConfigurationByIDPreview", "Getting configuration ID=%s", configID)
+
+	config, resp, err := svc.GetTeamViewerConfigurationByIDPreview(ctx, configID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, configID, config.ID)
+	acc.LogTestSuccess(t, "Configuration %q enabled=%t", config.DisplayName, config.Enabled)
+
+	// 3. GetTeamViewerConfigurationStatusPreview
+	status, _, err := svc.GetTeamViewerConfigurationStatusPreview(ctx, configID)
+	require.NoError(t, err)
+	acc.LogTestSuccess(t, "Connection verification: %s", status.ConnectionVerificationResult)
+
+	// 4. ListTeamViewerSessionsPreview
+	acc.LogTestStage(t, "ListTeamViewerSessionsPreview", "Listing sessions for configuration ID=%s", configID)
+
+	sessions, _, err := svc.ListTeamViewerSessionsPreview(ctx, configID, nil)
+	if client.IsForbidden(err) {
+		acc.LogTestWarning(t, "TeamViewer sessions not available (403); skipping session reads")
+		return
+	}
+	require.NoError(t, err)
+	acc.LogTestSuccess(t, "ListTeamViewerSessionsPreview: %d session(s)", sessions.TotalCount)
+
+	if len(sessions.Results) == 0 {
+		acc.LogTestWarning(t, "No TeamViewer sessions found; skipping per-session fetch tests")
+		return
+	}
+
+	// 5. GetTeamViewerSessionByIDPreview and status
+	sessionID := sessions.Results[0].ID
+	session, _, err := svc.GetTeamViewerSessionByIDPreview(ctx, configID, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, session.ID)
+
+	sessionStatus, _, err := svc.GetTeamViewerSessionStatusPreview(ctx, configID, sessionID)
+	require.NoError(t, err)
+	acc.LogTestSuccess(t, "Session %s state=%s online=%t", sessionID, sessionStatus.SessionState, sessionStatus.Online)
+}
+
+// TestAcceptance_RemoteAdministration_teamviewer_lifecycle creates, updates
+// and deletes a TeamViewer configuration. Requires TEAMVIEWER_SCRIPT_TOKEN.
+func TestAcceptance_RemoteAdministration_teamviewer_lifecycle(t *testing.T) {
+	acc.RequireClient(t)
+
+	token := environment.GetEnv("TEAMVIEWER_SCRIPT_TOKEN", "")
+	if token == "" {
+		t.Skip("TEAMVIEWER_SCRIPT_TOKEN not set; skipping TeamViewer configuration lifecycle")
+	}
+
+	svc := acc.Client.JamfProAPI.RemoteAdministration
+	ctx := context.Background()
+
+	// 1. Create
+	acc.LogTestStage(t, "CreateTeamViewerConfigurationPreview", "Creating TeamViewer configuration")
+
+	created, resp, err := svc.CreateTeamViewerConfigurationPreview(ctx, &remote_administration.RequestTeamViewerConfiguration{
+		SiteID:         "-1",
+		DisplayName:    acc.UniqueName("acc-teamviewer"),
+		ScriptToken:    token,
+		Enabled:        false,
+		SessionTimeout: 15,
+	})
+	if client.IsConflict(err) {
+		t.Skip("A TeamViewer configuration already exists for this site; skipping lifecycle")
+	}
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	require.NotEmpty(t, created.ID)
+	acc.LogTestSuccess(t, "Created TeamViewer configuration ID=%s", created.ID)
+
+	acc.Cleanup(t, func() {
+		_, err := svc.DeleteTeamViewerConfigurationByIDPreview(context.Background(), created.ID)
+		acc.LogCleanupDeleteError(t, "TeamViewer configuration", created.ID, err)
+	})
+
+	// 2. Get
+	fetched, _, err := svc.GetTeamViewerConfigurationByIDPreview(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, fetched.Enabled)
+
+	// 3. Update
+	acc.LogTestStage(t, "UpdateTeamViewerConfigurationByIDPreview", "Updating session timeout")
+
+	updated, _, err := svc.UpdateTeamViewerConfigurationByIDPreview(ctx, created.ID, &remote_administration.RequestTeamViewerConfigurationUpdate{SessionTimeout: 30})
+	require.NoError(t, err)
+	require.NotNil(t, updated.SessionTimeout)
+	assert.Equal(t, 30, *updated.SessionTimeout)
+	acc.LogTestSuccess(t, "Updated TeamViewer configuration ID=%s", created.ID)
+
+	// 4. Delete
+	resp, err = svc.DeleteTeamViewerConfigurationByIDPreview(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+	acc.LogTestSuccess(t, "Deleted TeamViewer configuration ID=%s", created.ID)
+}
+
+// TestAcceptance_RemoteAdministration_validation_errors verifies input validation.
+func TestAcceptance_RemoteAdministration_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.RemoteAdministration
+
+	t.Run("GetTeamViewerConfigurationByIDPreview_EmptyID", func(t *testing.T) {
+		_, _, err := svc.GetTeamViewerConfigurationByIDPreview(context.Background(), "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "configuration ID is required")
+	})
+
+	t.Run("CreateTeamViewerConfigurationPreview_NilRequest", func(t *testing.T) {
+		_, _, err := svc.CreateTeamViewerConfigurationPreview(context.Background(), nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "request is required")
+	})
+
+	t.Run("GetTeamViewerSessionByIDPreview_EmptySessionID", func(t *testing.T) {
+		_, _, err := svc.GetTeamViewerSessionByIDPreview(context.Background(), "1", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "session ID is required")
+	})
+}
@@ -0,0 +1,90 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Jamf Pro Scheduler
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • GetJobsV1(ctx) - Lists the keys of all scheduler jobs
+//   • GetJobTriggersV1(ctx, jobKey, rsqlQuery) - Lists triggers for a job
+//   • GetSummaryV1(ctx) - Gets pending/executing/executed job counts
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 4: Read-Only with Existing Data
+//     -- Tests: TestAcceptance_Scheduler_read_only
+//     -- Flow: GetSummaryV1 → GetJobsV1 → GetJobTriggersV1 for the first job
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_Scheduler_validation_errors
+//     -- Cases: GetJobTriggersV1 with empty job key
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • Scheduler state is owned by Jamf Pro; these endpoints are read-only
+//   • RSQL filter keys for triggers: triggerKey, previousFireTime, nextFireTime
+//
+// =============================================================================
+
+// TestAcceptance_Scheduler_read_only verifies the scheduler summary, job list
+// and job triggers can be read.
+func TestAcceptance_Scheduler_read_only(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.Scheduler
+	ctx := context.Background()
+
+	// 1. GetSummaryV1
+	acc.LogTestStage(t, "GetSummaryV1", "Retrieving scheduler summary")
+
+	summary, resp, err := svc.GetSummaryV1(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "Scheduler started=%t pending=%d executing=%d executed=%d",
+		summary.Started, summary.NumberOfPendingJobs, summary.NumberOfExecutingJobs, summary.NumberOfExecutedJobs)
+
+	// 2. GetJobsV1
+	acc.LogTestStage(t, "GetJobsV1", "Listing scheduler jobs")
+
+	jobs, resp, err := svc.GetJobsV1(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, jobs)
+	assert.Equal(t, 200, resp.StatusCode())
+	acc.LogTestSuccess(t, "GetJobsV1: %d job(s)", len(jobs.JobKeys))
+
+	if len(jobs.JobKeys) == 0 {
+		acc.LogTestWarning(t, "No scheduler jobs found; skipping trigger listing")
+		return
+	}
+
+	// 3. GetJobTriggersV1
+	jobKey := jobs.JobKeys[0]
+	acc.LogTestStage(t, "GetJobTriggersV1", "Listing triggers for job %s", jobKey)
+
+	triggers, resp, err := svc.GetJobTriggersV1(ctx, jobKey, map[string]string{"sort": "nextFireTime:asc"})
+	require.NoError(t, err)
+	require.NotNil(t, triggers)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, len(triggers.Results), triggers.TotalCount)
+	acc.LogTestSuccess(t, "GetJobTriggersV1: %d trigger(s) for %s", triggers.TotalCount, jobKey)
+}
+
+// TestAcceptance_Scheduler_validation_errors verifies client-side validation.
+func TestAcceptance_Scheduler_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	_, _, err := acc.Client.JamfProAPI.Scheduler.GetJobTriggersV1(context.Background(), "", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "job key is required")
+}
@@ -209,7 +209,7 @@ func TestAcceptance_Scripts_lifecycle(t *testing.T) {
 	// ------------------------------------------------------------------
 	acc.LogTestStage(t, "AddHistoryNotes", "Adding history note to script ID=%s", scriptID)
 
-	noteText := fmt.Sprintf("Acceptance test note at %s", time.Now().UTC().Format(time.RFC3339))
+	noteText := acc.RecordedValue(func() string { return fmt.Sprintf("Acceptance test note at %s", time.Now().UTC().Format(time.RFC3339)) })
 	noteReq := &scripts.AddScriptHistoryNotesRequest{Note: noteText}
 
 	ctx6, cancel6 := context.WithTimeout(ctx, acc.Config.RequestTimeout)
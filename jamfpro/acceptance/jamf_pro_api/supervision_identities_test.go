@@ -0,0 +1,159 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/supervision_identities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Supervision Identities
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • ListV1(ctx, rsqlQuery) - Lists supervision identities (sorted, paged)
+//   • GetByIDV1(ctx, id) - Gets a supervision identity by ID
+//   • CreateV1(ctx, request) - Generates a supervision identity
+//   • UpdateByIDV1(ctx, id, request) - Renames a supervision identity
+//   • DeleteByIDV1(ctx, id) - Deletes a supervision identity
+//   • UploadV1(ctx, request) - Uploads an existing PKCS#12 identity
+//   • DownloadByIDV1(ctx, id) - Downloads the identity as a .p12 file
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 1: Full CRUD Lifecycle
+//     -- Tests: TestAcceptance_SupervisionIdentities_lifecycle
+//     -- Flow: Create → List → GetByID → Update → Download → Upload the
+//        downloaded .p12 as a second identity → Delete both
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_SupervisionIdentities_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • The download/upload round trip mirrors an annual rotation where an
+//     identity exported from one instance is imported into another
+//
+// =============================================================================
+
+func TestAcceptance_SupervisionIdentities_lifecycle(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.SupervisionIdentities
+	ctx := context.Background()
+	password := "sdkv2-acc-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	deleteLater := func(id int) {
+		idStr := strconv.Itoa(id)
+		acc.Cleanup(t, func() {
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			_, delErr := svc.DeleteByIDV1(cleanupCtx, idStr)
+			acc.LogCleanupDeleteError(t, "supervision identity", idStr, delErr)
+		})
+	}
+
+	// 1. Create
+	acc.LogTestStage(t, "CreateV1", "Generating supervision identity")
+	displayName := acc.UniqueName("sdkv2_acc_supervision")
+	created, resp, err := svc.CreateV1(ctx, &supervision_identities.RequestCreate{
+		DisplayName: displayName,
+		Password:    password,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, 201, resp.StatusCode())
+	require.Positive(t, created.ID)
+	deleteLater(created.ID)
+	id := strconv.Itoa(created.ID)
+	acc.LogTestSuccess(t, "Created supervision identity ID=%s expires=%s", id, created.ExpirationDate)
+
+	// 2. List
+	acc.LogTestStage(t, "ListV1", "Listing supervision identities")
+	list, resp, err := svc.ListV1(ctx, map[string]string{"sort": "id:desc"})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	found := false
+	for _, item := range list.Results {
+		if item.ID == created.ID {
+			found = true
+		}
+	}
+	assert.True(t, found, "created supervision identity should be listed")
+
+	// 3. GetByID
+	acc.LogTestStage(t, "GetByIDV1", "Getting supervision identity ID=%s", id)
+	fetched, resp, err := svc.GetByIDV1(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, displayName, fetched.DisplayName)
+
+	// 4. Update
+	updatedName := displayName + "-renamed"
+	acc.LogTestStage(t, "UpdateByIDV1", "Renaming supervision identity to %q", updatedName)
+	updated, resp, err := svc.UpdateByIDV1(ctx, id, &supervision_identities.RequestUpdate{DisplayName: updatedName})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, updatedName, updated.DisplayName)
+
+	// 5. Download
+	acc.LogTestStage(t, "DownloadByIDV1", "Downloading supervision identity ID=%s", id)
+	p12, resp, err := svc.DownloadByIDV1(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotEmpty(t, p12)
+	acc.LogTestSuccess(t, "Downloaded %d byte(s) of PKCS#12 data", len(p12))
+
+	// 6. Upload
+	acc.LogTestStage(t, "UploadV1", "Uploading the downloaded identity")
+	uploaded, resp, err := svc.UploadV1(ctx, &supervision_identities.RequestUpload{
+		DisplayName:     acc.UniqueName("sdkv2_acc_supervision-upload"),
+		Password:        password,
+		CertificateData: p12,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, uploaded)
+	assert.Equal(t, 201, resp.StatusCode())
+	deleteLater(uploaded.ID)
+	assert.Equal(t, created.CommonName, uploaded.CommonName)
+	acc.LogTestSuccess(t, "Uploaded supervision identity ID=%d", uploaded.ID)
+
+	// 7. Delete
+	acc.LogTestStage(t, "DeleteByIDV1", "Deleting supervision identity ID=%s", id)
+	resp, err = svc.DeleteByIDV1(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+	acc.LogTestSuccess(t, "Supervision identity ID=%s deleted", id)
+}
+
+func TestAcceptance_SupervisionIdentities_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	svc := acc.Client.JamfProAPI.SupervisionIdentities
+	ctx := context.Background()
+
+	t.Run("GetByIDV1_EmptyID", func(t *testing.T) {
+		_, _, err := svc.GetByIDV1(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "supervision identity ID is required")
+	})
+
+	t.Run("CreateV1_MissingPassword", func(t *testing.T) {
+		_, _, err := svc.CreateV1(ctx, &supervision_identities.RequestCreate{DisplayName: "x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "password is required")
+	})
+
+	t.Run("UploadV1_MissingCertificate", func(t *testing.T) {
+		_, _, err := svc.UploadV1(ctx, &supervision_identities.RequestUpload{DisplayName: "x", Password: "y"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate data is required")
+	})
+}
@@ -0,0 +1,160 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	classic_smart_user_groups "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/smart_user_groups"
+	classic_static_user_groups "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/static_user_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: Static and Smart User Groups (Jamf Pro API)
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   StaticUserGroups
+//   • ListV1(ctx) - Lists all static user groups (no pagination or RSQL)
+//   • GetByIDV1(ctx, id) - Gets a static user group by ID
+//   • GetByNameV1(ctx, name) - Finds a static user group by name
+//
+//   SmartUserGroups
+//   • RecalculateByIDV1(ctx, id) - Recalculates membership and returns the count
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 1: Full CRUD Lifecycle (fixtures via Classic API)
+//     -- Tests: TestAcceptance_StaticUserGroups_read_via_jamf_pro_api,
+//               TestAcceptance_SmartUserGroups_recalculate
+//     -- Flow: Classic Create → Jamf Pro API read/recalculate → Classic Delete
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_UserGroups_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • The Jamf Pro API has no create, update or delete endpoints for user
+//     groups, so fixtures are created and removed through ClassicAPI
+//
+// =============================================================================
+
+func TestAcceptance_StaticUserGroups_read_via_jamf_pro_api(t *testing.T) {
+	acc.RequireClient(t)
+
+	classic := acc.Client.ClassicAPI.StaticUserGroups
+	svc := acc.Client.JamfProAPI.StaticUserGroups
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "Create", "Creating static user group via Classic API")
+	groupName := acc.UniqueName("sdkv2_acc_static-usergrp")
+	created, _, err := classic.Create(ctx, &classic_static_user_groups.RequestStaticUserGroup{
+		Name: groupName,
+		Site: &models.SharedResourceSite{ID: -1, Name: "None"},
+	})
+	require.NoError(t, err)
+	require.Positive(t, created.ID)
+	groupID := strconv.Itoa(created.ID)
+
+	acc.Cleanup(t, func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, delErr := classic.DeleteByID(cleanupCtx, created.ID)
+		acc.LogCleanupDeleteError(t, "static user group", groupID, delErr)
+	})
+
+	acc.LogTestStage(t, "ListV1", "Listing static user groups")
+	groups, resp, err := svc.ListV1(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	found := false
+	for _, g := range groups {
+		if g.ID == created.ID {
+			found = true
+			assert.Equal(t, groupName, g.Name)
+		}
+	}
+	assert.True(t, found, "static user group created via Classic API should be listed")
+	acc.LogTestSuccess(t, "ListV1: %d group(s)", len(groups))
+
+	acc.LogTestStage(t, "GetByIDV1", "Getting static user group ID=%s", groupID)
+	fetched, resp, err := svc.GetByIDV1(ctx, groupID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, groupName, fetched.Name)
+
+	acc.LogTestStage(t, "GetByNameV1", "Getting static user group name=%q", groupName)
+	byName, _, err := svc.GetByNameV1(ctx, groupName)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+	acc.LogTestSuccess(t, "Static user group ID=%s read via Jamf Pro API", groupID)
+}
+
+func TestAcceptance_SmartUserGroups_recalculate(t *testing.T) {
+	acc.RequireClient(t)
+
+	classic := acc.Client.ClassicAPI.SmartUserGroups
+	svc := acc.Client.JamfProAPI.SmartUserGroups
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "Create", "Creating smart user group via Classic API")
+	created, _, err := classic.Create(ctx, &classic_smart_user_groups.RequestSmartUserGroup{
+		Name:    acc.UniqueName("sdkv2_acc_smart-usergrp"),
+		IsSmart: true,
+		Site:    &models.SharedResourceSite{ID: -1, Name: "None"},
+		Criteria: &classic_smart_user_groups.CriteriaContainer{
+			Size: 1,
+			Criterion: []models.SharedSubsetCriteria{
+				{Name: "Email Address", AndOr: "and", SearchType: "like", Value: "@example.com"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Positive(t, created.ID)
+	groupID := strconv.Itoa(created.ID)
+
+	acc.Cleanup(t, func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, delErr := classic.DeleteByID(cleanupCtx, created.ID)
+		acc.LogCleanupDeleteError(t, "smart user group", groupID, delErr)
+	})
+
+	acc.LogTestStage(t, "RecalculateByIDV1", "Recalculating smart user group ID=%s", groupID)
+	result, resp, err := svc.RecalculateByIDV1(ctx, groupID)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.GreaterOrEqual(t, result.Count, 0)
+	acc.LogTestSuccess(t, "Smart user group ID=%s has %d member(s)", groupID, result.Count)
+}
+
+func TestAcceptance_UserGroups_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+
+	ctx := context.Background()
+
+	t.Run("StaticUserGroups_GetByIDV1_EmptyID", func(t *testing.T) {
+		_, _, err := acc.Client.JamfProAPI.StaticUserGroups.GetByIDV1(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "static user group ID is required")
+	})
+
+	t.Run("StaticUserGroups_GetByNameV1_EmptyName", func(t *testing.T) {
+		_, _, err := acc.Client.JamfProAPI.StaticUserGroups.GetByNameV1(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "static user group name is required")
+	})
+
+	t.Run("SmartUserGroups_RecalculateByIDV1_EmptyID", func(t *testing.T) {
+		_, _, err := acc.Client.JamfProAPI.SmartUserGroups.RecalculateByIDV1(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "smart user group ID is required")
+	})
+}
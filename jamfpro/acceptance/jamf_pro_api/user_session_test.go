@@ -0,0 +1,89 @@
+package jamf_pro_api
+
+import (
+	"context"
+	"testing"
+
+	acc "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/acceptance"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Acceptance Tests: User Session
+// =============================================================================
+//
+// Service Operations Available
+// -----------------------------------------------------------------------------
+//   • GetCurrentUser(ctx) - Gets the account, privileges and site of the session
+//   • UpdateSession(ctx, request) - Changes the active site of the session
+//
+// Test Strategies Applied
+// -----------------------------------------------------------------------------
+//   ✓ Pattern 3: Read-Only Information
+//     -- Tests: TestAcceptance_UserSession_get_current_user
+//     -- Flow: GetCurrentUser → inspect privileges for the current site
+//
+//   ✓ Pattern 2: Round Trip
+//     -- Tests: TestAcceptance_UserSession_update_session
+//     -- Flow: GetCurrentUser → UpdateSession(same site) → verify site unchanged
+//
+//   ✓ Pattern 7: Validation Errors
+//     -- Tests: TestAcceptance_UserSession_validation_errors
+//
+// Notes
+// -----------------------------------------------------------------------------
+//   • /api/user is not available to OAuth2 API clients; tests skip in that case
+//   • UpdateSession re-applies the current site so the session is left as found
+//
+// =============================================================================
+
+func TestAcceptance_UserSession_get_current_user(t *testing.T) {
+	acc.RequireClient(t)
+	svc := acc.Client.JamfProAPI.UserSession
+	ctx := context.Background()
+
+	acc.LogTestStage(t, "Get", "Getting current session user")
+	u, resp, err := svc.GetCurrentUser(ctx)
+	if err != nil {
+		acc.LogTestWarning(t, "GET /api/user returned error (may not be supported for API client credentials): %v", err)
+		t.Skip("GET /api/user is not supported for this authentication method")
+	}
+	require.NotNil(t, u)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.NotEmpty(t, u.Username)
+
+	privileges := u.PrivilegesForSite(u.CurrentSiteID)
+	acc.LogTestSuccess(t, "User %s (%s/%s) has %d privileges on site %d",
+		u.Username, u.AccessLevel, u.PrivilegeSet, len(privileges), u.CurrentSiteID)
+}
+
+func TestAcceptance_UserSession_update_session(t *testing.T) {
+	acc.RequireClient(t)
+	svc := acc.Client.JamfProAPI.UserSession
+	ctx := context.Background()
+
+	u, _, err := svc.GetCurrentUser(ctx)
+	if err != nil {
+		t.Skip("GET /api/user is not supported for this authentication method")
+	}
+
+	acc.LogTestStage(t, "Update", "Re-applying current site %d", u.CurrentSiteID)
+	session, resp, err := svc.UpdateSession(ctx, &user_session.RequestUpdateSession{CurrentSiteID: u.CurrentSiteID})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, u.CurrentSiteID, session.CurrentSiteID)
+	acc.LogTestSuccess(t, "Session site is %d", session.CurrentSiteID)
+}
+
+func TestAcceptance_UserSession_validation_errors(t *testing.T) {
+	acc.RequireClient(t)
+	svc := acc.Client.JamfProAPI.UserSession
+
+	t.Run("UpdateSession_NilRequest", func(t *testing.T) {
+		_, _, err := svc.UpdateSession(context.Background(), nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "request is required")
+	})
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package accounts
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Accounts.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.AccountsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestAccount) (*ResourceAccount, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceAccount, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceAccount, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestAccount) (*ResourceAccount, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestAccount) (*ResourceAccount, *resty.Response, error)
+}
+
+var _ Service = (*Accounts)(nil)
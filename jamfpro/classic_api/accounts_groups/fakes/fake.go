@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the accounts_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/accounts_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// AccountsGroupsFake is a accounts_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AccountsGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *accounts_groups.RequestAccountGroup) (*accounts_groups.CreateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*accounts_groups.ResourceAccountGroup, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*accounts_groups.ResourceAccountGroup, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *accounts_groups.RequestAccountGroup) (*accounts_groups.UpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *accounts_groups.RequestAccountGroup) (*accounts_groups.UpdateResponse, *resty.Response, error)
+}
+
+var _ accounts_groups.Service = (*AccountsGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *AccountsGroupsFake) Create(ctx context.Context, req *accounts_groups.RequestAccountGroup) (*accounts_groups.CreateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("AccountsGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *AccountsGroupsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("AccountsGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *AccountsGroupsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("AccountsGroupsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *AccountsGroupsFake) GetByID(ctx context.Context, id int) (*accounts_groups.ResourceAccountGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("AccountsGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *AccountsGroupsFake) GetByName(ctx context.Context, name string) (*accounts_groups.ResourceAccountGroup, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("AccountsGroupsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *AccountsGroupsFake) UpdateByID(ctx context.Context, id int, req *accounts_groups.RequestAccountGroup) (*accounts_groups.UpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("AccountsGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *AccountsGroupsFake) UpdateByName(ctx context.Context, name string, req *accounts_groups.RequestAccountGroup) (*accounts_groups.UpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("AccountsGroupsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
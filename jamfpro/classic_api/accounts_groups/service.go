@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package accounts_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AccountsGroups.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.AccountsGroupsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestAccountGroup) (*CreateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceAccountGroup, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceAccountGroup, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestAccountGroup) (*UpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestAccountGroup) (*UpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*AccountsGroups)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the activation_code
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/activation_code"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// ActivationCodeFake is a activation_code.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ActivationCodeFake struct {
+	fakes.Journal
+
+	GetActivationCodeFunc    func(ctx context.Context) (*activation_code.ResourceActivationCode, *resty.Response, error)
+	UpdateActivationCodeFunc func(ctx context.Context, request *activation_code.RequestActivationCode) (*resty.Response, error)
+}
+
+var _ activation_code.Service = (*ActivationCodeFake)(nil)
+
+// GetActivationCode records the call and delegates to GetActivationCodeFunc.
+func (f *ActivationCodeFake) GetActivationCode(ctx context.Context) (*activation_code.ResourceActivationCode, *resty.Response, error) {
+	f.Record("GetActivationCode", ctx)
+	if f.GetActivationCodeFunc == nil {
+		return nil, nil, fakes.NotStubbed("ActivationCodeFake.GetActivationCode")
+	}
+	return f.GetActivationCodeFunc(ctx)
+}
+
+// UpdateActivationCode records the call and delegates to UpdateActivationCodeFunc.
+func (f *ActivationCodeFake) UpdateActivationCode(ctx context.Context, request *activation_code.RequestActivationCode) (*resty.Response, error) {
+	f.Record("UpdateActivationCode", ctx, request)
+	if f.UpdateActivationCodeFunc == nil {
+		return nil, fakes.NotStubbed("ActivationCodeFake.UpdateActivationCode")
+	}
+	return f.UpdateActivationCodeFunc(ctx, request)
+}
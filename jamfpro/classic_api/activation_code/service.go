@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package activation_code
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ActivationCode.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ActivationCodeFake implements it for tests.
+type Service interface {
+	GetActivationCode(ctx context.Context) (*ResourceActivationCode, *resty.Response, error)
+	UpdateActivationCode(ctx context.Context, request *RequestActivationCode) (*resty.Response, error)
+}
+
+var _ Service = (*ActivationCode)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the advanced_user_searches
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/advanced_user_searches"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// AdvancedUserSearchesFake is a advanced_user_searches.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AdvancedUserSearchesFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*advanced_user_searches.ResourceAdvancedUserSearch, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*advanced_user_searches.ResourceAdvancedUserSearch, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*advanced_user_searches.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ advanced_user_searches.Service = (*AdvancedUserSearchesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *AdvancedUserSearchesFake) Create(ctx context.Context, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *AdvancedUserSearchesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("AdvancedUserSearchesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *AdvancedUserSearchesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("AdvancedUserSearchesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *AdvancedUserSearchesFake) GetByID(ctx context.Context, id int) (*advanced_user_searches.ResourceAdvancedUserSearch, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *AdvancedUserSearchesFake) GetByName(ctx context.Context, name string) (*advanced_user_searches.ResourceAdvancedUserSearch, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *AdvancedUserSearchesFake) List(ctx context.Context) (*advanced_user_searches.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *AdvancedUserSearchesFake) UpdateByID(ctx context.Context, id int, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *AdvancedUserSearchesFake) UpdateByName(ctx context.Context, name string, req *advanced_user_searches.RequestAdvancedUserSearch) (*advanced_user_searches.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedUserSearchesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
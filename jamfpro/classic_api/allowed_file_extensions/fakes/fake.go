@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the allowed_file_extensions
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/allowed_file_extensions"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// AllowedFileExtensionsFake is a allowed_file_extensions.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AllowedFileExtensionsFake struct {
+	fakes.Journal
+
+	CreateFunc         func(ctx context.Context, req *allowed_file_extensions.RequestAllowedFileExtension) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error)
+	DeleteByIDFunc     func(ctx context.Context, id int) (*resty.Response, error)
+	GetByExtensionFunc func(ctx context.Context, extension string) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error)
+	GetByIDFunc        func(ctx context.Context, id int) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error)
+	ListFunc           func(ctx context.Context) (*allowed_file_extensions.ListResponse, *resty.Response, error)
+}
+
+var _ allowed_file_extensions.Service = (*AllowedFileExtensionsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *AllowedFileExtensionsFake) Create(ctx context.Context, req *allowed_file_extensions.RequestAllowedFileExtension) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("AllowedFileExtensionsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *AllowedFileExtensionsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("AllowedFileExtensionsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByExtension records the call and delegates to GetByExtensionFunc.
+func (f *AllowedFileExtensionsFake) GetByExtension(ctx context.Context, extension string) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error) {
+	f.Record("GetByExtension", ctx, extension)
+	if f.GetByExtensionFunc == nil {
+		return nil, nil, fakes.NotStubbed("AllowedFileExtensionsFake.GetByExtension")
+	}
+	return f.GetByExtensionFunc(ctx, extension)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *AllowedFileExtensionsFake) GetByID(ctx context.Context, id int) (*allowed_file_extensions.ResourceAllowedFileExtension, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("AllowedFileExtensionsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *AllowedFileExtensionsFake) List(ctx context.Context) (*allowed_file_extensions.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("AllowedFileExtensionsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
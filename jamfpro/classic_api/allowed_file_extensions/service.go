@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package allowed_file_extensions
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AllowedFileExtensions.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.AllowedFileExtensionsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestAllowedFileExtension) (*ResourceAllowedFileExtension, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	GetByExtension(ctx context.Context, extension string) (*ResourceAllowedFileExtension, *resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceAllowedFileExtension, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*AllowedFileExtensions)(nil)
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package classes
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Classes.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ClassesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestClass) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceClass, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceClass, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestClass) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestClass) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*Classes)(nil)
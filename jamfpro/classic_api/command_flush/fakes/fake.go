@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the command_flush
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/command_flush"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// CommandFlushFake is a command_flush.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CommandFlushFake struct {
+	fakes.Journal
+
+	FlushByIDAndStatusFunc func(ctx context.Context, idType string, id string, status string) (*resty.Response, error)
+	FlushWithXMLFunc       func(ctx context.Context, req *command_flush.RequestCommandFlush) (*resty.Response, error)
+}
+
+var _ command_flush.Service = (*CommandFlushFake)(nil)
+
+// FlushByIDAndStatus records the call and delegates to FlushByIDAndStatusFunc.
+func (f *CommandFlushFake) FlushByIDAndStatus(ctx context.Context, idType string, id string, status string) (*resty.Response, error) {
+	f.Record("FlushByIDAndStatus", ctx, idType, id, status)
+	if f.FlushByIDAndStatusFunc == nil {
+		return nil, fakes.NotStubbed("CommandFlushFake.FlushByIDAndStatus")
+	}
+	return f.FlushByIDAndStatusFunc(ctx, idType, id, status)
+}
+
+// FlushWithXML records the call and delegates to FlushWithXMLFunc.
+func (f *CommandFlushFake) FlushWithXML(ctx context.Context, req *command_flush.RequestCommandFlush) (*resty.Response, error) {
+	f.Record("FlushWithXML", ctx, req)
+	if f.FlushWithXMLFunc == nil {
+		return nil, fakes.NotStubbed("CommandFlushFake.FlushWithXML")
+	}
+	return f.FlushWithXMLFunc(ctx, req)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package command_flush
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CommandFlush.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.CommandFlushFake implements it for tests.
+type Service interface {
+	FlushByIDAndStatus(ctx context.Context, idType string, id string, status string) (*resty.Response, error)
+	FlushWithXML(ctx context.Context, req *RequestCommandFlush) (*resty.Response, error)
+}
+
+var _ Service = (*CommandFlush)(nil)
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_commands
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerCommands.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ComputerCommandsFake implements it for tests.
+type Service interface {
+	SendCommand(ctx context.Context, command string, ids ...string) (*resty.Response, error)
+}
+
+var _ Service = (*ComputerCommands)(nil)
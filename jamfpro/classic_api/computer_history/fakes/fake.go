@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_history
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/computer_history"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// ComputerHistoryFake is a computer_history.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerHistoryFake struct {
+	fakes.Journal
+
+	GetByIDFunc                    func(ctx context.Context, id string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByIDAndSubsetFunc           func(ctx context.Context, id string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByMACAddressFunc            func(ctx context.Context, macAddress string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByMACAddressAndSubsetFunc   func(ctx context.Context, macAddress string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByNameFunc                  func(ctx context.Context, name string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByNameAndSubsetFunc         func(ctx context.Context, name string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetBySerialNumberFunc          func(ctx context.Context, serialNumber string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetBySerialNumberAndSubsetFunc func(ctx context.Context, serialNumber string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByUDIDFunc                  func(ctx context.Context, udid string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+	GetByUDIDAndSubsetFunc         func(ctx context.Context, udid string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error)
+}
+
+var _ computer_history.Service = (*ComputerHistoryFake)(nil)
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *ComputerHistoryFake) GetByID(ctx context.Context, id string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDAndSubset records the call and delegates to GetByIDAndSubsetFunc.
+func (f *ComputerHistoryFake) GetByIDAndSubset(ctx context.Context, id string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByIDAndSubset", ctx, id, subset)
+	if f.GetByIDAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByIDAndSubset")
+	}
+	return f.GetByIDAndSubsetFunc(ctx, id, subset)
+}
+
+// GetByMACAddress records the call and delegates to GetByMACAddressFunc.
+func (f *ComputerHistoryFake) GetByMACAddress(ctx context.Context, macAddress string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByMACAddress", ctx, macAddress)
+	if f.GetByMACAddressFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByMACAddress")
+	}
+	return f.GetByMACAddressFunc(ctx, macAddress)
+}
+
+// GetByMACAddressAndSubset records the call and delegates to GetByMACAddressAndSubsetFunc.
+func (f *ComputerHistoryFake) GetByMACAddressAndSubset(ctx context.Context, macAddress string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByMACAddressAndSubset", ctx, macAddress, subset)
+	if f.GetByMACAddressAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByMACAddressAndSubset")
+	}
+	return f.GetByMACAddressAndSubsetFunc(ctx, macAddress, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *ComputerHistoryFake) GetByName(ctx context.Context, name string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameAndSubset records the call and delegates to GetByNameAndSubsetFunc.
+func (f *ComputerHistoryFake) GetByNameAndSubset(ctx context.Context, name string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByNameAndSubset", ctx, name, subset)
+	if f.GetByNameAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByNameAndSubset")
+	}
+	return f.GetByNameAndSubsetFunc(ctx, name, subset)
+}
+
+// GetBySerialNumber records the call and delegates to GetBySerialNumberFunc.
+func (f *ComputerHistoryFake) GetBySerialNumber(ctx context.Context, serialNumber string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetBySerialNumber", ctx, serialNumber)
+	if f.GetBySerialNumberFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetBySerialNumber")
+	}
+	return f.GetBySerialNumberFunc(ctx, serialNumber)
+}
+
+// GetBySerialNumberAndSubset records the call and delegates to GetBySerialNumberAndSubsetFunc.
+func (f *ComputerHistoryFake) GetBySerialNumberAndSubset(ctx context.Context, serialNumber string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetBySerialNumberAndSubset", ctx, serialNumber, subset)
+	if f.GetBySerialNumberAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetBySerialNumberAndSubset")
+	}
+	return f.GetBySerialNumberAndSubsetFunc(ctx, serialNumber, subset)
+}
+
+// GetByUDID records the call and delegates to GetByUDIDFunc.
+func (f *ComputerHistoryFake) GetByUDID(ctx context.Context, udid string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByUDID", ctx, udid)
+	if f.GetByUDIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByUDID")
+	}
+	return f.GetByUDIDFunc(ctx, udid)
+}
+
+// GetByUDIDAndSubset records the call and delegates to GetByUDIDAndSubsetFunc.
+func (f *ComputerHistoryFake) GetByUDIDAndSubset(ctx context.Context, udid string, subset string) (*computer_history.ResourceComputerHistory, *resty.Response, error) {
+	f.Record("GetByUDIDAndSubset", ctx, udid, subset)
+	if f.GetByUDIDAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerHistoryFake.GetByUDIDAndSubset")
+	}
+	return f.GetByUDIDAndSubsetFunc(ctx, udid, subset)
+}
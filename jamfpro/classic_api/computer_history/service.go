@@ -0,0 +1,27 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_history
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerHistory.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ComputerHistoryFake implements it for tests.
+type Service interface {
+	GetByID(ctx context.Context, id string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByIDAndSubset(ctx context.Context, id string, subset string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByMACAddress(ctx context.Context, macAddress string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByMACAddressAndSubset(ctx context.Context, macAddress string, subset string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByNameAndSubset(ctx context.Context, name string, subset string) (*ResourceComputerHistory, *resty.Response, error)
+	GetBySerialNumber(ctx context.Context, serialNumber string) (*ResourceComputerHistory, *resty.Response, error)
+	GetBySerialNumberAndSubset(ctx context.Context, serialNumber string, subset string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByUDID(ctx context.Context, udid string) (*ResourceComputerHistory, *resty.Response, error)
+	GetByUDIDAndSubset(ctx context.Context, udid string, subset string) (*ResourceComputerHistory, *resty.Response, error)
+}
+
+var _ Service = (*ComputerHistory)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_inventory_collection
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/computer_inventory_collection"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// ComputerInventoryCollectionFake is a computer_inventory_collection.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerInventoryCollectionFake struct {
+	fakes.Journal
+
+	GetFunc    func(ctx context.Context) (*computer_inventory_collection.ResourceComputerInventoryCollection, *resty.Response, error)
+	UpdateFunc func(ctx context.Context, settings *computer_inventory_collection.ResourceComputerInventoryCollection) (*resty.Response, error)
+}
+
+var _ computer_inventory_collection.Service = (*ComputerInventoryCollectionFake)(nil)
+
+// Get records the call and delegates to GetFunc.
+func (f *ComputerInventoryCollectionFake) Get(ctx context.Context) (*computer_inventory_collection.ResourceComputerInventoryCollection, *resty.Response, error) {
+	f.Record("Get", ctx)
+	if f.GetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryCollectionFake.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// Update records the call and delegates to UpdateFunc.
+func (f *ComputerInventoryCollectionFake) Update(ctx context.Context, settings *computer_inventory_collection.ResourceComputerInventoryCollection) (*resty.Response, error) {
+	f.Record("Update", ctx, settings)
+	if f.UpdateFunc == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryCollectionFake.Update")
+	}
+	return f.UpdateFunc(ctx, settings)
+}
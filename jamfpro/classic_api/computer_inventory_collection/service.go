@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_inventory_collection
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerInventoryCollection.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ComputerInventoryCollectionFake implements it for tests.
+type Service interface {
+	Get(ctx context.Context) (*ResourceComputerInventoryCollection, *resty.Response, error)
+	Update(ctx context.Context, settings *ResourceComputerInventoryCollection) (*resty.Response, error)
+}
+
+var _ Service = (*ComputerInventoryCollection)(nil)
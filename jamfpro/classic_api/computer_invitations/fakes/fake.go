@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_invitations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/computer_invitations"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// ComputerInvitationsFake is a computer_invitations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerInvitationsFake struct {
+	fakes.Journal
+
+	CreateFunc            func(ctx context.Context, req *computer_invitations.ResourceComputerInvitation) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error)
+	DeleteByIDFunc        func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc           func(ctx context.Context, id string) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error)
+	GetByInvitationIDFunc func(ctx context.Context, invitationID string) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error)
+	ListFunc              func(ctx context.Context) (*computer_invitations.ListResponse, *resty.Response, error)
+}
+
+var _ computer_invitations.Service = (*ComputerInvitationsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *ComputerInvitationsFake) Create(ctx context.Context, req *computer_invitations.ResourceComputerInvitation) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInvitationsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *ComputerInvitationsFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("ComputerInvitationsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *ComputerInvitationsFake) GetByID(ctx context.Context, id string) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInvitationsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByInvitationID records the call and delegates to GetByInvitationIDFunc.
+func (f *ComputerInvitationsFake) GetByInvitationID(ctx context.Context, invitationID string) (*computer_invitations.ResourceComputerInvitation, *resty.Response, error) {
+	f.Record("GetByInvitationID", ctx, invitationID)
+	if f.GetByInvitationIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInvitationsFake.GetByInvitationID")
+	}
+	return f.GetByInvitationIDFunc(ctx, invitationID)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *ComputerInvitationsFake) List(ctx context.Context) (*computer_invitations.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInvitationsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_invitations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerInvitations.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ComputerInvitationsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *ResourceComputerInvitation) (*ResourceComputerInvitation, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResourceComputerInvitation, *resty.Response, error)
+	GetByInvitationID(ctx context.Context, invitationID string) (*ResourceComputerInvitation, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*ComputerInvitations)(nil)
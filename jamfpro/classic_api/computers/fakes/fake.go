@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computers
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/computers"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// ComputersFake is a computers.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputersFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id string) (*computers.ResponseComputer, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*computers.ResponseComputer, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*computers.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id string, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error)
+}
+
+var _ computers.Service = (*ComputersFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *ComputersFake) Create(ctx context.Context, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error) {
+	f.Record("Create", ctx, computer)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.Create")
+	}
+	return f.CreateFunc(ctx, computer)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *ComputersFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("ComputersFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *ComputersFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("ComputersFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *ComputersFake) GetByID(ctx context.Context, id string) (*computers.ResponseComputer, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *ComputersFake) GetByName(ctx context.Context, name string) (*computers.ResponseComputer, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *ComputersFake) List(ctx context.Context) (*computers.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *ComputersFake) UpdateByID(ctx context.Context, id string, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, computer)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, computer)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *ComputersFake) UpdateByName(ctx context.Context, name string, computer *computers.ResponseComputer) (*computers.ResponseComputer, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, computer)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("ComputersFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, computer)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computers
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Computers.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.ComputersFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, computer *ResponseComputer) (*ResponseComputer, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResponseComputer, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResponseComputer, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, computer *ResponseComputer) (*ResponseComputer, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, computer *ResponseComputer) (*ResponseComputer, *resty.Response, error)
+}
+
+var _ Service = (*Computers)(nil)
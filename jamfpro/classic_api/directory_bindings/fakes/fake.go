@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the directory_bindings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/directory_bindings"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// DirectoryBindingsFake is a directory_bindings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DirectoryBindingsFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*directory_bindings.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error)
+}
+
+var _ directory_bindings.Service = (*DirectoryBindingsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *DirectoryBindingsFake) Create(ctx context.Context, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *DirectoryBindingsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("DirectoryBindingsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *DirectoryBindingsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("DirectoryBindingsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *DirectoryBindingsFake) GetByID(ctx context.Context, id int) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *DirectoryBindingsFake) GetByName(ctx context.Context, name string) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *DirectoryBindingsFake) List(ctx context.Context) (*directory_bindings.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *DirectoryBindingsFake) UpdateByID(ctx context.Context, id int, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *DirectoryBindingsFake) UpdateByName(ctx context.Context, name string, req *directory_bindings.RequestDirectoryBinding) (*directory_bindings.ResourceDirectoryBinding, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("DirectoryBindingsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package directory_bindings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DirectoryBindings.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.DirectoryBindingsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestDirectoryBinding) (*ResourceDirectoryBinding, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceDirectoryBinding, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceDirectoryBinding, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestDirectoryBinding) (*ResourceDirectoryBinding, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestDirectoryBinding) (*ResourceDirectoryBinding, *resty.Response, error)
+}
+
+var _ Service = (*DirectoryBindings)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the disk_encryption_configurations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/disk_encryption_configurations"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// DiskEncryptionConfigurationsFake is a disk_encryption_configurations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DiskEncryptionConfigurationsFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*disk_encryption_configurations.ResourceDiskEncryptionConfiguration, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*disk_encryption_configurations.ResourceDiskEncryptionConfiguration, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*disk_encryption_configurations.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ disk_encryption_configurations.Service = (*DiskEncryptionConfigurationsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *DiskEncryptionConfigurationsFake) Create(ctx context.Context, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *DiskEncryptionConfigurationsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *DiskEncryptionConfigurationsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *DiskEncryptionConfigurationsFake) GetByID(ctx context.Context, id int) (*disk_encryption_configurations.ResourceDiskEncryptionConfiguration, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *DiskEncryptionConfigurationsFake) GetByName(ctx context.Context, name string) (*disk_encryption_configurations.ResourceDiskEncryptionConfiguration, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *DiskEncryptionConfigurationsFake) List(ctx context.Context) (*disk_encryption_configurations.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *DiskEncryptionConfigurationsFake) UpdateByID(ctx context.Context, id int, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *DiskEncryptionConfigurationsFake) UpdateByName(ctx context.Context, name string, req *disk_encryption_configurations.RequestDiskEncryptionConfiguration) (*disk_encryption_configurations.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("DiskEncryptionConfigurationsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package disk_encryption_configurations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DiskEncryptionConfigurations.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.DiskEncryptionConfigurationsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestDiskEncryptionConfiguration) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceDiskEncryptionConfiguration, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceDiskEncryptionConfiguration, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestDiskEncryptionConfiguration) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestDiskEncryptionConfiguration) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*DiskEncryptionConfigurations)(nil)
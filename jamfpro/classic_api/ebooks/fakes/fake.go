@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the ebooks
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/ebooks"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// EbooksFake is a ebooks.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EbooksFake struct {
+	fakes.Journal
+
+	CreateFunc             func(ctx context.Context, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc         func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc       func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc            func(ctx context.Context, id int) (*ebooks.Resource, *resty.Response, error)
+	GetByNameFunc          func(ctx context.Context, name string) (*ebooks.Resource, *resty.Response, error)
+	GetByNameAndSubsetFunc func(ctx context.Context, name string, subset string) (*ebooks.Resource, *resty.Response, error)
+	ListFunc               func(ctx context.Context) (*ebooks.ListResponse, *resty.Response, error)
+	UpdateByIDFunc         func(ctx context.Context, id int, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc       func(ctx context.Context, name string, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ ebooks.Service = (*EbooksFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *EbooksFake) Create(ctx context.Context, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *EbooksFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("EbooksFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *EbooksFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("EbooksFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *EbooksFake) GetByID(ctx context.Context, id int) (*ebooks.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *EbooksFake) GetByName(ctx context.Context, name string) (*ebooks.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameAndSubset records the call and delegates to GetByNameAndSubsetFunc.
+func (f *EbooksFake) GetByNameAndSubset(ctx context.Context, name string, subset string) (*ebooks.Resource, *resty.Response, error) {
+	f.Record("GetByNameAndSubset", ctx, name, subset)
+	if f.GetByNameAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.GetByNameAndSubset")
+	}
+	return f.GetByNameAndSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *EbooksFake) List(ctx context.Context) (*ebooks.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *EbooksFake) UpdateByID(ctx context.Context, id int, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *EbooksFake) UpdateByName(ctx context.Context, name string, req *ebooks.Resource) (*ebooks.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
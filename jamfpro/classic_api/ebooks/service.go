@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package ebooks
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Ebooks.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.EbooksFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*Resource, *resty.Response, error)
+	GetByNameAndSubset(ctx context.Context, name string, subset string) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*Ebooks)(nil)
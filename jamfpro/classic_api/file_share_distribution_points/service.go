@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package file_share_distribution_points
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of FileShareDistributionPoints.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.FileShareDistributionPointsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestFileShareDistributionPoint) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceFileShareDistributionPoint, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceFileShareDistributionPoint, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestFileShareDistributionPoint) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestFileShareDistributionPoint) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*FileShareDistributionPoints)(nil)
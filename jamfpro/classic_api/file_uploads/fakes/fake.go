@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the file_uploads
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/file_uploads"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// FileUploadsFake is a file_uploads.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type FileUploadsFake struct {
+	fakes.Journal
+
+	CreateAttachmentFunc func(ctx context.Context, resource string, idType file_uploads.ResourceIDType, identifier string, filePath string, forceIpaUpload bool) (*resty.Response, error)
+}
+
+var _ file_uploads.Service = (*FileUploadsFake)(nil)
+
+// CreateAttachment records the call and delegates to CreateAttachmentFunc.
+func (f *FileUploadsFake) CreateAttachment(ctx context.Context, resource string, idType file_uploads.ResourceIDType, identifier string, filePath string, forceIpaUpload bool) (*resty.Response, error) {
+	f.Record("CreateAttachment", ctx, resource, idType, identifier, filePath, forceIpaUpload)
+	if f.CreateAttachmentFunc == nil {
+		return nil, fakes.NotStubbed("FileUploadsFake.CreateAttachment")
+	}
+	return f.CreateAttachmentFunc(ctx, resource, idType, identifier, filePath, forceIpaUpload)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package file_uploads
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of FileUploads.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.FileUploadsFake implements it for tests.
+type Service interface {
+	CreateAttachment(ctx context.Context, resource string, idType ResourceIDType, identifier string, filePath string, forceIpaUpload bool) (*resty.Response, error)
+}
+
+var _ Service = (*FileUploads)(nil)
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package ibeacons
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Ibeacons.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.IbeaconsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestIBeacon) (*ResourceIBeacon, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceIBeacon, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceIBeacon, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestIBeacon) (*ResourceIBeacon, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestIBeacon) (*ResourceIBeacon, *resty.Response, error)
+}
+
+var _ Service = (*Ibeacons)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the ldap_servers
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/ldap_servers"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// LdapServersFake is a ldap_servers.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LdapServersFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ListItem, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*ldap_servers.ResourceLDAPServer, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*ldap_servers.ResourceLDAPServer, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*ldap_servers.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ResourceLDAPServer, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ResourceLDAPServer, *resty.Response, error)
+}
+
+var _ ldap_servers.Service = (*LdapServersFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *LdapServersFake) Create(ctx context.Context, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ListItem, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *LdapServersFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("LdapServersFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *LdapServersFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("LdapServersFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *LdapServersFake) GetByID(ctx context.Context, id int) (*ldap_servers.ResourceLDAPServer, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *LdapServersFake) GetByName(ctx context.Context, name string) (*ldap_servers.ResourceLDAPServer, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *LdapServersFake) List(ctx context.Context) (*ldap_servers.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *LdapServersFake) UpdateByID(ctx context.Context, id int, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ResourceLDAPServer, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *LdapServersFake) UpdateByName(ctx context.Context, name string, request *ldap_servers.RequestLDAPServer) (*ldap_servers.ResourceLDAPServer, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, request)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("LdapServersFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, request)
+}
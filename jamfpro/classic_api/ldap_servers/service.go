@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package ldap_servers
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LdapServers.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.LdapServersFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *RequestLDAPServer) (*ListItem, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceLDAPServer, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceLDAPServer, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, request *RequestLDAPServer) (*ResourceLDAPServer, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, request *RequestLDAPServer) (*ResourceLDAPServer, *resty.Response, error)
+}
+
+var _ Service = (*LdapServers)(nil)
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package licensed_software
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LicensedSoftware.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.LicensedSoftwareFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*LicensedSoftware)(nil)
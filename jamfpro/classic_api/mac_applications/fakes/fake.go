@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mac_applications
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mac_applications"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MacApplicationsFake is a mac_applications.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MacApplicationsFake struct {
+	fakes.Journal
+
+	CreateFunc             func(ctx context.Context, req *mac_applications.Resource) (*mac_applications.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc         func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc       func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc            func(ctx context.Context, id int) (*mac_applications.Resource, *resty.Response, error)
+	GetByIDAndSubsetFunc   func(ctx context.Context, id int, subset string) (*mac_applications.Resource, *resty.Response, error)
+	GetByNameFunc          func(ctx context.Context, name string) (*mac_applications.Resource, *resty.Response, error)
+	GetByNameAndSubsetFunc func(ctx context.Context, name string, subset string) (*mac_applications.Resource, *resty.Response, error)
+	ListFunc               func(ctx context.Context) (*mac_applications.ListResponse, *resty.Response, error)
+	UpdateByIDFunc         func(ctx context.Context, id int, req *mac_applications.Resource) (*mac_applications.Resource, *resty.Response, error)
+	UpdateByNameFunc       func(ctx context.Context, name string, req *mac_applications.Resource) (*mac_applications.Resource, *resty.Response, error)
+}
+
+var _ mac_applications.Service = (*MacApplicationsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MacApplicationsFake) Create(ctx context.Context, req *mac_applications.Resource) (*mac_applications.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MacApplicationsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MacApplicationsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MacApplicationsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MacApplicationsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MacApplicationsFake) GetByID(ctx context.Context, id int) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDAndSubset records the call and delegates to GetByIDAndSubsetFunc.
+func (f *MacApplicationsFake) GetByIDAndSubset(ctx context.Context, id int, subset string) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("GetByIDAndSubset", ctx, id, subset)
+	if f.GetByIDAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.GetByIDAndSubset")
+	}
+	return f.GetByIDAndSubsetFunc(ctx, id, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MacApplicationsFake) GetByName(ctx context.Context, name string) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameAndSubset records the call and delegates to GetByNameAndSubsetFunc.
+func (f *MacApplicationsFake) GetByNameAndSubset(ctx context.Context, name string, subset string) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("GetByNameAndSubset", ctx, name, subset)
+	if f.GetByNameAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.GetByNameAndSubset")
+	}
+	return f.GetByNameAndSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MacApplicationsFake) List(ctx context.Context) (*mac_applications.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MacApplicationsFake) UpdateByID(ctx context.Context, id int, req *mac_applications.Resource) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MacApplicationsFake) UpdateByName(ctx context.Context, name string, req *mac_applications.Resource) (*mac_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacApplicationsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
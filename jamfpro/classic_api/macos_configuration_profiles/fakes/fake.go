@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the macos_configuration_profiles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/macos_configuration_profiles"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MacosConfigurationProfilesFake is a macos_configuration_profiles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MacosConfigurationProfilesFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*macos_configuration_profiles.Resource, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*macos_configuration_profiles.Resource, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*macos_configuration_profiles.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ macos_configuration_profiles.Service = (*MacosConfigurationProfilesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MacosConfigurationProfilesFake) Create(ctx context.Context, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MacosConfigurationProfilesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MacosConfigurationProfilesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MacosConfigurationProfilesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MacosConfigurationProfilesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MacosConfigurationProfilesFake) GetByID(ctx context.Context, id int) (*macos_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MacosConfigurationProfilesFake) GetByName(ctx context.Context, name string) (*macos_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MacosConfigurationProfilesFake) List(ctx context.Context) (*macos_configuration_profiles.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MacosConfigurationProfilesFake) UpdateByID(ctx context.Context, id int, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MacosConfigurationProfilesFake) UpdateByName(ctx context.Context, name string, req *macos_configuration_profiles.RequestResource) (*macos_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,183 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_applications
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_applications"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceApplicationsFake is a mobile_device_applications.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceApplicationsFake struct {
+	fakes.Journal
+
+	CreateFunc                     func(ctx context.Context, req *mobile_device_applications.Resource) (*mobile_device_applications.CreateUpdateResponse, *resty.Response, error)
+	DeleteByBundleIDFunc           func(ctx context.Context, bundleID string) (*resty.Response, error)
+	DeleteByBundleIDAndVersionFunc func(ctx context.Context, bundleID string, version string) (*resty.Response, error)
+	DeleteByIDFunc                 func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc               func(ctx context.Context, name string) (*resty.Response, error)
+	GetByBundleIDFunc              func(ctx context.Context, bundleID string) (*mobile_device_applications.Resource, *resty.Response, error)
+	GetByBundleIDAndVersionFunc    func(ctx context.Context, bundleID string, version string) (*mobile_device_applications.Resource, *resty.Response, error)
+	GetByIDFunc                    func(ctx context.Context, id int) (*mobile_device_applications.Resource, *resty.Response, error)
+	GetByIDAndSubsetFunc           func(ctx context.Context, id int, subset string) (*mobile_device_applications.Resource, *resty.Response, error)
+	GetByNameFunc                  func(ctx context.Context, name string) (*mobile_device_applications.Resource, *resty.Response, error)
+	GetByNameAndSubsetFunc         func(ctx context.Context, name string, subset string) (*mobile_device_applications.Resource, *resty.Response, error)
+	ListFunc                       func(ctx context.Context) (*mobile_device_applications.ListResponse, *resty.Response, error)
+	UpdateByBundleIDFunc           func(ctx context.Context, bundleID string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error)
+	UpdateByIDFunc                 func(ctx context.Context, id int, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error)
+	UpdateByIDAndVersionFunc       func(ctx context.Context, id int, version string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error)
+	UpdateByNameFunc               func(ctx context.Context, name string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error)
+}
+
+var _ mobile_device_applications.Service = (*MobileDeviceApplicationsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MobileDeviceApplicationsFake) Create(ctx context.Context, req *mobile_device_applications.Resource) (*mobile_device_applications.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByBundleID records the call and delegates to DeleteByBundleIDFunc.
+func (f *MobileDeviceApplicationsFake) DeleteByBundleID(ctx context.Context, bundleID string) (*resty.Response, error) {
+	f.Record("DeleteByBundleID", ctx, bundleID)
+	if f.DeleteByBundleIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceApplicationsFake.DeleteByBundleID")
+	}
+	return f.DeleteByBundleIDFunc(ctx, bundleID)
+}
+
+// DeleteByBundleIDAndVersion records the call and delegates to DeleteByBundleIDAndVersionFunc.
+func (f *MobileDeviceApplicationsFake) DeleteByBundleIDAndVersion(ctx context.Context, bundleID string, version string) (*resty.Response, error) {
+	f.Record("DeleteByBundleIDAndVersion", ctx, bundleID, version)
+	if f.DeleteByBundleIDAndVersionFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceApplicationsFake.DeleteByBundleIDAndVersion")
+	}
+	return f.DeleteByBundleIDAndVersionFunc(ctx, bundleID, version)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDeviceApplicationsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceApplicationsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDeviceApplicationsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceApplicationsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByBundleID records the call and delegates to GetByBundleIDFunc.
+func (f *MobileDeviceApplicationsFake) GetByBundleID(ctx context.Context, bundleID string) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByBundleID", ctx, bundleID)
+	if f.GetByBundleIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByBundleID")
+	}
+	return f.GetByBundleIDFunc(ctx, bundleID)
+}
+
+// GetByBundleIDAndVersion records the call and delegates to GetByBundleIDAndVersionFunc.
+func (f *MobileDeviceApplicationsFake) GetByBundleIDAndVersion(ctx context.Context, bundleID string, version string) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByBundleIDAndVersion", ctx, bundleID, version)
+	if f.GetByBundleIDAndVersionFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByBundleIDAndVersion")
+	}
+	return f.GetByBundleIDAndVersionFunc(ctx, bundleID, version)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDeviceApplicationsFake) GetByID(ctx context.Context, id int) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDAndSubset records the call and delegates to GetByIDAndSubsetFunc.
+func (f *MobileDeviceApplicationsFake) GetByIDAndSubset(ctx context.Context, id int, subset string) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByIDAndSubset", ctx, id, subset)
+	if f.GetByIDAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByIDAndSubset")
+	}
+	return f.GetByIDAndSubsetFunc(ctx, id, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDeviceApplicationsFake) GetByName(ctx context.Context, name string) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameAndSubset records the call and delegates to GetByNameAndSubsetFunc.
+func (f *MobileDeviceApplicationsFake) GetByNameAndSubset(ctx context.Context, name string, subset string) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("GetByNameAndSubset", ctx, name, subset)
+	if f.GetByNameAndSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.GetByNameAndSubset")
+	}
+	return f.GetByNameAndSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDeviceApplicationsFake) List(ctx context.Context) (*mobile_device_applications.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByBundleID records the call and delegates to UpdateByBundleIDFunc.
+func (f *MobileDeviceApplicationsFake) UpdateByBundleID(ctx context.Context, bundleID string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByBundleID", ctx, bundleID, req)
+	if f.UpdateByBundleIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.UpdateByBundleID")
+	}
+	return f.UpdateByBundleIDFunc(ctx, bundleID, req)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDeviceApplicationsFake) UpdateByID(ctx context.Context, id int, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByIDAndVersion records the call and delegates to UpdateByIDAndVersionFunc.
+func (f *MobileDeviceApplicationsFake) UpdateByIDAndVersion(ctx context.Context, id int, version string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByIDAndVersion", ctx, id, version, req)
+	if f.UpdateByIDAndVersionFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.UpdateByIDAndVersion")
+	}
+	return f.UpdateByIDAndVersionFunc(ctx, id, version, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDeviceApplicationsFake) UpdateByName(ctx context.Context, name string, req *mobile_device_applications.Resource) (*mobile_device_applications.Resource, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceApplicationsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_applications
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceApplications.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDeviceApplicationsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByBundleID(ctx context.Context, bundleID string) (*resty.Response, error)
+	DeleteByBundleIDAndVersion(ctx context.Context, bundleID string, version string) (*resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByBundleID(ctx context.Context, bundleID string) (*Resource, *resty.Response, error)
+	GetByBundleIDAndVersion(ctx context.Context, bundleID string, version string) (*Resource, *resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	GetByIDAndSubset(ctx context.Context, id int, subset string) (*Resource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*Resource, *resty.Response, error)
+	GetByNameAndSubset(ctx context.Context, name string, subset string) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByBundleID(ctx context.Context, bundleID string, req *Resource) (*Resource, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *Resource) (*Resource, *resty.Response, error)
+	UpdateByIDAndVersion(ctx context.Context, id int, version string, req *Resource) (*Resource, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *Resource) (*Resource, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceApplications)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_commands
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_commands"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceCommandsFake is a mobile_device_commands.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceCommandsFake struct {
+	fakes.Journal
+
+	SendCommandFunc func(ctx context.Context, command string, ids ...string) (*resty.Response, error)
+}
+
+var _ mobile_device_commands.Service = (*MobileDeviceCommandsFake)(nil)
+
+// SendCommand records the call and delegates to SendCommandFunc.
+func (f *MobileDeviceCommandsFake) SendCommand(ctx context.Context, command string, ids ...string) (*resty.Response, error) {
+	f.Record("SendCommand", ctx, command, ids)
+	if f.SendCommandFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceCommandsFake.SendCommand")
+	}
+	return f.SendCommandFunc(ctx, command, ids...)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_commands
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceCommands.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDeviceCommandsFake implements it for tests.
+type Service interface {
+	SendCommand(ctx context.Context, command string, ids ...string) (*resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceCommands)(nil)
@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_configuration_profiles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_configuration_profiles"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceConfigurationProfilesFake is a mobile_device_configuration_profiles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceConfigurationProfilesFake struct {
+	fakes.Journal
+
+	CreateFunc              func(ctx context.Context, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc          func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc        func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc             func(ctx context.Context, id int) (*mobile_device_configuration_profiles.Resource, *resty.Response, error)
+	GetByIDWithSubsetFunc   func(ctx context.Context, id int, subset string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error)
+	GetByNameFunc           func(ctx context.Context, name string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error)
+	GetByNameWithSubsetFunc func(ctx context.Context, name string, subset string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error)
+	ListFunc                func(ctx context.Context) (*mobile_device_configuration_profiles.ListResponse, *resty.Response, error)
+	UpdateByIDFunc          func(ctx context.Context, id int, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc        func(ctx context.Context, name string, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ mobile_device_configuration_profiles.Service = (*MobileDeviceConfigurationProfilesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MobileDeviceConfigurationProfilesFake) Create(ctx context.Context, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDeviceConfigurationProfilesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDeviceConfigurationProfilesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDeviceConfigurationProfilesFake) GetByID(ctx context.Context, id int) (*mobile_device_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDWithSubset records the call and delegates to GetByIDWithSubsetFunc.
+func (f *MobileDeviceConfigurationProfilesFake) GetByIDWithSubset(ctx context.Context, id int, subset string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByIDWithSubset", ctx, id, subset)
+	if f.GetByIDWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.GetByIDWithSubset")
+	}
+	return f.GetByIDWithSubsetFunc(ctx, id, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDeviceConfigurationProfilesFake) GetByName(ctx context.Context, name string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameWithSubset records the call and delegates to GetByNameWithSubsetFunc.
+func (f *MobileDeviceConfigurationProfilesFake) GetByNameWithSubset(ctx context.Context, name string, subset string) (*mobile_device_configuration_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByNameWithSubset", ctx, name, subset)
+	if f.GetByNameWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.GetByNameWithSubset")
+	}
+	return f.GetByNameWithSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDeviceConfigurationProfilesFake) List(ctx context.Context) (*mobile_device_configuration_profiles.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDeviceConfigurationProfilesFake) UpdateByID(ctx context.Context, id int, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDeviceConfigurationProfilesFake) UpdateByName(ctx context.Context, name string, req *mobile_device_configuration_profiles.RequestResource) (*mobile_device_configuration_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceConfigurationProfilesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,153 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_enrollment_profiles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_enrollment_profiles"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceEnrollmentProfilesFake is a mobile_device_enrollment_profiles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceEnrollmentProfilesFake struct {
+	fakes.Journal
+
+	CreateFunc              func(ctx context.Context, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	DeleteByIDFunc          func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByInvitationFunc  func(ctx context.Context, invitation string) (*resty.Response, error)
+	DeleteByNameFunc        func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc             func(ctx context.Context, id int) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	GetByIDWithSubsetFunc   func(ctx context.Context, id int, subset string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	GetByInvitationFunc     func(ctx context.Context, invitation string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	GetByNameFunc           func(ctx context.Context, name string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	GetByNameWithSubsetFunc func(ctx context.Context, name string, subset string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	ListFunc                func(ctx context.Context) (*mobile_device_enrollment_profiles.ListResponse, *resty.Response, error)
+	UpdateByIDFunc          func(ctx context.Context, id int, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	UpdateByInvitationFunc  func(ctx context.Context, invitation string, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+	UpdateByNameFunc        func(ctx context.Context, name string, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error)
+}
+
+var _ mobile_device_enrollment_profiles.Service = (*MobileDeviceEnrollmentProfilesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) Create(ctx context.Context, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByInvitation records the call and delegates to DeleteByInvitationFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) DeleteByInvitation(ctx context.Context, invitation string) (*resty.Response, error) {
+	f.Record("DeleteByInvitation", ctx, invitation)
+	if f.DeleteByInvitationFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.DeleteByInvitation")
+	}
+	return f.DeleteByInvitationFunc(ctx, invitation)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) GetByID(ctx context.Context, id int) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDWithSubset records the call and delegates to GetByIDWithSubsetFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) GetByIDWithSubset(ctx context.Context, id int, subset string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByIDWithSubset", ctx, id, subset)
+	if f.GetByIDWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.GetByIDWithSubset")
+	}
+	return f.GetByIDWithSubsetFunc(ctx, id, subset)
+}
+
+// GetByInvitation records the call and delegates to GetByInvitationFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) GetByInvitation(ctx context.Context, invitation string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByInvitation", ctx, invitation)
+	if f.GetByInvitationFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.GetByInvitation")
+	}
+	return f.GetByInvitationFunc(ctx, invitation)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) GetByName(ctx context.Context, name string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameWithSubset records the call and delegates to GetByNameWithSubsetFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) GetByNameWithSubset(ctx context.Context, name string, subset string) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByNameWithSubset", ctx, name, subset)
+	if f.GetByNameWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.GetByNameWithSubset")
+	}
+	return f.GetByNameWithSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) List(ctx context.Context) (*mobile_device_enrollment_profiles.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) UpdateByID(ctx context.Context, id int, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByInvitation records the call and delegates to UpdateByInvitationFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) UpdateByInvitation(ctx context.Context, invitation string, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("UpdateByInvitation", ctx, invitation, req)
+	if f.UpdateByInvitationFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.UpdateByInvitation")
+	}
+	return f.UpdateByInvitationFunc(ctx, invitation, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDeviceEnrollmentProfilesFake) UpdateByName(ctx context.Context, name string, req *mobile_device_enrollment_profiles.Resource) (*mobile_device_enrollment_profiles.Resource, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfilesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,30 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_enrollment_profiles
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceEnrollmentProfiles.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDeviceEnrollmentProfilesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *Resource) (*Resource, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByInvitation(ctx context.Context, invitation string) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	GetByIDWithSubset(ctx context.Context, id int, subset string) (*Resource, *resty.Response, error)
+	GetByInvitation(ctx context.Context, invitation string) (*Resource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*Resource, *resty.Response, error)
+	GetByNameWithSubset(ctx context.Context, name string, subset string) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *Resource) (*Resource, *resty.Response, error)
+	UpdateByInvitation(ctx context.Context, invitation string, req *Resource) (*Resource, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *Resource) (*Resource, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceEnrollmentProfiles)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceGroupsFake is a mobile_device_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*mobile_device_groups.ResourceMobileDeviceGroup, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*mobile_device_groups.ResourceMobileDeviceGroup, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*mobile_device_groups.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ mobile_device_groups.Service = (*MobileDeviceGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MobileDeviceGroupsFake) Create(ctx context.Context, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDeviceGroupsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDeviceGroupsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDeviceGroupsFake) GetByID(ctx context.Context, id int) (*mobile_device_groups.ResourceMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDeviceGroupsFake) GetByName(ctx context.Context, name string) (*mobile_device_groups.ResourceMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDeviceGroupsFake) List(ctx context.Context) (*mobile_device_groups.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDeviceGroupsFake) UpdateByID(ctx context.Context, id int, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDeviceGroupsFake) UpdateByName(ctx context.Context, name string, req *mobile_device_groups.RequestMobileDeviceGroup) (*mobile_device_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
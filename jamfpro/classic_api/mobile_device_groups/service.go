@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceGroups.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDeviceGroupsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestMobileDeviceGroup) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceMobileDeviceGroup, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceMobileDeviceGroup, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestMobileDeviceGroup) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestMobileDeviceGroup) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceGroups)(nil)
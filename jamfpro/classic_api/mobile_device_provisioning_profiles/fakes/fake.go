@@ -0,0 +1,153 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_provisioning_profiles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_device_provisioning_profiles"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceProvisioningProfilesFake is a mobile_device_provisioning_profiles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceProvisioningProfilesFake struct {
+	fakes.Journal
+
+	CreateByIDFunc   func(ctx context.Context, id int, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+	CreateByNameFunc func(ctx context.Context, name string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+	CreateByUUIDFunc func(ctx context.Context, uuid string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	DeleteByUUIDFunc func(ctx context.Context, uuid string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error)
+	GetByUUIDFunc    func(ctx context.Context, uuid string) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*mobile_device_provisioning_profiles.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+	UpdateByUUIDFunc func(ctx context.Context, uuid string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ mobile_device_provisioning_profiles.Service = (*MobileDeviceProvisioningProfilesFake)(nil)
+
+// CreateByID records the call and delegates to CreateByIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) CreateByID(ctx context.Context, id int, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("CreateByID", ctx, id, req)
+	if f.CreateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.CreateByID")
+	}
+	return f.CreateByIDFunc(ctx, id, req)
+}
+
+// CreateByName records the call and delegates to CreateByNameFunc.
+func (f *MobileDeviceProvisioningProfilesFake) CreateByName(ctx context.Context, name string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("CreateByName", ctx, name, req)
+	if f.CreateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.CreateByName")
+	}
+	return f.CreateByNameFunc(ctx, name, req)
+}
+
+// CreateByUUID records the call and delegates to CreateByUUIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) CreateByUUID(ctx context.Context, uuid string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("CreateByUUID", ctx, uuid, req)
+	if f.CreateByUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.CreateByUUID")
+	}
+	return f.CreateByUUIDFunc(ctx, uuid, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDeviceProvisioningProfilesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// DeleteByUUID records the call and delegates to DeleteByUUIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) DeleteByUUID(ctx context.Context, uuid string) (*resty.Response, error) {
+	f.Record("DeleteByUUID", ctx, uuid)
+	if f.DeleteByUUIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.DeleteByUUID")
+	}
+	return f.DeleteByUUIDFunc(ctx, uuid)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) GetByID(ctx context.Context, id int) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDeviceProvisioningProfilesFake) GetByName(ctx context.Context, name string) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByUUID records the call and delegates to GetByUUIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) GetByUUID(ctx context.Context, uuid string) (*mobile_device_provisioning_profiles.Resource, *resty.Response, error) {
+	f.Record("GetByUUID", ctx, uuid)
+	if f.GetByUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.GetByUUID")
+	}
+	return f.GetByUUIDFunc(ctx, uuid)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDeviceProvisioningProfilesFake) List(ctx context.Context) (*mobile_device_provisioning_profiles.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) UpdateByID(ctx context.Context, id int, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDeviceProvisioningProfilesFake) UpdateByName(ctx context.Context, name string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
+
+// UpdateByUUID records the call and delegates to UpdateByUUIDFunc.
+func (f *MobileDeviceProvisioningProfilesFake) UpdateByUUID(ctx context.Context, uuid string, req *mobile_device_provisioning_profiles.RequestResource) (*mobile_device_provisioning_profiles.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByUUID", ctx, uuid, req)
+	if f.UpdateByUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceProvisioningProfilesFake.UpdateByUUID")
+	}
+	return f.UpdateByUUIDFunc(ctx, uuid, req)
+}
@@ -0,0 +1,30 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_provisioning_profiles
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceProvisioningProfiles.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDeviceProvisioningProfilesFake implements it for tests.
+type Service interface {
+	CreateByID(ctx context.Context, id int, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+	CreateByName(ctx context.Context, name string, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+	CreateByUUID(ctx context.Context, uuid string, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	DeleteByUUID(ctx context.Context, uuid string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*Resource, *resty.Response, error)
+	GetByUUID(ctx context.Context, uuid string) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByUUID(ctx context.Context, uuid string, req *RequestResource) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceProvisioningProfiles)(nil)
@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_devices
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/mobile_devices"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// MobileDevicesFake is a mobile_devices.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDevicesFake struct {
+	fakes.Journal
+
+	CreateFunc                 func(ctx context.Context, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	DeleteByIDFunc             func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameFunc           func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc                func(ctx context.Context, id string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	GetByIDAndDataSubsetFunc   func(ctx context.Context, id string, subset string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	GetByNameFunc              func(ctx context.Context, name string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	GetByNameAndDataSubsetFunc func(ctx context.Context, name string, subset string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	ListFunc                   func(ctx context.Context) (*mobile_devices.ListResponse, *resty.Response, error)
+	UpdateByIDFunc             func(ctx context.Context, id string, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+	UpdateByNameFunc           func(ctx context.Context, name string, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error)
+}
+
+var _ mobile_devices.Service = (*MobileDevicesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MobileDevicesFake) Create(ctx context.Context, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("Create", ctx, device)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.Create")
+	}
+	return f.CreateFunc(ctx, device)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *MobileDevicesFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("MobileDevicesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *MobileDevicesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("MobileDevicesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *MobileDevicesFake) GetByID(ctx context.Context, id string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDAndDataSubset records the call and delegates to GetByIDAndDataSubsetFunc.
+func (f *MobileDevicesFake) GetByIDAndDataSubset(ctx context.Context, id string, subset string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("GetByIDAndDataSubset", ctx, id, subset)
+	if f.GetByIDAndDataSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetByIDAndDataSubset")
+	}
+	return f.GetByIDAndDataSubsetFunc(ctx, id, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *MobileDevicesFake) GetByName(ctx context.Context, name string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameAndDataSubset records the call and delegates to GetByNameAndDataSubsetFunc.
+func (f *MobileDevicesFake) GetByNameAndDataSubset(ctx context.Context, name string, subset string) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("GetByNameAndDataSubset", ctx, name, subset)
+	if f.GetByNameAndDataSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetByNameAndDataSubset")
+	}
+	return f.GetByNameAndDataSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *MobileDevicesFake) List(ctx context.Context) (*mobile_devices.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *MobileDevicesFake) UpdateByID(ctx context.Context, id string, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, device)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, device)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *MobileDevicesFake) UpdateByName(ctx context.Context, name string, device *mobile_devices.ResponseMobileDevice) (*mobile_devices.ResponseMobileDevice, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, device)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, device)
+}
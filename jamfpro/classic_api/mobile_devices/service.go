@@ -0,0 +1,27 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_devices
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDevices.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.MobileDevicesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, device *ResponseMobileDevice) (*ResponseMobileDevice, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResponseMobileDevice, *resty.Response, error)
+	GetByIDAndDataSubset(ctx context.Context, id string, subset string) (*ResponseMobileDevice, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResponseMobileDevice, *resty.Response, error)
+	GetByNameAndDataSubset(ctx context.Context, name string, subset string) (*ResponseMobileDevice, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, device *ResponseMobileDevice) (*ResponseMobileDevice, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, device *ResponseMobileDevice) (*ResponseMobileDevice, *resty.Response, error)
+}
+
+var _ Service = (*MobileDevices)(nil)
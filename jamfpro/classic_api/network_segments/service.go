@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package network_segments
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of NetworkSegments.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.NetworkSegmentsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestNetworkSegment) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceNetworkSegment, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceNetworkSegment, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestNetworkSegment) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestNetworkSegment) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*NetworkSegments)(nil)
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the patch_external_sources
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/patch_external_sources"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// PatchExternalSourcesFake is a patch_external_sources.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PatchExternalSourcesFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*patch_external_sources.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error)
+}
+
+var _ patch_external_sources.Service = (*PatchExternalSourcesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *PatchExternalSourcesFake) Create(ctx context.Context, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *PatchExternalSourcesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("PatchExternalSourcesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *PatchExternalSourcesFake) GetByID(ctx context.Context, id int) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *PatchExternalSourcesFake) GetByName(ctx context.Context, name string) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *PatchExternalSourcesFake) List(ctx context.Context) (*patch_external_sources.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *PatchExternalSourcesFake) UpdateByID(ctx context.Context, id int, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *PatchExternalSourcesFake) UpdateByName(ctx context.Context, name string, req *patch_external_sources.RequestPatchExternalSource) (*patch_external_sources.ResourcePatchExternalSource, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("PatchExternalSourcesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
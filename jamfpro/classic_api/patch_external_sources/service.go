@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package patch_external_sources
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PatchExternalSources.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.PatchExternalSourcesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestPatchExternalSource) (*ResourcePatchExternalSource, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourcePatchExternalSource, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourcePatchExternalSource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestPatchExternalSource) (*ResourcePatchExternalSource, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestPatchExternalSource) (*ResourcePatchExternalSource, *resty.Response, error)
+}
+
+var _ Service = (*PatchExternalSources)(nil)
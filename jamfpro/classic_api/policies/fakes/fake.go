@@ -0,0 +1,143 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the policies
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/policies"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// PoliciesFake is a policies.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PoliciesFake struct {
+	fakes.Journal
+
+	CreateFunc              func(ctx context.Context, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc          func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc        func(ctx context.Context, name string) (*resty.Response, error)
+	GetByCategoryFunc       func(ctx context.Context, category string) (*policies.ListResponse, *resty.Response, error)
+	GetByCreatedByFunc      func(ctx context.Context, createdBy string) (*policies.ListResponse, *resty.Response, error)
+	GetByIDFunc             func(ctx context.Context, id int) (*policies.ResourcePolicy, *resty.Response, error)
+	GetByIDWithSubsetFunc   func(ctx context.Context, id int, subset string) (*policies.ResourcePolicy, *resty.Response, error)
+	GetByNameFunc           func(ctx context.Context, name string) (*policies.ResourcePolicy, *resty.Response, error)
+	GetByNameWithSubsetFunc func(ctx context.Context, name string, subset string) (*policies.ResourcePolicy, *resty.Response, error)
+	ListFunc                func(ctx context.Context) (*policies.ListResponse, *resty.Response, error)
+	UpdateByIDFunc          func(ctx context.Context, id int, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc        func(ctx context.Context, name string, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ policies.Service = (*PoliciesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *PoliciesFake) Create(ctx context.Context, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, policy)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.Create")
+	}
+	return f.CreateFunc(ctx, policy)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *PoliciesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("PoliciesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *PoliciesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("PoliciesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByCategory records the call and delegates to GetByCategoryFunc.
+func (f *PoliciesFake) GetByCategory(ctx context.Context, category string) (*policies.ListResponse, *resty.Response, error) {
+	f.Record("GetByCategory", ctx, category)
+	if f.GetByCategoryFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByCategory")
+	}
+	return f.GetByCategoryFunc(ctx, category)
+}
+
+// GetByCreatedBy records the call and delegates to GetByCreatedByFunc.
+func (f *PoliciesFake) GetByCreatedBy(ctx context.Context, createdBy string) (*policies.ListResponse, *resty.Response, error) {
+	f.Record("GetByCreatedBy", ctx, createdBy)
+	if f.GetByCreatedByFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByCreatedBy")
+	}
+	return f.GetByCreatedByFunc(ctx, createdBy)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *PoliciesFake) GetByID(ctx context.Context, id int) (*policies.ResourcePolicy, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByIDWithSubset records the call and delegates to GetByIDWithSubsetFunc.
+func (f *PoliciesFake) GetByIDWithSubset(ctx context.Context, id int, subset string) (*policies.ResourcePolicy, *resty.Response, error) {
+	f.Record("GetByIDWithSubset", ctx, id, subset)
+	if f.GetByIDWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByIDWithSubset")
+	}
+	return f.GetByIDWithSubsetFunc(ctx, id, subset)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *PoliciesFake) GetByName(ctx context.Context, name string) (*policies.ResourcePolicy, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetByNameWithSubset records the call and delegates to GetByNameWithSubsetFunc.
+func (f *PoliciesFake) GetByNameWithSubset(ctx context.Context, name string, subset string) (*policies.ResourcePolicy, *resty.Response, error) {
+	f.Record("GetByNameWithSubset", ctx, name, subset)
+	if f.GetByNameWithSubsetFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.GetByNameWithSubset")
+	}
+	return f.GetByNameWithSubsetFunc(ctx, name, subset)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *PoliciesFake) List(ctx context.Context) (*policies.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *PoliciesFake) UpdateByID(ctx context.Context, id int, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, policy)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, policy)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *PoliciesFake) UpdateByName(ctx context.Context, name string, policy *policies.ResourcePolicy) (*policies.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, policy)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("PoliciesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, policy)
+}
@@ -0,0 +1,29 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package policies
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Policies.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.PoliciesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, policy *ResourcePolicy) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByCategory(ctx context.Context, category string) (*ListResponse, *resty.Response, error)
+	GetByCreatedBy(ctx context.Context, createdBy string) (*ListResponse, *resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourcePolicy, *resty.Response, error)
+	GetByIDWithSubset(ctx context.Context, id int, subset string) (*ResourcePolicy, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourcePolicy, *resty.Response, error)
+	GetByNameWithSubset(ctx context.Context, name string, subset string) (*ResourcePolicy, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, policy *ResourcePolicy) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, policy *ResourcePolicy) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*Policies)(nil)
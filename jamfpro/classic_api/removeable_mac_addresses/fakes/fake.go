@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the removeable_mac_addresses
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/removeable_mac_addresses"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// RemoveableMacAddressesFake is a removeable_mac_addresses.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type RemoveableMacAddressesFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*removeable_mac_addresses.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error)
+}
+
+var _ removeable_mac_addresses.Service = (*RemoveableMacAddressesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *RemoveableMacAddressesFake) Create(ctx context.Context, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *RemoveableMacAddressesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("RemoveableMacAddressesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *RemoveableMacAddressesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("RemoveableMacAddressesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *RemoveableMacAddressesFake) GetByID(ctx context.Context, id int) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *RemoveableMacAddressesFake) GetByName(ctx context.Context, name string) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *RemoveableMacAddressesFake) List(ctx context.Context) (*removeable_mac_addresses.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *RemoveableMacAddressesFake) UpdateByID(ctx context.Context, id int, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *RemoveableMacAddressesFake) UpdateByName(ctx context.Context, name string, req *removeable_mac_addresses.RequestRemoveableMacAddress) (*removeable_mac_addresses.ResourceRemoveableMacAddress, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoveableMacAddressesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
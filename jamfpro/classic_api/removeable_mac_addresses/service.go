@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package removeable_mac_addresses
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of RemoveableMacAddresses.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.RemoveableMacAddressesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestRemoveableMacAddress) (*ResourceRemoveableMacAddress, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceRemoveableMacAddress, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceRemoveableMacAddress, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestRemoveableMacAddress) (*ResourceRemoveableMacAddress, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestRemoveableMacAddress) (*ResourceRemoveableMacAddress, *resty.Response, error)
+}
+
+var _ Service = (*RemoveableMacAddresses)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the restricted_software
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/restricted_software"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// RestrictedSoftwareFake is a restricted_software.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type RestrictedSoftwareFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*restricted_software.ResourceRestrictedSoftware, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*restricted_software.ResourceRestrictedSoftware, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*restricted_software.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ restricted_software.Service = (*RestrictedSoftwareFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *RestrictedSoftwareFake) Create(ctx context.Context, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *RestrictedSoftwareFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("RestrictedSoftwareFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *RestrictedSoftwareFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("RestrictedSoftwareFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *RestrictedSoftwareFake) GetByID(ctx context.Context, id int) (*restricted_software.ResourceRestrictedSoftware, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *RestrictedSoftwareFake) GetByName(ctx context.Context, name string) (*restricted_software.ResourceRestrictedSoftware, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *RestrictedSoftwareFake) List(ctx context.Context) (*restricted_software.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *RestrictedSoftwareFake) UpdateByID(ctx context.Context, id int, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *RestrictedSoftwareFake) UpdateByName(ctx context.Context, name string, req *restricted_software.RequestRestrictedSoftware) (*restricted_software.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("RestrictedSoftwareFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package restricted_software
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of RestrictedSoftware.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.RestrictedSoftwareFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestRestrictedSoftware) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceRestrictedSoftware, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceRestrictedSoftware, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestRestrictedSoftware) (*CreateUpdateResponse, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestRestrictedSoftware) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*RestrictedSoftware)(nil)
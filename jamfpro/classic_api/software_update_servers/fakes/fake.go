@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the software_update_servers
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/software_update_servers"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// SoftwareUpdateServersFake is a software_update_servers.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SoftwareUpdateServersFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*software_update_servers.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error)
+}
+
+var _ software_update_servers.Service = (*SoftwareUpdateServersFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *SoftwareUpdateServersFake) Create(ctx context.Context, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *SoftwareUpdateServersFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("SoftwareUpdateServersFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *SoftwareUpdateServersFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("SoftwareUpdateServersFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *SoftwareUpdateServersFake) GetByID(ctx context.Context, id int) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *SoftwareUpdateServersFake) GetByName(ctx context.Context, name string) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *SoftwareUpdateServersFake) List(ctx context.Context) (*software_update_servers.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *SoftwareUpdateServersFake) UpdateByID(ctx context.Context, id int, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *SoftwareUpdateServersFake) UpdateByName(ctx context.Context, name string, req *software_update_servers.RequestSoftwareUpdateServer) (*software_update_servers.ResourceSoftwareUpdateServer, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SoftwareUpdateServersFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package software_update_servers
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SoftwareUpdateServers.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.SoftwareUpdateServersFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceSoftwareUpdateServer, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceSoftwareUpdateServer, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestSoftwareUpdateServer) (*ResourceSoftwareUpdateServer, *resty.Response, error)
+}
+
+var _ Service = (*SoftwareUpdateServers)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the static_user_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/static_user_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// StaticUserGroupsFake is a static_user_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type StaticUserGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*static_user_groups.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ static_user_groups.Service = (*StaticUserGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *StaticUserGroupsFake) Create(ctx context.Context, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *StaticUserGroupsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("StaticUserGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *StaticUserGroupsFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("StaticUserGroupsFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *StaticUserGroupsFake) GetByID(ctx context.Context, id int) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *StaticUserGroupsFake) GetByName(ctx context.Context, name string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *StaticUserGroupsFake) List(ctx context.Context) (*static_user_groups.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *StaticUserGroupsFake) UpdateByID(ctx context.Context, id int, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *StaticUserGroupsFake) UpdateByName(ctx context.Context, name string, req *static_user_groups.RequestStaticUserGroup) (*static_user_groups.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
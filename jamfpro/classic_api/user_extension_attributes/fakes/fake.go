@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the user_extension_attributes
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/user_extension_attributes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// UserExtensionAttributesFake is a user_extension_attributes.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UserExtensionAttributesFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*user_extension_attributes.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error)
+}
+
+var _ user_extension_attributes.Service = (*UserExtensionAttributesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *UserExtensionAttributesFake) Create(ctx context.Context, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *UserExtensionAttributesFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("UserExtensionAttributesFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *UserExtensionAttributesFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("UserExtensionAttributesFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *UserExtensionAttributesFake) GetByID(ctx context.Context, id int) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *UserExtensionAttributesFake) GetByName(ctx context.Context, name string) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *UserExtensionAttributesFake) List(ctx context.Context) (*user_extension_attributes.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *UserExtensionAttributesFake) UpdateByID(ctx context.Context, id int, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *UserExtensionAttributesFake) UpdateByName(ctx context.Context, name string, req *user_extension_attributes.RequestUserExtensionAttribute) (*user_extension_attributes.ResourceUserExtensionAttribute, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserExtensionAttributesFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package user_extension_attributes
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of UserExtensionAttributes.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.UserExtensionAttributesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestUserExtensionAttribute) (*ResourceUserExtensionAttribute, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceUserExtensionAttribute, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceUserExtensionAttribute, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestUserExtensionAttribute) (*ResourceUserExtensionAttribute, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestUserExtensionAttribute) (*ResourceUserExtensionAttribute, *resty.Response, error)
+}
+
+var _ Service = (*UserExtensionAttributes)(nil)
@@ -0,0 +1,133 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the users
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/users"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// UsersFake is a users.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UsersFake struct {
+	fakes.Journal
+
+	CreateFunc        func(ctx context.Context, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error)
+	DeleteByEmailFunc func(ctx context.Context, email string) (*resty.Response, error)
+	DeleteByIDFunc    func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc  func(ctx context.Context, name string) (*resty.Response, error)
+	GetByEmailFunc    func(ctx context.Context, email string) (*users.ListResponse, *resty.Response, error)
+	GetByIDFunc       func(ctx context.Context, id int) (*users.ResourceUser, *resty.Response, error)
+	GetByNameFunc     func(ctx context.Context, name string) (*users.ResourceUser, *resty.Response, error)
+	ListFunc          func(ctx context.Context) (*users.ListResponse, *resty.Response, error)
+	UpdateByEmailFunc func(ctx context.Context, email string, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error)
+	UpdateByIDFunc    func(ctx context.Context, id int, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error)
+	UpdateByNameFunc  func(ctx context.Context, name string, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error)
+}
+
+var _ users.Service = (*UsersFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *UsersFake) Create(ctx context.Context, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByEmail records the call and delegates to DeleteByEmailFunc.
+func (f *UsersFake) DeleteByEmail(ctx context.Context, email string) (*resty.Response, error) {
+	f.Record("DeleteByEmail", ctx, email)
+	if f.DeleteByEmailFunc == nil {
+		return nil, fakes.NotStubbed("UsersFake.DeleteByEmail")
+	}
+	return f.DeleteByEmailFunc(ctx, email)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *UsersFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("UsersFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *UsersFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("UsersFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByEmail records the call and delegates to GetByEmailFunc.
+func (f *UsersFake) GetByEmail(ctx context.Context, email string) (*users.ListResponse, *resty.Response, error) {
+	f.Record("GetByEmail", ctx, email)
+	if f.GetByEmailFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.GetByEmail")
+	}
+	return f.GetByEmailFunc(ctx, email)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *UsersFake) GetByID(ctx context.Context, id int) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *UsersFake) GetByName(ctx context.Context, name string) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *UsersFake) List(ctx context.Context) (*users.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByEmail records the call and delegates to UpdateByEmailFunc.
+func (f *UsersFake) UpdateByEmail(ctx context.Context, email string, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("UpdateByEmail", ctx, email, req)
+	if f.UpdateByEmailFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.UpdateByEmail")
+	}
+	return f.UpdateByEmailFunc(ctx, email, req)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *UsersFake) UpdateByID(ctx context.Context, id int, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *UsersFake) UpdateByName(ctx context.Context, name string, req *users.RequestUser) (*users.ResourceUser, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("UsersFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
@@ -0,0 +1,28 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Users.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.UsersFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestUser) (*ResourceUser, *resty.Response, error)
+	DeleteByEmail(ctx context.Context, email string) (*resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByEmail(ctx context.Context, email string) (*ListResponse, *resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceUser, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceUser, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByEmail(ctx context.Context, email string, req *RequestUser) (*ResourceUser, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestUser) (*ResourceUser, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestUser) (*ResourceUser, *resty.Response, error)
+}
+
+var _ Service = (*Users)(nil)
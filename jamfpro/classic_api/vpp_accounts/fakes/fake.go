@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the vpp_accounts
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/vpp_accounts"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// VppAccountsFake is a vpp_accounts.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type VppAccountsFake struct {
+	fakes.Journal
+
+	CreateFunc     func(ctx context.Context, req *vpp_accounts.RequestVPPAccount) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error)
+	DeleteByIDFunc func(ctx context.Context, id int) (*resty.Response, error)
+	GetByIDFunc    func(ctx context.Context, id int) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error)
+	ListFunc       func(ctx context.Context) (*vpp_accounts.ListResponse, *resty.Response, error)
+	UpdateByIDFunc func(ctx context.Context, id int, req *vpp_accounts.RequestVPPAccount) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error)
+}
+
+var _ vpp_accounts.Service = (*VppAccountsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *VppAccountsFake) Create(ctx context.Context, req *vpp_accounts.RequestVPPAccount) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAccountsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *VppAccountsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("VppAccountsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *VppAccountsFake) GetByID(ctx context.Context, id int) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAccountsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *VppAccountsFake) List(ctx context.Context) (*vpp_accounts.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAccountsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *VppAccountsFake) UpdateByID(ctx context.Context, id int, req *vpp_accounts.RequestVPPAccount) (*vpp_accounts.ResourceVPPAccount, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAccountsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
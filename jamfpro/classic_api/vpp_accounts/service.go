@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package vpp_accounts
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of VppAccounts.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.VppAccountsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestVPPAccount) (*ResourceVPPAccount, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceVPPAccount, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestVPPAccount) (*ResourceVPPAccount, *resty.Response, error)
+}
+
+var _ Service = (*VppAccounts)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the vpp_assignments
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/vpp_assignments"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// VppAssignmentsFake is a vpp_assignments.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type VppAssignmentsFake struct {
+	fakes.Journal
+
+	CreateFunc     func(ctx context.Context, req *vpp_assignments.Resource) (*vpp_assignments.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDFunc func(ctx context.Context, id int) (*resty.Response, error)
+	GetByIDFunc    func(ctx context.Context, id int) (*vpp_assignments.Resource, *resty.Response, error)
+	ListFunc       func(ctx context.Context) (*vpp_assignments.ListResponse, *resty.Response, error)
+	UpdateByIDFunc func(ctx context.Context, id int, req *vpp_assignments.Resource) (*vpp_assignments.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ vpp_assignments.Service = (*VppAssignmentsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *VppAssignmentsFake) Create(ctx context.Context, req *vpp_assignments.Resource) (*vpp_assignments.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAssignmentsFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *VppAssignmentsFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("VppAssignmentsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *VppAssignmentsFake) GetByID(ctx context.Context, id int) (*vpp_assignments.Resource, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAssignmentsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *VppAssignmentsFake) List(ctx context.Context) (*vpp_assignments.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAssignmentsFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *VppAssignmentsFake) UpdateByID(ctx context.Context, id int, req *vpp_assignments.Resource) (*vpp_assignments.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VppAssignmentsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
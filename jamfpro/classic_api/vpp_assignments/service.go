@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package vpp_assignments
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of VppAssignments.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.VppAssignmentsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*Resource, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *Resource) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*VppAssignments)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the webhooks
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/webhooks"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"resty.dev/v3"
+)
+
+// WebhooksFake is a webhooks.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type WebhooksFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id int) (*webhooks.ResourceWebhook, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*webhooks.ResourceWebhook, *resty.Response, error)
+	ListFunc         func(ctx context.Context) (*webhooks.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id int, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error)
+}
+
+var _ webhooks.Service = (*WebhooksFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *WebhooksFake) Create(ctx context.Context, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error) {
+	f.Record("Create", ctx, req)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.Create")
+	}
+	return f.CreateFunc(ctx, req)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *WebhooksFake) DeleteByID(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("WebhooksFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *WebhooksFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("WebhooksFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *WebhooksFake) GetByID(ctx context.Context, id int) (*webhooks.ResourceWebhook, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *WebhooksFake) GetByName(ctx context.Context, name string) (*webhooks.ResourceWebhook, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *WebhooksFake) List(ctx context.Context) (*webhooks.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.List")
+	}
+	return f.ListFunc(ctx)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *WebhooksFake) UpdateByID(ctx context.Context, id int, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, req)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, req)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *WebhooksFake) UpdateByName(ctx context.Context, name string, req *webhooks.RequestWebhook) (*webhooks.ResourceWebhook, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, req)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("WebhooksFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, req)
+}
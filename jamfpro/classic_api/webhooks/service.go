@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Webhooks.
+// jamfpro.ClassicAPIClient exposes the service through it, and
+// fakes.WebhooksFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, req *RequestWebhook) (*ResourceWebhook, *resty.Response, error)
+	DeleteByID(ctx context.Context, id int) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id int) (*ResourceWebhook, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceWebhook, *resty.Response, error)
+	List(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id int, req *RequestWebhook) (*ResourceWebhook, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, req *RequestWebhook) (*ResourceWebhook, *resty.Response, error)
+}
+
+var _ Service = (*Webhooks)(nil)
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
@@ -14,16 +16,28 @@ import (
 	"resty.dev/v3"
 )
 
+// TokenSource supplies bearer tokens to the transport. It is an alias of
+// config.TokenSource so that AuthConfig can carry one without an import cycle.
+type TokenSource = config.TokenSource
+
 // bearerTokenManager manages the full lifecycle of a Jamf Pro bearer token:
 // automatic refresh before expiry, keep-alive extension, and revocation.
 // All methods are safe for concurrent use.
 //
+// Tokens are obtained from source, which is either one of the built-in flows
+// (fetchOAuth2, fetchBasic) or a caller-supplied TokenSource. Tokens from the
+// built-in flows are also written to cache under cacheKey so that other
+// transports or processes sharing the cache can reuse them until expiry.
+// cacheKey is empty for custom sources, which manage their own caching.
+//
 // authClient is a dedicated resty client used exclusively for auth operations
 // (token fetch, keep-alive, invalidate). It is intentionally separate from
 // the main Transport client to prevent a deadlock: the Transport client carries
 // an auth middleware that calls getToken(), which holds the mutex and calls
-// fetchFn(). If fetchFn() made requests through the same client, the middleware
-// would fire again and attempt to re-acquire the mutex — deadlocking.
+// source.Token(). If the built-in sources made requests through the same
+// client, the middleware would fire again and attempt to re-acquire the
+// mutex — deadlocking. Custom TokenSources must likewise not call back into
+// the Jamf Pro client they authenticate.
 type bearerTokenManager struct {
 	mu                sync.Mutex
 	token             string
@@ -34,7 +48,11 @@ type bearerTokenManager struct {
 	authClient        *resty.Client
 	baseURL           string
 	hideSensitiveData bool
-	fetchFn           func() (string, time.Time, error)
+	source            TokenSource
+	method            string
+	cache             TokenCache
+	cacheKey          string
+	forcedRefreshes   atomic.Int64
 }
 
 // logToken returns the token string for logging, redacted when HideSensitiveData is set.
@@ -46,28 +64,157 @@ func (h *bearerTokenManager) logToken() string {
 }
 
 // getToken returns the current bearer token, refreshing it when expired or
-// within the buffer period before expiry.
-func (h *bearerTokenManager) getToken() (string, error) {
+// within the buffer period before expiry. A still-fresh token in the shared
+// cache is preferred over fetching a new one from source.
+func (h *bearerTokenManager) getToken(ctx context.Context) (string, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	now := time.Now()
-	if h.token != "" && now.Before(h.expiry) && now.Add(h.buffer).Before(h.expiry) {
+	if h.token != "" && h.fresh(h.expiry) {
+		return h.token, nil
+	}
+
+	if cached := h.loadCached(); cached != nil {
+		h.token = cached.Token
+		h.expiry = cached.Expiry
+		h.logger.Debug("Bearer token reused from token cache", zap.Time("expiry", cached.Expiry))
 		return h.token, nil
 	}
 
-	token, expiry, err := h.fetchFn()
+	token, expiry, err := h.source.Token(ctx)
 	if err != nil {
 		return "", err
 	}
+	if token == "" {
+		return "", fmt.Errorf("token source returned an empty token")
+	}
 	h.token = token
 	h.expiry = expiry
+	h.storeCached(token, expiry)
 	return token, nil
 }
 
+// fresh reports whether a token expiring at expiry is still usable, i.e. not
+// yet within the refresh buffer. A zero expiry is never fresh.
+func (h *bearerTokenManager) fresh(expiry time.Time) bool {
+	now := time.Now()
+	return now.Before(expiry) && now.Add(h.buffer).Before(expiry)
+}
+
+// loadCached returns the cached token when caching is enabled and the entry
+// is still fresh. Cache failures are logged and treated as a miss so that a
+// broken cache never prevents authentication.
+func (h *bearerTokenManager) loadCached() *CachedToken {
+	if h.cache == nil || h.cacheKey == "" {
+		return nil
+	}
+	cached, err := h.cache.Get(h.cacheKey)
+	if err != nil {
+		h.logger.Warn("Failed to read token cache", zap.Error(err))
+		return nil
+	}
+	if cached == nil || cached.Token == "" || !h.fresh(cached.Expiry) {
+		return nil
+	}
+	return cached
+}
+
+// storeCached writes a token to the cache when caching is enabled.
+func (h *bearerTokenManager) storeCached(token string, expiry time.Time) {
+	if h.cache == nil || h.cacheKey == "" {
+		return
+	}
+	if err := h.cache.Set(h.cacheKey, CachedToken{Token: token, Expiry: expiry}); err != nil {
+		h.logger.Warn("Failed to write token cache", zap.Error(err))
+	}
+}
+
+// evictCached removes the cache entry if it still holds token. An entry that
+// another process has already replaced with a newer token is left alone.
+func (h *bearerTokenManager) evictCached(token string) {
+	if h.cache == nil || h.cacheKey == "" {
+		return
+	}
+	cached, err := h.cache.Get(h.cacheKey)
+	if err != nil {
+		h.logger.Warn("Failed to read token cache", zap.Error(err))
+		return
+	}
+	if cached == nil || cached.Token != token {
+		return
+	}
+	if err := h.cache.Delete(h.cacheKey); err != nil {
+		h.logger.Warn("Failed to delete token cache entry", zap.Error(err))
+	}
+}
+
+// discard drops token after the API has rejected it (HTTP 401), both locally
+// and from the cache, so the next request fetches a new one. It is a no-op if
+// the token has already been replaced, e.g. by a concurrent refresh.
+func (h *bearerTokenManager) discard(token string) {
+	if token == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.token == token {
+		h.token = ""
+		h.expiry = time.Time{}
+	}
+	h.evictCached(token)
+	h.logger.Warn("Bearer token rejected by Jamf Pro API; discarded")
+}
+
+// forceRefresh discards a token the API rejected and obtains a replacement,
+// from the cache if another process has already refreshed it, otherwise from
+// source. It reports whether the replacement differs from rejected; a source
+// that hands back the same token (e.g. StaticTokenSource) makes a replay
+// pointless.
+func (h *bearerTokenManager) forceRefresh(ctx context.Context, rejected string) (bool, error) {
+	h.discard(rejected)
+	token, err := h.getToken(ctx)
+	if err != nil {
+		return false, err
+	}
+	if token == rejected {
+		return false, nil
+	}
+	h.forcedRefreshes.Add(1)
+	return true, nil
+}
+
+// refresh unconditionally fetches a new token from source, replacing the
+// current one locally and in the cache. Used when keep-alive fails and the
+// current token can no longer be extended.
+func (h *bearerTokenManager) refresh(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	token, expiry, err := h.source.Token(ctx)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return fmt.Errorf("token source returned an empty token")
+	}
+	h.token = token
+	h.expiry = expiry
+	h.storeCached(token, expiry)
+	return nil
+}
+
+// expiresAt returns the expiry of the current token, or the zero time when
+// there is none.
+func (h *bearerTokenManager) expiresAt() time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.expiry
+}
+
 // invalidate revokes the current bearer token at the Jamf Pro API and clears
 // the local cache so the next request forces a full re-authentication.
-func (h *bearerTokenManager) invalidate() error {
+func (h *bearerTokenManager) invalidate(ctx context.Context) error {
 	h.mu.Lock()
 	currentToken := h.token
 	h.mu.Unlock()
@@ -79,6 +226,7 @@ func (h *bearerTokenManager) invalidate() error {
 	endpoint := strings.TrimSuffix(h.baseURL, "/") + constants.EndpointInvalidateToken
 
 	resp, err := h.authClient.R().
+		SetContext(ctx).
 		SetAuthToken(currentToken).
 		Post(endpoint)
 
@@ -93,6 +241,7 @@ func (h *bearerTokenManager) invalidate() error {
 	h.mu.Lock()
 	h.token = ""
 	h.expiry = time.Time{}
+	h.evictCached(currentToken)
 	h.mu.Unlock()
 
 	h.logger.Info("Bearer token invalidated")
@@ -101,7 +250,7 @@ func (h *bearerTokenManager) invalidate() error {
 
 // keepAlive extends the current token lifetime via the Jamf Pro API and updates
 // the cached token and expiry time from the response.
-func (h *bearerTokenManager) keepAlive() error {
+func (h *bearerTokenManager) keepAlive(ctx context.Context) error {
 	h.mu.Lock()
 	currentToken := h.token
 	h.mu.Unlock()
@@ -118,6 +267,7 @@ func (h *bearerTokenManager) keepAlive() error {
 	}
 
 	resp, err := h.authClient.R().
+		SetContext(ctx).
 		SetAuthToken(currentToken).
 		SetResult(&result).
 		Post(endpoint)
@@ -133,13 +283,15 @@ func (h *bearerTokenManager) keepAlive() error {
 	h.mu.Lock()
 	h.token = result.Token
 	h.expiry = result.Expires
+	h.storeCached(result.Token, result.Expires)
 	h.mu.Unlock()
 
 	h.logger.Info("Bearer token keep-alive successful", zap.Time("new_expiry", result.Expires))
 	return nil
 }
 
-func (h *bearerTokenManager) fetchOAuth2() (string, time.Time, error) {
+// fetchOAuth2 is the built-in OAuth2 client credentials TokenSource.
+func (h *bearerTokenManager) fetchOAuth2(ctx context.Context) (string, time.Time, error) {
 	endpoint := strings.TrimSuffix(h.baseURL, "/") + constants.EndpointOAuthToken
 
 	data := url.Values{}
@@ -153,6 +305,7 @@ func (h *bearerTokenManager) fetchOAuth2() (string, time.Time, error) {
 	}
 
 	resp, err := h.authClient.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/x-www-form-urlencoded").
 		SetFormDataFromValues(data).
 		SetResult(&result).
@@ -194,7 +347,8 @@ func (h *bearerTokenManager) fetchOAuth2() (string, time.Time, error) {
 	return result.AccessToken, expiry, nil
 }
 
-func (h *bearerTokenManager) fetchBasic() (string, time.Time, error) {
+// fetchBasic is the built-in basic auth to bearer token exchange TokenSource.
+func (h *bearerTokenManager) fetchBasic(ctx context.Context) (string, time.Time, error) {
 	endpoint := strings.TrimSuffix(h.baseURL, "/") + constants.EndpointBearerToken
 
 	var result struct {
@@ -203,6 +357,7 @@ func (h *bearerTokenManager) fetchBasic() (string, time.Time, error) {
 	}
 
 	resp, err := h.authClient.R().
+		SetContext(ctx).
 		SetBasicAuth(h.auth.Username, h.auth.Password).
 		SetResult(&result).
 		Post(endpoint)
@@ -251,12 +406,30 @@ func (h *bearerTokenManager) fetchBasic() (string, time.Time, error) {
 // if token refresh fired the middleware re-entrantly while holding the mutex.
 // The passed restyClient is only used to install the request middleware.
 //
+// The token source is chosen in order of precedence: settings.TokenSource
+// (WithTokenSource), authConfig.TokenSource, then the built-in flow selected
+// by authConfig.AuthMethod. With a caller-supplied source only the instance
+// domain is validated.
+//
+// Tokens from the built-in flows are cached in settings.TokenCache (an
+// in-memory cache by default) keyed by instance domain, auth method, and
+// client ID or username, so the initial fetch is skipped when a fresh token
+// for the same principal is already cached.
+//
 // Returns the bearerTokenManager so the Transport can expose InvalidateToken
 // and KeepAliveToken.
 //
 // See: https://developer.jamf.com/jamf-pro/docs/classic-api-authentication-changes
 func SetupAuthentication(restyClient *resty.Client, authConfig *config.AuthConfig, logger *zap.Logger, settings *TransportSettings) (*bearerTokenManager, error) {
-	if err := authConfig.Validate(); err != nil {
+	source := authConfig.TokenSource
+	if settings != nil && settings.TokenSource != nil {
+		source = settings.TokenSource
+	}
+	if source != nil {
+		if authConfig.InstanceDomain == "" {
+			return nil, fmt.Errorf("authentication configuration invalid: instance domain is required")
+		}
+	} else if err := authConfig.Validate(); err != nil {
 		return nil, fmt.Errorf("authentication configuration invalid: %w", err)
 	}
 
@@ -294,21 +467,34 @@ func SetupAuthentication(restyClient *resty.Client, authConfig *config.AuthConfi
 		hideSensitiveData: authConfig.HideSensitiveData,
 	}
 
-	switch authConfig.AuthMethod {
-	case constants.AuthMethodOAuth2:
-		tokenManager.fetchFn = tokenManager.fetchOAuth2
-	case constants.AuthMethodBasic:
-		tokenManager.fetchFn = tokenManager.fetchBasic
+	if settings != nil && settings.TokenCache != nil {
+		tokenManager.cache = settings.TokenCache
+	} else {
+		tokenManager.cache = NewMemoryTokenCache()
+	}
+
+	switch {
+	case source != nil:
+		tokenManager.source = source
+		tokenManager.method = constants.AuthMethodTokenSource
+	case authConfig.AuthMethod == constants.AuthMethodOAuth2:
+		tokenManager.source = config.TokenSourceFunc(tokenManager.fetchOAuth2)
+		tokenManager.method = constants.AuthMethodOAuth2
+		tokenManager.cacheKey = TokenCacheKey(baseURL, tokenManager.method, authConfig.ClientID)
+	case authConfig.AuthMethod == constants.AuthMethodBasic:
+		tokenManager.source = config.TokenSourceFunc(tokenManager.fetchBasic)
+		tokenManager.method = constants.AuthMethodBasic
+		tokenManager.cacheKey = TokenCacheKey(baseURL, tokenManager.method, authConfig.Username)
 	default:
 		return nil, fmt.Errorf("unsupported auth method: %q", authConfig.AuthMethod)
 	}
 
-	if _, err := tokenManager.getToken(); err != nil {
+	if _, err := tokenManager.getToken(context.Background()); err != nil {
 		return nil, fmt.Errorf("initial token fetch failed: %w", err)
 	}
 
 	restyClient.AddRequestMiddleware(func(_ *resty.Client, r *resty.Request) error {
-		token, err := tokenManager.getToken()
+		token, err := tokenManager.getToken(r.Context())
 		if err != nil {
 			return err
 		}
@@ -317,7 +503,7 @@ func SetupAuthentication(restyClient *resty.Client, authConfig *config.AuthConfi
 	})
 
 	logger.Info("Jamf Pro API authentication configured",
-		zap.String("auth_method", authConfig.AuthMethod),
+		zap.String("auth_method", tokenManager.method),
 		zap.String("instance", baseURL),
 	)
 	return tokenManager, nil
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -127,3 +129,80 @@ func TestSetupAuthentication_InvalidMethod(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "auth method")
 }
+
+func TestSetupAuthentication_CustomTokenSource(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/oauth/token" || r.URL.Path == "/api/v1/auth/token" {
+			t.Errorf("built-in token endpoint %s must not be called with a custom token source", r.URL.Path)
+		}
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	calls := 0
+	source := config.TokenSourceFunc(func(context.Context) (string, time.Time, error) {
+		calls++
+		return "tok-custom", time.Now().Add(time.Hour), nil
+	})
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, TokenSource: source}
+
+	tr, err := NewTransport(cfg, func(s *TransportSettings) error {
+		s.Logger = zap.NewNop()
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, constants.AuthMethodTokenSource, tr.tokenManager.method)
+
+	_, err = tr.NewRequest(context.Background()).Get("/api/test")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer tok-custom", gotAuth)
+	assert.Equal(t, 1, calls, "a token with a future expiry should be cached")
+}
+
+func TestSetupAuthentication_SettingsTokenSourceTakesPrecedence(t *testing.T) {
+	cfg := &config.AuthConfig{
+		InstanceDomain: "https://x.com",
+		TokenSource:    config.StaticTokenSource("from-config", time.Time{}),
+	}
+	settings := &TransportSettings{TokenSource: config.StaticTokenSource("from-option", time.Time{})}
+
+	holder, err := SetupAuthentication(resty.New(), cfg, zap.NewNop(), settings)
+	require.NoError(t, err)
+	assert.Equal(t, "from-option", holder.token)
+}
+
+func TestSetupAuthentication_TokenSourceError(t *testing.T) {
+	cfg := &config.AuthConfig{
+		InstanceDomain: "https://x.com",
+		TokenSource: config.TokenSourceFunc(func(context.Context) (string, time.Time, error) {
+			return "", time.Time{}, errors.New("broker unavailable")
+		}),
+	}
+	_, err := SetupAuthentication(resty.New(), cfg, zap.NewNop(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "initial token fetch failed")
+	assert.Contains(t, err.Error(), "broker unavailable")
+}
+
+func TestSetupAuthentication_TokenSourceRequiresInstanceDomain(t *testing.T) {
+	cfg := &config.AuthConfig{TokenSource: config.StaticTokenSource("t", time.Time{})}
+	_, err := SetupAuthentication(resty.New(), cfg, zap.NewNop(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instance domain")
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	tok, _, err := config.StaticTokenSource("abc", time.Time{}).Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "abc", tok)
+
+	_, _, err = config.StaticTokenSource("", time.Time{}).Token(context.Background())
+	require.Error(t, err)
+
+	_, _, err = config.StaticTokenSource("abc", time.Now().Add(-time.Minute)).Token(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+}
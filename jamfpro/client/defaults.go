@@ -25,5 +25,10 @@ const (
 	// delay computed from response-time EMA tracking. Prevents unbounded
 	// stalls when the server is under extreme load.
 	adaptiveDelayMax = 5 * time.Second
+
+	// keepAliveMinWait is the shortest pause between background keep-alive
+	// attempts, so a token already inside its refresh buffer (or a loop
+	// restarting after a panic) cannot spin against the auth endpoints.
+	keepAliveMinWait = time.Second
 )
 
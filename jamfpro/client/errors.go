@@ -2,13 +2,79 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"go.uber.org/zap"
 )
 
+// Jamf Pro API error codes that callers commonly branch on. They appear in
+// APIError.Code and FieldError.Code.
+const (
+	// ErrorCodeOptimisticLockFailed is returned when an update carries a stale
+	// versionLock because the resource changed since it was read.
+	ErrorCodeOptimisticLockFailed = "OPTIMISTIC_LOCK_FAILED"
+
+	// ErrorCodeDuplicateField is returned when a field that must be unique,
+	// typically the name, collides with an existing resource.
+	ErrorCodeDuplicateField = "DUPLICATE_FIELD"
+
+	// ErrorCodeInvalidField is returned when a field fails validation.
+	ErrorCodeInvalidField = "INVALID_FIELD"
+)
+
+// Sentinel errors matched by APIError.Is, so errors.Is works on any error
+// returned by a service method, however deeply wrapped:
+//
+//	if errors.Is(err, client.ErrOptimisticLock) { /* re-read and retry */ }
+var (
+	ErrBadRequest     = errors.New("bad request")
+	ErrUnauthorized   = errors.New("unauthorized")
+	ErrForbidden      = errors.New("forbidden")
+	ErrNotFound       = errors.New("not found")
+	ErrConflict       = errors.New("conflict")
+	ErrDuplicate      = errors.New("duplicate")
+	ErrOptimisticLock = errors.New("optimistic lock failure")
+	ErrServerError    = errors.New("server error")
+)
+
+// FieldError is one entry of the errors array in a Jamf Pro API error
+// response, or the message of a Classic API HTML error page (which carries
+// only a Description).
+type FieldError struct {
+	Code        string
+	Field       string
+	Description string
+	ID          string
+}
+
+// String formats the error as "field: description (CODE)", omitting
+// empty parts.
+func (f FieldError) String() string {
+	var b strings.Builder
+	if f.Field != "" {
+		b.WriteString(f.Field)
+		b.WriteString(": ")
+	}
+	b.WriteString(f.Description)
+	if f.Code != "" {
+		if f.Description != "" {
+			b.WriteString(" ")
+		}
+		b.WriteString("(" + f.Code + ")")
+	}
+	return b.String()
+}
+
 // APIError represents an error response from the Jamf Pro API.
+//
+// Code is the top-level Jamf error code when the response has one, otherwise
+// the code of the first FieldError. Errors holds every entry of the response's
+// errors array (or the Classic API error page text) for per-field diagnostics.
 type APIError struct {
 	Code       string
 	Message    string
@@ -16,6 +82,7 @@ type APIError struct {
 	Status     string
 	Endpoint   string
 	Method     string
+	Errors     []FieldError
 }
 
 // Error implements the error interface.
@@ -28,13 +95,90 @@ func (e *APIError) Error() string {
 		e.StatusCode, e.Status, e.Method, e.Endpoint, e.Message)
 }
 
-// jamfErrorBody is a common shape for Jamf error responses.
+// HasCode reports whether the top-level code or any field error carries code.
+func (e *APIError) HasCode(code string) bool {
+	if e.Code == code {
+		return true
+	}
+	for _, f := range e.Errors {
+		if f.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldErrors returns the field errors reported against field.
+func (e *APIError) FieldErrors(field string) []FieldError {
+	var out []FieldError
+	for _, f := range e.Errors {
+		if f.Field == field {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Is matches the package sentinel errors (ErrNotFound, ErrConflict, ...)
+// against the status code and Jamf error codes, enabling errors.Is.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrDuplicate:
+		return e.isDuplicate()
+	case ErrOptimisticLock:
+		return e.HasCode(ErrorCodeOptimisticLockFailed)
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError && e.StatusCode < 600
+	}
+	return false
+}
+
+// isDuplicate recognises DUPLICATE_FIELD from the Jamf Pro API and the
+// "Duplicate name" style conflicts the Classic API reports as plain text.
+func (e *APIError) isDuplicate() bool {
+	if e.HasCode(ErrorCodeDuplicateField) {
+		return true
+	}
+	return e.StatusCode == http.StatusConflict && strings.Contains(strings.ToLower(e.Message), "duplicate")
+}
+
+// AsAPIError returns the *APIError in err's chain, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// jamfErrorBody is a common shape for Jamf error responses. The Jamf Pro API
+// reports validation failures in the errors array; older endpoints use a
+// single code and message.
 type jamfErrorBody struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	Errors  []struct {
+		Code        string `json:"code"`
+		Field       string `json:"field"`
+		Description string `json:"description"`
+		ID          any    `json:"id"`
+	} `json:"errors"`
 }
 
-// ParseErrorResponse parses an error response from the API.
+// ParseErrorResponse parses an error response from the API. It understands
+// Jamf Pro API JSON bodies (top-level code/message and the errors array of
+// code/field/description/id entries) and Classic API HTML error pages; any
+// other body is kept verbatim as the message.
 func ParseErrorResponse(body []byte, statusCode int, status, method, endpoint string, logger *zap.Logger) error {
 	apiError := &APIError{
 		StatusCode: statusCode,
@@ -43,23 +187,85 @@ func ParseErrorResponse(body []byte, statusCode int, status, method, endpoint st
 		Method:     method,
 	}
 	var parsed jamfErrorBody
-	if err := json.Unmarshal(body, &parsed); err == nil && (parsed.Code != "" || parsed.Message != "") {
+	switch {
+	case json.Unmarshal(body, &parsed) == nil && (parsed.Code != "" || parsed.Message != "" || len(parsed.Errors) > 0):
 		apiError.Code = parsed.Code
 		apiError.Message = parsed.Message
-	} else {
-		apiError.Message = string(body)
-		if apiError.Message == "" {
-			apiError.Message = defaultMessageForStatus(statusCode)
+		for _, fe := range parsed.Errors {
+			f := FieldError{Code: fe.Code, Field: fe.Field, Description: fe.Description}
+			if fe.ID != nil {
+				f.ID = fmt.Sprint(fe.ID)
+			}
+			apiError.Errors = append(apiError.Errors, f)
+		}
+		if len(apiError.Errors) > 0 {
+			if apiError.Code == "" {
+				apiError.Code = apiError.Errors[0].Code
+			}
+			if apiError.Message == "" {
+				parts := make([]string, len(apiError.Errors))
+				for i, f := range apiError.Errors {
+					parts[i] = f.String()
+				}
+				apiError.Message = strings.Join(parts, "; ")
+			}
+		}
+	case isHTMLErrorPage(body):
+		messages := parseHTMLErrorPage(body)
+		for _, m := range messages {
+			apiError.Errors = append(apiError.Errors, FieldError{Description: m})
 		}
+		apiError.Message = strings.Join(messages, "; ")
+	default:
+		apiError.Message = string(body)
+	}
+	if apiError.Message == "" {
+		apiError.Message = defaultMessageForStatus(statusCode)
 	}
 	logger.Error("API error response",
 		zap.Int("status_code", statusCode),
 		zap.String("method", method),
 		zap.String("endpoint", endpoint),
+		zap.String("code", apiError.Code),
 		zap.String("message", apiError.Message))
 	return apiError
 }
 
+var (
+	htmlParagraph = regexp.MustCompile(`(?is)<p[^>]*>(.*?)</p>`)
+	htmlTag       = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// isHTMLErrorPage reports whether body looks like the HTML status page the
+// Classic API (and Tomcat) return for failed requests.
+func isHTMLErrorPage(body []byte) bool {
+	head := strings.ToLower(strings.TrimSpace(string(body[:min(len(body), 512)])))
+	return strings.HasPrefix(head, "<html") || strings.HasPrefix(head, "<!doctype html")
+}
+
+// parseHTMLErrorPage extracts the error text from a Classic API status page:
+//
+//	<html><head><title>Status page</title></head><body>
+//	<p>Conflict</p><p>Error: Duplicate name</p>
+//	<p>You can get technical details <a href="...">here</a>...</p></body></html>
+//
+// The first paragraph repeats the status text and the "technical details"
+// boilerplate is dropped; a leading "Error: " is trimmed from the rest.
+func parseHTMLErrorPage(body []byte) []string {
+	var messages []string
+	for i, m := range htmlParagraph.FindAllSubmatch(body, -1) {
+		text := strings.Join(strings.Fields(html.UnescapeString(htmlTag.ReplaceAllString(string(m[1]), " "))), " ")
+		if text == "" || (i == 0 && len(messages) == 0 && !strings.HasPrefix(text, "Error")) {
+			continue
+		}
+		if strings.HasPrefix(text, "You can get technical details") {
+			continue
+		}
+		messages = append(messages, strings.TrimPrefix(text, "Error: "))
+	}
+	return messages
+}
+
 func defaultMessageForStatus(statusCode int) string {
 	switch statusCode {
 	case http.StatusBadRequest:
@@ -89,42 +295,46 @@ func defaultMessageForStatus(statusCode int) string {
 
 // IsNotFound checks if the error is a not found error (404).
 func IsNotFound(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusNotFound
-	}
-	return false
+	return errors.Is(err, ErrNotFound)
 }
 
 // IsUnauthorized checks if the error is an authentication error (401).
 func IsUnauthorized(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusUnauthorized
-	}
-	return false
+	return errors.Is(err, ErrUnauthorized)
 }
 
 // IsBadRequest checks if the error is a bad request error (400).
 func IsBadRequest(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusBadRequest
-	}
-	return false
+	return errors.Is(err, ErrBadRequest)
 }
 
 // IsForbidden reports whether err is an APIError with HTTP 403 Forbidden.
 // Jamf Pro returns 403 (rather than 404) from the Jamf Remote Assist session
 // endpoints when the feature is not enabled on the instance.
 func IsForbidden(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusForbidden
-	}
-	return false
+	return errors.Is(err, ErrForbidden)
 }
 
 // IsServerError checks if the error is a server error (5xx).
 func IsServerError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode >= http.StatusInternalServerError && apiErr.StatusCode < 600
-	}
-	return false
+	return errors.Is(err, ErrServerError)
+}
+
+// IsConflict checks if the error is a conflict error (409).
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsDuplicate reports whether err rejects a value that must be unique: a
+// DUPLICATE_FIELD error from the Jamf Pro API, or a Classic API 409 whose
+// message mentions a duplicate (e.g. "Duplicate name").
+func IsDuplicate(err error) bool {
+	return errors.Is(err, ErrDuplicate)
+}
+
+// IsOptimisticLockFailure reports whether err is an OPTIMISTIC_LOCK_FAILED
+// error, i.e. an update was sent with a stale versionLock. Re-read the
+// resource and retry the update.
+func IsOptimisticLockFailure(err error) bool {
+	return errors.Is(err, ErrOptimisticLock)
 }
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -108,3 +110,76 @@ func TestParseErrorResponse_DefaultMessageForStatus(t *testing.T) {
 		assert.Equal(t, want, err.(*APIError).Message)
 	}
 }
+
+func TestParseErrorResponse_FieldErrors(t *testing.T) {
+	body := []byte(`{"httpStatus":400,"errors":[
+		{"code":"INVALID_FIELD","field":"name","description":"must not be blank","id":"0"},
+		{"code":"DUPLICATE_FIELD","field":"serialNumber","description":"already in use","id":7}]}`)
+
+	err := ParseErrorResponse(body, 400, "Bad Request", "POST", "/api/v1/buildings", zap.NewNop())
+	apiErr, ok := AsAPIError(err)
+	require.True(t, ok)
+	require.Len(t, apiErr.Errors, 2)
+	assert.Equal(t, FieldError{Code: "INVALID_FIELD", Field: "name", Description: "must not be blank", ID: "0"}, apiErr.Errors[0])
+	assert.Equal(t, "7", apiErr.Errors[1].ID)
+	assert.Equal(t, "INVALID_FIELD", apiErr.Code)
+	assert.Equal(t, "name: must not be blank (INVALID_FIELD); serialNumber: already in use (DUPLICATE_FIELD)", apiErr.Message)
+	assert.Len(t, apiErr.FieldErrors("serialNumber"), 1)
+	assert.True(t, IsBadRequest(err))
+	assert.True(t, IsDuplicate(err))
+	assert.False(t, IsConflict(err))
+}
+
+func TestParseErrorResponse_ClassicHTML(t *testing.T) {
+	body := []byte(`<html>
+<head><title>Status page</title></head>
+<body style="font-family: sans-serif;">
+<p style="font-size: 1.2em;font-weight: bold;margin: 1em 0px;">Conflict</p>
+<p style="font-size: 1.2em;font-family: sans-serif;">Error: Duplicate name</p>
+<p>You can get technical details <a href="http://www.w3.org/Protocols/rfc2616/rfc2616-sec10.html#sec10.4.10">here</a>.<br>
+Please continue your visit at our <a href="/">home page</a>.
+</p>
+</body>
+</html>`)
+
+	err := ParseErrorResponse(body, 409, "Conflict", "POST", "/JSSResource/buildings/id/0", zap.NewNop())
+	apiErr, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, "Duplicate name", apiErr.Message)
+	assert.Equal(t, []FieldError{{Description: "Duplicate name"}}, apiErr.Errors)
+	assert.True(t, IsConflict(err))
+	assert.True(t, IsDuplicate(err))
+}
+
+func TestParseErrorResponse_OptimisticLock(t *testing.T) {
+	body := []byte(`{"httpStatus":409,"errors":[{"code":"OPTIMISTIC_LOCK_FAILED","field":null,"description":"Optimistic lock failed","id":null}]}`)
+
+	err := ParseErrorResponse(body, 409, "Conflict", "PUT", "/api/v1/computers-inventory-detail/1", zap.NewNop())
+	assert.True(t, IsOptimisticLockFailure(err))
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsDuplicate(err))
+	assert.Equal(t, "", err.(*APIError).Errors[0].ID)
+}
+
+func TestAPIError_WrappedErrorsIsAndAs(t *testing.T) {
+	base := ParseErrorResponse([]byte(`{"code":"NOT_FOUND","message":"missing"}`), 404, "Not Found", "GET", "/api/v1/buildings/9", zap.NewNop())
+	err := fmt.Errorf("failed to get building: %w", base)
+
+	assert.True(t, IsNotFound(err))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrServerError))
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "NOT_FOUND", apiErr.Code)
+	assert.True(t, apiErr.HasCode("NOT_FOUND"))
+
+	_, ok := AsAPIError(errors.New("other"))
+	assert.False(t, ok)
+}
+
+func TestIsConflict_NonAPIError(t *testing.T) {
+	assert.False(t, IsConflict(errors.New("other")))
+	assert.False(t, IsDuplicate(errors.New("other")))
+	assert.False(t, IsOptimisticLockFailure(errors.New("other")))
+}
@@ -12,7 +12,7 @@ import (
 type Client interface {
 	// NewRequest returns a RequestBuilder that the service layer uses to
 	// construct a complete request — headers, body, query params, result
-	// target — before executing it via Get/Post/Put/Patch/Delete/GetBytes/GetPaginated.
+	// target — before executing it via Get/Post/Put/Patch/Delete/GetBytes/GetPaginated/Iterate.
 	// Auth, retry, throttling, and concurrency limiting are applied by
 	// the transport at execution time.
 	NewRequest(ctx context.Context) *RequestBuilder
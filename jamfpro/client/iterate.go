@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// Iterate streams the records of a paginated Jamf Pro API endpoint one at a
+// time instead of buffering every page the way GetPaginated does. Each record
+// is yielded as its raw JSON; use IterateAs for typed decoding.
+//
+// Pages are requested lazily as the consumer ranges over the sequence, so
+// breaking out of the loop stops pagination without fetching the remaining
+// pages. Cancelling the request context ends the sequence with ctx.Err() at
+// the next page boundary. Query parameters already set on the builder
+// (filter, sort, section) apply to every page; page and page-size are managed
+// by the transport exactly as for GetPaginated.
+//
+// A failure is yielded once as a non-nil error, after which the sequence ends.
+//
+// Usage:
+//
+//	for raw, err := range s.client.NewRequest(ctx).
+//	    SetHeader("Accept", constants.ApplicationJSON).
+//	    Iterate(constants.EndpointFoo) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    ...
+//	}
+func (b *RequestBuilder) Iterate(path string) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		stopped := false
+		_, err := b.executor.executeIterate(b.req, path, func(page []byte) (bool, error) {
+			var records []json.RawMessage
+			if err := json.Unmarshal(page, &records); err != nil {
+				return false, fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			for _, record := range records {
+				if !yield(record, nil) {
+					stopped = true
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// IterateAs is the typed form of RequestBuilder.Iterate: each record is
+// unmarshaled into T before being yielded. A record that fails to decode ends
+// the sequence with the decode error.
+func IterateAs[T any](b *RequestBuilder, path string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for raw, err := range b.Iterate(path) {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			var record T
+			if err := json.Unmarshal(raw, &record); err != nil {
+				yield(zero, fmt.Errorf("failed to unmarshal record: %w", err))
+				return
+			}
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// tokenKeeper runs the background keep-alive loop enabled by
+// TransportSettings.AutoKeepAliveInterval. It extends the current bearer
+// token via keepAlive and, when that fails (e.g. the token was revoked or the
+// source issues tokens keep-alive cannot extend), falls back to a full fetch
+// from the token source so long-running daemons never start a request with
+// an expired token.
+//
+// The loop is supervised: a panic inside an iteration is recovered, logged,
+// and the loop restarted after keepAliveMinWait.
+type tokenKeeper struct {
+	manager  *bearerTokenManager
+	interval time.Duration
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// startTokenKeeper starts the keep-alive goroutine and returns a handle used
+// to stop it.
+func startTokenKeeper(manager *bearerTokenManager, interval time.Duration, logger *zap.Logger) *tokenKeeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	k := &tokenKeeper{
+		manager:  manager,
+		interval: interval,
+		logger:   logger,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go k.supervise(ctx)
+	logger.Info("Bearer token auto keep-alive started", zap.Duration("interval", interval))
+	return k
+}
+
+// stop cancels the loop and waits for it to exit or for ctx to be done.
+// Safe to call more than once.
+func (k *tokenKeeper) stop(ctx context.Context) error {
+	k.cancel()
+	select {
+	case <-k.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (k *tokenKeeper) supervise(ctx context.Context) {
+	defer close(k.done)
+	for {
+		if k.run(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keepAliveMinWait):
+		}
+	}
+}
+
+// run executes the loop until ctx is done, returning true. It returns false
+// when an iteration panicked and the loop should be restarted.
+func (k *tokenKeeper) run(ctx context.Context) (stopped bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.logger.Error("Bearer token keep-alive loop panicked; restarting",
+				zap.Error(fmt.Errorf("panic: %v", r)),
+			)
+			stopped = false
+		}
+	}()
+
+	for {
+		timer := time.NewTimer(k.nextWait())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return true
+		case <-timer.C:
+		}
+		k.tick(ctx)
+	}
+}
+
+// nextWait returns the configured interval, shortened so that the next
+// attempt lands before the current token enters its refresh buffer.
+func (k *tokenKeeper) nextWait() time.Duration {
+	wait := k.interval
+	if expiry := k.manager.expiresAt(); !expiry.IsZero() {
+		if untilBuffer := time.Until(expiry) - k.manager.buffer; untilBuffer < wait {
+			wait = untilBuffer
+		}
+	}
+	return max(wait, keepAliveMinWait)
+}
+
+func (k *tokenKeeper) tick(ctx context.Context) {
+	err := k.manager.keepAlive(ctx)
+	if err == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	k.logger.Warn("Bearer token keep-alive failed; fetching a new token", zap.Error(err))
+	if err := k.manager.refresh(ctx); err != nil && ctx.Err() == nil {
+		k.logger.Error("Bearer token refresh after failed keep-alive failed", zap.Error(err))
+	}
+}
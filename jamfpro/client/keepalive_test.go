@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// keepAliveServer counts calls to the token, keep-alive, and invalidate
+// endpoints. Keep-alive answers with keepAliveStatus.
+type keepAliveServer struct {
+	*httptest.Server
+	tokenCalls      atomic.Int32
+	keepAliveCalls  atomic.Int32
+	invalidateCalls atomic.Int32
+	keepAliveStatus atomic.Int32
+}
+
+func newKeepAliveServer(t *testing.T) *keepAliveServer {
+	t.Helper()
+	ks := &keepAliveServer{}
+	ks.keepAliveStatus.Store(http.StatusOK)
+	ks.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case constants.EndpointOAuthToken:
+			n := ks.tokenCalls.Add(1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"tok-%d","expires_in":3600}`, n)
+		case constants.EndpointKeepAliveToken:
+			n := ks.keepAliveCalls.Add(1)
+			status := int(ks.keepAliveStatus.Load())
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"token":   fmt.Sprintf("extended-%d", n),
+					"expires": time.Now().Add(time.Hour).Format(time.RFC3339),
+				})
+			}
+		case constants.EndpointInvalidateToken:
+			ks.invalidateCalls.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(ks.Close)
+	return ks
+}
+
+func newKeepAliveTestTransport(t *testing.T, srv *keepAliveServer, interval time.Duration) *Transport {
+	t.Helper()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg, func(s *TransportSettings) error {
+		s.Logger = zap.NewNop()
+		s.AutoKeepAliveInterval = interval
+		return nil
+	})
+	require.NoError(t, err)
+	return tr
+}
+
+func TestTokenKeeper_ExtendsToken(t *testing.T) {
+	srv := newKeepAliveServer(t)
+	tr := newKeepAliveTestTransport(t, srv, keepAliveMinWait)
+	t.Cleanup(func() { _ = tr.Close(context.Background()) })
+
+	require.Eventually(t, func() bool { return srv.keepAliveCalls.Load() >= 1 }, 5*time.Second, 20*time.Millisecond)
+
+	token, err := tr.tokenManager.getToken(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, token, "extended-")
+	assert.Equal(t, int32(1), srv.tokenCalls.Load())
+}
+
+func TestTokenKeeper_FallsBackToFetch(t *testing.T) {
+	srv := newKeepAliveServer(t)
+	srv.keepAliveStatus.Store(http.StatusUnauthorized)
+	tr := newKeepAliveTestTransport(t, srv, keepAliveMinWait)
+	t.Cleanup(func() { _ = tr.Close(context.Background()) })
+
+	require.Eventually(t, func() bool { return srv.tokenCalls.Load() >= 2 }, 5*time.Second, 20*time.Millisecond)
+	assert.GreaterOrEqual(t, srv.keepAliveCalls.Load(), int32(1))
+}
+
+func TestTokenKeeper_NextWaitBeforeRefreshBuffer(t *testing.T) {
+	m := &bearerTokenManager{buffer: time.Minute}
+	k := &tokenKeeper{manager: m, interval: time.Hour}
+
+	assert.Equal(t, time.Hour, k.nextWait(), "no token yet: use the interval")
+
+	m.expiry = time.Now().Add(11 * time.Minute)
+	assert.InDelta(t, float64(10*time.Minute), float64(k.nextWait()), float64(time.Second))
+
+	m.expiry = time.Now().Add(30 * time.Second)
+	assert.Equal(t, keepAliveMinWait, k.nextWait(), "already inside the buffer: wait the minimum")
+}
+
+func TestTransport_Close_StopsKeeperAndInvalidates(t *testing.T) {
+	srv := newKeepAliveServer(t)
+	tr := newKeepAliveTestTransport(t, srv, time.Hour)
+
+	require.NoError(t, tr.Close(context.Background()))
+	assert.Equal(t, int32(1), srv.invalidateCalls.Load())
+
+	select {
+	case <-tr.keeper.done:
+	default:
+		t.Fatal("keep-alive goroutine still running after Close")
+	}
+	assert.Equal(t, int32(0), srv.keepAliveCalls.Load())
+
+	require.NoError(t, tr.Close(context.Background()), "Close is idempotent")
+	assert.Equal(t, int32(1), srv.invalidateCalls.Load())
+}
+
+func TestTransport_Close_WithoutKeeper(t *testing.T) {
+	srv := newKeepAliveServer(t)
+	tr := newKeepAliveTestTransport(t, srv, 0)
+
+	assert.Nil(t, tr.keeper)
+	require.NoError(t, tr.Close(context.Background()))
+	assert.Equal(t, int32(1), srv.invalidateCalls.Load())
+}
@@ -4,8 +4,14 @@ import (
 	"net/http"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
 )
 
+// instrumentationName scopes the SDK's own metrics in the global MeterProvider.
+const instrumentationName = "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+
 // applyOpenTelemetry wraps the HTTP transport with OpenTelemetry instrumentation.
 // This is always enabled and uses the global OpenTelemetry providers set via:
 //   - otel.SetTracerProvider()
@@ -21,6 +27,9 @@ import (
 // - Request/response timing
 // - Metrics (request duration, body size, etc.)
 //
+// The SDK additionally records jamfpro.client.token.forced_refreshes, the
+// number of bearer tokens re-fetched after the API rejected them with 401.
+//
 // All telemetry follows OpenTelemetry semantic conventions for HTTP clients.
 // See: https://opentelemetry.io/docs/languages/go/getting-started/
 func (t *Transport) applyOpenTelemetry() {
@@ -37,5 +46,15 @@ func (t *Transport) applyOpenTelemetry() {
 	instrumentedTransport := otelhttp.NewTransport(transport)
 	httpClient.Transport = instrumentedTransport
 
+	counter, err := otel.Meter(instrumentationName).Int64Counter(
+		"jamfpro.client.token.forced_refreshes",
+		metric.WithDescription("Bearer tokens re-fetched after the Jamf Pro API rejected them with 401"),
+	)
+	if err != nil {
+		t.logger.Warn("Failed to create forced token refresh counter", zap.Error(err))
+	} else {
+		t.forcedRefreshCounter = counter
+	}
+
 	t.logger.Debug("OpenTelemetry HTTP instrumentation enabled (uses global providers)")
 }
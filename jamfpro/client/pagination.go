@@ -29,29 +29,63 @@ type jamfPaginatedPage struct {
 // Pagination is only available on endpoints that explicitly support it.
 // Example: GET /api/v3/computers-inventory
 // See: https://developer.jamf.com/jamf-pro/reference/get_v3-computers-inventory
+//
+// When parallel pagination is enabled (WithParallelPagination), pages after
+// the first are fetched concurrently; see prefetchPages.
 func (t *Transport) executePaginated(req *resty.Request, path string, mergePage func([]byte) error) (*resty.Response, error) {
+	merge := func(results []byte) (bool, error) {
+		if err := mergePage(results); err != nil {
+			return false, fmt.Errorf("merge page: %w", err)
+		}
+		return true, nil
+	}
+	if t.parallelPagination {
+		return t.prefetchPages(req, path, merge)
+	}
+	return t.walkPages(req, path, merge)
+}
+
+// executeIterate implements requestExecutor for Transport. Pages are fetched
+// lazily: the next page is only requested once onPage has returned true for
+// the current one, so a consumer that stops early never pays for the rest.
+func (t *Transport) executeIterate(req *resty.Request, path string, onPage func([]byte) (bool, error)) (*resty.Response, error) {
+	return t.walkPages(req, path, onPage)
+}
+
+// pageTemplate is the per-page request shape derived from the caller's
+// original request: base query params (filter, sort, page, page-size) and
+// the per-request headers the service set (e.g. Accept).
+type pageTemplate struct {
+	ctx     context.Context
+	params  map[string]string
+	headers map[string]string
+}
+
+// newPageTemplate snapshots req into a pageTemplate. page and page-size
+// default to 0 and DefaultPageSize when the caller has not set them.
+func newPageTemplate(req *resty.Request) *pageTemplate {
 	// Build initial page params from query params already set on the request.
 	// The caller has set filter/sort via SetQueryParam(s); we manage page/page-size.
-	currentParams := make(map[string]string)
+	params := make(map[string]string)
 	for k, vs := range req.QueryParams {
 		if len(vs) > 0 {
-			currentParams[k] = vs[0]
+			params[k] = vs[0]
 		}
 	}
-	if currentParams["page"] == "" {
-		currentParams["page"] = "0"
+	if params["page"] == "" {
+		params["page"] = "0"
 	}
-	if currentParams["page-size"] == "" {
-		currentParams["page-size"] = strconv.Itoa(DefaultPageSize)
+	if params["page-size"] == "" {
+		params["page-size"] = strconv.Itoa(DefaultPageSize)
 	}
 
 	// Snapshot per-request headers the service set (e.g. Accept).
 	// Client-level headers (User-Agent, global headers, Authorization) are
 	// applied automatically by resty to every new request created from t.client.R().
-	templateHeaders := make(map[string]string)
+	headers := make(map[string]string)
 	for k, vs := range req.Header {
 		if len(vs) > 0 {
-			templateHeaders[k] = vs[0]
+			headers[k] = vs[0]
 		}
 	}
 
@@ -59,44 +93,82 @@ func (t *Transport) executePaginated(req *resty.Request, path string, mergePage
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	return &pageTemplate{ctx: ctx, params: params, headers: headers}
+}
 
-	var lastResp *resty.Response
-	for {
-		var pageResp jamfPaginatedPage
-		pageReq := t.client.R().
-			SetContext(ctx).
-			SetResult(&pageResp).
-			SetResponseBodyUnlimitedReads(true)
-		for k, v := range currentParams {
-			if v != "" {
-				pageReq.SetQueryParam(k, v)
-			}
+// startPage returns the zero-based page number the caller asked to start from.
+func (p *pageTemplate) startPage() int {
+	n, _ := strconv.Atoi(p.params["page"])
+	return n
+}
+
+// pageSize returns the effective page size, falling back to DefaultPageSize.
+func (p *pageTemplate) pageSize() int {
+	n, _ := strconv.Atoi(p.params["page-size"])
+	if n <= 0 {
+		return DefaultPageSize
+	}
+	return n
+}
+
+// fetchPage requests a single page through executeRequest. A new
+// resty.Request is created per page so each one is independently retryable.
+func (t *Transport) fetchPage(ctx context.Context, tmpl *pageTemplate, path string, page int) (*jamfPaginatedPage, *resty.Response, error) {
+	var pageResp jamfPaginatedPage
+	pageReq := t.client.R().
+		SetContext(ctx).
+		SetResult(&pageResp).
+		SetResponseBodyUnlimitedReads(true)
+	for k, v := range tmpl.params {
+		if v != "" {
+			pageReq.SetQueryParam(k, v)
+		}
+	}
+	pageReq.SetQueryParam("page", strconv.Itoa(page))
+	for k, v := range tmpl.headers {
+		if v != "" {
+			pageReq.SetHeader(k, v)
 		}
-		for k, v := range templateHeaders {
-			if v != "" {
-				pageReq.SetHeader(k, v)
-			}
+	}
+
+	resp, err := t.executeRequest(pageReq, "GET", path)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pageResp, resp, nil
+}
+
+// walkPages is the page loop shared by executePaginated and executeIterate.
+// onPage receives each page's raw results array and returns false to stop
+// before the next page is requested. The request context is checked before
+// every page so cancellation takes effect between pages as well as mid-request.
+func (t *Transport) walkPages(req *resty.Request, path string, onPage func([]byte) (bool, error)) (*resty.Response, error) {
+	tmpl := newPageTemplate(req)
+	pageSize := tmpl.pageSize()
+
+	var lastResp *resty.Response
+	for pageNum := tmpl.startPage(); ; pageNum++ {
+		if err := tmpl.ctx.Err(); err != nil {
+			return lastResp, err
 		}
 
-		resp, err := t.executeRequest(pageReq, "GET", path)
+		pageResp, resp, err := t.fetchPage(tmpl.ctx, tmpl, path, pageNum)
 		lastResp = resp
 		if err != nil {
 			return lastResp, err
 		}
 
-		if err := mergePage(pageResp.Results); err != nil {
-			return lastResp, fmt.Errorf("merge page: %w", err)
+		more, err := onPage(pageResp.Results)
+		if err != nil {
+			return lastResp, err
 		}
-
-		pageNum, _ := strconv.Atoi(currentParams["page"])
-		pageSize, _ := strconv.Atoi(currentParams["page-size"])
-		if pageSize <= 0 {
-			pageSize = DefaultPageSize
+		if !more {
+			break
 		}
+
 		if len(pageResp.Results) == 0 || (pageNum+1)*pageSize >= pageResp.TotalCount {
 			break
 		}
-		currentParams["page"] = strconv.Itoa(pageNum + 1)
 	}
 	return lastResp, nil
 }
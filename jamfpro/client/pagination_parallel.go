@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// prefetchPages is the parallel counterpart of walkPages used by
+// executePaginated when parallel pagination is enabled.
+//
+// The first page is fetched on its own to learn totalCount. The remaining
+// page numbers are then fanned out to a bounded pool of workers; every page
+// request still goes through executeRequest, so the concurrency semaphore,
+// retry policy and adaptive throttling apply exactly as for sequential
+// pagination. Pages are buffered and handed to onPage strictly in page order,
+// so callers see the same result ordering as with walkPages.
+//
+// Consistency: Jamf Pro has no snapshot or cursor semantics. Each page is an
+// independent query, so records created or deleted while the pages are in
+// flight can shift items across page boundaries — sequential pagination has
+// the same exposure, but parallel fetching widens the window. A deterministic
+// sort key is required so that page boundaries are at least stable between
+// requests; requests without a sort query parameter fall back to sequential
+// pagination with a warning.
+func (t *Transport) prefetchPages(req *resty.Request, path string, onPage func([]byte) (bool, error)) (*resty.Response, error) {
+	tmpl := newPageTemplate(req)
+	if tmpl.params["sort"] == "" {
+		t.logger.Warn("Parallel pagination requires a sort query parameter for stable page boundaries; fetching pages sequentially",
+			zap.String("path", path),
+		)
+		return t.walkPages(req, path, onPage)
+	}
+
+	start := tmpl.startPage()
+	pageSize := tmpl.pageSize()
+
+	first, lastResp, err := t.fetchPage(tmpl.ctx, tmpl, path, start)
+	if err != nil {
+		return lastResp, err
+	}
+	more, err := onPage(first.Results)
+	if err != nil || !more || len(first.Results) == 0 {
+		return lastResp, err
+	}
+
+	lastPage := (first.TotalCount - 1) / pageSize
+	remaining := lastPage - start
+	if remaining <= 0 {
+		return lastResp, nil
+	}
+
+	t.logger.Debug("Prefetching remaining pages in parallel",
+		zap.String("path", path),
+		zap.Int("total_count", first.TotalCount),
+		zap.Int("remaining_pages", remaining),
+	)
+
+	ctx, cancel := context.WithCancel(tmpl.ctx)
+	defer cancel()
+
+	pages := make([]*jamfPaginatedPage, remaining)
+	resps := make([]*resty.Response, remaining)
+
+	var (
+		errOnce  sync.Once
+		fetchErr error
+		errResp  *resty.Response
+	)
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(t.paginationWorkers(), remaining) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				page, resp, err := t.fetchPage(ctx, tmpl, path, start+1+i)
+				if err != nil {
+					errOnce.Do(func() {
+						fetchErr = err
+						errResp = resp
+						cancel()
+					})
+					continue
+				}
+				pages[i] = page
+				resps[i] = resp
+			}
+		}()
+	}
+
+feed:
+	for i := range remaining {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if fetchErr != nil {
+		return errResp, fetchErr
+	}
+	if err := tmpl.ctx.Err(); err != nil {
+		return lastResp, err
+	}
+
+	for i, page := range pages {
+		lastResp = resps[i]
+		more, err := onPage(page.Results)
+		if err != nil {
+			return lastResp, err
+		}
+		if !more {
+			break
+		}
+	}
+	return lastResp, nil
+}
+
+// paginationWorkers returns the fan-out width for prefetchPages: the
+// configured concurrency limit when one is set, otherwise the Jamf-recommended
+// DefaultMaxConcurrentRequests so an unlimited transport does not open one
+// connection per page.
+func (t *Transport) paginationWorkers() int {
+	if t.sem != nil {
+		return cap(t.sem.ch)
+	}
+	return DefaultMaxConcurrentRequests
+}
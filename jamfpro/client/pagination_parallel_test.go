@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parallelPageServer serves total items at /api/v3/items and records the
+// page request count and the peak number of concurrent page requests.
+type parallelPageServer struct {
+	*httptest.Server
+	requests atomic.Int32
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	failPage string
+}
+
+func newParallelPageServer(t *testing.T, total int, failPage string) *parallelPageServer {
+	t.Helper()
+	ps := &parallelPageServer{failPage: failPage}
+	ps.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/oauth/token" && r.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"access_token":"t","expires_in":3600}`))
+			return
+		}
+		if r.URL.Path != "/api/v3/items" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		ps.requests.Add(1)
+		n := ps.inFlight.Add(1)
+		defer ps.inFlight.Add(-1)
+		for {
+			p := ps.peak.Load()
+			if n <= p || ps.peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		pageParam := r.URL.Query().Get("page")
+		if pageParam == ps.failPage {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"BAD","message":"bad page"}`))
+			return
+		}
+		page, _ := strconv.Atoi(pageParam)
+		size, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+		results := []any{}
+		for i := page * size; i < (page+1)*size && i < total; i++ {
+			results = append(results, map[string]string{"id": strconv.Itoa(i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]any{"totalCount": total, "results": results})
+	}))
+	return ps
+}
+
+func newParallelTransport(t *testing.T, url string, maxConcurrent int) *Transport {
+	t.Helper()
+	cfg := &config.AuthConfig{InstanceDomain: url, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg, func(s *TransportSettings) error {
+		s.ParallelPagination = true
+		s.MaxConcurrentRequests = maxConcurrent
+		return nil
+	})
+	require.NoError(t, err)
+	return tr
+}
+
+func collectIDs(t *testing.T, ids *[]string) func([]byte) error {
+	t.Helper()
+	return func(pageData []byte) error {
+		var items []map[string]string
+		if err := json.Unmarshal(pageData, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			*ids = append(*ids, item["id"])
+		}
+		return nil
+	}
+}
+
+func TestTransport_GetPaginated_Parallel_InOrder(t *testing.T) {
+	srv := newParallelPageServer(t, 23, "")
+	defer srv.Close()
+	tr := newParallelTransport(t, srv.URL, 3)
+
+	var ids []string
+	_, err := tr.NewRequest(context.Background()).
+		SetQueryParam("sort", "id:asc").
+		SetQueryParam("page-size", "2").
+		GetPaginated("/api/v3/items", collectIDs(t, &ids))
+	require.NoError(t, err)
+
+	require.Len(t, ids, 23)
+	for i, id := range ids {
+		assert.Equal(t, strconv.Itoa(i), id)
+	}
+	assert.Equal(t, int32(12), srv.requests.Load())
+	assert.Greater(t, srv.peak.Load(), int32(1), "remaining pages should be fetched concurrently")
+	assert.LessOrEqual(t, srv.peak.Load(), int32(3), "fan-out must respect MaxConcurrentRequests")
+}
+
+func TestTransport_GetPaginated_Parallel_RequiresSort(t *testing.T) {
+	srv := newParallelPageServer(t, 6, "")
+	defer srv.Close()
+	tr := newParallelTransport(t, srv.URL, 3)
+
+	var ids []string
+	_, err := tr.NewRequest(context.Background()).
+		SetQueryParam("page-size", "2").
+		GetPaginated("/api/v3/items", collectIDs(t, &ids))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5"}, ids)
+	assert.Equal(t, int32(1), srv.peak.Load(), "unsorted requests fall back to sequential pagination")
+}
+
+func TestTransport_GetPaginated_Parallel_PageError(t *testing.T) {
+	srv := newParallelPageServer(t, 10, "3")
+	defer srv.Close()
+	tr := newParallelTransport(t, srv.URL, 2)
+
+	var ids []string
+	resp, err := tr.NewRequest(context.Background()).
+		SetQueryParam("sort", "id:asc").
+		SetQueryParam("page-size", "2").
+		GetPaginated("/api/v3/items", collectIDs(t, &ids))
+	require.Error(t, err)
+	assert.True(t, IsBadRequest(err))
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	assert.Equal(t, []string{"0", "1"}, ids, "only the first page is merged when a later page fails")
+}
+
+func TestTransport_GetPaginated_Parallel_SinglePage(t *testing.T) {
+	srv := newParallelPageServer(t, 2, "")
+	defer srv.Close()
+	tr := newParallelTransport(t, srv.URL, 0)
+
+	var ids []string
+	_, err := tr.NewRequest(context.Background()).
+		SetQueryParam("sort", "id:asc").
+		GetPaginated("/api/v3/items", collectIDs(t, &ids))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0", "1"}, ids)
+	assert.Equal(t, int32(1), srv.requests.Load())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
@@ -138,3 +139,128 @@ func TestTransport_GetPaginated_MergeError(t *testing.T) {
 	assert.Contains(t, err.Error(), "merge page")
 	assert.NotNil(t, resp)
 }
+
+// newPagedItemsServer serves total items at /api/v3/items in pages of the
+// requested page-size and counts how many page requests it received.
+func newPagedItemsServer(t *testing.T, total int, pageRequests *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/oauth/token" && r.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"access_token":"t","expires_in":3600}`))
+			return
+		}
+		if r.URL.Path == "/api/v3/items" {
+			*pageRequests++
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			size, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+			results := []any{}
+			for i := page * size; i < (page+1)*size && i < total; i++ {
+				results = append(results, map[string]string{"id": strconv.Itoa(i)})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"totalCount": total,
+				"results":    results,
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
+
+func TestTransport_Iterate_AllPages(t *testing.T) {
+	pageRequests := 0
+	srv := newPagedItemsServer(t, 5, &pageRequests)
+	defer srv.Close()
+
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg)
+	require.NoError(t, err)
+
+	var ids []string
+	req := tr.NewRequest(context.Background()).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParam("page-size", "2")
+	for item, err := range IterateAs[map[string]string](req, "/api/v3/items") {
+		require.NoError(t, err)
+		ids = append(ids, item["id"])
+	}
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+	assert.Equal(t, 3, pageRequests)
+}
+
+func TestTransport_Iterate_StopEarly(t *testing.T) {
+	pageRequests := 0
+	srv := newPagedItemsServer(t, 10, &pageRequests)
+	defer srv.Close()
+
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg)
+	require.NoError(t, err)
+
+	seen := 0
+	for _, err := range tr.NewRequest(context.Background()).
+		SetQueryParam("page-size", "2").
+		Iterate("/api/v3/items") {
+		require.NoError(t, err)
+		seen++
+		if seen == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, 3, seen)
+	assert.Equal(t, 2, pageRequests, "only the pages needed for three records should be fetched")
+}
+
+func TestTransport_Iterate_ContextCanceled(t *testing.T) {
+	pageRequests := 0
+	srv := newPagedItemsServer(t, 10, &pageRequests)
+	defer srv.Close()
+
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var iterErr error
+	seen := 0
+	for _, err := range tr.NewRequest(ctx).
+		SetQueryParam("page-size", "2").
+		Iterate("/api/v3/items") {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		seen++
+		cancel()
+	}
+
+	require.ErrorIs(t, iterErr, context.Canceled)
+	assert.Equal(t, 2, seen)
+	assert.Equal(t, 1, pageRequests)
+}
+
+func TestTransport_Iterate_DecodeError(t *testing.T) {
+	pageRequests := 0
+	srv := newPagedItemsServer(t, 1, &pageRequests)
+	defer srv.Close()
+
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg)
+	require.NoError(t, err)
+
+	var iterErr error
+	for _, err := range IterateAs[[]int](tr.NewRequest(context.Background()), "/api/v3/items") {
+		iterErr = err
+	}
+
+	require.Error(t, iterErr)
+	assert.Contains(t, iterErr.Error(), "failed to unmarshal record")
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// ProgressPhase identifies the stage of a file transfer a ProgressEvent
+// belongs to.
+type ProgressPhase string
+
+// Progress phases reported by file transfer operations.
+const (
+	// ProgressPhaseHashing is reported while local checksums are computed.
+	ProgressPhaseHashing ProgressPhase = "hashing"
+	// ProgressPhaseUploading is reported as file bytes are sent.
+	ProgressPhaseUploading ProgressPhase = "uploading"
+	// ProgressPhaseDownloading is reported as file bytes are received.
+	ProgressPhaseDownloading ProgressPhase = "downloading"
+	// ProgressPhaseVerifying is reported while a transferred file is checked.
+	ProgressPhaseVerifying ProgressPhase = "verifying"
+	// ProgressPhaseComplete is reported once when a transfer has finished.
+	ProgressPhaseComplete ProgressPhase = "complete"
+)
+
+// ProgressEvent describes the state of one file transfer.
+type ProgressEvent struct {
+	// Phase is the stage of the transfer.
+	Phase ProgressPhase
+	// File is the name of the file being transferred.
+	File string
+	// BytesTransferred is the number of bytes processed so far in Phase.
+	BytesTransferred int64
+	// TotalBytes is the size of the file, or 0 when unknown.
+	TotalBytes int64
+}
+
+// ProgressReporter receives progress events from uploads and downloads
+// (JCDS, packages, Classic API file uploads, inventory preload CSV, and
+// branding images). Report may be called from the goroutine performing the
+// transfer and must return quickly.
+//
+// The SDK is silent by default. Configure a reporter for every request with
+// WithProgressReporter at client construction, or for a single call with
+// ContextWithProgressReporter; upload_counter.New provides a terminal
+// progress bar.
+type ProgressReporter interface {
+	Report(event ProgressEvent)
+}
+
+// ProgressReporterFunc adapts a function to ProgressReporter.
+type ProgressReporterFunc func(event ProgressEvent)
+
+// Report calls f(event).
+func (f ProgressReporterFunc) Report(event ProgressEvent) { f(event) }
+
+// silentProgress discards every event.
+type silentProgress struct{}
+
+func (silentProgress) Report(ProgressEvent) {}
+
+// progressContextKey is the context key for a per-call ProgressReporter.
+type progressContextKey struct{}
+
+// ContextWithProgressReporter returns a copy of ctx carrying reporter, which
+// takes precedence over the client's reporter for calls made with ctx.
+func ContextWithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, reporter)
+}
+
+// progressReporterProvider is implemented by clients that carry a default
+// ProgressReporter (Transport does; test mocks need not).
+type progressReporterProvider interface {
+	ProgressReporter() ProgressReporter
+}
+
+// ProgressReporterFor returns the reporter for a call made with ctx through c:
+// the one in ctx, else the client's, else a reporter that discards events.
+// It never returns nil.
+func ProgressReporterFor(ctx context.Context, c Client) ProgressReporter {
+	if ctx != nil {
+		if r, ok := ctx.Value(progressContextKey{}).(ProgressReporter); ok && r != nil {
+			return r
+		}
+	}
+	if p, ok := c.(progressReporterProvider); ok {
+		if r := p.ProgressReporter(); r != nil {
+			return r
+		}
+	}
+	return silentProgress{}
+}
+
+// NewProgressReader wraps r so that every Read reports the running byte
+// count to reporter, in phase, for file of total bytes.
+func NewProgressReader(r io.Reader, reporter ProgressReporter, phase ProgressPhase, file string, total int64) io.Reader {
+	return &progressReader{r: r, reporter: reporter, phase: phase, file: file, total: total}
+}
+
+type progressReader struct {
+	r        io.Reader
+	reporter ProgressReporter
+	phase    ProgressPhase
+	file     string
+	total    int64
+	done     int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.done += int64(n)
+		p.reporter.Report(ProgressEvent{Phase: p.phase, File: p.file, BytesTransferred: p.done, TotalBytes: p.total})
+	}
+	return n, err
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingProgress collects reported events.
+type recordingProgress struct {
+	mu     sync.Mutex
+	events []ProgressEvent
+}
+
+func (r *recordingProgress) Report(e ProgressEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recordingProgress) phases() []ProgressPhase {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []ProgressPhase
+	for _, e := range r.events {
+		if len(out) == 0 || out[len(out)-1] != e.Phase {
+			out = append(out, e.Phase)
+		}
+	}
+	return out
+}
+
+func TestProgressReporterFor_Precedence(t *testing.T) {
+	clientReporter := &recordingProgress{}
+	ctxReporter := &recordingProgress{}
+	tr := &Transport{progress: clientReporter}
+
+	assert.Same(t, clientReporter, ProgressReporterFor(context.Background(), tr))
+	assert.Same(t, ctxReporter, ProgressReporterFor(ContextWithProgressReporter(context.Background(), ctxReporter), tr))
+
+	silent := ProgressReporterFor(context.Background(), &Transport{})
+	require.NotNil(t, silent)
+	silent.Report(ProgressEvent{Phase: ProgressPhaseUploading})
+}
+
+func TestNewProgressReader_ReportsRunningTotal(t *testing.T) {
+	rec := &recordingProgress{}
+	r := NewProgressReader(strings.NewReader("0123456789"), rec, ProgressPhaseUploading, "a.pkg", 10)
+
+	buf := make([]byte, 4)
+	for {
+		if _, err := r.Read(buf); err == io.EOF {
+			break
+		}
+	}
+	require.NotEmpty(t, rec.events)
+	last := rec.events[len(rec.events)-1]
+	assert.Equal(t, ProgressEvent{Phase: ProgressPhaseUploading, File: "a.pkg", BytesTransferred: 10, TotalBytes: 10}, last)
+}
+
+func TestTransport_Multipart_ReportsProgress(t *testing.T) {
+	srv := newMockAuthServer(t)
+	defer srv.Close()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+
+	clientReporter := &recordingProgress{}
+	tr, err := NewTransport(cfg, func(s *TransportSettings) error {
+		s.ProgressReporter = clientReporter
+		return nil
+	})
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("x"), 64*1024)
+	_, err = tr.NewRequest(context.Background()).
+		SetMultipartFile("file", "users.csv", bytes.NewReader(data), int64(len(data)), nil).
+		Post("/api/v1/inventory-preload/csv")
+	require.NoError(t, err)
+	assert.Equal(t, []ProgressPhase{ProgressPhaseUploading, ProgressPhaseComplete}, clientReporter.phases())
+	assert.Equal(t, "users.csv", clientReporter.events[0].File)
+
+	// A reporter on the context replaces the client's for that call.
+	ctxReporter := &recordingProgress{}
+	clientReporter.events = nil
+	ctx := ContextWithProgressReporter(context.Background(), ctxReporter)
+	_, err = tr.NewRequest(ctx).
+		SetMultipartFile("file", "logo.png", bytes.NewReader(data), int64(len(data)), nil).
+		Post("/api/v1/self-service/branding/images")
+	require.NoError(t, err)
+	assert.NotEmpty(t, ctxReporter.events)
+	assert.Empty(t, clientReporter.events)
+}
+
+func TestTransport_Multipart_SilentByDefault(t *testing.T) {
+	srv := newMockAuthServer(t)
+	defer srv.Close()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg)
+	require.NoError(t, err)
+
+	b := tr.NewRequest(context.Background())
+	assert.Nil(t, b.multipartProgress())
+	_, err = b.SetMultipartFile("file", "a.txt", strings.NewReader("x"), 1, nil).Post("/api/upload")
+	require.NoError(t, err)
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointRateLimit is an additional token bucket applied to requests whose
+// path starts with Prefix, e.g. "/JSSResource" for the Classic API.
+type EndpointRateLimit struct {
+	Prefix            string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimiter is a proactive, client-side requests-per-second budget built
+// from token buckets: one global bucket plus optional per-endpoint-prefix
+// buckets. A request waits for a token from the global bucket and from the
+// bucket of the longest matching prefix, so a prefix limit can only tighten
+// the budget for its endpoints, never loosen it.
+//
+// Unlike the adaptive delay of responseTimeTracker, which reacts once the
+// server is already slowing down, the limiter paces requests before they are
+// sent. A single RateLimiter may be passed to several clients (see
+// WithRateLimit) so that every worker talking to the same tenant shares one
+// budget. It is safe for concurrent use.
+type RateLimiter struct {
+	global    *tokenBucket
+	endpoints []endpointBucket
+}
+
+// endpointBucket pairs a path prefix with its bucket.
+type endpointBucket struct {
+	prefix string
+	bucket *tokenBucket
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond on average
+// across all endpoints, with bursts of up to burst requests, plus any
+// per-prefix limits. A requestsPerSecond of 0 leaves the global budget
+// unlimited so that only the endpoint limits apply. A burst of 0 defaults to
+// 1.
+func NewRateLimiter(requestsPerSecond float64, burst int, endpoints ...EndpointRateLimit) (*RateLimiter, error) {
+	if requestsPerSecond < 0 {
+		return nil, fmt.Errorf("requests per second cannot be negative, got %v", requestsPerSecond)
+	}
+	l := &RateLimiter{}
+	if requestsPerSecond > 0 {
+		b, err := newTokenBucket(requestsPerSecond, burst)
+		if err != nil {
+			return nil, err
+		}
+		l.global = b
+	}
+
+	seen := make(map[string]bool, len(endpoints))
+	for _, e := range endpoints {
+		if e.Prefix == "" {
+			return nil, fmt.Errorf("endpoint rate limit prefix cannot be empty")
+		}
+		if seen[e.Prefix] {
+			return nil, fmt.Errorf("duplicate endpoint rate limit for prefix %q", e.Prefix)
+		}
+		seen[e.Prefix] = true
+		if e.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("endpoint %q: requests per second must be positive, got %v", e.Prefix, e.RequestsPerSecond)
+		}
+		b, err := newTokenBucket(e.RequestsPerSecond, e.Burst)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %q: %w", e.Prefix, err)
+		}
+		l.endpoints = append(l.endpoints, endpointBucket{prefix: e.Prefix, bucket: b})
+	}
+	// Longest prefix first, so the first match is the most specific.
+	sort.SliceStable(l.endpoints, func(i, j int) bool {
+		return len(l.endpoints[i].prefix) > len(l.endpoints[j].prefix)
+	})
+	return l, nil
+}
+
+// Wait blocks until a request to path may be sent, or ctx is done. Tokens
+// reserved by a wait that is cancelled are returned to their buckets.
+func (l *RateLimiter) Wait(ctx context.Context, path string) error {
+	buckets := make([]*tokenBucket, 0, 2)
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+	for _, e := range l.endpoints {
+		if strings.HasPrefix(path, e.prefix) {
+			buckets = append(buckets, e.bucket)
+			break
+		}
+	}
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var delay time.Duration
+	for _, b := range buckets {
+		delay = max(delay, b.reserve(now))
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		for _, b := range buckets {
+			b.cancel()
+		}
+		return ctx.Err()
+	}
+}
+
+// tokenBucket refills at rate tokens per second up to burst. Reservations
+// may drive the balance negative; the deficit is the time the caller must
+// wait before its token is available.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket.
+func newTokenBucket(rate float64, burst int) (*tokenBucket, error) {
+	if burst < 0 {
+		return nil, fmt.Errorf("burst cannot be negative, got %d", burst)
+	}
+	if burst == 0 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}, nil
+}
+
+// reserve takes one token and returns how long the caller must wait for it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimiter_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		rps       float64
+		burst     int
+		endpoints []EndpointRateLimit
+	}{
+		{"negative rps", -1, 1, nil},
+		{"negative burst", 1, -1, nil},
+		{"empty prefix", 1, 1, []EndpointRateLimit{{RequestsPerSecond: 1}}},
+		{"zero endpoint rps", 1, 1, []EndpointRateLimit{{Prefix: "/JSSResource"}}},
+		{"duplicate prefix", 1, 1, []EndpointRateLimit{
+			{Prefix: "/JSSResource", RequestsPerSecond: 1},
+			{Prefix: "/JSSResource", RequestsPerSecond: 2},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRateLimiter(tt.rps, tt.burst, tt.endpoints...)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRateLimiter_Wait_BurstThenPaced(t *testing.T) {
+	l, err := NewRateLimiter(20, 2)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 4 {
+		require.NoError(t, l.Wait(ctx, "/api/v1/buildings"))
+	}
+	// Two requests fit in the burst; the other two wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_Wait_EndpointPrefix(t *testing.T) {
+	l, err := NewRateLimiter(0, 0,
+		EndpointRateLimit{Prefix: "/JSSResource", RequestsPerSecond: 10, Burst: 1},
+		EndpointRateLimit{Prefix: "/JSSResource/computers", RequestsPerSecond: 1000, Burst: 10},
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 5 {
+		require.NoError(t, l.Wait(ctx, "/api/v1/buildings"))
+		require.NoError(t, l.Wait(ctx, "/JSSResource/computers/id/1"))
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "unmatched and loosely limited paths must not wait")
+
+	require.NoError(t, l.Wait(ctx, "/JSSResource/buildings"))
+	start = time.Now()
+	require.NoError(t, l.Wait(ctx, "/JSSResource/buildings"))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_Wait_ContextCancelledRefunds(t *testing.T) {
+	l, err := NewRateLimiter(1, 1)
+	require.NoError(t, err)
+	require.NoError(t, l.Wait(context.Background(), "/api"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = l.Wait(ctx, "/api")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	l.global.mu.Lock()
+	defer l.global.mu.Unlock()
+	assert.Greater(t, l.global.tokens, -1.0, "cancelled reservation must be returned")
+}
+
+func TestTransport_RateLimit_SharedAcrossTransports(t *testing.T) {
+	srv := newMockAuthServer(t)
+	defer srv.Close()
+
+	limiter, err := NewRateLimiter(20, 1)
+	require.NoError(t, err)
+	withLimiter := func(s *TransportSettings) error {
+		s.RateLimiter = limiter
+		return nil
+	}
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr1, err := NewTransport(cfg, withLimiter)
+	require.NoError(t, err)
+	tr2, err := NewTransport(cfg, withLimiter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	start := time.Now()
+	for _, tr := range []*Transport{tr1, tr2, tr1, tr2} {
+		_, err := tr.NewRequest(ctx).Get("/api/v1/buildings")
+		require.NoError(t, err)
+	}
+	// One burst token, then three requests paced at 50ms across both transports.
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+}
+
+func TestTransport_RateLimit_ContextCancelled(t *testing.T) {
+	srv := newMockAuthServer(t)
+	defer srv.Close()
+
+	limiter, err := NewRateLimiter(0.1, 1)
+	require.NoError(t, err)
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg, func(s *TransportSettings) error {
+		s.RateLimiter = limiter
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = tr.NewRequest(context.Background()).Get("/api/v1/buildings")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = tr.NewRequest(ctx).Get("/api/v1/buildings")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "rate limit")
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"net/http"
+	"net/url"
 
 	"resty.dev/v3"
 )
@@ -18,6 +21,7 @@ type requestExecutor interface {
 	execute(req *resty.Request, method, path string, result any) (*resty.Response, error)
 	executeGetBytes(req *resty.Request, path string) (*resty.Response, []byte, error)
 	executePaginated(req *resty.Request, path string, mergePage func([]byte) error) (*resty.Response, error)
+	executeIterate(req *resty.Request, path string, onPage func([]byte) (bool, error)) (*resty.Response, error)
 }
 
 // RequestBuilder constructs a single API request. Following the same pattern
@@ -38,6 +42,7 @@ type RequestBuilder struct {
 	req      *resty.Request
 	executor requestExecutor
 	result   any
+	progress ProgressReporter
 }
 
 // SetHeader sets a request-level header. Empty values are ignored.
@@ -84,6 +89,10 @@ func (b *RequestBuilder) SetResult(result any) *RequestBuilder {
 // SetMultipartFile configures the request for a multipart file upload.
 // Execute with Post after setting any additional form fields or headers.
 // Content-Type is managed automatically by resty.
+//
+// Progress goes to callback when non-nil; otherwise it is reported as
+// ProgressPhaseUploading events to the ProgressReporter in the request context
+// or configured on the client, if any.
 func (b *RequestBuilder) SetMultipartFile(fileField, fileName string, fileReader io.Reader, fileSize int64, callback MultipartProgressCallback) *RequestBuilder {
 	if fileReader != nil && fileName != "" && fileField != "" {
 		field := &resty.MultipartField{
@@ -93,6 +102,9 @@ func (b *RequestBuilder) SetMultipartFile(fileField, fileName string, fileReader
 			Reader:      fileReader,
 			FileSize:    fileSize,
 		}
+		if callback == nil {
+			callback = b.multipartProgress()
+		}
 		if callback != nil {
 			field.ProgressCallback = func(p resty.MultipartFieldProgress) {
 				callback(p.Name, p.FileName, p.Written, p.FileSize)
@@ -103,6 +115,27 @@ func (b *RequestBuilder) SetMultipartFile(fileField, fileName string, fileReader
 	return b
 }
 
+// multipartProgress adapts the request's ProgressReporter to a multipart
+// progress callback, reporting ProgressPhaseComplete once the whole file has
+// been written. It returns nil when no reporter is configured.
+func (b *RequestBuilder) multipartProgress() MultipartProgressCallback {
+	reporter, ok := b.req.Context().Value(progressContextKey{}).(ProgressReporter)
+	if !ok || reporter == nil {
+		reporter = b.progress
+	}
+	if reporter == nil {
+		return nil
+	}
+	completed := false
+	return func(_, fileName string, written, total int64) {
+		reporter.Report(ProgressEvent{Phase: ProgressPhaseUploading, File: fileName, BytesTransferred: written, TotalBytes: total})
+		if total > 0 && written >= total && !completed {
+			completed = true
+			reporter.Report(ProgressEvent{Phase: ProgressPhaseComplete, File: fileName, BytesTransferred: written, TotalBytes: total})
+		}
+	}
+}
+
 // SetMultipartFormData adds additional form fields to a multipart request.
 func (b *RequestBuilder) SetMultipartFormData(formFields map[string]string) *RequestBuilder {
 	if len(formFields) > 0 {
@@ -155,17 +188,25 @@ func (b *RequestBuilder) GetPaginated(path string, mergePage func([]byte) error)
 // through a caller-supplied dispatch function instead of a real Transport.
 type mockRequestExecutor struct {
 	fn              func(method, path string, result any) (*resty.Response, error)
+	requestFn       func(req MockRequest, result any) (*resty.Response, error)
 	queryParamStore *map[string]string
 }
 
-func (m *mockRequestExecutor) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+// dispatch hands the request to requestFn when set, otherwise to fn.
+func (m *mockRequestExecutor) dispatch(req *resty.Request, method, path string, result any) (*resty.Response, error) {
 	m.captureQueryParams(req)
+	if m.requestFn != nil {
+		return m.requestFn(newMockRequest(req, method, path), result)
+	}
 	return m.fn(method, path, result)
 }
 
+func (m *mockRequestExecutor) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	return m.dispatch(req, method, path, result)
+}
+
 func (m *mockRequestExecutor) executeGetBytes(req *resty.Request, path string) (*resty.Response, []byte, error) {
-	m.captureQueryParams(req)
-	resp, err := m.fn("GET", path, nil)
+	resp, err := m.dispatch(req, "GET", path, nil)
 	if err != nil {
 		return resp, nil, err
 	}
@@ -173,8 +214,7 @@ func (m *mockRequestExecutor) executeGetBytes(req *resty.Request, path string) (
 }
 
 func (m *mockRequestExecutor) executePaginated(req *resty.Request, path string, mergePage func([]byte) error) (*resty.Response, error) {
-	m.captureQueryParams(req)
-	resp, err := m.fn("GET", path, nil)
+	resp, err := m.dispatch(req, "GET", path, nil)
 	if err != nil {
 		return resp, err
 	}
@@ -196,6 +236,25 @@ func (m *mockRequestExecutor) executePaginated(req *resty.Request, path string,
 	return resp, nil
 }
 
+func (m *mockRequestExecutor) executeIterate(req *resty.Request, path string, onPage func([]byte) (bool, error)) (*resty.Response, error) {
+	resp, err := m.dispatch(req, "GET", path, nil)
+	if err != nil {
+		return resp, err
+	}
+	body := resp.Bytes()
+	if len(body) == 0 {
+		return resp, nil
+	}
+	var pageResp struct {
+		Results json.RawMessage `json:"results"`
+	}
+	if json.Unmarshal(body, &pageResp) == nil && len(pageResp.Results) > 0 {
+		body = pageResp.Results
+	}
+	_, err = onPage(body)
+	return resp, err
+}
+
 func (m *mockRequestExecutor) captureQueryParams(req *resty.Request) {
 	if m.queryParamStore != nil && req != nil {
 		params := make(map[string]string)
@@ -229,3 +288,36 @@ func NewMockRequestBuilderWithQueryCapture(ctx context.Context, fn func(method,
 		executor: &mockRequestExecutor{fn: fn, queryParamStore: queryStore},
 	}
 }
+
+// MockRequest is the request a RequestBuilder would have sent, as seen by a
+// callback passed to NewMockRequestBuilderWithCapture.
+type MockRequest struct {
+	Method   string
+	Path     string
+	Header   http.Header
+	Query    url.Values
+	FormData url.Values
+	// Body is the value passed to SetBody, before serialization.
+	Body any
+}
+
+func newMockRequest(req *resty.Request, method, path string) MockRequest {
+	return MockRequest{
+		Method:   method,
+		Path:     path,
+		Header:   req.Header.Clone(),
+		Query:    maps.Clone(req.QueryParams),
+		FormData: maps.Clone(req.FormData),
+		Body:     req.Body,
+	}
+}
+
+// NewMockRequestBuilderWithCapture returns a RequestBuilder suitable for unit
+// tests whose callback receives the whole request — headers, query
+// parameters and body — rather than only the method and path.
+func NewMockRequestBuilderWithCapture(ctx context.Context, fn func(req MockRequest, result any) (*resty.Response, error)) *RequestBuilder {
+	return &RequestBuilder{
+		req:      resty.New().R().SetContext(ctx),
+		executor: &mockRequestExecutor{requestFn: fn},
+	}
+}
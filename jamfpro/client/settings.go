@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
 	"go.uber.org/zap"
 )
 
@@ -68,7 +69,35 @@ type TransportSettings struct {
 	// request. Useful for bulk operations to avoid hitting rate limits.
 	MandatoryRequestDelay time.Duration
 
+	// RateLimiter paces requests with a token-bucket budget before they are
+	// sent. Nil disables it. Share one RateLimiter across transports to give
+	// them a common budget.
+	RateLimiter *RateLimiter
+
 	// TotalRetryDuration sets a maximum wall-clock budget for a request
 	// including all retry attempts. Zero disables the budget.
 	TotalRetryDuration time.Duration
+
+	// ParallelPagination fetches the remaining pages of a paginated request
+	// concurrently once the first page has reported totalCount. Only applied
+	// to requests carrying a sort query parameter; see WithParallelPagination.
+	ParallelPagination bool
+
+	// ProgressReporter receives progress events from file uploads and
+	// downloads. Nil keeps transfers silent.
+	ProgressReporter ProgressReporter
+
+	// TokenSource overrides both AuthConfig.TokenSource and the built-in
+	// OAuth2 / basic flows as the source of bearer tokens when non-nil.
+	TokenSource config.TokenSource
+
+	// TokenCache stores bearer tokens from the built-in OAuth2 / basic flows
+	// for reuse until expiry. Defaults to a per-transport in-memory cache; use
+	// NewFileTokenCache to share tokens across processes.
+	TokenCache TokenCache
+
+	// AutoKeepAliveInterval enables a background goroutine that extends the
+	// bearer token at this interval, or sooner when it would otherwise enter
+	// the refresh buffer. Zero disables it. Stop it with Transport.Close.
+	AutoKeepAliveInterval time.Duration
 }
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedToken is a bearer token as persisted by a TokenCache.
+type CachedToken struct {
+	Token  string    `json:"token"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// TokenCache stores bearer tokens so they can be reused until expiry instead
+// of re-authenticating on every client construction. Keys are produced by
+// TokenCacheKey and identify one set of credentials on one instance.
+//
+// The default is an in-memory cache scoped to a single transport. Use
+// NewFileTokenCache to share tokens between processes, e.g. many short-lived
+// CLI invocations or cron jobs using the same API client.
+//
+// Implementations must be safe for concurrent use.
+type TokenCache interface {
+	// Get returns the cached token for key, or nil with a nil error on a miss.
+	Get(key string) (*CachedToken, error)
+	// Set stores token under key, replacing any existing entry.
+	Set(key string, token CachedToken) error
+	// Delete removes the entry for key. Deleting a missing key is not an error.
+	Delete(key string) error
+}
+
+// TokenCacheKey returns the cache key for a principal on a Jamf Pro instance:
+// the instance domain (without trailing slash), the auth method, and the
+// OAuth2 client ID or basic auth username. Secrets never form part of a key.
+func TokenCacheKey(instanceDomain, authMethod, principal string) string {
+	return strings.TrimSuffix(instanceDomain, "/") + "|" + authMethod + "|" + principal
+}
+
+// memoryTokenCache is the default in-process TokenCache.
+type memoryTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]CachedToken
+}
+
+// NewMemoryTokenCache returns an in-process TokenCache. Pass the same instance
+// to several clients to share tokens between them within one process.
+func NewMemoryTokenCache() TokenCache {
+	return &memoryTokenCache{tokens: make(map[string]CachedToken)}
+}
+
+func (c *memoryTokenCache) Get(key string) (*CachedToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &t, nil
+}
+
+func (c *memoryTokenCache) Set(key string, token CachedToken) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = token
+	return nil
+}
+
+func (c *memoryTokenCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, key)
+	return nil
+}
+
+// fileTokenCache persists one JSON file per key in a directory.
+type fileTokenCache struct {
+	dir string
+}
+
+// fileTokenEntry is the on-disk shape of a fileTokenCache entry. The key is
+// stored alongside the token so an entry can be attributed when inspected.
+type fileTokenEntry struct {
+	Key string `json:"key"`
+	CachedToken
+}
+
+// NewFileTokenCache returns a TokenCache that persists tokens as files in
+// dir, which is created with 0700 permissions if it does not exist. Each
+// entry is written with 0600 permissions to a file named after the SHA-256 of
+// its key, via a temp file and rename so concurrent processes never observe a
+// partial write. Pass an empty dir to use DefaultTokenCacheDir.
+func NewFileTokenCache(dir string) (TokenCache, error) {
+	if dir == "" {
+		var err error
+		dir, err = DefaultTokenCacheDir()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create token cache directory: %w", err)
+	}
+	return &fileTokenCache{dir: dir}, nil
+}
+
+// DefaultTokenCacheDir returns the per-user directory used by
+// NewFileTokenCache when no directory is given:
+// <os.UserCacheDir>/go-sdk-jamfpro-v2/tokens.
+func DefaultTokenCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve user cache directory: %w", err)
+	}
+	return filepath.Join(base, UserAgentBase, "tokens"), nil
+}
+
+func (c *fileTokenCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *fileTokenCache) Get(key string) (*CachedToken, error) {
+	data, err := os.ReadFile(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read token cache entry: %w", err)
+	}
+	var entry fileTokenEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("parse token cache entry: %w", err)
+	}
+	if entry.Key != key {
+		return nil, nil
+	}
+	return &entry.CachedToken, nil
+}
+
+func (c *fileTokenCache) Set(key string, token CachedToken) error {
+	data, err := json.Marshal(fileTokenEntry{Key: key, CachedToken: token})
+	if err != nil {
+		return fmt.Errorf("encode token cache entry: %w", err)
+	}
+	tmp, err := os.CreateTemp(c.dir, ".token-*")
+	if err != nil {
+		return fmt.Errorf("create token cache entry: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// CreateTemp already uses 0600; Chmod guards against a permissive umask
+	// on platforms where it would otherwise widen the mode.
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("set token cache entry permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write token cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write token cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("commit token cache entry: %w", err)
+	}
+	return nil
+}
+
+func (c *fileTokenCache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete token cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFileTokenCache_RoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	cache, err := NewFileTokenCache(dir)
+	require.NoError(t, err)
+
+	key := TokenCacheKey("https://x.jamfcloud.com/", constants.AuthMethodOAuth2, "cid")
+	got, err := cache.Get(key)
+	require.NoError(t, err)
+	assert.Nil(t, got, "miss returns nil without error")
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, cache.Set(key, CachedToken{Token: "tok", Expiry: expiry}))
+
+	got, err = cache.Get(key)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "tok", got.Token)
+	assert.True(t, expiry.Equal(got.Expiry))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temp files must not be left behind")
+	info, err := entries[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	dirInfo, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), dirInfo.Mode().Perm())
+
+	require.NoError(t, cache.Delete(key))
+	got, err = cache.Get(key)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	assert.NoError(t, cache.Delete(key), "deleting a missing key is not an error")
+}
+
+func TestTokenCacheKey_TrimsTrailingSlash(t *testing.T) {
+	assert.Equal(t,
+		TokenCacheKey("https://x.jamfcloud.com", constants.AuthMethodBasic, "admin"),
+		TokenCacheKey("https://x.jamfcloud.com/", constants.AuthMethodBasic, "admin"),
+	)
+	assert.NotEqual(t,
+		TokenCacheKey("https://x.jamfcloud.com", constants.AuthMethodOAuth2, "a"),
+		TokenCacheKey("https://x.jamfcloud.com", constants.AuthMethodOAuth2, "b"),
+	)
+}
+
+// tokenCacheServer issues a numbered OAuth2 token per call and rejects
+// resource requests carrying any token listed in revoked with 401.
+func tokenCacheServer(t *testing.T, tokenCalls *atomic.Int32, revoked map[string]bool) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == constants.EndpointOAuthToken {
+			n := tokenCalls.Add(1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"tok-%d","expires_in":3600}`, n)
+			return
+		}
+		if revoked[r.Header.Get("Authorization")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"httpStatus":401,"errors":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newCachedTestTransport(t *testing.T, baseURL string, cache TokenCache) *Transport {
+	t.Helper()
+	tr, err := NewTransport(&config.AuthConfig{
+		InstanceDomain: baseURL,
+		AuthMethod:     constants.AuthMethodOAuth2,
+		ClientID:       "cid",
+		ClientSecret:   "secret",
+	}, func(s *TransportSettings) error {
+		s.Logger = zap.NewNop()
+		s.TokenCache = cache
+		return nil
+	})
+	require.NoError(t, err)
+	return tr
+}
+
+func TestTokenCache_SharedAcrossTransports(t *testing.T) {
+	var tokenCalls atomic.Int32
+	srv := tokenCacheServer(t, &tokenCalls, nil)
+
+	cache, err := NewFileTokenCache(t.TempDir())
+	require.NoError(t, err)
+
+	first := newCachedTestTransport(t, srv.URL, cache)
+	second := newCachedTestTransport(t, srv.URL, cache)
+
+	_, err = second.NewRequest(context.Background()).Get("/api/v1/ping")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), tokenCalls.Load(), "second transport should reuse the cached token")
+
+	token, err := first.tokenManager.getToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "tok-1", token)
+}
+
+func TestTokenCache_ExpiredEntryIgnored(t *testing.T) {
+	var tokenCalls atomic.Int32
+	srv := tokenCacheServer(t, &tokenCalls, nil)
+
+	cache := NewMemoryTokenCache()
+	key := TokenCacheKey(srv.URL, constants.AuthMethodOAuth2, "cid")
+	require.NoError(t, cache.Set(key, CachedToken{Token: "stale", Expiry: time.Now().Add(time.Minute)}))
+
+	tr := newCachedTestTransport(t, srv.URL, cache)
+	assert.Equal(t, int32(1), tokenCalls.Load(), "entry within the refresh buffer must not be reused")
+
+	cached, err := cache.Get(key)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	assert.Equal(t, "tok-1", cached.Token)
+	assert.Equal(t, "tok-1", tr.tokenManager.token)
+}
+
+func TestTokenCache_UnauthorizedEvictsToken(t *testing.T) {
+	var tokenCalls atomic.Int32
+	srv := tokenCacheServer(t, &tokenCalls, map[string]bool{"Bearer tok-1": true, "Bearer tok-2": true})
+
+	cache := NewMemoryTokenCache()
+	tr := newCachedTestTransport(t, srv.URL, cache)
+
+	_, err := tr.NewRequest(context.Background()).Get("/api/v1/ping")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+
+	key := TokenCacheKey(srv.URL, constants.AuthMethodOAuth2, "cid")
+	cached, err := cache.Get(key)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "rejected token must be evicted from the cache")
+
+	_, err = tr.tokenManager.getToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), tokenCalls.Load(), "next request must re-authenticate")
+}
+
+func TestTokenCache_DiscardKeepsNewerEntry(t *testing.T) {
+	var tokenCalls atomic.Int32
+	srv := tokenCacheServer(t, &tokenCalls, nil)
+
+	cache := NewMemoryTokenCache()
+	tr := newCachedTestTransport(t, srv.URL, cache)
+
+	key := TokenCacheKey(srv.URL, constants.AuthMethodOAuth2, "cid")
+	require.NoError(t, cache.Set(key, CachedToken{Token: "from-other-process", Expiry: time.Now().Add(time.Hour)}))
+
+	tr.tokenManager.discard("tok-1")
+
+	cached, err := cache.Get(key)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	assert.Equal(t, "from-other-process", cached.Token)
+
+	token, err := tr.tokenManager.getToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "from-other-process", token)
+	assert.Equal(t, int32(1), tokenCalls.Load())
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/apilifecycle"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 	"resty.dev/v3"
 )
@@ -30,8 +34,19 @@ type Transport struct {
 
 	// Optional throttles — nil / zero means disabled.
 	sem                *semaphore
+	rateLimiter        *RateLimiter
+	progress           ProgressReporter
 	requestDelay       time.Duration
 	totalRetryDuration time.Duration
+	parallelPagination bool
+
+	// keeper runs the background keep-alive loop when AutoKeepAliveInterval
+	// is set; nil otherwise.
+	keeper *tokenKeeper
+
+	// forcedRefreshCounter counts 401-triggered token refreshes in the global
+	// MeterProvider; nil when the counter could not be created.
+	forcedRefreshCounter metric.Int64Counter
 
 	// responseTracker measures per-request latency and derives an adaptive
 	// inter-request delay when the server begins responding slowly.
@@ -63,13 +78,33 @@ func (t *Transport) RSQLBuilder() RSQLFilterBuilder {
 // InvalidateToken revokes the current bearer token at the Jamf Pro API and
 // clears the local cache. The next request triggers a full re-authentication.
 func (t *Transport) InvalidateToken() error {
-	return t.tokenManager.invalidate()
+	return t.tokenManager.invalidate(context.Background())
+}
+
+// ForcedTokenRefreshes returns how many times this transport re-fetched its
+// bearer token because the API rejected the current one with 401, e.g. after
+// it was revoked by another process or the API client secret was rotated.
+func (t *Transport) ForcedTokenRefreshes() int64 {
+	return t.tokenManager.forcedRefreshes.Load()
 }
 
 // KeepAliveToken extends the current bearer token lifetime without re-auth.
 // Use before long-running operations to prevent mid-operation token expiry.
 func (t *Transport) KeepAliveToken() error {
-	return t.tokenManager.keepAlive()
+	return t.tokenManager.keepAlive(context.Background())
+}
+
+// Close stops the auto keep-alive goroutine, if WithAutoKeepAlive enabled
+// one, and revokes the current bearer token so it does not outlive the
+// process. ctx bounds both the wait for the goroutine and the revocation
+// request. The transport must not be used after Close.
+func (t *Transport) Close(ctx context.Context) error {
+	if t.keeper != nil {
+		if err := t.keeper.stop(ctx); err != nil {
+			return fmt.Errorf("stop token keep-alive: %w", err)
+		}
+	}
+	return t.tokenManager.invalidate(ctx)
 }
 
 // NewTransport creates and fully configures a Jamf Pro API transport.
@@ -195,8 +230,11 @@ func NewTransport(authConfig *config.AuthConfig, opts ...ClientOption) (*Transpo
 		userAgent:          userAgent,
 		responseTracker:    newResponseTimeTracker(),
 		sem:                sem,
+		rateLimiter:        settings.RateLimiter,
+		progress:           settings.ProgressReporter,
 		requestDelay:       settings.MandatoryRequestDelay,
 		totalRetryDuration: settings.TotalRetryDuration,
+		parallelPagination: settings.ParallelPagination,
 	}
 
 	// Log deprecated endpoint warnings and cookie usage via resty response middleware.
@@ -232,6 +270,10 @@ func NewTransport(authConfig *config.AuthConfig, opts ...ClientOption) (*Transpo
 	}
 	transport.tokenManager = tokenManager
 
+	if settings.AutoKeepAliveInterval > 0 {
+		transport.keeper = startTokenKeeper(tokenManager, settings.AutoKeepAliveInterval, transport.logger)
+	}
+
 	// Apply OpenTelemetry instrumentation (always enabled, uses global providers).
 	// If no global providers are configured, this is a no-op.
 	// This must happen AFTER construction is complete.
@@ -239,7 +281,7 @@ func NewTransport(authConfig *config.AuthConfig, opts ...ClientOption) (*Transpo
 
 	logger.Info("Jamf Pro API transport created",
 		zap.String("base_url", transport.BaseURL),
-		zap.String("auth_method", authConfig.AuthMethod),
+		zap.String("auth_method", tokenManager.method),
 	)
 	return transport, nil
 }
@@ -261,9 +303,16 @@ func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
 	return &RequestBuilder{
 		req:      t.client.R().SetContext(ctx).SetResponseBodyUnlimitedReads(true),
 		executor: t,
+		progress: t.progress,
 	}
 }
 
+// ProgressReporter returns the reporter configured with WithProgressReporter,
+// or nil. Services resolve the reporter for a call with ProgressReporterFor.
+func (t *Transport) ProgressReporter() ProgressReporter {
+	return t.progress
+}
+
 // execute implements requestExecutor for Transport.
 func (t *Transport) execute(req *resty.Request, method, path string, _ any) (*resty.Response, error) {
 	return t.executeRequest(req, method, path)
@@ -280,9 +329,21 @@ func (t *Transport) executeGetBytes(req *resty.Request, path string) (*resty.Res
 	return resp, resp.Bytes(), nil
 }
 
+// waitRateLimit blocks until the configured RateLimiter admits a request to
+// path. It is a no-op when no limiter is configured.
+func (t *Transport) waitRateLimit(ctx context.Context, path string) error {
+	if t.rateLimiter == nil {
+		return nil
+	}
+	if err := t.rateLimiter.Wait(ctx, path); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+	return nil
+}
+
 // executeRequest is the central request executor used by all HTTP verb methods.
-// It applies the concurrency semaphore, total-retry deadline, mandatory
-// per-request delay, and adaptive response-time throttling.
+// It applies the rate limiter, concurrency semaphore, total-retry deadline,
+// mandatory per-request delay, and adaptive response-time throttling.
 func (t *Transport) executeRequest(req *resty.Request, method, path string) (*resty.Response, error) {
 	ctx := req.Context()
 	if ctx == nil {
@@ -300,6 +361,12 @@ func (t *Transport) executeRequest(req *resty.Request, method, path string) (*re
 		}
 	}
 
+	// Wait for the request budget before taking a concurrency slot, so that
+	// paced requests do not hold slots while they sleep.
+	if err := t.waitRateLimit(ctx, path); err != nil {
+		return nil, err
+	}
+
 	// Acquire concurrency slot — blocks until available or context cancelled.
 	if t.sem != nil {
 		if err := t.sem.acquire(ctx); err != nil {
@@ -312,10 +379,41 @@ func (t *Transport) executeRequest(req *resty.Request, method, path string) (*re
 
 	resp, execErr := req.Execute(method, path)
 
+	// A 401 on a token that has not yet reached its local expiry means it was
+	// revoked server-side. Refresh it and replay the request once.
+	forcedRefresh := false
+	if execErr == nil && t.tokenRejected(resp) {
+		rejected := resp.Request.AuthToken
+		if !replayable(req, resp) {
+			t.tokenManager.discard(rejected)
+		} else if refreshed, err := t.tokenManager.forceRefresh(ctx, rejected); err != nil {
+			t.logger.Warn("Forced token refresh failed",
+				zap.String("method", method),
+				zap.String("path", path),
+				zap.Error(err),
+			)
+		} else if refreshed {
+			forcedRefresh = true
+			if t.forcedRefreshCounter != nil {
+				t.forcedRefreshCounter.Add(ctx, 1)
+			}
+			t.logger.Warn("Bearer token rejected; replaying request with refreshed token",
+				zap.String("method", method),
+				zap.String("path", path),
+				zap.Bool("forced_token_refresh", true),
+			)
+			if err := t.waitRateLimit(ctx, path); err != nil {
+				return resp, err
+			}
+			resp, execErr = req.Execute(method, path)
+		}
+	}
+
 	if execErr != nil {
 		t.logger.Error("Request failed",
 			zap.String("method", method),
 			zap.String("path", path),
+			zap.Bool("forced_token_refresh", forcedRefresh),
 			zap.Error(execErr),
 		)
 		return resp, fmt.Errorf("request failed: %w", execErr)
@@ -326,6 +424,11 @@ func (t *Transport) executeRequest(req *resty.Request, method, path string) (*re
 	}
 
 	if resp.IsStatusFailure() {
+		// A token rejected even after a refresh must not be served again
+		// from memory or from a cache shared with other processes.
+		if t.tokenRejected(resp) {
+			t.tokenManager.discard(resp.Request.AuthToken)
+		}
 		return resp, ParseErrorResponse(
 			[]byte(resp.String()),
 			resp.StatusCode(),
@@ -354,6 +457,7 @@ func (t *Transport) executeRequest(req *resty.Request, method, path string) (*re
 		zap.String("path", path),
 		zap.Int("status_code", resp.StatusCode()),
 		zap.Duration("duration", duration),
+		zap.Bool("forced_token_refresh", forcedRefresh),
 		zap.String("sticky_session_cookie", stickySessionCookie),
 		zap.Strings("all_response_cookies", allCookies),
 	)
@@ -378,6 +482,31 @@ func (t *Transport) executeRequest(req *resty.Request, method, path string) (*re
 	return resp, nil
 }
 
+// tokenRejected reports whether resp is a 401 for a request that carried a
+// bearer token issued by this transport's token manager.
+func (t *Transport) tokenRejected(resp *resty.Response) bool {
+	return t.tokenManager != nil &&
+		resp != nil &&
+		resp.StatusCode() == http.StatusUnauthorized &&
+		resp.Request != nil &&
+		resp.Request.AuthToken != ""
+}
+
+// replayable reports whether req can be sent a second time. Multipart bodies
+// are streamed from their readers and closed after the first attempt, and
+// other readers can only be replayed when they can seek back to the start.
+func replayable(req *resty.Request, resp *resty.Response) bool {
+	if raw := resp.Request.RawRequest; raw != nil &&
+		strings.HasPrefix(raw.Header.Get("Content-Type"), "multipart/") {
+		return false
+	}
+	if r, ok := req.Body.(io.Reader); ok {
+		_, seekable := r.(io.Seeker)
+		return seekable
+	}
+	return true
+}
+
 // ServerVersion fetches and caches the connected Jamf Pro server version. The
 // version endpoint is hit at most once per Transport instance; both the parsed
 // version and any error are memoised.
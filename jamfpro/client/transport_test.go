@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -539,3 +542,119 @@ func TestTransport_PostMultipart_NoFile(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 200, resp.StatusCode())
 }
+
+// revokingServer issues tok-1, tok-2, ... from the OAuth2 endpoint and rejects
+// resource requests with 401 while they carry a token listed in revoked. It
+// records the number of resource requests and the body of the last one.
+type revokingServer struct {
+	*httptest.Server
+	tokenCalls atomic.Int32
+	hits       atomic.Int32
+	lastBody   atomic.Value
+}
+
+func newRevokingServer(t *testing.T, revoked ...string) *revokingServer {
+	t.Helper()
+	rejected := make(map[string]bool, len(revoked))
+	for _, tok := range revoked {
+		rejected["Bearer "+tok] = true
+	}
+	rs := &revokingServer{}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == constants.EndpointOAuthToken {
+			n := rs.tokenCalls.Add(1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"tok-%d","expires_in":3600}`, n)
+			return
+		}
+		rs.hits.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		rs.lastBody.Store(string(body))
+		if rejected[r.Header.Get("Authorization")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"httpStatus":401,"errors":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(rs.Close)
+	return rs
+}
+
+func newReauthTestTransport(t *testing.T, srv *revokingServer, opts ...ClientOption) *Transport {
+	t.Helper()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := NewTransport(cfg, opts...)
+	require.NoError(t, err)
+	return tr
+}
+
+func TestTransport_Unauthorized_RefreshesAndReplaysOnce(t *testing.T) {
+	srv := newRevokingServer(t, "tok-1")
+	tr := newReauthTestTransport(t, srv)
+
+	var result map[string]bool
+	resp, err := tr.NewRequest(context.Background()).SetResult(&result).Get("/api/v1/ping")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.True(t, result["ok"])
+	assert.Equal(t, int32(2), srv.tokenCalls.Load())
+	assert.Equal(t, int32(2), srv.hits.Load())
+	assert.Equal(t, int64(1), tr.ForcedTokenRefreshes())
+}
+
+func TestTransport_Unauthorized_ReplaysBody(t *testing.T) {
+	srv := newRevokingServer(t, "tok-1")
+	tr := newReauthTestTransport(t, srv)
+
+	_, err := tr.NewRequest(context.Background()).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(map[string]string{"name": "replayed"}).
+		Post("/api/v1/things")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), srv.hits.Load())
+	assert.JSONEq(t, `{"name":"replayed"}`, srv.lastBody.Load().(string))
+}
+
+func TestTransport_Unauthorized_NoSecondReplay(t *testing.T) {
+	srv := newRevokingServer(t, "tok-1", "tok-2")
+	tr := newReauthTestTransport(t, srv)
+
+	_, err := tr.NewRequest(context.Background()).Get("/api/v1/ping")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(2), srv.hits.Load(), "request must be replayed at most once")
+	assert.Equal(t, int64(1), tr.ForcedTokenRefreshes())
+	assert.Empty(t, tr.tokenManager.token, "token rejected after replay must be discarded")
+}
+
+func TestTransport_Unauthorized_SameTokenNotReplayed(t *testing.T) {
+	srv := newRevokingServer(t, "static")
+	tr := newReauthTestTransport(t, srv, func(s *TransportSettings) error {
+		s.TokenSource = config.StaticTokenSource("static", time.Time{})
+		return nil
+	})
+
+	_, err := tr.NewRequest(context.Background()).Get("/api/v1/ping")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(1), srv.hits.Load())
+	assert.Equal(t, int64(0), tr.ForcedTokenRefreshes())
+}
+
+func TestTransport_Unauthorized_MultipartNotReplayed(t *testing.T) {
+	srv := newRevokingServer(t, "tok-1")
+	tr := newReauthTestTransport(t, srv)
+
+	_, err := tr.NewRequest(context.Background()).
+		SetMultipartFile("file", "a.txt", bytes.NewReader([]byte("x")), 1, nil).
+		Post("/api/upload")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(1), srv.hits.Load())
+
+	_, err = tr.NewRequest(context.Background()).
+		SetMultipartFile("file", "a.txt", bytes.NewReader([]byte("x")), 1, nil).
+		Post("/api/upload")
+	require.NoError(t, err, "the rejected token is discarded so the next request re-authenticates")
+}
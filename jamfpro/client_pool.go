@@ -0,0 +1,320 @@
+package jamfpro
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+)
+
+// Client pool defaults.
+const (
+	// DefaultPoolMaxConnsPerHost caps connections to each Jamf Pro instance,
+	// matching Jamf's guidance of at most 5 concurrent connections.
+	DefaultPoolMaxConnsPerHost = client.DefaultMaxConcurrentRequests
+
+	// DefaultPoolMaxIdleConns caps idle connections kept across all instances.
+	DefaultPoolMaxIdleConns = 100
+
+	// DefaultPoolConcurrency is the number of instances ForEach runs at once.
+	DefaultPoolConcurrency = 8
+)
+
+// ClientPool manages clients for many Jamf Pro instances, keyed by an
+// instance name of the caller's choosing (e.g. the tenant name).
+//
+// Clients are constructed lazily on first use and cached, so tenants that are
+// never touched never authenticate. All clients share one bounded
+// http.Transport instead of each holding its own connection pool. All methods
+// are safe for concurrent use.
+type ClientPool struct {
+	configs       map[string]*AuthConfig
+	entries       map[string]*poolEntry
+	names         []string
+	clientOptions []ClientOption
+	httpTransport *http.Transport
+	concurrency   int
+}
+
+// poolEntry guards lazy construction of one instance's client. A failed
+// construction is not cached, so the next Get retries it.
+type poolEntry struct {
+	mu     sync.Mutex
+	client *Client
+}
+
+// ClientPoolOption configures a ClientPool at construction time.
+type ClientPoolOption func(*ClientPool) error
+
+// WithPoolClientOptions applies opts to every client in the pool, after the
+// pool's shared transport. TLS and proxy client options do not reach the
+// shared transport; use WithPoolTLSClientConfig, and HTTPS_PROXY / NO_PROXY
+// for proxies. Passing WithTransport here opts the clients out of the shared
+// connection pool.
+func WithPoolClientOptions(opts ...ClientOption) ClientPoolOption {
+	return func(p *ClientPool) error {
+		p.clientOptions = append(p.clientOptions, opts...)
+		return nil
+	}
+}
+
+// WithPoolMaxConnsPerHost caps the shared transport's connections to each
+// instance (dialing, active, and idle). Defaults to DefaultPoolMaxConnsPerHost.
+func WithPoolMaxConnsPerHost(n int) ClientPoolOption {
+	return func(p *ClientPool) error {
+		if n <= 0 {
+			return fmt.Errorf("max connections per host must be positive, got %d", n)
+		}
+		p.httpTransport.MaxConnsPerHost = n
+		p.httpTransport.MaxIdleConnsPerHost = n
+		return nil
+	}
+}
+
+// WithPoolMaxIdleConns caps idle connections kept across all instances.
+// Defaults to DefaultPoolMaxIdleConns.
+func WithPoolMaxIdleConns(n int) ClientPoolOption {
+	return func(p *ClientPool) error {
+		if n <= 0 {
+			return fmt.Errorf("max idle connections must be positive, got %d", n)
+		}
+		p.httpTransport.MaxIdleConns = n
+		return nil
+	}
+}
+
+// WithPoolTLSClientConfig sets the TLS configuration of the shared transport,
+// e.g. to trust a private CA used by on-premises instances.
+func WithPoolTLSClientConfig(tlsConfig *tls.Config) ClientPoolOption {
+	return func(p *ClientPool) error {
+		if tlsConfig == nil {
+			return fmt.Errorf("TLS client config cannot be nil")
+		}
+		p.httpTransport.TLSClientConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithPoolConcurrency sets how many instances ForEach runs at once.
+// Defaults to DefaultPoolConcurrency.
+func WithPoolConcurrency(n int) ClientPoolOption {
+	return func(p *ClientPool) error {
+		if n <= 0 {
+			return fmt.Errorf("pool concurrency must be positive, got %d", n)
+		}
+		p.concurrency = n
+		return nil
+	}
+}
+
+// NewClientPool returns a pool over configs, keyed by instance name. No
+// client is constructed and no token is fetched until the instance is used.
+func NewClientPool(configs map[string]*AuthConfig, opts ...ClientPoolOption) (*ClientPool, error) {
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("at least one instance is required")
+	}
+
+	p := &ClientPool{
+		configs:       make(map[string]*AuthConfig, len(configs)),
+		entries:       make(map[string]*poolEntry, len(configs)),
+		names:         make([]string, 0, len(configs)),
+		httpTransport: newPoolHTTPTransport(),
+		concurrency:   DefaultPoolConcurrency,
+	}
+	for name, cfg := range configs {
+		if name == "" {
+			return nil, fmt.Errorf("instance name cannot be empty")
+		}
+		if cfg == nil {
+			return nil, fmt.Errorf("instance %q: auth config is required", name)
+		}
+		p.configs[name] = cfg
+		p.entries[name] = &poolEntry{}
+		p.names = append(p.names, name)
+	}
+	sort.Strings(p.names)
+
+	for _, opt := range opts {
+		if err := opt(p); err != nil {
+			return nil, fmt.Errorf("failed to apply client pool option: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// NewClientPoolFromFile loads instance configs with LoadAuthConfigsFromFile
+// and returns a pool over them.
+func NewClientPoolFromFile(path string, opts ...ClientPoolOption) (*ClientPool, error) {
+	configs, err := config.LoadAuthConfigsFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientPool(configs, opts...)
+}
+
+// newPoolHTTPTransport returns the transport shared by every pooled client.
+func newPoolHTTPTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          DefaultPoolMaxIdleConns,
+		MaxIdleConnsPerHost:   DefaultPoolMaxConnsPerHost,
+		MaxConnsPerHost:       DefaultPoolMaxConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// Names returns the instance names in the pool, sorted.
+func (p *ClientPool) Names() []string {
+	return append([]string(nil), p.names...)
+}
+
+// Get returns the client for the named instance, constructing it (and
+// fetching its first token) on first use.
+func (p *ClientPool) Get(name string) (*Client, error) {
+	entry, ok := p.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown instance %q", name)
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.client != nil {
+		return entry.client, nil
+	}
+
+	opts := make([]ClientOption, 0, len(p.clientOptions)+1)
+	opts = append(opts, WithTransport(p.httpTransport))
+	opts = append(opts, p.clientOptions...)
+
+	c, err := NewClient(p.configs[name], opts...)
+	if err != nil {
+		return nil, fmt.Errorf("instance %q: %w", name, err)
+	}
+	entry.client = c
+	return c, nil
+}
+
+// ForEach calls fn for every instance in the pool, running up to the pool's
+// concurrency at once. Every instance is attempted even when others fail; the
+// returned error is a *PoolError holding each failed instance's error, or nil
+// if all succeeded. Instances not yet started when ctx is done fail with the
+// context error.
+func (p *ClientPool) ForEach(ctx context.Context, fn func(ctx context.Context, name string, c *Client) error) error {
+	var (
+		mu   sync.Mutex
+		errs = make(map[string]error)
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, p.concurrency)
+	)
+	record := func(name string, err error) {
+		mu.Lock()
+		errs[name] = err
+		mu.Unlock()
+	}
+
+	for _, name := range p.names {
+		if err := ctx.Err(); err != nil {
+			record(name, err)
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			record(name, ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			c, err := p.Get(name)
+			if err == nil {
+				err = fn(ctx, name, c)
+			}
+			if err != nil {
+				record(name, err)
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &PoolError{Errors: errs}
+}
+
+// Close closes every client the pool has constructed (stopping keep-alives and
+// revoking their tokens) and releases the shared idle connections. Errors are
+// aggregated into a *PoolError.
+func (p *ClientPool) Close(ctx context.Context) error {
+	errs := make(map[string]error)
+	for _, name := range p.names {
+		entry := p.entries[name]
+		entry.mu.Lock()
+		c := entry.client
+		entry.client = nil
+		entry.mu.Unlock()
+
+		if c == nil {
+			continue
+		}
+		if err := c.Close(ctx); err != nil {
+			errs[name] = err
+		}
+	}
+	p.httpTransport.CloseIdleConnections()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &PoolError{Errors: errs}
+}
+
+// PoolError aggregates per-instance errors from ClientPool.ForEach and
+// ClientPool.Close. It unwraps to the individual errors, so errors.Is and
+// errors.As match if any instance failed with the target error.
+type PoolError struct {
+	// Errors maps instance name to that instance's error.
+	Errors map[string]error
+}
+
+// Error lists the failed instances in name order.
+func (e *PoolError) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s: %v", name, e.Errors[name]))
+	}
+	return fmt.Sprintf("%d instance(s) failed: %s", len(names), strings.Join(parts, "; "))
+}
+
+// Unwrap returns the per-instance errors.
+func (e *PoolError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,219 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// tenantServer is a minimal Jamf Pro instance that counts OAuth2 token and
+// invalidate calls. When failAuth is set the token endpoint returns 401.
+type tenantServer struct {
+	*httptest.Server
+	tokenCalls      atomic.Int32
+	invalidateCalls atomic.Int32
+}
+
+func newTenantServer(t *testing.T, failAuth bool) *tenantServer {
+	t.Helper()
+	ts := &tenantServer{}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case constants.EndpointOAuthToken:
+			ts.tokenCalls.Add(1)
+			if failAuth {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"t","expires_in":3600}`))
+		case constants.EndpointInvalidateToken:
+			ts.invalidateCalls.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func oauthConfig(domain string) *AuthConfig {
+	return &AuthConfig{
+		InstanceDomain: domain,
+		AuthMethod:     constants.AuthMethodOAuth2,
+		ClientID:       "cid",
+		ClientSecret:   "secret",
+	}
+}
+
+func quietPool(t *testing.T, configs map[string]*AuthConfig, opts ...ClientPoolOption) *ClientPool {
+	t.Helper()
+	opts = append([]ClientPoolOption{WithPoolClientOptions(WithLogger(zap.NewNop()))}, opts...)
+	pool, err := NewClientPool(configs, opts...)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestClientPool_GetIsLazyAndCached(t *testing.T) {
+	acme := newTenantServer(t, false)
+	globex := newTenantServer(t, false)
+	pool := quietPool(t, map[string]*AuthConfig{
+		"acme":   oauthConfig(acme.URL),
+		"globex": oauthConfig(globex.URL),
+	})
+
+	assert.Equal(t, []string{"acme", "globex"}, pool.Names())
+	assert.Equal(t, int32(0), acme.tokenCalls.Load(), "no client built before first use")
+
+	first, err := pool.Get("acme")
+	require.NoError(t, err)
+	second, err := pool.Get("acme")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, int32(1), acme.tokenCalls.Load())
+	assert.Equal(t, int32(0), globex.tokenCalls.Load())
+
+	_, err = pool.Get("initech")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown instance "initech"`)
+}
+
+func TestClientPool_FailedConstructionIsRetried(t *testing.T) {
+	broken := newTenantServer(t, true)
+	pool := quietPool(t, map[string]*AuthConfig{"broken": oauthConfig(broken.URL)})
+
+	_, err := pool.Get("broken")
+	require.Error(t, err)
+	_, err = pool.Get("broken")
+	require.Error(t, err)
+	assert.Equal(t, int32(2), broken.tokenCalls.Load())
+}
+
+func TestClientPool_ForEachAggregatesErrors(t *testing.T) {
+	ok := newTenantServer(t, false)
+	broken := newTenantServer(t, true)
+	failing := newTenantServer(t, false)
+	pool := quietPool(t, map[string]*AuthConfig{
+		"ok":      oauthConfig(ok.URL),
+		"broken":  oauthConfig(broken.URL),
+		"failing": oauthConfig(failing.URL),
+	})
+
+	errFn := errors.New("boom")
+	var visited atomic.Int32
+	err := pool.ForEach(context.Background(), func(ctx context.Context, name string, c *Client) error {
+		visited.Add(1)
+		require.NotNil(t, c)
+		if name == "failing" {
+			return errFn
+		}
+		return nil
+	})
+
+	var poolErr *PoolError
+	require.ErrorAs(t, err, &poolErr)
+	assert.Len(t, poolErr.Errors, 2)
+	assert.Contains(t, poolErr.Errors, "broken")
+	assert.ErrorIs(t, poolErr.Errors["failing"], errFn)
+	assert.ErrorIs(t, err, errFn)
+	assert.NotContains(t, poolErr.Errors, "ok")
+	assert.Equal(t, int32(2), visited.Load(), "fn is not called for instances whose client failed")
+	assert.Contains(t, err.Error(), "2 instance(s) failed: broken: ")
+}
+
+func TestClientPool_ForEachConcurrencyLimit(t *testing.T) {
+	configs := make(map[string]*AuthConfig)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		configs[name] = oauthConfig(newTenantServer(t, false).URL)
+	}
+	pool := quietPool(t, configs, WithPoolConcurrency(2))
+
+	var inFlight, peak atomic.Int32
+	err := pool.ForEach(context.Background(), func(ctx context.Context, name string, c *Client) error {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestClientPool_ForEachCanceledContext(t *testing.T) {
+	srv := newTenantServer(t, false)
+	pool := quietPool(t, map[string]*AuthConfig{"a": oauthConfig(srv.URL), "b": oauthConfig(srv.URL)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := pool.ForEach(ctx, func(ctx context.Context, name string, c *Client) error {
+		t.Fatalf("fn called for %s after cancel", name)
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(0), srv.tokenCalls.Load())
+}
+
+func TestClientPool_CloseInvalidatesConstructedClients(t *testing.T) {
+	used := newTenantServer(t, false)
+	unused := newTenantServer(t, false)
+	pool := quietPool(t, map[string]*AuthConfig{
+		"used":   oauthConfig(used.URL),
+		"unused": oauthConfig(unused.URL),
+	})
+
+	_, err := pool.Get("used")
+	require.NoError(t, err)
+	require.NoError(t, pool.Close(context.Background()))
+	assert.Equal(t, int32(1), used.invalidateCalls.Load())
+	assert.Equal(t, int32(0), unused.invalidateCalls.Load())
+}
+
+func TestNewClientPool_Validation(t *testing.T) {
+	_, err := NewClientPool(nil)
+	require.Error(t, err)
+
+	_, err = NewClientPool(map[string]*AuthConfig{"a": nil})
+	require.Error(t, err)
+
+	_, err = NewClientPool(map[string]*AuthConfig{"a": oauthConfig("https://x")}, WithPoolConcurrency(0))
+	require.Error(t, err)
+}
+
+func TestNewClientPoolFromFile(t *testing.T) {
+	srv := newTenantServer(t, false)
+	path := filepath.Join(t.TempDir(), "tenants.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"acme": {"instance_domain": "`+srv.URL+`", "auth_method": "oauth2", "client_id": "c", "client_secret": "s"},
+		"globex": {"instance_domain": "https://globex.jamfcloud.com", "auth_method": "basic", "basic_auth_username": "u", "basic_auth_password": "p"}
+	}`), 0o600))
+
+	pool, err := NewClientPoolFromFile(path, WithPoolClientOptions(WithLogger(zap.NewNop())))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "globex"}, pool.Names())
+
+	_, err = pool.Get("acme")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"bad": {"instance_domain": "https://x", "auth_method": "oauth2"}}`), 0o600))
+	_, err = NewClientPoolFromFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `instance "bad"`)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
@@ -17,6 +18,9 @@ import (
 //   - OAuth2 client credentials (recommended): POST /api/v1/oauth/token
 //   - Basic auth to bearer token exchange:     POST /api/v1/auth/token
 //
+// Alternatively, set TokenSource to supply bearer tokens from your own
+// credential source; AuthMethod and the credential fields are then ignored.
+//
 // See: https://developer.jamf.com/jamf-pro/docs/classic-api-authentication-changes
 type AuthConfig struct {
 	// InstanceDomain is the Jamf Pro instance base URL (e.g. https://example.jamfcloud.com).
@@ -40,6 +44,11 @@ type AuthConfig struct {
 	// HideSensitiveData suppresses bearer token values in log output.
 	// Enable in production to prevent tokens from appearing in log files.
 	HideSensitiveData bool
+
+	// TokenSource, when non-nil, replaces the built-in OAuth2 and basic auth
+	// flows as the source of bearer tokens. Only InstanceDomain is required
+	// alongside it. Not read from config files or the environment.
+	TokenSource TokenSource
 }
 
 // Validate checks the auth configuration for required fields.
@@ -47,6 +56,9 @@ func (a *AuthConfig) Validate() error {
 	if a.InstanceDomain == "" {
 		return fmt.Errorf("instance domain is required")
 	}
+	if a.TokenSource != nil {
+		return nil
+	}
 	if a.AuthMethod != constants.AuthMethodOAuth2 && a.AuthMethod != constants.AuthMethodBasic {
 		return fmt.Errorf("auth method must be %q or %q", constants.AuthMethodOAuth2, constants.AuthMethodBasic)
 	}
@@ -92,6 +104,45 @@ func LoadAuthConfigFromFile(path string) (*AuthConfig, error) {
 	if err := json.Unmarshal(data, &c); err != nil {
 		return nil, fmt.Errorf("parse config file: %w", err)
 	}
+	return c.authConfig(), nil
+}
+
+// LoadAuthConfigsFromFile loads several AuthConfigs from one JSON file whose
+// top-level object maps an instance name to an object in the same format as
+// LoadAuthConfigFromFile, e.g. {"acme": {"instance_domain": ...}, ...}.
+// Each entry is validated; the error names the first invalid instance.
+func LoadAuthConfigsFromFile(path string) (map[string]*AuthConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+	var entries map[string]authConfigFile
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse config file: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("config file %s defines no instances", path)
+	}
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	configs := make(map[string]*AuthConfig, len(entries))
+	for _, name := range names {
+		cfg := entries[name].authConfig()
+		if err := cfg.Validate(); err != nil {
+			return nil, fmt.Errorf("instance %q: %w", name, err)
+		}
+		configs[name] = cfg
+	}
+	return configs, nil
+}
+
+// authConfig converts the file representation into an AuthConfig, applying
+// the default refresh buffer.
+func (c authConfigFile) authConfig() *AuthConfig {
 	buffer := time.Duration(c.TokenRefreshBufferSeconds) * time.Second
 	if buffer == 0 {
 		buffer = 5 * time.Minute
@@ -105,7 +156,7 @@ func LoadAuthConfigFromFile(path string) (*AuthConfig, error) {
 		Password:                 c.Password,
 		TokenRefreshBufferPeriod: buffer,
 		HideSensitiveData:        c.HideSensitiveData,
-	}, nil
+	}
 }
 
 // AuthConfigFromEnv builds AuthConfig from environment variables.
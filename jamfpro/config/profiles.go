@@ -0,0 +1,250 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileEnvVar names the environment variable that selects a profile when
+// none is passed explicitly to ProfilesFile.Resolve.
+const ProfileEnvVar = "JAMFPRO_PROFILE"
+
+// Secret reference prefixes accepted in credential and proxy fields of a
+// profile. Any other value is used literally.
+const (
+	SecretRefEnv  = "env:"
+	SecretRefFile = "file:"
+)
+
+// ProfilesFile is a configuration file holding several named profiles
+// (contexts), e.g. dev, staging, and prod tenants. It is read from JSON, or
+// from YAML when the file extension is .yaml or .yml:
+//
+//	current_profile: dev
+//	profiles:
+//	  dev:
+//	    instance_domain: https://dev.jamfcloud.com
+//	    auth_method: oauth2
+//	    client_id: env:JAMF_DEV_CLIENT_ID
+//	    client_secret: file:/run/secrets/jamf_dev_secret
+//	    transport:
+//	      timeout_seconds: 60
+//	      retry_count: 5
+//	      max_concurrent_requests: 3
+//	      proxy: http://proxy.internal:8080
+type ProfilesFile struct {
+	// CurrentProfile is used when no profile is named explicitly and
+	// JAMFPRO_PROFILE is unset.
+	CurrentProfile string `json:"current_profile" yaml:"current_profile"`
+
+	// Profiles maps profile name to its settings.
+	Profiles map[string]Profile `json:"profiles" yaml:"profiles"`
+}
+
+// Profile is one named environment in a ProfilesFile. Credential fields and
+// Transport.Proxy may hold secret references ("env:VAR" or "file:/path")
+// instead of literal values, so secrets never need to live in the file.
+type Profile struct {
+	InstanceDomain            string            `json:"instance_domain" yaml:"instance_domain"`
+	AuthMethod                string            `json:"auth_method" yaml:"auth_method"`
+	ClientID                  string            `json:"client_id" yaml:"client_id"`
+	ClientSecret              string            `json:"client_secret" yaml:"client_secret"`
+	Username                  string            `json:"basic_auth_username" yaml:"basic_auth_username"`
+	Password                  string            `json:"basic_auth_password" yaml:"basic_auth_password"`
+	TokenRefreshBufferSeconds int               `json:"token_refresh_buffer_period_seconds" yaml:"token_refresh_buffer_period_seconds"`
+	HideSensitiveData         bool              `json:"hide_sensitive_data" yaml:"hide_sensitive_data"`
+	Transport                 *TransportProfile `json:"transport,omitempty" yaml:"transport,omitempty"`
+}
+
+// TransportProfile holds per-profile transport settings. Zero values leave
+// the SDK defaults in place.
+type TransportProfile struct {
+	TimeoutSeconds        int    `json:"timeout_seconds" yaml:"timeout_seconds"`
+	RetryCount            int    `json:"retry_count" yaml:"retry_count"`
+	RetryWaitSeconds      int    `json:"retry_wait_seconds" yaml:"retry_wait_seconds"`
+	RetryMaxWaitSeconds   int    `json:"retry_max_wait_seconds" yaml:"retry_max_wait_seconds"`
+	MaxConcurrentRequests int    `json:"max_concurrent_requests" yaml:"max_concurrent_requests"`
+	Proxy                 string `json:"proxy" yaml:"proxy"`
+}
+
+// Timeout returns TimeoutSeconds as a duration.
+func (t TransportProfile) Timeout() time.Duration {
+	return time.Duration(t.TimeoutSeconds) * time.Second
+}
+
+// RetryWait returns RetryWaitSeconds as a duration.
+func (t TransportProfile) RetryWait() time.Duration {
+	return time.Duration(t.RetryWaitSeconds) * time.Second
+}
+
+// RetryMaxWait returns RetryMaxWaitSeconds as a duration.
+func (t TransportProfile) RetryMaxWait() time.Duration {
+	return time.Duration(t.RetryMaxWaitSeconds) * time.Second
+}
+
+// validate rejects negative settings.
+func (t TransportProfile) validate() error {
+	fields := []struct {
+		name  string
+		value int
+	}{
+		{"timeout_seconds", t.TimeoutSeconds},
+		{"retry_count", t.RetryCount},
+		{"retry_wait_seconds", t.RetryWaitSeconds},
+		{"retry_max_wait_seconds", t.RetryMaxWaitSeconds},
+		{"max_concurrent_requests", t.MaxConcurrentRequests},
+	}
+	for _, f := range fields {
+		if f.value < 0 {
+			return fmt.Errorf("transport.%s cannot be negative", f.name)
+		}
+	}
+	return nil
+}
+
+// LoadProfilesFile reads a ProfilesFile from path, as YAML when the extension
+// is .yaml or .yml and as JSON otherwise.
+func LoadProfilesFile(path string) (*ProfilesFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read profiles file: %w", err)
+	}
+
+	var pf ProfilesFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &pf)
+	default:
+		err = json.Unmarshal(data, &pf)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse profiles file: %w", err)
+	}
+	if len(pf.Profiles) == 0 {
+		return nil, fmt.Errorf("profiles file %s defines no profiles", path)
+	}
+	return &pf, nil
+}
+
+// Names returns the profile names, sorted.
+func (pf *ProfilesFile) Names() []string {
+	names := make([]string, 0, len(pf.Profiles))
+	for name := range pf.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SelectProfile returns the name of the profile to use: name if non-empty,
+// else $JAMFPRO_PROFILE, else CurrentProfile, else the only profile in the
+// file.
+func (pf *ProfilesFile) SelectProfile(name string) (string, error) {
+	if name == "" {
+		name = os.Getenv(ProfileEnvVar)
+	}
+	if name == "" {
+		name = pf.CurrentProfile
+	}
+	if name == "" {
+		if len(pf.Profiles) != 1 {
+			return "", fmt.Errorf("no profile selected: set %s or current_profile (available: %s)",
+				ProfileEnvVar, strings.Join(pf.Names(), ", "))
+		}
+		name = pf.Names()[0]
+	}
+	if _, ok := pf.Profiles[name]; !ok {
+		return "", fmt.Errorf("profile %q not found (available: %s)", name, strings.Join(pf.Names(), ", "))
+	}
+	return name, nil
+}
+
+// Resolve selects a profile (see SelectProfile), resolves its secret
+// references, and returns the validated AuthConfig together with the
+// profile's transport settings, which are never nil.
+func (pf *ProfilesFile) Resolve(name string) (*AuthConfig, *TransportProfile, error) {
+	name, err := pf.SelectProfile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := pf.Profiles[name]
+
+	transport := TransportProfile{}
+	if p.Transport != nil {
+		transport = *p.Transport
+	}
+
+	secrets := []struct {
+		field string
+		value *string
+	}{
+		{"client_id", &p.ClientID},
+		{"client_secret", &p.ClientSecret},
+		{"basic_auth_username", &p.Username},
+		{"basic_auth_password", &p.Password},
+		{"transport.proxy", &transport.Proxy},
+	}
+	for _, s := range secrets {
+		resolved, err := ResolveSecret(*s.value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("profile %q: %s: %w", name, s.field, err)
+		}
+		*s.value = resolved
+	}
+	if err := transport.validate(); err != nil {
+		return nil, nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	cfg := authConfigFile{
+		InstanceDomain:            p.InstanceDomain,
+		AuthMethod:                p.AuthMethod,
+		ClientID:                  p.ClientID,
+		ClientSecret:              p.ClientSecret,
+		Username:                  p.Username,
+		Password:                  p.Password,
+		TokenRefreshBufferSeconds: p.TokenRefreshBufferSeconds,
+		HideSensitiveData:         p.HideSensitiveData,
+	}.authConfig()
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+	return cfg, &transport, nil
+}
+
+// ResolveSecret expands a secret reference. "env:VAR" returns the value of
+// environment variable VAR, which must be set and non-empty. "file:/path"
+// returns the file's contents with surrounding whitespace trimmed. Any other
+// value, including the empty string, is returned unchanged.
+func ResolveSecret(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, SecretRefEnv):
+		name := strings.TrimPrefix(value, SecretRefEnv)
+		if name == "" {
+			return "", fmt.Errorf("secret reference %q names no variable", value)
+		}
+		v := os.Getenv(name)
+		if v == "" {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return v, nil
+	case strings.HasPrefix(value, SecretRefFile):
+		path := strings.TrimPrefix(value, SecretRefFile)
+		if path == "" {
+			return "", fmt.Errorf("secret reference %q names no file", value)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("read secret file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	default:
+		return value, nil
+	}
+}
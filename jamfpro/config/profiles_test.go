@@ -0,0 +1,193 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesYAML = `
+current_profile: dev
+profiles:
+  dev:
+    instance_domain: https://dev.jamfcloud.com
+    auth_method: oauth2
+    client_id: dev-client
+    client_secret: env:TEST_JAMF_DEV_SECRET
+    transport:
+      timeout_seconds: 60
+      retry_count: 5
+      max_concurrent_requests: 3
+      proxy: http://proxy.internal:8080
+  prod:
+    instance_domain: https://prod.jamfcloud.com
+    auth_method: basic
+    basic_auth_username: admin
+    basic_auth_password: file:%s
+    token_refresh_buffer_period_seconds: 120
+`
+
+func writeProfiles(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestProfilesFile_ResolveYAML(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "")
+	t.Setenv("TEST_JAMF_DEV_SECRET", "dev-secret")
+	secretPath := writeProfiles(t, "prod_password", "  prod-pass\n")
+	path := writeProfiles(t, "profiles.yaml", fmt.Sprintf(profilesYAML, secretPath))
+
+	pf, err := LoadProfilesFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "prod"}, pf.Names())
+
+	cfg, transport, err := pf.Resolve("")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.jamfcloud.com", cfg.InstanceDomain)
+	assert.Equal(t, "dev-secret", cfg.ClientSecret)
+	assert.Equal(t, 5*time.Minute, cfg.TokenRefreshBufferPeriod)
+	assert.Equal(t, 60*time.Second, transport.Timeout())
+	assert.Equal(t, 5, transport.RetryCount)
+	assert.Equal(t, 3, transport.MaxConcurrentRequests)
+	assert.Equal(t, "http://proxy.internal:8080", transport.Proxy)
+
+	cfg, transport, err = pf.Resolve("prod")
+	require.NoError(t, err)
+	assert.Equal(t, constants.AuthMethodBasic, cfg.AuthMethod)
+	assert.Equal(t, "prod-pass", cfg.Password, "file secrets are trimmed")
+	assert.Equal(t, 2*time.Minute, cfg.TokenRefreshBufferPeriod)
+	assert.Equal(t, TransportProfile{}, *transport)
+}
+
+func TestProfilesFile_EnvSelector(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "prod")
+	path := writeProfiles(t, "profiles.json", `{
+		"current_profile": "dev",
+		"profiles": {
+			"dev":  {"instance_domain": "https://dev.jamfcloud.com", "auth_method": "oauth2", "client_id": "c", "client_secret": "s"},
+			"prod": {"instance_domain": "https://prod.jamfcloud.com", "auth_method": "oauth2", "client_id": "c", "client_secret": "s"}
+		}
+	}`)
+	pf, err := LoadProfilesFile(path)
+	require.NoError(t, err)
+
+	cfg, _, err := pf.Resolve("")
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.jamfcloud.com", cfg.InstanceDomain)
+
+	cfg, _, err = pf.Resolve("dev")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.jamfcloud.com", cfg.InstanceDomain, "explicit name wins over the env selector")
+}
+
+func TestProfilesFile_SelectProfile(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "")
+	single := &ProfilesFile{Profiles: map[string]Profile{"only": {}}}
+	name, err := single.SelectProfile("")
+	require.NoError(t, err)
+	assert.Equal(t, "only", name)
+
+	multi := &ProfilesFile{Profiles: map[string]Profile{"a": {}, "b": {}}}
+	_, err = multi.SelectProfile("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "available: a, b")
+
+	_, err = multi.SelectProfile("c")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `profile "c" not found`)
+}
+
+func TestProfilesFile_ResolveErrors(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "")
+	t.Setenv("TEST_JAMF_UNSET", "")
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr string
+	}{
+		{
+			name:    "unset env secret",
+			profile: Profile{InstanceDomain: "https://x", AuthMethod: "oauth2", ClientID: "c", ClientSecret: "env:TEST_JAMF_UNSET"},
+			wantErr: "client_secret: environment variable TEST_JAMF_UNSET is not set",
+		},
+		{
+			name:    "missing file secret",
+			profile: Profile{InstanceDomain: "https://x", AuthMethod: "basic", Username: "u", Password: "file:/nonexistent/secret"},
+			wantErr: "basic_auth_password: read secret file",
+		},
+		{
+			name:    "negative transport setting",
+			profile: Profile{InstanceDomain: "https://x", AuthMethod: "oauth2", ClientID: "c", ClientSecret: "s", Transport: &TransportProfile{RetryCount: -1}},
+			wantErr: "transport.retry_count cannot be negative",
+		},
+		{
+			name:    "invalid auth config",
+			profile: Profile{InstanceDomain: "https://x", AuthMethod: "oauth2"},
+			wantErr: "client_id and client_secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pf := &ProfilesFile{Profiles: map[string]Profile{"p": tt.profile}}
+			_, _, err := pf.Resolve("p")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Contains(t, err.Error(), `profile "p"`)
+		})
+	}
+}
+
+func TestResolveSecret(t *testing.T) {
+	t.Setenv("TEST_JAMF_SECRET", "from-env")
+
+	v, err := ResolveSecret("literal")
+	require.NoError(t, err)
+	assert.Equal(t, "literal", v)
+
+	v, err = ResolveSecret("")
+	require.NoError(t, err)
+	assert.Empty(t, v)
+
+	v, err = ResolveSecret("env:TEST_JAMF_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", v)
+
+	_, err = ResolveSecret("env:")
+	require.Error(t, err)
+	_, err = ResolveSecret("file:")
+	require.Error(t, err)
+}
+
+func TestLoadProfilesFile_Errors(t *testing.T) {
+	_, err := LoadProfilesFile(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+
+	_, err = LoadProfilesFile(writeProfiles(t, "empty.yml", "profiles: {}\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "defines no profiles")
+
+	_, err = LoadProfilesFile(writeProfiles(t, "bad.json", "{"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parse profiles file")
+}
+
+func TestLoadAuthConfigsFromFile(t *testing.T) {
+	path := writeProfiles(t, "tenants.json", `{
+		"acme":   {"instance_domain": "https://acme.jamfcloud.com", "auth_method": "oauth2", "client_id": "c", "client_secret": "s"},
+		"globex": {"instance_domain": "https://globex.jamfcloud.com", "auth_method": "basic", "basic_auth_username": "u", "basic_auth_password": "p"}
+	}`)
+	configs, err := LoadAuthConfigsFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, "https://globex.jamfcloud.com", configs["globex"].InstanceDomain)
+	assert.Equal(t, 5*time.Minute, configs["acme"].TokenRefreshBufferPeriod)
+}
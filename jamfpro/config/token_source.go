@@ -0,0 +1,47 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TokenSource supplies Jamf Pro bearer tokens to the SDK.
+//
+// The built-in OAuth2 client credentials and basic auth flows are both
+// TokenSource implementations. Supply your own to obtain tokens from
+// elsewhere — a token broker sidecar, a secrets manager, or a bearer token
+// minted ahead of time for CI — via AuthConfig.TokenSource or the
+// WithTokenSource client option.
+//
+// Token is called at startup and whenever the cached token is within the
+// refresh buffer of its expiry. It is never called concurrently by a single
+// client. Returning a zero expiry disables caching, so Token is called
+// before every request.
+type TokenSource interface {
+	Token(ctx context.Context) (token string, expiry time.Time, err error)
+}
+
+// TokenSourceFunc adapts an ordinary function to the TokenSource interface.
+type TokenSourceFunc func(ctx context.Context) (string, time.Time, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, time.Time, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a TokenSource that always yields the given
+// pre-minted bearer token. Once expiry has passed, Token returns an error
+// rather than handing out a token the server will reject. A zero expiry
+// means the token is assumed not to expire.
+func StaticTokenSource(token string, expiry time.Time) TokenSource {
+	return TokenSourceFunc(func(context.Context) (string, time.Time, error) {
+		if token == "" {
+			return "", time.Time{}, fmt.Errorf("static token source: token is empty")
+		}
+		if !expiry.IsZero() && !time.Now().Before(expiry) {
+			return "", time.Time{}, fmt.Errorf("static token source: token expired at %s", expiry.Format(time.RFC3339))
+		}
+		return token, expiry, nil
+	})
+}
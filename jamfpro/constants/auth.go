@@ -5,9 +5,12 @@ package constants
 // ============================================================================
 
 // AuthMethod constants for the Jamf Pro authentication methods.
+// AuthMethodTokenSource is reported when a caller-supplied TokenSource
+// provides bearer tokens instead of one of the built-in flows.
 const (
-	AuthMethodOAuth2 = "oauth2"
-	AuthMethodBasic  = "basic"
+	AuthMethodOAuth2      = "oauth2"
+	AuthMethodBasic       = "basic"
+	AuthMethodTokenSource = "token_source"
 )
 
 // ============================================================================
@@ -67,6 +67,8 @@ const (
 	EndpointJamfProComputerPrestagesV3                   = "/api/v3/computer-prestages"
 	EndpointJamfProConditionalAccessV1                   = "/api/v1/conditional-access"
 	EndpointJamfProCSAV1                                 = "/api/v1/csa/token"
+	EndpointJamfProDashboardV1                           = "/api/v1/dashboard"
+	EndpointJamfProDashboardToggleV1                     = "/api/v1/dashboard/toggle"
 	EndpointJamfProDeclarativeDeviceManagementV1         = "/api/v1/ddm"
 	EndpointJamfProDepartmentsV1                         = "/api/v1/departments"
 	EndpointJamfProDeviceCommunicationSettingsV1         = "/api/v1/device-communication-settings"
@@ -166,6 +168,7 @@ const (
 	EndpointJamfProPackagesV1                            = "/api/v1/packages"
 	EndpointJamfProPackagesExport                        = "/api/v1/packages/export"
 	EndpointJamfProPackagesHistoryExport                 = "/history/export"
+	EndpointJamfProParentAppV1                           = "/api/v1/parent-app"
 	EndpointJamfProPatchManagementAcceptDisclaimerV2     = "/api/v2/patch-management-accept-disclaimer"
 	EndpointJamfProPatchPoliciesV2                       = "/api/v2/patch-policies"
 	EndpointJamfProPatchPoliciesPolicyDetails            = "/api/v2/patch-policies/policy-details"
@@ -175,7 +178,10 @@ const (
 	EndpointJamfProReenrollmentV1                        = "/api/v1/reenrollment"
 	EndpointJamfProReenrollmentHistoryV1                 = "/api/v1/reenrollment/history"
 	EndpointJamfProReenrollmentHistoryExport             = "/api/v1/reenrollment/history/export"
+	EndpointJamfProRemoteAdministrationPreview           = "/api/preview/remote-administration-configurations"
+	EndpointJamfProRemoteAdministrationTeamViewerPreview = "/api/preview/remote-administration-configurations/team-viewer"
 	EndpointJamfProReturnToServiceV1                     = "/api/v1/return-to-service"
+	EndpointJamfProSchedulerV1                           = "/api/v1/scheduler"
 	EndpointJamfProScriptsV1                             = "/api/v1/scripts"
 	EndpointJamfProSelfServiceBrandingMobileV1           = "/api/v1/self-service/branding/ios"
 	EndpointJamfProSelfServiceBrandingMacOSV1            = "/api/v1/self-service/branding/macos"
@@ -192,6 +198,7 @@ const (
 	EndpointJamfProSmartComputerGroupMembership2V2       = "/api/v2/computer-groups/smart-group-membership"
 	EndpointJamfProSmartMobileDeviceGroups2V2            = "/api/v2/mobile-device-groups/smart-groups"
 	EndpointJamfProSmartMobileDeviceGroupMembership      = "/api/v2/mobile-device-groups/smart-group-membership"
+	EndpointJamfProSmartUserGroupsV1                     = "/api/v1/smart-user-groups"
 	EndpointJamfProSMTPServerV2                          = "/api/v2/smtp-server"
 	EndpointJamfProSMTPServerHistoryV1                   = "/api/v1/smtp-server/history"
 	EndpointJamfProSMTPServerTestV1                      = "/api/v1/smtp-server/test"
@@ -208,6 +215,10 @@ const (
 	EndpointJamfProStartupStatus                         = "/api/startup-status"
 	EndpointJamfProStaticComputerGroups2V2               = "/api/v2/computer-groups/static-groups"
 	EndpointJamfProStaticMobileDeviceGroups2V2           = "/api/v2/mobile-device-groups/static-groups"
+	EndpointJamfProStaticUserGroupsV1                    = "/api/v1/static-user-groups"
+	EndpointJamfProSupervisionIdentitiesV1               = "/api/v1/supervision-identities"
+	EndpointJamfProSupervisionIdentitiesUploadV1         = "/api/v1/supervision-identities/upload"
+	EndpointJamfProTeacherAppV1                          = "/api/v1/teacher-app"
 	EndpointJamfProTimeZonesV1                           = "/api/v1/time-zones"
 	EndpointJamfProIssueTomcatSslCertificate             = "/api/settings/issueTomcatSslCertificate"
 	EndpointJamfProUser                                  = "/api/user"
@@ -0,0 +1,93 @@
+// Package fakes holds the support code shared by the generated service fakes.
+//
+// Every service package has a fakes subpackage with a hand-controllable
+// implementation of its Service interface, for example
+// jamf_pro_api/packages/fakes.PackagesFake. Each method has a matching
+// <Method>Func field: set it to control what the method returns, and leave it
+// nil to have the method return ErrNotStubbed. Every call is recorded in the
+// fake's embedded Journal whether or not it was stubbed.
+//
+//	import packagesfakes "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages/fakes"
+//
+//	fake := &packagesfakes.PackagesFake{
+//		GetByIDV1Func: func(ctx context.Context, id string) (*packages.ResourcePackage, *resty.Response, error) {
+//			return &packages.ResourcePackage{ID: id, PackageName: "Firefox"}, nil, nil
+//		},
+//	}
+//	client := &jamfpro.Client{JamfProAPI: &jamfpro.JamfProAPIClient{Packages: fake}}
+package fakes
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotStubbed is returned by a fake method whose Func field is nil.
+var ErrNotStubbed = errors.New("fake method not stubbed")
+
+// NotStubbed returns ErrNotStubbed annotated with the fake method's name.
+func NotStubbed(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotStubbed)
+}
+
+// Call is one invocation of a fake method.
+type Call struct {
+	// Ordinal is the call's 1-based position among all calls to the fake.
+	Ordinal int
+	Method  string
+	// Args holds the arguments in declaration order, including the context.
+	// A variadic parameter is recorded as a single slice.
+	Args []any
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("#%d %s", c.Ordinal, c.Method)
+}
+
+// Journal records the calls made to a fake. The zero value is ready to use and
+// it is safe for concurrent use.
+type Journal struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record appends a call to the journal. Generated fakes call it at the start
+// of every method.
+func (j *Journal) Record(method string, args ...any) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.calls = append(j.calls, Call{Ordinal: len(j.calls) + 1, Method: method, Args: args})
+}
+
+// Calls returns every call the fake has received, in order.
+func (j *Journal) Calls() []Call {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]Call(nil), j.calls...)
+}
+
+// CallsTo returns the calls made to method, in order.
+func (j *Journal) CallsTo(method string) []Call {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var out []Call
+	for _, c := range j.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CallCount returns how many times method was called.
+func (j *Journal) CallCount(method string) int {
+	return len(j.CallsTo(method))
+}
+
+// ResetCalls clears the journal.
+func (j *Journal) ResetCalls() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.calls = nil
+}
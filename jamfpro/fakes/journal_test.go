@@ -0,0 +1,116 @@
+package fakes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	computercommandsfakes "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/computer_commands/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages"
+	packagesfakes "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+// packageNames stands in for downstream business logic that only knows the
+// aggregate client.
+func packageNames(ctx context.Context, client *jamfpro.Client) ([]string, error) {
+	list, _, err := client.JamfProAPI.Packages.ListV1(ctx, map[string]string{"sort": "packageName:asc"})
+	if err != nil {
+		return nil, fmt.Errorf("list packages: %w", err)
+	}
+	names := make([]string, 0, len(list.Results))
+	for _, p := range list.Results {
+		names = append(names, p.PackageName)
+	}
+	return names, nil
+}
+
+func TestUnit_Fakes_StubbedMethodDelegates(t *testing.T) {
+	fake := &packagesfakes.PackagesFake{
+		ListV1Func: func(ctx context.Context, rsqlQuery map[string]string) (*packages.ListResponse, *resty.Response, error) {
+			return &packages.ListResponse{
+				TotalCount: 2,
+				Results:    []packages.ResourcePackage{{PackageName: "Chrome"}, {PackageName: "Firefox"}},
+			}, nil, nil
+		},
+	}
+	client := &jamfpro.Client{JamfProAPI: &jamfpro.JamfProAPIClient{Packages: fake}}
+
+	names, err := packageNames(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Chrome", "Firefox"}, names)
+
+	require.Equal(t, 1, fake.CallCount("ListV1"))
+	call := fake.CallsTo("ListV1")[0]
+	assert.Equal(t, 1, call.Ordinal)
+	require.Len(t, call.Args, 2)
+	assert.Equal(t, map[string]string{"sort": "packageName:asc"}, call.Args[1])
+}
+
+func TestUnit_Fakes_UnstubbedMethodReturnsErrNotStubbed(t *testing.T) {
+	fake := &packagesfakes.PackagesFake{}
+	client := &jamfpro.Client{JamfProAPI: &jamfpro.JamfProAPIClient{Packages: fake}}
+
+	_, err := packageNames(context.Background(), client)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, fakes.ErrNotStubbed))
+	assert.Contains(t, err.Error(), "PackagesFake.ListV1")
+	assert.Equal(t, 1, fake.CallCount("ListV1"), "unstubbed calls are recorded too")
+}
+
+func TestUnit_Fakes_VariadicArgumentsRecordedAsSlice(t *testing.T) {
+	var got []string
+	fake := &computercommandsfakes.ComputerCommandsFake{
+		SendCommandFunc: func(ctx context.Context, command string, ids ...string) (*resty.Response, error) {
+			got = ids
+			return nil, nil
+		},
+	}
+
+	_, err := fake.SendCommand(context.Background(), "DeviceLock", "1", "2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, got)
+
+	call := fake.CallsTo("SendCommand")[0]
+	assert.Equal(t, []any{context.Background(), "DeviceLock", []string{"1", "2"}}, call.Args)
+}
+
+func TestUnit_Journal_CallsAndReset(t *testing.T) {
+	var j fakes.Journal
+	j.Record("GetByID", 1)
+	j.Record("List")
+	j.Record("GetByID", 2)
+
+	calls := j.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, "#2 List", calls[1].String())
+	assert.Equal(t, 2, j.CallCount("GetByID"))
+	assert.Equal(t, []any{2}, j.CallsTo("GetByID")[1].Args)
+	assert.Empty(t, j.CallsTo("Delete"))
+
+	j.ResetCalls()
+	assert.Empty(t, j.Calls())
+	j.Record("List")
+	assert.Equal(t, 1, j.Calls()[0].Ordinal)
+}
+
+func TestUnit_Journal_ConcurrentRecord(t *testing.T) {
+	var j fakes.Journal
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() { j.Record("List") })
+	}
+	wg.Wait()
+
+	calls := j.Calls()
+	require.Len(t, calls, 50)
+	for i, c := range calls {
+		assert.Equal(t, i+1, c.Ordinal)
+	}
+}
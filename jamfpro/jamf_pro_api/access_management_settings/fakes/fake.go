@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the access_management_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/access_management_settings"
+	"resty.dev/v3"
+)
+
+// AccessManagementSettingsFake is a access_management_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AccessManagementSettingsFake struct {
+	fakes.Journal
+
+	CreateV4Func func(ctx context.Context, request *access_management_settings.ResourceAccessManagementSettings) (*access_management_settings.ResourceAccessManagementSettings, *resty.Response, error)
+	GetV4Func    func(ctx context.Context) (*access_management_settings.ResourceAccessManagementSettings, *resty.Response, error)
+}
+
+var _ access_management_settings.Service = (*AccessManagementSettingsFake)(nil)
+
+// CreateV4 records the call and delegates to CreateV4Func.
+func (f *AccessManagementSettingsFake) CreateV4(ctx context.Context, request *access_management_settings.ResourceAccessManagementSettings) (*access_management_settings.ResourceAccessManagementSettings, *resty.Response, error) {
+	f.Record("CreateV4", ctx, request)
+	if f.CreateV4Func == nil {
+		return nil, nil, fakes.NotStubbed("AccessManagementSettingsFake.CreateV4")
+	}
+	return f.CreateV4Func(ctx, request)
+}
+
+// GetV4 records the call and delegates to GetV4Func.
+func (f *AccessManagementSettingsFake) GetV4(ctx context.Context) (*access_management_settings.ResourceAccessManagementSettings, *resty.Response, error) {
+	f.Record("GetV4", ctx)
+	if f.GetV4Func == nil {
+		return nil, nil, fakes.NotStubbed("AccessManagementSettingsFake.GetV4")
+	}
+	return f.GetV4Func(ctx)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package access_management_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AccessManagementSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AccessManagementSettingsFake implements it for tests.
+type Service interface {
+	CreateV4(ctx context.Context, request *ResourceAccessManagementSettings) (*ResourceAccessManagementSettings, *resty.Response, error)
+	GetV4(ctx context.Context) (*ResourceAccessManagementSettings, *resty.Response, error)
+}
+
+var _ Service = (*AccessManagementSettings)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the account_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/account_groups"
+	"resty.dev/v3"
+)
+
+// AccountGroupsFake is a account_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AccountGroupsFake struct {
+	fakes.Journal
+
+	GetByIDV1Func func(ctx context.Context, id string) (*account_groups.ResourceAccountGroup, *resty.Response, error)
+	ListV1Func    func(ctx context.Context, rsqlQuery map[string]string) (*account_groups.ListAccountGroupsResponse, *resty.Response, error)
+}
+
+var _ account_groups.Service = (*AccountGroupsFake)(nil)
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *AccountGroupsFake) GetByIDV1(ctx context.Context, id string) (*account_groups.ResourceAccountGroup, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AccountGroupsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *AccountGroupsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*account_groups.ListAccountGroupsResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AccountGroupsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package account_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AccountGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AccountGroupsFake implements it for tests.
+type Service interface {
+	GetByIDV1(ctx context.Context, id string) (*ResourceAccountGroup, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListAccountGroupsResponse, *resty.Response, error)
+}
+
+var _ Service = (*AccountGroups)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the account_preferences
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/account_preferences"
+	"resty.dev/v3"
+)
+
+// AccountPreferencesFake is a account_preferences.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AccountPreferencesFake struct {
+	fakes.Journal
+
+	GetV3Func    func(ctx context.Context) (*account_preferences.ResourceAccountPreferencesV2, *resty.Response, error)
+	UpdateV3Func func(ctx context.Context, request *account_preferences.ResourceAccountPreferencesV2) (*account_preferences.ResourceAccountPreferencesV2, *resty.Response, error)
+}
+
+var _ account_preferences.Service = (*AccountPreferencesFake)(nil)
+
+// GetV3 records the call and delegates to GetV3Func.
+func (f *AccountPreferencesFake) GetV3(ctx context.Context) (*account_preferences.ResourceAccountPreferencesV2, *resty.Response, error) {
+	f.Record("GetV3", ctx)
+	if f.GetV3Func == nil {
+		return nil, nil, fakes.NotStubbed("AccountPreferencesFake.GetV3")
+	}
+	return f.GetV3Func(ctx)
+}
+
+// UpdateV3 records the call and delegates to UpdateV3Func.
+func (f *AccountPreferencesFake) UpdateV3(ctx context.Context, request *account_preferences.ResourceAccountPreferencesV2) (*account_preferences.ResourceAccountPreferencesV2, *resty.Response, error) {
+	f.Record("UpdateV3", ctx, request)
+	if f.UpdateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("AccountPreferencesFake.UpdateV3")
+	}
+	return f.UpdateV3Func(ctx, request)
+}
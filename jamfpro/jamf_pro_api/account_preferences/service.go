@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package account_preferences
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AccountPreferences.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AccountPreferencesFake implements it for tests.
+type Service interface {
+	GetV3(ctx context.Context) (*ResourceAccountPreferencesV2, *resty.Response, error)
+	UpdateV3(ctx context.Context, request *ResourceAccountPreferencesV2) (*ResourceAccountPreferencesV2, *resty.Response, error)
+}
+
+var _ Service = (*AccountPreferences)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the activation_code
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/activation_code"
+	"resty.dev/v3"
+)
+
+// ActivationCodeFake is a activation_code.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ActivationCodeFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func         func(ctx context.Context, req *activation_code.HistoryNoteRequest) (*activation_code.HistoryEntry, *resty.Response, error)
+	ExportHistoryV1Func          func(ctx context.Context, queryParams map[string]string, req *activation_code.HistoryExportRequest) (*activation_code.HistoryExportResponse, *resty.Response, error)
+	GetHistoryV1Func             func(ctx context.Context, rsqlQuery map[string]string) (*activation_code.HistoryResponse, *resty.Response, error)
+	UpdateOrganizationNameV1Func func(ctx context.Context, req *activation_code.OrganizationNameRequest) (*resty.Response, error)
+	UpdateV1Func                 func(ctx context.Context, req *activation_code.ActivationCodeRequest) (*resty.Response, error)
+}
+
+var _ activation_code.Service = (*ActivationCodeFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *ActivationCodeFake) AddHistoryNoteV1(ctx context.Context, req *activation_code.HistoryNoteRequest) (*activation_code.HistoryEntry, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, req)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ActivationCodeFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, req)
+}
+
+// ExportHistoryV1 records the call and delegates to ExportHistoryV1Func.
+func (f *ActivationCodeFake) ExportHistoryV1(ctx context.Context, queryParams map[string]string, req *activation_code.HistoryExportRequest) (*activation_code.HistoryExportResponse, *resty.Response, error) {
+	f.Record("ExportHistoryV1", ctx, queryParams, req)
+	if f.ExportHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ActivationCodeFake.ExportHistoryV1")
+	}
+	return f.ExportHistoryV1Func(ctx, queryParams, req)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *ActivationCodeFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*activation_code.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ActivationCodeFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// UpdateOrganizationNameV1 records the call and delegates to UpdateOrganizationNameV1Func.
+func (f *ActivationCodeFake) UpdateOrganizationNameV1(ctx context.Context, req *activation_code.OrganizationNameRequest) (*resty.Response, error) {
+	f.Record("UpdateOrganizationNameV1", ctx, req)
+	if f.UpdateOrganizationNameV1Func == nil {
+		return nil, fakes.NotStubbed("ActivationCodeFake.UpdateOrganizationNameV1")
+	}
+	return f.UpdateOrganizationNameV1Func(ctx, req)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *ActivationCodeFake) UpdateV1(ctx context.Context, req *activation_code.ActivationCodeRequest) (*resty.Response, error) {
+	f.Record("UpdateV1", ctx, req)
+	if f.UpdateV1Func == nil {
+		return nil, fakes.NotStubbed("ActivationCodeFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, req)
+}
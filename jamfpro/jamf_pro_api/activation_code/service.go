@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package activation_code
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ActivationCode.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ActivationCodeFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, req *HistoryNoteRequest) (*HistoryEntry, *resty.Response, error)
+	ExportHistoryV1(ctx context.Context, queryParams map[string]string, req *HistoryExportRequest) (*HistoryExportResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	UpdateOrganizationNameV1(ctx context.Context, req *OrganizationNameRequest) (*resty.Response, error)
+	UpdateV1(ctx context.Context, req *ActivationCodeRequest) (*resty.Response, error)
+}
+
+var _ Service = (*ActivationCode)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the adcs_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/adcs_settings"
+	"resty.dev/v3"
+)
+
+// AdcsSettingsFake is a adcs_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AdcsSettingsFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV1Func        func(ctx context.Context, id string, request *adcs_settings.HistoryNoteRequest) (*resty.Response, error)
+	CreateV1Func                    func(ctx context.Context, request *adcs_settings.ResourceAdcsSettings) (*adcs_settings.ResponseAdcsSettingsCreated, *resty.Response, error)
+	DeleteByIDV1Func                func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func                   func(ctx context.Context, id string) (*adcs_settings.ResponseAdcsSettings, *resty.Response, error)
+	GetDependenciesByIDV1Func       func(ctx context.Context, id string) (*adcs_settings.DependenciesResponse, *resty.Response, error)
+	GetHistoryByIDV1Func            func(ctx context.Context, id string, query map[string]string) (*adcs_settings.HistoryResponse, *resty.Response, error)
+	UpdateByIDV1Func                func(ctx context.Context, id string, request *adcs_settings.ResourceAdcsSettings) (*resty.Response, error)
+	ValidateClientCertificateV1Func func(ctx context.Context, request *adcs_settings.ValidateCertificateRequest) (*resty.Response, error)
+	ValidateServerCertificateV1Func func(ctx context.Context, request *adcs_settings.ValidateCertificateRequest) (*resty.Response, error)
+}
+
+var _ adcs_settings.Service = (*AdcsSettingsFake)(nil)
+
+// AddHistoryNoteByIDV1 records the call and delegates to AddHistoryNoteByIDV1Func.
+func (f *AdcsSettingsFake) AddHistoryNoteByIDV1(ctx context.Context, id string, request *adcs_settings.HistoryNoteRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV1", ctx, id, request)
+	if f.AddHistoryNoteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AdcsSettingsFake.AddHistoryNoteByIDV1")
+	}
+	return f.AddHistoryNoteByIDV1Func(ctx, id, request)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *AdcsSettingsFake) CreateV1(ctx context.Context, request *adcs_settings.ResourceAdcsSettings) (*adcs_settings.ResponseAdcsSettingsCreated, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdcsSettingsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *AdcsSettingsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AdcsSettingsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *AdcsSettingsFake) GetByIDV1(ctx context.Context, id string) (*adcs_settings.ResponseAdcsSettings, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdcsSettingsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetDependenciesByIDV1 records the call and delegates to GetDependenciesByIDV1Func.
+func (f *AdcsSettingsFake) GetDependenciesByIDV1(ctx context.Context, id string) (*adcs_settings.DependenciesResponse, *resty.Response, error) {
+	f.Record("GetDependenciesByIDV1", ctx, id)
+	if f.GetDependenciesByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdcsSettingsFake.GetDependenciesByIDV1")
+	}
+	return f.GetDependenciesByIDV1Func(ctx, id)
+}
+
+// GetHistoryByIDV1 records the call and delegates to GetHistoryByIDV1Func.
+func (f *AdcsSettingsFake) GetHistoryByIDV1(ctx context.Context, id string, query map[string]string) (*adcs_settings.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV1", ctx, id, query)
+	if f.GetHistoryByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdcsSettingsFake.GetHistoryByIDV1")
+	}
+	return f.GetHistoryByIDV1Func(ctx, id, query)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *AdcsSettingsFake) UpdateByIDV1(ctx context.Context, id string, request *adcs_settings.ResourceAdcsSettings) (*resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AdcsSettingsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// ValidateClientCertificateV1 records the call and delegates to ValidateClientCertificateV1Func.
+func (f *AdcsSettingsFake) ValidateClientCertificateV1(ctx context.Context, request *adcs_settings.ValidateCertificateRequest) (*resty.Response, error) {
+	f.Record("ValidateClientCertificateV1", ctx, request)
+	if f.ValidateClientCertificateV1Func == nil {
+		return nil, fakes.NotStubbed("AdcsSettingsFake.ValidateClientCertificateV1")
+	}
+	return f.ValidateClientCertificateV1Func(ctx, request)
+}
+
+// ValidateServerCertificateV1 records the call and delegates to ValidateServerCertificateV1Func.
+func (f *AdcsSettingsFake) ValidateServerCertificateV1(ctx context.Context, request *adcs_settings.ValidateCertificateRequest) (*resty.Response, error) {
+	f.Record("ValidateServerCertificateV1", ctx, request)
+	if f.ValidateServerCertificateV1Func == nil {
+		return nil, fakes.NotStubbed("AdcsSettingsFake.ValidateServerCertificateV1")
+	}
+	return f.ValidateServerCertificateV1Func(ctx, request)
+}
@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package adcs_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AdcsSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AdcsSettingsFake implements it for tests.
+type Service interface {
+	AddHistoryNoteByIDV1(ctx context.Context, id string, request *HistoryNoteRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *ResourceAdcsSettings) (*ResponseAdcsSettingsCreated, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResponseAdcsSettings, *resty.Response, error)
+	GetDependenciesByIDV1(ctx context.Context, id string) (*DependenciesResponse, *resty.Response, error)
+	GetHistoryByIDV1(ctx context.Context, id string, query map[string]string) (*HistoryResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceAdcsSettings) (*resty.Response, error)
+	ValidateClientCertificateV1(ctx context.Context, request *ValidateCertificateRequest) (*resty.Response, error)
+	ValidateServerCertificateV1(ctx context.Context, request *ValidateCertificateRequest) (*resty.Response, error)
+}
+
+var _ Service = (*AdcsSettings)(nil)
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package adue_session_token_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AdueSessionTokenSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AdueSessionTokenSettingsFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceADUETokenSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceADUETokenSettings) (*ResourceADUETokenSettings, *resty.Response, error)
+}
+
+var _ Service = (*AdueSessionTokenSettings)(nil)
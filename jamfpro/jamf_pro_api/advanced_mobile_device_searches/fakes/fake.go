@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the advanced_mobile_device_searches
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/advanced_mobile_device_searches"
+	"resty.dev/v3"
+)
+
+// AdvancedMobileDeviceSearchesFake is a advanced_mobile_device_searches.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AdvancedMobileDeviceSearchesFake struct {
+	fakes.Journal
+
+	CreateV1Func         func(ctx context.Context, request *advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch) (*advanced_mobile_device_searches.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func     func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteMultipleV1Func func(ctx context.Context, req *advanced_mobile_device_searches.DeleteAdvancedMobileDeviceSearchesByIDRequest) (*resty.Response, error)
+	GetByIDV1Func        func(ctx context.Context, id string) (*advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch, *resty.Response, error)
+	GetChoicesV1Func     func(ctx context.Context, criteria string, site string, contains string) (*advanced_mobile_device_searches.ChoicesResponse, *resty.Response, error)
+	ListV1Func           func(ctx context.Context, rsqlQuery map[string]string) (*advanced_mobile_device_searches.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func     func(ctx context.Context, id string, request *advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch) (*advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch, *resty.Response, error)
+}
+
+var _ advanced_mobile_device_searches.Service = (*AdvancedMobileDeviceSearchesFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) CreateV1(ctx context.Context, request *advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch) (*advanced_mobile_device_searches.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteMultipleV1 records the call and delegates to DeleteMultipleV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) DeleteMultipleV1(ctx context.Context, req *advanced_mobile_device_searches.DeleteAdvancedMobileDeviceSearchesByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteMultipleV1", ctx, req)
+	if f.DeleteMultipleV1Func == nil {
+		return nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.DeleteMultipleV1")
+	}
+	return f.DeleteMultipleV1Func(ctx, req)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) GetByIDV1(ctx context.Context, id string) (*advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetChoicesV1 records the call and delegates to GetChoicesV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) GetChoicesV1(ctx context.Context, criteria string, site string, contains string) (*advanced_mobile_device_searches.ChoicesResponse, *resty.Response, error) {
+	f.Record("GetChoicesV1", ctx, criteria, site, contains)
+	if f.GetChoicesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.GetChoicesV1")
+	}
+	return f.GetChoicesV1Func(ctx, criteria, site, contains)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*advanced_mobile_device_searches.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *AdvancedMobileDeviceSearchesFake) UpdateByIDV1(ctx context.Context, id string, request *advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch) (*advanced_mobile_device_searches.ResourceAdvancedMobileDeviceSearch, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AdvancedMobileDeviceSearchesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package advanced_mobile_device_searches
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AdvancedMobileDeviceSearches.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AdvancedMobileDeviceSearchesFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceAdvancedMobileDeviceSearch) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteMultipleV1(ctx context.Context, req *DeleteAdvancedMobileDeviceSearchesByIDRequest) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceAdvancedMobileDeviceSearch, *resty.Response, error)
+	GetChoicesV1(ctx context.Context, criteria string, site string, contains string) (*ChoicesResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceAdvancedMobileDeviceSearch) (*ResourceAdvancedMobileDeviceSearch, *resty.Response, error)
+}
+
+var _ Service = (*AdvancedMobileDeviceSearches)(nil)
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package advanced_user_content_searches
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AdvancedUserContentSearches.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AdvancedUserContentSearchesFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceAdvancedUserContentSearch) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceAdvancedUserContentSearch, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceAdvancedUserContentSearch) (*ResourceAdvancedUserContentSearch, *resty.Response, error)
+}
+
+var _ Service = (*AdvancedUserContentSearches)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the api_authorization
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/api_authorization"
+	"resty.dev/v3"
+)
+
+// ApiAuthorizationFake is a api_authorization.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ApiAuthorizationFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*api_authorization.ResourceAuthV1, *resty.Response, error)
+}
+
+var _ api_authorization.Service = (*ApiAuthorizationFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *ApiAuthorizationFake) GetV1(ctx context.Context) (*api_authorization.ResourceAuthV1, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiAuthorizationFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package api_authorization
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ApiAuthorization.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ApiAuthorizationFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceAuthV1, *resty.Response, error)
+}
+
+var _ Service = (*ApiAuthorization)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the api_integrations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/api_integrations"
+	"resty.dev/v3"
+)
+
+// ApiIntegrationsFake is a api_integrations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ApiIntegrationsFake struct {
+	fakes.Journal
+
+	CreateV1Func                       func(ctx context.Context, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error)
+	DeleteByIDV1Func                   func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1Func                 func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1Func                      func(ctx context.Context, id string) (*api_integrations.ResourceApiIntegration, *resty.Response, error)
+	GetByNameV1Func                    func(ctx context.Context, name string) (*api_integrations.ResourceApiIntegration, *resty.Response, error)
+	ListV1Func                         func(ctx context.Context, rsqlQuery map[string]string) (*api_integrations.ListResponse, *resty.Response, error)
+	RefreshClientCredentialsByIDV1Func func(ctx context.Context, id string) (*api_integrations.ResourceClientCredentials, *resty.Response, error)
+	UpdateByIDV1Func                   func(ctx context.Context, id string, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error)
+	UpdateByNameV1Func                 func(ctx context.Context, name string, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error)
+}
+
+var _ api_integrations.Service = (*ApiIntegrationsFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *ApiIntegrationsFake) CreateV1(ctx context.Context, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *ApiIntegrationsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ApiIntegrationsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteByNameV1 records the call and delegates to DeleteByNameV1Func.
+func (f *ApiIntegrationsFake) DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV1", ctx, name)
+	if f.DeleteByNameV1Func == nil {
+		return nil, fakes.NotStubbed("ApiIntegrationsFake.DeleteByNameV1")
+	}
+	return f.DeleteByNameV1Func(ctx, name)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *ApiIntegrationsFake) GetByIDV1(ctx context.Context, id string) (*api_integrations.ResourceApiIntegration, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *ApiIntegrationsFake) GetByNameV1(ctx context.Context, name string) (*api_integrations.ResourceApiIntegration, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ApiIntegrationsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*api_integrations.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// RefreshClientCredentialsByIDV1 records the call and delegates to RefreshClientCredentialsByIDV1Func.
+func (f *ApiIntegrationsFake) RefreshClientCredentialsByIDV1(ctx context.Context, id string) (*api_integrations.ResourceClientCredentials, *resty.Response, error) {
+	f.Record("RefreshClientCredentialsByIDV1", ctx, id)
+	if f.RefreshClientCredentialsByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.RefreshClientCredentialsByIDV1")
+	}
+	return f.RefreshClientCredentialsByIDV1Func(ctx, id)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *ApiIntegrationsFake) UpdateByIDV1(ctx context.Context, id string, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UpdateByNameV1 records the call and delegates to UpdateByNameV1Func.
+func (f *ApiIntegrationsFake) UpdateByNameV1(ctx context.Context, name string, request *api_integrations.RequestApiIntegration) (*api_integrations.ResourceApiIntegration, *resty.Response, error) {
+	f.Record("UpdateByNameV1", ctx, name, request)
+	if f.UpdateByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiIntegrationsFake.UpdateByNameV1")
+	}
+	return f.UpdateByNameV1Func(ctx, name, request)
+}
@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package api_integrations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ApiIntegrations.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ApiIntegrationsFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *RequestApiIntegration) (*ResourceApiIntegration, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceApiIntegration, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceApiIntegration, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	RefreshClientCredentialsByIDV1(ctx context.Context, id string) (*ResourceClientCredentials, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestApiIntegration) (*ResourceApiIntegration, *resty.Response, error)
+	UpdateByNameV1(ctx context.Context, name string, request *RequestApiIntegration) (*ResourceApiIntegration, *resty.Response, error)
+}
+
+var _ Service = (*ApiIntegrations)(nil)
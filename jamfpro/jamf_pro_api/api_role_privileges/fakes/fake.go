@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the api_role_privileges
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/api_role_privileges"
+	"resty.dev/v3"
+)
+
+// ApiRolePrivilegesFake is a api_role_privileges.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ApiRolePrivilegesFake struct {
+	fakes.Journal
+
+	ListV1Func                   func(ctx context.Context) (*api_role_privileges.ListResponse, *resty.Response, error)
+	SearchPrivilegesByNameV1Func func(ctx context.Context, name string, limit int) (*api_role_privileges.ListResponse, *resty.Response, error)
+}
+
+var _ api_role_privileges.Service = (*ApiRolePrivilegesFake)(nil)
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ApiRolePrivilegesFake) ListV1(ctx context.Context) (*api_role_privileges.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolePrivilegesFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
+
+// SearchPrivilegesByNameV1 records the call and delegates to SearchPrivilegesByNameV1Func.
+func (f *ApiRolePrivilegesFake) SearchPrivilegesByNameV1(ctx context.Context, name string, limit int) (*api_role_privileges.ListResponse, *resty.Response, error) {
+	f.Record("SearchPrivilegesByNameV1", ctx, name, limit)
+	if f.SearchPrivilegesByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolePrivilegesFake.SearchPrivilegesByNameV1")
+	}
+	return f.SearchPrivilegesByNameV1Func(ctx, name, limit)
+}
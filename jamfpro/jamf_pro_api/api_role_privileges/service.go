@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package api_role_privileges
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ApiRolePrivileges.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ApiRolePrivilegesFake implements it for tests.
+type Service interface {
+	ListV1(ctx context.Context) (*ListResponse, *resty.Response, error)
+	SearchPrivilegesByNameV1(ctx context.Context, name string, limit int) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*ApiRolePrivileges)(nil)
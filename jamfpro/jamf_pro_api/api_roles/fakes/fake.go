@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the api_roles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/api_roles"
+	"resty.dev/v3"
+)
+
+// ApiRolesFake is a api_roles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ApiRolesFake struct {
+	fakes.Journal
+
+	CreateV1Func     func(ctx context.Context, request *api_roles.RequestAPIRole) (*api_roles.ResourceAPIRole, *resty.Response, error)
+	DeleteByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func    func(ctx context.Context, id string) (*api_roles.ResourceAPIRole, *resty.Response, error)
+	ListV1Func       func(ctx context.Context, rsqlQuery map[string]string) (*api_roles.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func func(ctx context.Context, id string, request *api_roles.RequestAPIRole) (*api_roles.ResourceAPIRole, *resty.Response, error)
+}
+
+var _ api_roles.Service = (*ApiRolesFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *ApiRolesFake) CreateV1(ctx context.Context, request *api_roles.RequestAPIRole) (*api_roles.ResourceAPIRole, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *ApiRolesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ApiRolesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *ApiRolesFake) GetByIDV1(ctx context.Context, id string) (*api_roles.ResourceAPIRole, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ApiRolesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*api_roles.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *ApiRolesFake) UpdateByIDV1(ctx context.Context, id string, request *api_roles.RequestAPIRole) (*api_roles.ResourceAPIRole, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApiRolesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the apns_client_push_status
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/apns_client_push_status"
+	"resty.dev/v3"
+)
+
+// ApnsClientPushStatusFake is a apns_client_push_status.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ApnsClientPushStatusFake struct {
+	fakes.Journal
+
+	EnableAllClientsV1Func          func(ctx context.Context) (*resty.Response, error)
+	EnableClientV1Func              func(ctx context.Context, req *apns_client_push_status.EnableClientRequest) (*resty.Response, error)
+	GetEnableAllClientsStatusV1Func func(ctx context.Context) (*apns_client_push_status.EnableAllClientsStatusResponse, *resty.Response, error)
+	ListV1Func                      func(ctx context.Context, rsqlQuery map[string]string) (*apns_client_push_status.ListResponse, *resty.Response, error)
+}
+
+var _ apns_client_push_status.Service = (*ApnsClientPushStatusFake)(nil)
+
+// EnableAllClientsV1 records the call and delegates to EnableAllClientsV1Func.
+func (f *ApnsClientPushStatusFake) EnableAllClientsV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("EnableAllClientsV1", ctx)
+	if f.EnableAllClientsV1Func == nil {
+		return nil, fakes.NotStubbed("ApnsClientPushStatusFake.EnableAllClientsV1")
+	}
+	return f.EnableAllClientsV1Func(ctx)
+}
+
+// EnableClientV1 records the call and delegates to EnableClientV1Func.
+func (f *ApnsClientPushStatusFake) EnableClientV1(ctx context.Context, req *apns_client_push_status.EnableClientRequest) (*resty.Response, error) {
+	f.Record("EnableClientV1", ctx, req)
+	if f.EnableClientV1Func == nil {
+		return nil, fakes.NotStubbed("ApnsClientPushStatusFake.EnableClientV1")
+	}
+	return f.EnableClientV1Func(ctx, req)
+}
+
+// GetEnableAllClientsStatusV1 records the call and delegates to GetEnableAllClientsStatusV1Func.
+func (f *ApnsClientPushStatusFake) GetEnableAllClientsStatusV1(ctx context.Context) (*apns_client_push_status.EnableAllClientsStatusResponse, *resty.Response, error) {
+	f.Record("GetEnableAllClientsStatusV1", ctx)
+	if f.GetEnableAllClientsStatusV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApnsClientPushStatusFake.GetEnableAllClientsStatusV1")
+	}
+	return f.GetEnableAllClientsStatusV1Func(ctx)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ApnsClientPushStatusFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*apns_client_push_status.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ApnsClientPushStatusFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
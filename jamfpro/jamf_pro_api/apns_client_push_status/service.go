@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package apns_client_push_status
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ApnsClientPushStatus.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ApnsClientPushStatusFake implements it for tests.
+type Service interface {
+	EnableAllClientsV1(ctx context.Context) (*resty.Response, error)
+	EnableClientV1(ctx context.Context, req *EnableClientRequest) (*resty.Response, error)
+	GetEnableAllClientsStatusV1(ctx context.Context) (*EnableAllClientsStatusResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*ApnsClientPushStatus)(nil)
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the app_installers
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/app_installers"
+	"resty.dev/v3"
+)
+
+// AppInstallersFake is a app_installers.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AppInstallersFake struct {
+	fakes.Journal
+
+	CreateDeploymentV1Func     func(ctx context.Context, request *app_installers.RequestDeployment) (*app_installers.CreateDeploymentResponse, *resty.Response, error)
+	DeleteDeploymentByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetDeploymentByIDV1Func    func(ctx context.Context, id string) (*app_installers.ResourceJamfAppCatalogDeployment, *resty.Response, error)
+	GetTitleByIDV1Func         func(ctx context.Context, id string) (*app_installers.ResourceJamfAppCatalogAppInstaller, *resty.Response, error)
+	ListDeploymentsV1Func      func(ctx context.Context, rsqlQuery map[string]string) (*app_installers.ListDeploymentsResponse, *resty.Response, error)
+	ListTitlesV1Func           func(ctx context.Context, rsqlQuery map[string]string) (*app_installers.ListTitlesResponse, *resty.Response, error)
+	UpdateDeploymentByIDV1Func func(ctx context.Context, id string, request *app_installers.RequestDeployment) (*app_installers.ResourceJamfAppCatalogDeployment, *resty.Response, error)
+}
+
+var _ app_installers.Service = (*AppInstallersFake)(nil)
+
+// CreateDeploymentV1 records the call and delegates to CreateDeploymentV1Func.
+func (f *AppInstallersFake) CreateDeploymentV1(ctx context.Context, request *app_installers.RequestDeployment) (*app_installers.CreateDeploymentResponse, *resty.Response, error) {
+	f.Record("CreateDeploymentV1", ctx, request)
+	if f.CreateDeploymentV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.CreateDeploymentV1")
+	}
+	return f.CreateDeploymentV1Func(ctx, request)
+}
+
+// DeleteDeploymentByIDV1 records the call and delegates to DeleteDeploymentByIDV1Func.
+func (f *AppInstallersFake) DeleteDeploymentByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteDeploymentByIDV1", ctx, id)
+	if f.DeleteDeploymentByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AppInstallersFake.DeleteDeploymentByIDV1")
+	}
+	return f.DeleteDeploymentByIDV1Func(ctx, id)
+}
+
+// GetDeploymentByIDV1 records the call and delegates to GetDeploymentByIDV1Func.
+func (f *AppInstallersFake) GetDeploymentByIDV1(ctx context.Context, id string) (*app_installers.ResourceJamfAppCatalogDeployment, *resty.Response, error) {
+	f.Record("GetDeploymentByIDV1", ctx, id)
+	if f.GetDeploymentByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.GetDeploymentByIDV1")
+	}
+	return f.GetDeploymentByIDV1Func(ctx, id)
+}
+
+// GetTitleByIDV1 records the call and delegates to GetTitleByIDV1Func.
+func (f *AppInstallersFake) GetTitleByIDV1(ctx context.Context, id string) (*app_installers.ResourceJamfAppCatalogAppInstaller, *resty.Response, error) {
+	f.Record("GetTitleByIDV1", ctx, id)
+	if f.GetTitleByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.GetTitleByIDV1")
+	}
+	return f.GetTitleByIDV1Func(ctx, id)
+}
+
+// ListDeploymentsV1 records the call and delegates to ListDeploymentsV1Func.
+func (f *AppInstallersFake) ListDeploymentsV1(ctx context.Context, rsqlQuery map[string]string) (*app_installers.ListDeploymentsResponse, *resty.Response, error) {
+	f.Record("ListDeploymentsV1", ctx, rsqlQuery)
+	if f.ListDeploymentsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.ListDeploymentsV1")
+	}
+	return f.ListDeploymentsV1Func(ctx, rsqlQuery)
+}
+
+// ListTitlesV1 records the call and delegates to ListTitlesV1Func.
+func (f *AppInstallersFake) ListTitlesV1(ctx context.Context, rsqlQuery map[string]string) (*app_installers.ListTitlesResponse, *resty.Response, error) {
+	f.Record("ListTitlesV1", ctx, rsqlQuery)
+	if f.ListTitlesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.ListTitlesV1")
+	}
+	return f.ListTitlesV1Func(ctx, rsqlQuery)
+}
+
+// UpdateDeploymentByIDV1 records the call and delegates to UpdateDeploymentByIDV1Func.
+func (f *AppInstallersFake) UpdateDeploymentByIDV1(ctx context.Context, id string, request *app_installers.RequestDeployment) (*app_installers.ResourceJamfAppCatalogDeployment, *resty.Response, error) {
+	f.Record("UpdateDeploymentByIDV1", ctx, id, request)
+	if f.UpdateDeploymentByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppInstallersFake.UpdateDeploymentByIDV1")
+	}
+	return f.UpdateDeploymentByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package app_installers
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AppInstallers.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AppInstallersFake implements it for tests.
+type Service interface {
+	CreateDeploymentV1(ctx context.Context, request *RequestDeployment) (*CreateDeploymentResponse, *resty.Response, error)
+	DeleteDeploymentByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetDeploymentByIDV1(ctx context.Context, id string) (*ResourceJamfAppCatalogDeployment, *resty.Response, error)
+	GetTitleByIDV1(ctx context.Context, id string) (*ResourceJamfAppCatalogAppInstaller, *resty.Response, error)
+	ListDeploymentsV1(ctx context.Context, rsqlQuery map[string]string) (*ListDeploymentsResponse, *resty.Response, error)
+	ListTitlesV1(ctx context.Context, rsqlQuery map[string]string) (*ListTitlesResponse, *resty.Response, error)
+	UpdateDeploymentByIDV1(ctx context.Context, id string, request *RequestDeployment) (*ResourceJamfAppCatalogDeployment, *resty.Response, error)
+}
+
+var _ Service = (*AppInstallers)(nil)
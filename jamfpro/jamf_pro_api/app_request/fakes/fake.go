@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the app_request
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/app_request"
+	"resty.dev/v3"
+)
+
+// AppRequestFake is a app_request.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AppRequestFake struct {
+	fakes.Journal
+
+	CreateFormInputFieldV1Func     func(ctx context.Context, request *app_request.RequestFormInputField) (*app_request.ResourceFormInputField, *resty.Response, error)
+	DeleteFormInputFieldByIDV1Func func(ctx context.Context, id int) (*resty.Response, error)
+	GetFormInputFieldByIDV1Func    func(ctx context.Context, id int) (*app_request.ResourceFormInputField, *resty.Response, error)
+	GetSettingsV1Func              func(ctx context.Context) (*app_request.ResourceAppRequestSettings, *resty.Response, error)
+	ListFormInputFieldsV1Func      func(ctx context.Context, rsqlQuery map[string]string) (*app_request.FormInputFieldListResponse, *resty.Response, error)
+	ReplaceFormInputFieldsV1Func   func(ctx context.Context, request []app_request.RequestFormInputField) ([]app_request.ResourceFormInputField, *resty.Response, error)
+	UpdateFormInputFieldByIDV1Func func(ctx context.Context, id int, request *app_request.RequestFormInputField) (*app_request.ResourceFormInputField, *resty.Response, error)
+	UpdateSettingsV1Func           func(ctx context.Context, request *app_request.ResourceAppRequestSettings) (*app_request.ResourceAppRequestSettings, *resty.Response, error)
+}
+
+var _ app_request.Service = (*AppRequestFake)(nil)
+
+// CreateFormInputFieldV1 records the call and delegates to CreateFormInputFieldV1Func.
+func (f *AppRequestFake) CreateFormInputFieldV1(ctx context.Context, request *app_request.RequestFormInputField) (*app_request.ResourceFormInputField, *resty.Response, error) {
+	f.Record("CreateFormInputFieldV1", ctx, request)
+	if f.CreateFormInputFieldV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.CreateFormInputFieldV1")
+	}
+	return f.CreateFormInputFieldV1Func(ctx, request)
+}
+
+// DeleteFormInputFieldByIDV1 records the call and delegates to DeleteFormInputFieldByIDV1Func.
+func (f *AppRequestFake) DeleteFormInputFieldByIDV1(ctx context.Context, id int) (*resty.Response, error) {
+	f.Record("DeleteFormInputFieldByIDV1", ctx, id)
+	if f.DeleteFormInputFieldByIDV1Func == nil {
+		return nil, fakes.NotStubbed("AppRequestFake.DeleteFormInputFieldByIDV1")
+	}
+	return f.DeleteFormInputFieldByIDV1Func(ctx, id)
+}
+
+// GetFormInputFieldByIDV1 records the call and delegates to GetFormInputFieldByIDV1Func.
+func (f *AppRequestFake) GetFormInputFieldByIDV1(ctx context.Context, id int) (*app_request.ResourceFormInputField, *resty.Response, error) {
+	f.Record("GetFormInputFieldByIDV1", ctx, id)
+	if f.GetFormInputFieldByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.GetFormInputFieldByIDV1")
+	}
+	return f.GetFormInputFieldByIDV1Func(ctx, id)
+}
+
+// GetSettingsV1 records the call and delegates to GetSettingsV1Func.
+func (f *AppRequestFake) GetSettingsV1(ctx context.Context) (*app_request.ResourceAppRequestSettings, *resty.Response, error) {
+	f.Record("GetSettingsV1", ctx)
+	if f.GetSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.GetSettingsV1")
+	}
+	return f.GetSettingsV1Func(ctx)
+}
+
+// ListFormInputFieldsV1 records the call and delegates to ListFormInputFieldsV1Func.
+func (f *AppRequestFake) ListFormInputFieldsV1(ctx context.Context, rsqlQuery map[string]string) (*app_request.FormInputFieldListResponse, *resty.Response, error) {
+	f.Record("ListFormInputFieldsV1", ctx, rsqlQuery)
+	if f.ListFormInputFieldsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.ListFormInputFieldsV1")
+	}
+	return f.ListFormInputFieldsV1Func(ctx, rsqlQuery)
+}
+
+// ReplaceFormInputFieldsV1 records the call and delegates to ReplaceFormInputFieldsV1Func.
+func (f *AppRequestFake) ReplaceFormInputFieldsV1(ctx context.Context, request []app_request.RequestFormInputField) ([]app_request.ResourceFormInputField, *resty.Response, error) {
+	f.Record("ReplaceFormInputFieldsV1", ctx, request)
+	if f.ReplaceFormInputFieldsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.ReplaceFormInputFieldsV1")
+	}
+	return f.ReplaceFormInputFieldsV1Func(ctx, request)
+}
+
+// UpdateFormInputFieldByIDV1 records the call and delegates to UpdateFormInputFieldByIDV1Func.
+func (f *AppRequestFake) UpdateFormInputFieldByIDV1(ctx context.Context, id int, request *app_request.RequestFormInputField) (*app_request.ResourceFormInputField, *resty.Response, error) {
+	f.Record("UpdateFormInputFieldByIDV1", ctx, id, request)
+	if f.UpdateFormInputFieldByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.UpdateFormInputFieldByIDV1")
+	}
+	return f.UpdateFormInputFieldByIDV1Func(ctx, id, request)
+}
+
+// UpdateSettingsV1 records the call and delegates to UpdateSettingsV1Func.
+func (f *AppRequestFake) UpdateSettingsV1(ctx context.Context, request *app_request.ResourceAppRequestSettings) (*app_request.ResourceAppRequestSettings, *resty.Response, error) {
+	f.Record("UpdateSettingsV1", ctx, request)
+	if f.UpdateSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppRequestFake.UpdateSettingsV1")
+	}
+	return f.UpdateSettingsV1Func(ctx, request)
+}
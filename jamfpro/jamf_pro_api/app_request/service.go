@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package app_request
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AppRequest.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AppRequestFake implements it for tests.
+type Service interface {
+	CreateFormInputFieldV1(ctx context.Context, request *RequestFormInputField) (*ResourceFormInputField, *resty.Response, error)
+	DeleteFormInputFieldByIDV1(ctx context.Context, id int) (*resty.Response, error)
+	GetFormInputFieldByIDV1(ctx context.Context, id int) (*ResourceFormInputField, *resty.Response, error)
+	GetSettingsV1(ctx context.Context) (*ResourceAppRequestSettings, *resty.Response, error)
+	ListFormInputFieldsV1(ctx context.Context, rsqlQuery map[string]string) (*FormInputFieldListResponse, *resty.Response, error)
+	ReplaceFormInputFieldsV1(ctx context.Context, request []RequestFormInputField) ([]ResourceFormInputField, *resty.Response, error)
+	UpdateFormInputFieldByIDV1(ctx context.Context, id int, request *RequestFormInputField) (*ResourceFormInputField, *resty.Response, error)
+	UpdateSettingsV1(ctx context.Context, request *ResourceAppRequestSettings) (*ResourceAppRequestSettings, *resty.Response, error)
+}
+
+var _ Service = (*AppRequest)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the app_store_country_codes
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/app_store_country_codes"
+	"resty.dev/v3"
+)
+
+// AppStoreCountryCodesFake is a app_store_country_codes.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type AppStoreCountryCodesFake struct {
+	fakes.Journal
+
+	ListV1Func func(ctx context.Context) (*app_store_country_codes.ListResponse, *resty.Response, error)
+}
+
+var _ app_store_country_codes.Service = (*AppStoreCountryCodesFake)(nil)
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *AppStoreCountryCodesFake) ListV1(ctx context.Context) (*app_store_country_codes.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("AppStoreCountryCodesFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
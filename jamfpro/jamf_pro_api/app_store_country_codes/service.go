@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package app_store_country_codes
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of AppStoreCountryCodes.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.AppStoreCountryCodesFake implements it for tests.
+type Service interface {
+	ListV1(ctx context.Context) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*AppStoreCountryCodes)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the bookmarks
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/bookmarks"
+	"resty.dev/v3"
+)
+
+// BookmarksFake is a bookmarks.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type BookmarksFake struct {
+	fakes.Journal
+
+	CreateV1Func     func(ctx context.Context, request *bookmarks.ResourceBookmark) (*bookmarks.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func    func(ctx context.Context, id string) (*bookmarks.ResourceBookmark, *resty.Response, error)
+	ListV1Func       func(ctx context.Context, rsqlQuery map[string]string) (*bookmarks.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func func(ctx context.Context, id string, request *bookmarks.ResourceBookmark) (*bookmarks.ResourceBookmark, *resty.Response, error)
+}
+
+var _ bookmarks.Service = (*BookmarksFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *BookmarksFake) CreateV1(ctx context.Context, request *bookmarks.ResourceBookmark) (*bookmarks.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BookmarksFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *BookmarksFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("BookmarksFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *BookmarksFake) GetByIDV1(ctx context.Context, id string) (*bookmarks.ResourceBookmark, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BookmarksFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *BookmarksFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*bookmarks.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BookmarksFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *BookmarksFake) UpdateByIDV1(ctx context.Context, id string, request *bookmarks.ResourceBookmark) (*bookmarks.ResourceBookmark, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BookmarksFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
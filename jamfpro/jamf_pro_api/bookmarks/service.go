@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package bookmarks
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Bookmarks.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.BookmarksFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceBookmark) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceBookmark, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceBookmark) (*ResourceBookmark, *resty.Response, error)
+}
+
+var _ Service = (*Bookmarks)(nil)
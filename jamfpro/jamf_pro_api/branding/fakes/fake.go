@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the branding
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/branding"
+	"resty.dev/v3"
+)
+
+// BrandingFake is a branding.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type BrandingFake struct {
+	fakes.Journal
+
+	DownloadBrandingImageV1Func func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+}
+
+var _ branding.Service = (*BrandingFake)(nil)
+
+// DownloadBrandingImageV1 records the call and delegates to DownloadBrandingImageV1Func.
+func (f *BrandingFake) DownloadBrandingImageV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("DownloadBrandingImageV1", ctx, id)
+	if f.DownloadBrandingImageV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BrandingFake.DownloadBrandingImageV1")
+	}
+	return f.DownloadBrandingImageV1Func(ctx, id)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package branding
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Branding.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.BrandingFake implements it for tests.
+type Service interface {
+	DownloadBrandingImageV1(ctx context.Context, id string) ([]byte, *resty.Response, error)
+}
+
+var _ Service = (*Branding)(nil)
@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the buildings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/buildings"
+	"resty.dev/v3"
+)
+
+// BuildingsFake is a buildings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type BuildingsFake struct {
+	fakes.Journal
+
+	AddBuildingHistoryNotesV1Func func(ctx context.Context, id string, req *buildings.AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1Func                  func(ctx context.Context, request *buildings.RequestBuilding) (*buildings.CreateResponse, *resty.Response, error)
+	DeleteBuildingsByIDV1Func     func(ctx context.Context, req *buildings.DeleteBuildingsByIDRequest) (*resty.Response, error)
+	DeleteByIDV1Func              func(ctx context.Context, id string) (*resty.Response, error)
+	ExportHistoryV1Func           func(ctx context.Context, id string, rsqlQuery map[string]string, req *buildings.ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	ExportV1Func                  func(ctx context.Context, rsqlQuery map[string]string, req *buildings.ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetBuildingHistoryV1Func      func(ctx context.Context, id string, rsqlQuery map[string]string) (*buildings.HistoryResponse, *resty.Response, error)
+	GetByIDV1Func                 func(ctx context.Context, id string) (*buildings.ResourceBuilding, *resty.Response, error)
+	ListV1Func                    func(ctx context.Context, rsqlQuery map[string]string) (*buildings.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func              func(ctx context.Context, id string, request *buildings.RequestBuilding) (*buildings.ResourceBuilding, *resty.Response, error)
+}
+
+var _ buildings.Service = (*BuildingsFake)(nil)
+
+// AddBuildingHistoryNotesV1 records the call and delegates to AddBuildingHistoryNotesV1Func.
+func (f *BuildingsFake) AddBuildingHistoryNotesV1(ctx context.Context, id string, req *buildings.AddHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddBuildingHistoryNotesV1", ctx, id, req)
+	if f.AddBuildingHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("BuildingsFake.AddBuildingHistoryNotesV1")
+	}
+	return f.AddBuildingHistoryNotesV1Func(ctx, id, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *BuildingsFake) CreateV1(ctx context.Context, request *buildings.RequestBuilding) (*buildings.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteBuildingsByIDV1 records the call and delegates to DeleteBuildingsByIDV1Func.
+func (f *BuildingsFake) DeleteBuildingsByIDV1(ctx context.Context, req *buildings.DeleteBuildingsByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteBuildingsByIDV1", ctx, req)
+	if f.DeleteBuildingsByIDV1Func == nil {
+		return nil, fakes.NotStubbed("BuildingsFake.DeleteBuildingsByIDV1")
+	}
+	return f.DeleteBuildingsByIDV1Func(ctx, req)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *BuildingsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("BuildingsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// ExportHistoryV1 records the call and delegates to ExportHistoryV1Func.
+func (f *BuildingsFake) ExportHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string, req *buildings.ExportRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("ExportHistoryV1", ctx, id, rsqlQuery, req, acceptType)
+	if f.ExportHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.ExportHistoryV1")
+	}
+	return f.ExportHistoryV1Func(ctx, id, rsqlQuery, req, acceptType)
+}
+
+// ExportV1 records the call and delegates to ExportV1Func.
+func (f *BuildingsFake) ExportV1(ctx context.Context, rsqlQuery map[string]string, req *buildings.ExportRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("ExportV1", ctx, rsqlQuery, req, acceptType)
+	if f.ExportV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.ExportV1")
+	}
+	return f.ExportV1Func(ctx, rsqlQuery, req, acceptType)
+}
+
+// GetBuildingHistoryV1 records the call and delegates to GetBuildingHistoryV1Func.
+func (f *BuildingsFake) GetBuildingHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*buildings.HistoryResponse, *resty.Response, error) {
+	f.Record("GetBuildingHistoryV1", ctx, id, rsqlQuery)
+	if f.GetBuildingHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.GetBuildingHistoryV1")
+	}
+	return f.GetBuildingHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *BuildingsFake) GetByIDV1(ctx context.Context, id string) (*buildings.ResourceBuilding, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *BuildingsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*buildings.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *BuildingsFake) UpdateByIDV1(ctx context.Context, id string, request *buildings.RequestBuilding) (*buildings.ResourceBuilding, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("BuildingsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
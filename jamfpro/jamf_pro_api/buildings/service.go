@@ -0,0 +1,27 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package buildings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Buildings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.BuildingsFake implements it for tests.
+type Service interface {
+	AddBuildingHistoryNotesV1(ctx context.Context, id string, req *AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestBuilding) (*CreateResponse, *resty.Response, error)
+	DeleteBuildingsByIDV1(ctx context.Context, req *DeleteBuildingsByIDRequest) (*resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	ExportHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string, req *ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	ExportV1(ctx context.Context, rsqlQuery map[string]string, req *ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetBuildingHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceBuilding, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestBuilding) (*ResourceBuilding, *resty.Response, error)
+}
+
+var _ Service = (*Buildings)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cache_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cache_settings"
+	"resty.dev/v3"
+)
+
+// CacheSettingsFake is a cache_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CacheSettingsFake struct {
+	fakes.Journal
+
+	GetV1Func    func(ctx context.Context) (*cache_settings.ResourceCacheSettings, *resty.Response, error)
+	UpdateV1Func func(ctx context.Context, request *cache_settings.ResourceCacheSettings) (*cache_settings.ResourceCacheSettings, *resty.Response, error)
+}
+
+var _ cache_settings.Service = (*CacheSettingsFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *CacheSettingsFake) GetV1(ctx context.Context) (*cache_settings.ResourceCacheSettings, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CacheSettingsFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *CacheSettingsFake) UpdateV1(ctx context.Context, request *cache_settings.ResourceCacheSettings) (*cache_settings.ResourceCacheSettings, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CacheSettingsFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cache_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CacheSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CacheSettingsFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceCacheSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceCacheSettings) (*ResourceCacheSettings, *resty.Response, error)
+}
+
+var _ Service = (*CacheSettings)(nil)
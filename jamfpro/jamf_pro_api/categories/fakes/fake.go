@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the categories
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/categories"
+	"resty.dev/v3"
+)
+
+// CategoriesFake is a categories.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CategoriesFake struct {
+	fakes.Journal
+
+	AddCategoryHistoryNotesV1Func func(ctx context.Context, id string, req *categories.AddCategoryHistoryNotesRequest) (*resty.Response, error)
+	CreateV1Func                  func(ctx context.Context, request *categories.RequestCategory) (*categories.CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDV1Func              func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteCategoriesByIDV1Func    func(ctx context.Context, req *categories.DeleteCategoriesByIDRequest) (*resty.Response, error)
+	GetByIDV1Func                 func(ctx context.Context, id string) (*categories.ResourceCategory, *resty.Response, error)
+	GetCategoryHistoryV1Func      func(ctx context.Context, id string, rsqlQuery map[string]string) (*categories.CategoryHistoryResponse, *resty.Response, error)
+	ListV1Func                    func(ctx context.Context, rsqlQuery map[string]string) (*categories.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func              func(ctx context.Context, id string, request *categories.RequestCategory) (*categories.CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ categories.Service = (*CategoriesFake)(nil)
+
+// AddCategoryHistoryNotesV1 records the call and delegates to AddCategoryHistoryNotesV1Func.
+func (f *CategoriesFake) AddCategoryHistoryNotesV1(ctx context.Context, id string, req *categories.AddCategoryHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddCategoryHistoryNotesV1", ctx, id, req)
+	if f.AddCategoryHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("CategoriesFake.AddCategoryHistoryNotesV1")
+	}
+	return f.AddCategoryHistoryNotesV1Func(ctx, id, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *CategoriesFake) CreateV1(ctx context.Context, request *categories.RequestCategory) (*categories.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CategoriesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *CategoriesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("CategoriesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteCategoriesByIDV1 records the call and delegates to DeleteCategoriesByIDV1Func.
+func (f *CategoriesFake) DeleteCategoriesByIDV1(ctx context.Context, req *categories.DeleteCategoriesByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteCategoriesByIDV1", ctx, req)
+	if f.DeleteCategoriesByIDV1Func == nil {
+		return nil, fakes.NotStubbed("CategoriesFake.DeleteCategoriesByIDV1")
+	}
+	return f.DeleteCategoriesByIDV1Func(ctx, req)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *CategoriesFake) GetByIDV1(ctx context.Context, id string) (*categories.ResourceCategory, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CategoriesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetCategoryHistoryV1 records the call and delegates to GetCategoryHistoryV1Func.
+func (f *CategoriesFake) GetCategoryHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*categories.CategoryHistoryResponse, *resty.Response, error) {
+	f.Record("GetCategoryHistoryV1", ctx, id, rsqlQuery)
+	if f.GetCategoryHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CategoriesFake.GetCategoryHistoryV1")
+	}
+	return f.GetCategoryHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *CategoriesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*categories.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CategoriesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *CategoriesFake) UpdateByIDV1(ctx context.Context, id string, request *categories.RequestCategory) (*categories.CreateUpdateResponse, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CategoriesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package categories
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Categories.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CategoriesFake implements it for tests.
+type Service interface {
+	AddCategoryHistoryNotesV1(ctx context.Context, id string, req *AddCategoryHistoryNotesRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestCategory) (*CreateUpdateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteCategoriesByIDV1(ctx context.Context, req *DeleteCategoriesByIDRequest) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceCategory, *resty.Response, error)
+	GetCategoryHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*CategoryHistoryResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestCategory) (*CreateUpdateResponse, *resty.Response, error)
+}
+
+var _ Service = (*Categories)(nil)
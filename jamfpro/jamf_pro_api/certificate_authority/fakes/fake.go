@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the certificate_authority
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/certificate_authority"
+	"resty.dev/v3"
+)
+
+// CertificateAuthorityFake is a certificate_authority.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CertificateAuthorityFake struct {
+	fakes.Journal
+
+	GetActiveCertificateAuthorityDERV1Func func(ctx context.Context) (*resty.Response, []byte, error)
+	GetActiveCertificateAuthorityPEMV1Func func(ctx context.Context) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDDERV1Func   func(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDPEMV1Func   func(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDV1Func      func(ctx context.Context, id string) (*certificate_authority.ResourceActiveCertificateAuthorityV1, *resty.Response, error)
+	GetV1Func                              func(ctx context.Context) (*certificate_authority.ResourceActiveCertificateAuthorityV1, *resty.Response, error)
+}
+
+var _ certificate_authority.Service = (*CertificateAuthorityFake)(nil)
+
+// GetActiveCertificateAuthorityDERV1 records the call and delegates to GetActiveCertificateAuthorityDERV1Func.
+func (f *CertificateAuthorityFake) GetActiveCertificateAuthorityDERV1(ctx context.Context) (*resty.Response, []byte, error) {
+	f.Record("GetActiveCertificateAuthorityDERV1", ctx)
+	if f.GetActiveCertificateAuthorityDERV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetActiveCertificateAuthorityDERV1")
+	}
+	return f.GetActiveCertificateAuthorityDERV1Func(ctx)
+}
+
+// GetActiveCertificateAuthorityPEMV1 records the call and delegates to GetActiveCertificateAuthorityPEMV1Func.
+func (f *CertificateAuthorityFake) GetActiveCertificateAuthorityPEMV1(ctx context.Context) (*resty.Response, []byte, error) {
+	f.Record("GetActiveCertificateAuthorityPEMV1", ctx)
+	if f.GetActiveCertificateAuthorityPEMV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetActiveCertificateAuthorityPEMV1")
+	}
+	return f.GetActiveCertificateAuthorityPEMV1Func(ctx)
+}
+
+// GetCertificateAuthorityByIDDERV1 records the call and delegates to GetCertificateAuthorityByIDDERV1Func.
+func (f *CertificateAuthorityFake) GetCertificateAuthorityByIDDERV1(ctx context.Context, id string) (*resty.Response, []byte, error) {
+	f.Record("GetCertificateAuthorityByIDDERV1", ctx, id)
+	if f.GetCertificateAuthorityByIDDERV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetCertificateAuthorityByIDDERV1")
+	}
+	return f.GetCertificateAuthorityByIDDERV1Func(ctx, id)
+}
+
+// GetCertificateAuthorityByIDPEMV1 records the call and delegates to GetCertificateAuthorityByIDPEMV1Func.
+func (f *CertificateAuthorityFake) GetCertificateAuthorityByIDPEMV1(ctx context.Context, id string) (*resty.Response, []byte, error) {
+	f.Record("GetCertificateAuthorityByIDPEMV1", ctx, id)
+	if f.GetCertificateAuthorityByIDPEMV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetCertificateAuthorityByIDPEMV1")
+	}
+	return f.GetCertificateAuthorityByIDPEMV1Func(ctx, id)
+}
+
+// GetCertificateAuthorityByIDV1 records the call and delegates to GetCertificateAuthorityByIDV1Func.
+func (f *CertificateAuthorityFake) GetCertificateAuthorityByIDV1(ctx context.Context, id string) (*certificate_authority.ResourceActiveCertificateAuthorityV1, *resty.Response, error) {
+	f.Record("GetCertificateAuthorityByIDV1", ctx, id)
+	if f.GetCertificateAuthorityByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetCertificateAuthorityByIDV1")
+	}
+	return f.GetCertificateAuthorityByIDV1Func(ctx, id)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *CertificateAuthorityFake) GetV1(ctx context.Context) (*certificate_authority.ResourceActiveCertificateAuthorityV1, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CertificateAuthorityFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
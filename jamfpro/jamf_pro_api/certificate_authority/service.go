@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package certificate_authority
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CertificateAuthority.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CertificateAuthorityFake implements it for tests.
+type Service interface {
+	GetActiveCertificateAuthorityDERV1(ctx context.Context) (*resty.Response, []byte, error)
+	GetActiveCertificateAuthorityPEMV1(ctx context.Context) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDDERV1(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDPEMV1(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetCertificateAuthorityByIDV1(ctx context.Context, id string) (*ResourceActiveCertificateAuthorityV1, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceActiveCertificateAuthorityV1, *resty.Response, error)
+}
+
+var _ Service = (*CertificateAuthority)(nil)
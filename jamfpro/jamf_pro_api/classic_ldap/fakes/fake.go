@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the classic_ldap
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/classic_ldap"
+	"resty.dev/v3"
+)
+
+// ClassicLdapFake is a classic_ldap.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ClassicLdapFake struct {
+	fakes.Journal
+
+	GetMappingsByIDV1Func func(ctx context.Context, id string) (*classic_ldap.ResourceOnPremLdapMappingsV1, *resty.Response, error)
+}
+
+var _ classic_ldap.Service = (*ClassicLdapFake)(nil)
+
+// GetMappingsByIDV1 records the call and delegates to GetMappingsByIDV1Func.
+func (f *ClassicLdapFake) GetMappingsByIDV1(ctx context.Context, id string) (*classic_ldap.ResourceOnPremLdapMappingsV1, *resty.Response, error) {
+	f.Record("GetMappingsByIDV1", ctx, id)
+	if f.GetMappingsByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ClassicLdapFake.GetMappingsByIDV1")
+	}
+	return f.GetMappingsByIDV1Func(ctx, id)
+}
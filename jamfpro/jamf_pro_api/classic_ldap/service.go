@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package classic_ldap
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ClassicLdap.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ClassicLdapFake implements it for tests.
+type Service interface {
+	GetMappingsByIDV1(ctx context.Context, id string) (*ResourceOnPremLdapMappingsV1, *resty.Response, error)
+}
+
+var _ Service = (*ClassicLdap)(nil)
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the client_checkin
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/client_checkin"
+	"resty.dev/v3"
+)
+
+// ClientCheckinFake is a client_checkin.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ClientCheckinFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV3Func func(ctx context.Context, request *client_checkin.RequestClientCheckinHistoryNote) (*client_checkin.CreateHistoryResponse, *resty.Response, error)
+	GetHistoryV3Func     func(ctx context.Context, rsqlQuery map[string]string) (*client_checkin.ResourceClientCheckinHistory, *resty.Response, error)
+	GetV3Func            func(ctx context.Context) (*client_checkin.ResourceClientCheckinSettings, *resty.Response, error)
+	UpdateV3Func         func(ctx context.Context, request *client_checkin.ResourceClientCheckinSettings) (*client_checkin.ResourceClientCheckinSettings, *resty.Response, error)
+}
+
+var _ client_checkin.Service = (*ClientCheckinFake)(nil)
+
+// AddHistoryNoteV3 records the call and delegates to AddHistoryNoteV3Func.
+func (f *ClientCheckinFake) AddHistoryNoteV3(ctx context.Context, request *client_checkin.RequestClientCheckinHistoryNote) (*client_checkin.CreateHistoryResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV3", ctx, request)
+	if f.AddHistoryNoteV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ClientCheckinFake.AddHistoryNoteV3")
+	}
+	return f.AddHistoryNoteV3Func(ctx, request)
+}
+
+// GetHistoryV3 records the call and delegates to GetHistoryV3Func.
+func (f *ClientCheckinFake) GetHistoryV3(ctx context.Context, rsqlQuery map[string]string) (*client_checkin.ResourceClientCheckinHistory, *resty.Response, error) {
+	f.Record("GetHistoryV3", ctx, rsqlQuery)
+	if f.GetHistoryV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ClientCheckinFake.GetHistoryV3")
+	}
+	return f.GetHistoryV3Func(ctx, rsqlQuery)
+}
+
+// GetV3 records the call and delegates to GetV3Func.
+func (f *ClientCheckinFake) GetV3(ctx context.Context) (*client_checkin.ResourceClientCheckinSettings, *resty.Response, error) {
+	f.Record("GetV3", ctx)
+	if f.GetV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ClientCheckinFake.GetV3")
+	}
+	return f.GetV3Func(ctx)
+}
+
+// UpdateV3 records the call and delegates to UpdateV3Func.
+func (f *ClientCheckinFake) UpdateV3(ctx context.Context, request *client_checkin.ResourceClientCheckinSettings) (*client_checkin.ResourceClientCheckinSettings, *resty.Response, error) {
+	f.Record("UpdateV3", ctx, request)
+	if f.UpdateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ClientCheckinFake.UpdateV3")
+	}
+	return f.UpdateV3Func(ctx, request)
+}
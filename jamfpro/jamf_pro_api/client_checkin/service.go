@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package client_checkin
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ClientCheckin.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ClientCheckinFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV3(ctx context.Context, request *RequestClientCheckinHistoryNote) (*CreateHistoryResponse, *resty.Response, error)
+	GetHistoryV3(ctx context.Context, rsqlQuery map[string]string) (*ResourceClientCheckinHistory, *resty.Response, error)
+	GetV3(ctx context.Context) (*ResourceClientCheckinSettings, *resty.Response, error)
+	UpdateV3(ctx context.Context, request *ResourceClientCheckinSettings) (*ResourceClientCheckinSettings, *resty.Response, error)
+}
+
+var _ Service = (*ClientCheckin)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_azure
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_azure"
+	"resty.dev/v3"
+)
+
+// CloudAzureFake is a cloud_azure.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudAzureFake struct {
+	fakes.Journal
+
+	CreateV1Func                        func(ctx context.Context, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResponseCloudAzureCreated, *resty.Response, error)
+	DeleteByIDV1Func                    func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1Func                  func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1Func                       func(ctx context.Context, id string) (*cloud_azure.ResourceCloudAzure, *resty.Response, error)
+	GetByNameV1Func                     func(ctx context.Context, name string) (*cloud_azure.ResourceCloudAzure, *resty.Response, error)
+	GetDefaultMappingsV1Func            func(ctx context.Context) (*cloud_azure.CloudAzureServerMappings, *resty.Response, error)
+	GetDefaultServerConfigurationV1Func func(ctx context.Context) (*cloud_azure.CloudAzureServer, *resty.Response, error)
+	UpdateByIDV1Func                    func(ctx context.Context, id string, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResourceCloudAzure, *resty.Response, error)
+	UpdateByNameV1Func                  func(ctx context.Context, name string, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResourceCloudAzure, *resty.Response, error)
+}
+
+var _ cloud_azure.Service = (*CloudAzureFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *CloudAzureFake) CreateV1(ctx context.Context, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResponseCloudAzureCreated, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *CloudAzureFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("CloudAzureFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteByNameV1 records the call and delegates to DeleteByNameV1Func.
+func (f *CloudAzureFake) DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV1", ctx, name)
+	if f.DeleteByNameV1Func == nil {
+		return nil, fakes.NotStubbed("CloudAzureFake.DeleteByNameV1")
+	}
+	return f.DeleteByNameV1Func(ctx, name)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *CloudAzureFake) GetByIDV1(ctx context.Context, id string) (*cloud_azure.ResourceCloudAzure, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *CloudAzureFake) GetByNameV1(ctx context.Context, name string) (*cloud_azure.ResourceCloudAzure, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// GetDefaultMappingsV1 records the call and delegates to GetDefaultMappingsV1Func.
+func (f *CloudAzureFake) GetDefaultMappingsV1(ctx context.Context) (*cloud_azure.CloudAzureServerMappings, *resty.Response, error) {
+	f.Record("GetDefaultMappingsV1", ctx)
+	if f.GetDefaultMappingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.GetDefaultMappingsV1")
+	}
+	return f.GetDefaultMappingsV1Func(ctx)
+}
+
+// GetDefaultServerConfigurationV1 records the call and delegates to GetDefaultServerConfigurationV1Func.
+func (f *CloudAzureFake) GetDefaultServerConfigurationV1(ctx context.Context) (*cloud_azure.CloudAzureServer, *resty.Response, error) {
+	f.Record("GetDefaultServerConfigurationV1", ctx)
+	if f.GetDefaultServerConfigurationV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.GetDefaultServerConfigurationV1")
+	}
+	return f.GetDefaultServerConfigurationV1Func(ctx)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *CloudAzureFake) UpdateByIDV1(ctx context.Context, id string, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResourceCloudAzure, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UpdateByNameV1 records the call and delegates to UpdateByNameV1Func.
+func (f *CloudAzureFake) UpdateByNameV1(ctx context.Context, name string, request *cloud_azure.ResourceCloudAzure) (*cloud_azure.ResourceCloudAzure, *resty.Response, error) {
+	f.Record("UpdateByNameV1", ctx, name, request)
+	if f.UpdateByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudAzureFake.UpdateByNameV1")
+	}
+	return f.UpdateByNameV1Func(ctx, name, request)
+}
@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_azure
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudAzure.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudAzureFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceCloudAzure) (*ResponseCloudAzureCreated, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceCloudAzure, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceCloudAzure, *resty.Response, error)
+	GetDefaultMappingsV1(ctx context.Context) (*CloudAzureServerMappings, *resty.Response, error)
+	GetDefaultServerConfigurationV1(ctx context.Context) (*CloudAzureServer, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceCloudAzure) (*ResourceCloudAzure, *resty.Response, error)
+	UpdateByNameV1(ctx context.Context, name string, request *ResourceCloudAzure) (*ResourceCloudAzure, *resty.Response, error)
+}
+
+var _ Service = (*CloudAzure)(nil)
@@ -0,0 +1,133 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_distribution_point
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_distribution_point"
+	"resty.dev/v3"
+)
+
+// CloudDistributionPointFake is a cloud_distribution_point.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudDistributionPointFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func      func(ctx context.Context, request *cloud_distribution_point.RequestHistoryNoteV1) (*cloud_distribution_point.HistoryItem, *resty.Response, error)
+	CreateV1Func              func(ctx context.Context, request *cloud_distribution_point.RequestCloudDistributionPointV1) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error)
+	DeleteV1Func              func(ctx context.Context) (*resty.Response, error)
+	FailUploadV1Func          func(ctx context.Context, id string, fileName string, fileType string) (*resty.Response, error)
+	GetFilesV1Func            func(ctx context.Context, rsqlQuery map[string]string) (*cloud_distribution_point.FilesResponse, *resty.Response, error)
+	GetHistoryV1Func          func(ctx context.Context, rsqlQuery map[string]string) (*cloud_distribution_point.HistoryResponse, *resty.Response, error)
+	GetTestConnectionV1Func   func(ctx context.Context) (*cloud_distribution_point.TestConnectionV1, *resty.Response, error)
+	GetUploadCapabilityV1Func func(ctx context.Context) (*cloud_distribution_point.UploadCapabilityV1, *resty.Response, error)
+	GetV1Func                 func(ctx context.Context) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error)
+	RefreshInventoryV1Func    func(ctx context.Context, fileName string) (*resty.Response, error)
+	UpdateV1Func              func(ctx context.Context, request *cloud_distribution_point.RequestCloudDistributionPointV1) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error)
+}
+
+var _ cloud_distribution_point.Service = (*CloudDistributionPointFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *CloudDistributionPointFake) AddHistoryNoteV1(ctx context.Context, request *cloud_distribution_point.RequestHistoryNoteV1) (*cloud_distribution_point.HistoryItem, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, request)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, request)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *CloudDistributionPointFake) CreateV1(ctx context.Context, request *cloud_distribution_point.RequestCloudDistributionPointV1) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteV1 records the call and delegates to DeleteV1Func.
+func (f *CloudDistributionPointFake) DeleteV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("DeleteV1", ctx)
+	if f.DeleteV1Func == nil {
+		return nil, fakes.NotStubbed("CloudDistributionPointFake.DeleteV1")
+	}
+	return f.DeleteV1Func(ctx)
+}
+
+// FailUploadV1 records the call and delegates to FailUploadV1Func.
+func (f *CloudDistributionPointFake) FailUploadV1(ctx context.Context, id string, fileName string, fileType string) (*resty.Response, error) {
+	f.Record("FailUploadV1", ctx, id, fileName, fileType)
+	if f.FailUploadV1Func == nil {
+		return nil, fakes.NotStubbed("CloudDistributionPointFake.FailUploadV1")
+	}
+	return f.FailUploadV1Func(ctx, id, fileName, fileType)
+}
+
+// GetFilesV1 records the call and delegates to GetFilesV1Func.
+func (f *CloudDistributionPointFake) GetFilesV1(ctx context.Context, rsqlQuery map[string]string) (*cloud_distribution_point.FilesResponse, *resty.Response, error) {
+	f.Record("GetFilesV1", ctx, rsqlQuery)
+	if f.GetFilesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.GetFilesV1")
+	}
+	return f.GetFilesV1Func(ctx, rsqlQuery)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *CloudDistributionPointFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*cloud_distribution_point.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetTestConnectionV1 records the call and delegates to GetTestConnectionV1Func.
+func (f *CloudDistributionPointFake) GetTestConnectionV1(ctx context.Context) (*cloud_distribution_point.TestConnectionV1, *resty.Response, error) {
+	f.Record("GetTestConnectionV1", ctx)
+	if f.GetTestConnectionV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.GetTestConnectionV1")
+	}
+	return f.GetTestConnectionV1Func(ctx)
+}
+
+// GetUploadCapabilityV1 records the call and delegates to GetUploadCapabilityV1Func.
+func (f *CloudDistributionPointFake) GetUploadCapabilityV1(ctx context.Context) (*cloud_distribution_point.UploadCapabilityV1, *resty.Response, error) {
+	f.Record("GetUploadCapabilityV1", ctx)
+	if f.GetUploadCapabilityV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.GetUploadCapabilityV1")
+	}
+	return f.GetUploadCapabilityV1Func(ctx)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *CloudDistributionPointFake) GetV1(ctx context.Context) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// RefreshInventoryV1 records the call and delegates to RefreshInventoryV1Func.
+func (f *CloudDistributionPointFake) RefreshInventoryV1(ctx context.Context, fileName string) (*resty.Response, error) {
+	f.Record("RefreshInventoryV1", ctx, fileName)
+	if f.RefreshInventoryV1Func == nil {
+		return nil, fakes.NotStubbed("CloudDistributionPointFake.RefreshInventoryV1")
+	}
+	return f.RefreshInventoryV1Func(ctx, fileName)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *CloudDistributionPointFake) UpdateV1(ctx context.Context, request *cloud_distribution_point.RequestCloudDistributionPointV1) (*cloud_distribution_point.ResourceCloudDistributionPointV1, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudDistributionPointFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
@@ -0,0 +1,28 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_distribution_point
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudDistributionPoint.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudDistributionPointFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, request *RequestHistoryNoteV1) (*HistoryItem, *resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestCloudDistributionPointV1) (*ResourceCloudDistributionPointV1, *resty.Response, error)
+	DeleteV1(ctx context.Context) (*resty.Response, error)
+	FailUploadV1(ctx context.Context, id string, fileName string, fileType string) (*resty.Response, error)
+	GetFilesV1(ctx context.Context, rsqlQuery map[string]string) (*FilesResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetTestConnectionV1(ctx context.Context) (*TestConnectionV1, *resty.Response, error)
+	GetUploadCapabilityV1(ctx context.Context) (*UploadCapabilityV1, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceCloudDistributionPointV1, *resty.Response, error)
+	RefreshInventoryV1(ctx context.Context, fileName string) (*resty.Response, error)
+	UpdateV1(ctx context.Context, request *RequestCloudDistributionPointV1) (*ResourceCloudDistributionPointV1, *resty.Response, error)
+}
+
+var _ Service = (*CloudDistributionPoint)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_idp
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_idp"
+	"resty.dev/v3"
+)
+
+// CloudIdpFake is a cloud_idp.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudIdpFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV1Func     func(ctx context.Context, id string, request *cloud_idp.HistoryNoteRequest) (*resty.Response, error)
+	ExportV1Func                 func(ctx context.Context, query map[string]string, request *cloud_idp.ExportRequest, accept ...string) (*resty.Response, []byte, error)
+	GetByIDV1Func                func(ctx context.Context, id string) (*cloud_idp.ResourceCloudIdProviderDetails, *resty.Response, error)
+	GetByNameV1Func              func(ctx context.Context, name string) (*cloud_idp.ResourceCloudIdProviderDetails, *resty.Response, error)
+	GetHistoryByIDV1Func         func(ctx context.Context, id string, query map[string]string) (*cloud_idp.HistoryResponse, *resty.Response, error)
+	ListV1Func                   func(ctx context.Context, query map[string]string) (*cloud_idp.ListResponse, *resty.Response, error)
+	TestGroupSearchByIDV1Func    func(ctx context.Context, id string, request *cloud_idp.TestGroupSearchRequest) (*cloud_idp.TestGroupSearchResponse, *resty.Response, error)
+	TestUserMembershipByIDV1Func func(ctx context.Context, id string, request *cloud_idp.TestUserMembershipRequest) (*cloud_idp.TestUserMembershipResponse, *resty.Response, error)
+	TestUserSearchByIDV1Func     func(ctx context.Context, id string, request *cloud_idp.TestUserSearchRequest) (*cloud_idp.TestUserSearchResponse, *resty.Response, error)
+}
+
+var _ cloud_idp.Service = (*CloudIdpFake)(nil)
+
+// AddHistoryNoteByIDV1 records the call and delegates to AddHistoryNoteByIDV1Func.
+func (f *CloudIdpFake) AddHistoryNoteByIDV1(ctx context.Context, id string, request *cloud_idp.HistoryNoteRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV1", ctx, id, request)
+	if f.AddHistoryNoteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("CloudIdpFake.AddHistoryNoteByIDV1")
+	}
+	return f.AddHistoryNoteByIDV1Func(ctx, id, request)
+}
+
+// ExportV1 records the call and delegates to ExportV1Func.
+func (f *CloudIdpFake) ExportV1(ctx context.Context, query map[string]string, request *cloud_idp.ExportRequest, accept ...string) (*resty.Response, []byte, error) {
+	f.Record("ExportV1", ctx, query, request, accept)
+	if f.ExportV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.ExportV1")
+	}
+	return f.ExportV1Func(ctx, query, request, accept...)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *CloudIdpFake) GetByIDV1(ctx context.Context, id string) (*cloud_idp.ResourceCloudIdProviderDetails, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *CloudIdpFake) GetByNameV1(ctx context.Context, name string) (*cloud_idp.ResourceCloudIdProviderDetails, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// GetHistoryByIDV1 records the call and delegates to GetHistoryByIDV1Func.
+func (f *CloudIdpFake) GetHistoryByIDV1(ctx context.Context, id string, query map[string]string) (*cloud_idp.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV1", ctx, id, query)
+	if f.GetHistoryByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.GetHistoryByIDV1")
+	}
+	return f.GetHistoryByIDV1Func(ctx, id, query)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *CloudIdpFake) ListV1(ctx context.Context, query map[string]string) (*cloud_idp.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, query)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.ListV1")
+	}
+	return f.ListV1Func(ctx, query)
+}
+
+// TestGroupSearchByIDV1 records the call and delegates to TestGroupSearchByIDV1Func.
+func (f *CloudIdpFake) TestGroupSearchByIDV1(ctx context.Context, id string, request *cloud_idp.TestGroupSearchRequest) (*cloud_idp.TestGroupSearchResponse, *resty.Response, error) {
+	f.Record("TestGroupSearchByIDV1", ctx, id, request)
+	if f.TestGroupSearchByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.TestGroupSearchByIDV1")
+	}
+	return f.TestGroupSearchByIDV1Func(ctx, id, request)
+}
+
+// TestUserMembershipByIDV1 records the call and delegates to TestUserMembershipByIDV1Func.
+func (f *CloudIdpFake) TestUserMembershipByIDV1(ctx context.Context, id string, request *cloud_idp.TestUserMembershipRequest) (*cloud_idp.TestUserMembershipResponse, *resty.Response, error) {
+	f.Record("TestUserMembershipByIDV1", ctx, id, request)
+	if f.TestUserMembershipByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.TestUserMembershipByIDV1")
+	}
+	return f.TestUserMembershipByIDV1Func(ctx, id, request)
+}
+
+// TestUserSearchByIDV1 records the call and delegates to TestUserSearchByIDV1Func.
+func (f *CloudIdpFake) TestUserSearchByIDV1(ctx context.Context, id string, request *cloud_idp.TestUserSearchRequest) (*cloud_idp.TestUserSearchResponse, *resty.Response, error) {
+	f.Record("TestUserSearchByIDV1", ctx, id, request)
+	if f.TestUserSearchByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudIdpFake.TestUserSearchByIDV1")
+	}
+	return f.TestUserSearchByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_idp
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudIdp.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudIdpFake implements it for tests.
+type Service interface {
+	AddHistoryNoteByIDV1(ctx context.Context, id string, request *HistoryNoteRequest) (*resty.Response, error)
+	ExportV1(ctx context.Context, query map[string]string, request *ExportRequest, accept ...string) (*resty.Response, []byte, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceCloudIdProviderDetails, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceCloudIdProviderDetails, *resty.Response, error)
+	GetHistoryByIDV1(ctx context.Context, id string, query map[string]string) (*HistoryResponse, *resty.Response, error)
+	ListV1(ctx context.Context, query map[string]string) (*ListResponse, *resty.Response, error)
+	TestGroupSearchByIDV1(ctx context.Context, id string, request *TestGroupSearchRequest) (*TestGroupSearchResponse, *resty.Response, error)
+	TestUserMembershipByIDV1(ctx context.Context, id string, request *TestUserMembershipRequest) (*TestUserMembershipResponse, *resty.Response, error)
+	TestUserSearchByIDV1(ctx context.Context, id string, request *TestUserSearchRequest) (*TestUserSearchResponse, *resty.Response, error)
+}
+
+var _ Service = (*CloudIdp)(nil)
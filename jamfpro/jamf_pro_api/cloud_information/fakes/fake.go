@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_information
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_information"
+	"resty.dev/v3"
+)
+
+// CloudInformationFake is a cloud_information.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudInformationFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*cloud_information.ResourceCloudInformation, *resty.Response, error)
+}
+
+var _ cloud_information.Service = (*CloudInformationFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *CloudInformationFake) GetV1(ctx context.Context) (*cloud_information.ResourceCloudInformation, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudInformationFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_information
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudInformation.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudInformationFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceCloudInformation, *resty.Response, error)
+}
+
+var _ Service = (*CloudInformation)(nil)
@@ -0,0 +1,133 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_ldap
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_ldap"
+	"resty.dev/v3"
+)
+
+// CloudLdapFake is a cloud_ldap.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudLdapFake struct {
+	fakes.Journal
+
+	CreateV2Func                           func(ctx context.Context, request *cloud_ldap.ResourceCloudLdap) (*cloud_ldap.ResponseCloudLdapCreated, *resty.Response, error)
+	DeleteByIDV2Func                       func(ctx context.Context, id string) (*resty.Response, error)
+	GetBindConnectionPoolStatsByIDV2Func   func(ctx context.Context, id string) (*cloud_ldap.ConnectionPoolStats, *resty.Response, error)
+	GetByIDV2Func                          func(ctx context.Context, id string) (*cloud_ldap.ResourceCloudLdap, *resty.Response, error)
+	GetDefaultMappingsV2Func               func(ctx context.Context, providerName string) (*cloud_ldap.ResponseDefaultMappings, *resty.Response, error)
+	GetDefaultServerConfigurationV2Func    func(ctx context.Context, providerName string) (*cloud_ldap.ResponseDefaultServerConfiguration, *resty.Response, error)
+	GetMappingsByIDV2Func                  func(ctx context.Context, id string) (*cloud_ldap.CloudLdapMappings, *resty.Response, error)
+	GetSearchConnectionPoolStatsByIDV2Func func(ctx context.Context, id string) (*cloud_ldap.ConnectionPoolStats, *resty.Response, error)
+	TestConnectionByIDV2Func               func(ctx context.Context, id string) (*cloud_ldap.ConnectionStatusResponse, *resty.Response, error)
+	UpdateByIDV2Func                       func(ctx context.Context, id string, request *cloud_ldap.ResourceCloudLdap) (*cloud_ldap.ResourceCloudLdap, *resty.Response, error)
+	UpdateMappingsByIDV2Func               func(ctx context.Context, id string, request *cloud_ldap.CloudLdapMappings) (*cloud_ldap.CloudLdapMappings, *resty.Response, error)
+}
+
+var _ cloud_ldap.Service = (*CloudLdapFake)(nil)
+
+// CreateV2 records the call and delegates to CreateV2Func.
+func (f *CloudLdapFake) CreateV2(ctx context.Context, request *cloud_ldap.ResourceCloudLdap) (*cloud_ldap.ResponseCloudLdapCreated, *resty.Response, error) {
+	f.Record("CreateV2", ctx, request)
+	if f.CreateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.CreateV2")
+	}
+	return f.CreateV2Func(ctx, request)
+}
+
+// DeleteByIDV2 records the call and delegates to DeleteByIDV2Func.
+func (f *CloudLdapFake) DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV2", ctx, id)
+	if f.DeleteByIDV2Func == nil {
+		return nil, fakes.NotStubbed("CloudLdapFake.DeleteByIDV2")
+	}
+	return f.DeleteByIDV2Func(ctx, id)
+}
+
+// GetBindConnectionPoolStatsByIDV2 records the call and delegates to GetBindConnectionPoolStatsByIDV2Func.
+func (f *CloudLdapFake) GetBindConnectionPoolStatsByIDV2(ctx context.Context, id string) (*cloud_ldap.ConnectionPoolStats, *resty.Response, error) {
+	f.Record("GetBindConnectionPoolStatsByIDV2", ctx, id)
+	if f.GetBindConnectionPoolStatsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetBindConnectionPoolStatsByIDV2")
+	}
+	return f.GetBindConnectionPoolStatsByIDV2Func(ctx, id)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *CloudLdapFake) GetByIDV2(ctx context.Context, id string) (*cloud_ldap.ResourceCloudLdap, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetDefaultMappingsV2 records the call and delegates to GetDefaultMappingsV2Func.
+func (f *CloudLdapFake) GetDefaultMappingsV2(ctx context.Context, providerName string) (*cloud_ldap.ResponseDefaultMappings, *resty.Response, error) {
+	f.Record("GetDefaultMappingsV2", ctx, providerName)
+	if f.GetDefaultMappingsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetDefaultMappingsV2")
+	}
+	return f.GetDefaultMappingsV2Func(ctx, providerName)
+}
+
+// GetDefaultServerConfigurationV2 records the call and delegates to GetDefaultServerConfigurationV2Func.
+func (f *CloudLdapFake) GetDefaultServerConfigurationV2(ctx context.Context, providerName string) (*cloud_ldap.ResponseDefaultServerConfiguration, *resty.Response, error) {
+	f.Record("GetDefaultServerConfigurationV2", ctx, providerName)
+	if f.GetDefaultServerConfigurationV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetDefaultServerConfigurationV2")
+	}
+	return f.GetDefaultServerConfigurationV2Func(ctx, providerName)
+}
+
+// GetMappingsByIDV2 records the call and delegates to GetMappingsByIDV2Func.
+func (f *CloudLdapFake) GetMappingsByIDV2(ctx context.Context, id string) (*cloud_ldap.CloudLdapMappings, *resty.Response, error) {
+	f.Record("GetMappingsByIDV2", ctx, id)
+	if f.GetMappingsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetMappingsByIDV2")
+	}
+	return f.GetMappingsByIDV2Func(ctx, id)
+}
+
+// GetSearchConnectionPoolStatsByIDV2 records the call and delegates to GetSearchConnectionPoolStatsByIDV2Func.
+func (f *CloudLdapFake) GetSearchConnectionPoolStatsByIDV2(ctx context.Context, id string) (*cloud_ldap.ConnectionPoolStats, *resty.Response, error) {
+	f.Record("GetSearchConnectionPoolStatsByIDV2", ctx, id)
+	if f.GetSearchConnectionPoolStatsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.GetSearchConnectionPoolStatsByIDV2")
+	}
+	return f.GetSearchConnectionPoolStatsByIDV2Func(ctx, id)
+}
+
+// TestConnectionByIDV2 records the call and delegates to TestConnectionByIDV2Func.
+func (f *CloudLdapFake) TestConnectionByIDV2(ctx context.Context, id string) (*cloud_ldap.ConnectionStatusResponse, *resty.Response, error) {
+	f.Record("TestConnectionByIDV2", ctx, id)
+	if f.TestConnectionByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.TestConnectionByIDV2")
+	}
+	return f.TestConnectionByIDV2Func(ctx, id)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *CloudLdapFake) UpdateByIDV2(ctx context.Context, id string, request *cloud_ldap.ResourceCloudLdap) (*cloud_ldap.ResourceCloudLdap, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, request)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, request)
+}
+
+// UpdateMappingsByIDV2 records the call and delegates to UpdateMappingsByIDV2Func.
+func (f *CloudLdapFake) UpdateMappingsByIDV2(ctx context.Context, id string, request *cloud_ldap.CloudLdapMappings) (*cloud_ldap.CloudLdapMappings, *resty.Response, error) {
+	f.Record("UpdateMappingsByIDV2", ctx, id, request)
+	if f.UpdateMappingsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapFake.UpdateMappingsByIDV2")
+	}
+	return f.UpdateMappingsByIDV2Func(ctx, id, request)
+}
@@ -0,0 +1,28 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_ldap
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudLdap.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudLdapFake implements it for tests.
+type Service interface {
+	CreateV2(ctx context.Context, request *ResourceCloudLdap) (*ResponseCloudLdapCreated, *resty.Response, error)
+	DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	GetBindConnectionPoolStatsByIDV2(ctx context.Context, id string) (*ConnectionPoolStats, *resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourceCloudLdap, *resty.Response, error)
+	GetDefaultMappingsV2(ctx context.Context, providerName string) (*ResponseDefaultMappings, *resty.Response, error)
+	GetDefaultServerConfigurationV2(ctx context.Context, providerName string) (*ResponseDefaultServerConfiguration, *resty.Response, error)
+	GetMappingsByIDV2(ctx context.Context, id string) (*CloudLdapMappings, *resty.Response, error)
+	GetSearchConnectionPoolStatsByIDV2(ctx context.Context, id string) (*ConnectionPoolStats, *resty.Response, error)
+	TestConnectionByIDV2(ctx context.Context, id string) (*ConnectionStatusResponse, *resty.Response, error)
+	UpdateByIDV2(ctx context.Context, id string, request *ResourceCloudLdap) (*ResourceCloudLdap, *resty.Response, error)
+	UpdateMappingsByIDV2(ctx context.Context, id string, request *CloudLdapMappings) (*CloudLdapMappings, *resty.Response, error)
+}
+
+var _ Service = (*CloudLdap)(nil)
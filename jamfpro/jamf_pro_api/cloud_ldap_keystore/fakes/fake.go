@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the cloud_ldap_keystore
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_ldap_keystore"
+	"resty.dev/v3"
+)
+
+// CloudLdapKeystoreFake is a cloud_ldap_keystore.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CloudLdapKeystoreFake struct {
+	fakes.Journal
+
+	ValidateV1Func func(ctx context.Context, request *cloud_ldap_keystore.ValidateKeystoreRequest) (*cloud_ldap_keystore.ResponseValidateKeystore, *resty.Response, error)
+}
+
+var _ cloud_ldap_keystore.Service = (*CloudLdapKeystoreFake)(nil)
+
+// ValidateV1 records the call and delegates to ValidateV1Func.
+func (f *CloudLdapKeystoreFake) ValidateV1(ctx context.Context, request *cloud_ldap_keystore.ValidateKeystoreRequest) (*cloud_ldap_keystore.ResponseValidateKeystore, *resty.Response, error) {
+	f.Record("ValidateV1", ctx, request)
+	if f.ValidateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CloudLdapKeystoreFake.ValidateV1")
+	}
+	return f.ValidateV1Func(ctx, request)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package cloud_ldap_keystore
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of CloudLdapKeystore.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CloudLdapKeystoreFake implements it for tests.
+type Service interface {
+	ValidateV1(ctx context.Context, request *ValidateKeystoreRequest) (*ResponseValidateKeystore, *resty.Response, error)
+}
+
+var _ Service = (*CloudLdapKeystore)(nil)
@@ -0,0 +1,154 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_extension_attributes
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_extension_attributes"
+	"resty.dev/v3"
+)
+
+// ComputerExtensionAttributesFake is a computer_extension_attributes.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerExtensionAttributesFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV1Func                    func(ctx context.Context, id string, req *computer_extension_attributes.AddHistoryNoteRequest) (*resty.Response, error)
+	CreateV1Func                                func(ctx context.Context, request *computer_extension_attributes.RequestComputerExtensionAttribute) (*computer_extension_attributes.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func                            func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteComputerExtensionAttributesByIDV1Func func(ctx context.Context, req *computer_extension_attributes.DeleteComputerExtensionAttributesByIDRequest) (*resty.Response, error)
+	DownloadByIDV1Func                          func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetByIDV1Func                               func(ctx context.Context, id string) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error)
+	GetDataDependencyByIDV1Func                 func(ctx context.Context, id string) (*computer_extension_attributes.DataDependencyResponse, *resty.Response, error)
+	GetHistoryByIDV1Func                        func(ctx context.Context, id string, rsqlQuery map[string]string) (*computer_extension_attributes.HistoryResponse, *resty.Response, error)
+	GetTemplateByIDV1Func                       func(ctx context.Context, id string) (*computer_extension_attributes.ResourceComputerExtensionAttributeTemplate, *resty.Response, error)
+	ListTemplatesV1Func                         func(ctx context.Context, rsqlQuery map[string]string) (*computer_extension_attributes.TemplateListResponse, *resty.Response, error)
+	ListV1Func                                  func(ctx context.Context, rsqlQuery map[string]string) (*computer_extension_attributes.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func                            func(ctx context.Context, id string, request *computer_extension_attributes.RequestComputerExtensionAttribute) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error)
+	UploadV1Func                                func(ctx context.Context, fileReader io.Reader, fileSize int64, filename string) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error)
+}
+
+var _ computer_extension_attributes.Service = (*ComputerExtensionAttributesFake)(nil)
+
+// AddHistoryNoteByIDV1 records the call and delegates to AddHistoryNoteByIDV1Func.
+func (f *ComputerExtensionAttributesFake) AddHistoryNoteByIDV1(ctx context.Context, id string, req *computer_extension_attributes.AddHistoryNoteRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV1", ctx, id, req)
+	if f.AddHistoryNoteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ComputerExtensionAttributesFake.AddHistoryNoteByIDV1")
+	}
+	return f.AddHistoryNoteByIDV1Func(ctx, id, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *ComputerExtensionAttributesFake) CreateV1(ctx context.Context, request *computer_extension_attributes.RequestComputerExtensionAttribute) (*computer_extension_attributes.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *ComputerExtensionAttributesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ComputerExtensionAttributesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteComputerExtensionAttributesByIDV1 records the call and delegates to DeleteComputerExtensionAttributesByIDV1Func.
+func (f *ComputerExtensionAttributesFake) DeleteComputerExtensionAttributesByIDV1(ctx context.Context, req *computer_extension_attributes.DeleteComputerExtensionAttributesByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteComputerExtensionAttributesByIDV1", ctx, req)
+	if f.DeleteComputerExtensionAttributesByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ComputerExtensionAttributesFake.DeleteComputerExtensionAttributesByIDV1")
+	}
+	return f.DeleteComputerExtensionAttributesByIDV1Func(ctx, req)
+}
+
+// DownloadByIDV1 records the call and delegates to DownloadByIDV1Func.
+func (f *ComputerExtensionAttributesFake) DownloadByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("DownloadByIDV1", ctx, id)
+	if f.DownloadByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.DownloadByIDV1")
+	}
+	return f.DownloadByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *ComputerExtensionAttributesFake) GetByIDV1(ctx context.Context, id string) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetDataDependencyByIDV1 records the call and delegates to GetDataDependencyByIDV1Func.
+func (f *ComputerExtensionAttributesFake) GetDataDependencyByIDV1(ctx context.Context, id string) (*computer_extension_attributes.DataDependencyResponse, *resty.Response, error) {
+	f.Record("GetDataDependencyByIDV1", ctx, id)
+	if f.GetDataDependencyByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.GetDataDependencyByIDV1")
+	}
+	return f.GetDataDependencyByIDV1Func(ctx, id)
+}
+
+// GetHistoryByIDV1 records the call and delegates to GetHistoryByIDV1Func.
+func (f *ComputerExtensionAttributesFake) GetHistoryByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*computer_extension_attributes.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV1", ctx, id, rsqlQuery)
+	if f.GetHistoryByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.GetHistoryByIDV1")
+	}
+	return f.GetHistoryByIDV1Func(ctx, id, rsqlQuery)
+}
+
+// GetTemplateByIDV1 records the call and delegates to GetTemplateByIDV1Func.
+func (f *ComputerExtensionAttributesFake) GetTemplateByIDV1(ctx context.Context, id string) (*computer_extension_attributes.ResourceComputerExtensionAttributeTemplate, *resty.Response, error) {
+	f.Record("GetTemplateByIDV1", ctx, id)
+	if f.GetTemplateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.GetTemplateByIDV1")
+	}
+	return f.GetTemplateByIDV1Func(ctx, id)
+}
+
+// ListTemplatesV1 records the call and delegates to ListTemplatesV1Func.
+func (f *ComputerExtensionAttributesFake) ListTemplatesV1(ctx context.Context, rsqlQuery map[string]string) (*computer_extension_attributes.TemplateListResponse, *resty.Response, error) {
+	f.Record("ListTemplatesV1", ctx, rsqlQuery)
+	if f.ListTemplatesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.ListTemplatesV1")
+	}
+	return f.ListTemplatesV1Func(ctx, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ComputerExtensionAttributesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*computer_extension_attributes.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *ComputerExtensionAttributesFake) UpdateByIDV1(ctx context.Context, id string, request *computer_extension_attributes.RequestComputerExtensionAttribute) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UploadV1 records the call and delegates to UploadV1Func.
+func (f *ComputerExtensionAttributesFake) UploadV1(ctx context.Context, fileReader io.Reader, fileSize int64, filename string) (*computer_extension_attributes.ResourceComputerExtensionAttribute, *resty.Response, error) {
+	f.Record("UploadV1", ctx, fileReader, fileSize, filename)
+	if f.UploadV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerExtensionAttributesFake.UploadV1")
+	}
+	return f.UploadV1Func(ctx, fileReader, fileSize, filename)
+}
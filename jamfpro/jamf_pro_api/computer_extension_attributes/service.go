@@ -0,0 +1,31 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_extension_attributes
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerExtensionAttributes.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ComputerExtensionAttributesFake implements it for tests.
+type Service interface {
+	AddHistoryNoteByIDV1(ctx context.Context, id string, req *AddHistoryNoteRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestComputerExtensionAttribute) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteComputerExtensionAttributesByIDV1(ctx context.Context, req *DeleteComputerExtensionAttributesByIDRequest) (*resty.Response, error)
+	DownloadByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceComputerExtensionAttribute, *resty.Response, error)
+	GetDataDependencyByIDV1(ctx context.Context, id string) (*DataDependencyResponse, *resty.Response, error)
+	GetHistoryByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetTemplateByIDV1(ctx context.Context, id string) (*ResourceComputerExtensionAttributeTemplate, *resty.Response, error)
+	ListTemplatesV1(ctx context.Context, rsqlQuery map[string]string) (*TemplateListResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestComputerExtensionAttribute) (*ResourceComputerExtensionAttribute, *resty.Response, error)
+	UploadV1(ctx context.Context, fileReader io.Reader, fileSize int64, filename string) (*ResourceComputerExtensionAttribute, *resty.Response, error)
+}
+
+var _ Service = (*ComputerExtensionAttributes)(nil)
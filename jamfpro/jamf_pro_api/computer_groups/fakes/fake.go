@@ -0,0 +1,253 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_groups"
+	"resty.dev/v3"
+)
+
+// ComputerGroupsFake is a computer_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerGroupsFake struct {
+	fakes.Journal
+
+	CreateSmartV2Func                 func(ctx context.Context, request *computer_groups.RequestSmartGroup) (*computer_groups.CreateSmartResponse, *resty.Response, error)
+	CreateSmartV3Func                 func(ctx context.Context, request *computer_groups.RequestSmartGroupV3) (*computer_groups.CreateSmartResponse, *resty.Response, error)
+	CreateStaticV2Func                func(ctx context.Context, request *computer_groups.RequestStaticGroup) (*computer_groups.CreateStaticResponse, *resty.Response, error)
+	CreateStaticV3Func                func(ctx context.Context, request *computer_groups.RequestStaticGroupV3) (*computer_groups.CreateStaticResponse, *resty.Response, error)
+	DeleteSmartByIDV3Func             func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteSmartV2Func                 func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV2Func            func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV3Func            func(ctx context.Context, id string) (*resty.Response, error)
+	GetSmartByIDV2Func                func(ctx context.Context, id string) (*computer_groups.ResourceSmartGroup, *resty.Response, error)
+	GetSmartByIDV3Func                func(ctx context.Context, id string) (*computer_groups.ResourceSmartGroupV3, *resty.Response, error)
+	GetSmartGroupMembershipByIDV2Func func(ctx context.Context, id string) (*computer_groups.SmartGroupMembershipResponse, *resty.Response, error)
+	GetSmartGroupMembershipByIDV3Func func(ctx context.Context, id string) (*computer_groups.SmartGroupMembershipResponse, *resty.Response, error)
+	GetStaticByIDV2Func               func(ctx context.Context, id string) (*computer_groups.ResourceStaticGroup, *resty.Response, error)
+	GetStaticByIDV3Func               func(ctx context.Context, id string) (*computer_groups.ResourceStaticGroupV3, *resty.Response, error)
+	ListAllV1Func                     func(ctx context.Context) ([]computer_groups.ResourceGroupV1, *resty.Response, error)
+	ListSmartV2Func                   func(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListSmartResponse, *resty.Response, error)
+	ListSmartV3Func                   func(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListSmartV3Response, *resty.Response, error)
+	ListStaticV2Func                  func(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListStaticResponse, *resty.Response, error)
+	ListStaticV3Func                  func(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListStaticV3Response, *resty.Response, error)
+	UpdateSmartByIDV3Func             func(ctx context.Context, id string, request *computer_groups.RequestSmartGroupV3) (*computer_groups.ResourceSmartGroupV3, *resty.Response, error)
+	UpdateSmartV2Func                 func(ctx context.Context, id string, request *computer_groups.RequestSmartGroup) (*computer_groups.ResourceSmartGroup, *resty.Response, error)
+	UpdateStaticByIDV2Func            func(ctx context.Context, id string, request *computer_groups.RequestStaticGroup) (*computer_groups.ResourceStaticGroup, *resty.Response, error)
+	UpdateStaticByIDV3Func            func(ctx context.Context, id string, request *computer_groups.RequestStaticGroupV3) (*computer_groups.ResourceStaticGroupV3, *resty.Response, error)
+}
+
+var _ computer_groups.Service = (*ComputerGroupsFake)(nil)
+
+// CreateSmartV2 records the call and delegates to CreateSmartV2Func.
+func (f *ComputerGroupsFake) CreateSmartV2(ctx context.Context, request *computer_groups.RequestSmartGroup) (*computer_groups.CreateSmartResponse, *resty.Response, error) {
+	f.Record("CreateSmartV2", ctx, request)
+	if f.CreateSmartV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.CreateSmartV2")
+	}
+	return f.CreateSmartV2Func(ctx, request)
+}
+
+// CreateSmartV3 records the call and delegates to CreateSmartV3Func.
+func (f *ComputerGroupsFake) CreateSmartV3(ctx context.Context, request *computer_groups.RequestSmartGroupV3) (*computer_groups.CreateSmartResponse, *resty.Response, error) {
+	f.Record("CreateSmartV3", ctx, request)
+	if f.CreateSmartV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.CreateSmartV3")
+	}
+	return f.CreateSmartV3Func(ctx, request)
+}
+
+// CreateStaticV2 records the call and delegates to CreateStaticV2Func.
+func (f *ComputerGroupsFake) CreateStaticV2(ctx context.Context, request *computer_groups.RequestStaticGroup) (*computer_groups.CreateStaticResponse, *resty.Response, error) {
+	f.Record("CreateStaticV2", ctx, request)
+	if f.CreateStaticV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.CreateStaticV2")
+	}
+	return f.CreateStaticV2Func(ctx, request)
+}
+
+// CreateStaticV3 records the call and delegates to CreateStaticV3Func.
+func (f *ComputerGroupsFake) CreateStaticV3(ctx context.Context, request *computer_groups.RequestStaticGroupV3) (*computer_groups.CreateStaticResponse, *resty.Response, error) {
+	f.Record("CreateStaticV3", ctx, request)
+	if f.CreateStaticV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.CreateStaticV3")
+	}
+	return f.CreateStaticV3Func(ctx, request)
+}
+
+// DeleteSmartByIDV3 records the call and delegates to DeleteSmartByIDV3Func.
+func (f *ComputerGroupsFake) DeleteSmartByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteSmartByIDV3", ctx, id)
+	if f.DeleteSmartByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerGroupsFake.DeleteSmartByIDV3")
+	}
+	return f.DeleteSmartByIDV3Func(ctx, id)
+}
+
+// DeleteSmartV2 records the call and delegates to DeleteSmartV2Func.
+func (f *ComputerGroupsFake) DeleteSmartV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteSmartV2", ctx, id)
+	if f.DeleteSmartV2Func == nil {
+		return nil, fakes.NotStubbed("ComputerGroupsFake.DeleteSmartV2")
+	}
+	return f.DeleteSmartV2Func(ctx, id)
+}
+
+// DeleteStaticByIDV2 records the call and delegates to DeleteStaticByIDV2Func.
+func (f *ComputerGroupsFake) DeleteStaticByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteStaticByIDV2", ctx, id)
+	if f.DeleteStaticByIDV2Func == nil {
+		return nil, fakes.NotStubbed("ComputerGroupsFake.DeleteStaticByIDV2")
+	}
+	return f.DeleteStaticByIDV2Func(ctx, id)
+}
+
+// DeleteStaticByIDV3 records the call and delegates to DeleteStaticByIDV3Func.
+func (f *ComputerGroupsFake) DeleteStaticByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteStaticByIDV3", ctx, id)
+	if f.DeleteStaticByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerGroupsFake.DeleteStaticByIDV3")
+	}
+	return f.DeleteStaticByIDV3Func(ctx, id)
+}
+
+// GetSmartByIDV2 records the call and delegates to GetSmartByIDV2Func.
+func (f *ComputerGroupsFake) GetSmartByIDV2(ctx context.Context, id string) (*computer_groups.ResourceSmartGroup, *resty.Response, error) {
+	f.Record("GetSmartByIDV2", ctx, id)
+	if f.GetSmartByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetSmartByIDV2")
+	}
+	return f.GetSmartByIDV2Func(ctx, id)
+}
+
+// GetSmartByIDV3 records the call and delegates to GetSmartByIDV3Func.
+func (f *ComputerGroupsFake) GetSmartByIDV3(ctx context.Context, id string) (*computer_groups.ResourceSmartGroupV3, *resty.Response, error) {
+	f.Record("GetSmartByIDV3", ctx, id)
+	if f.GetSmartByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetSmartByIDV3")
+	}
+	return f.GetSmartByIDV3Func(ctx, id)
+}
+
+// GetSmartGroupMembershipByIDV2 records the call and delegates to GetSmartGroupMembershipByIDV2Func.
+func (f *ComputerGroupsFake) GetSmartGroupMembershipByIDV2(ctx context.Context, id string) (*computer_groups.SmartGroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetSmartGroupMembershipByIDV2", ctx, id)
+	if f.GetSmartGroupMembershipByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetSmartGroupMembershipByIDV2")
+	}
+	return f.GetSmartGroupMembershipByIDV2Func(ctx, id)
+}
+
+// GetSmartGroupMembershipByIDV3 records the call and delegates to GetSmartGroupMembershipByIDV3Func.
+func (f *ComputerGroupsFake) GetSmartGroupMembershipByIDV3(ctx context.Context, id string) (*computer_groups.SmartGroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetSmartGroupMembershipByIDV3", ctx, id)
+	if f.GetSmartGroupMembershipByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetSmartGroupMembershipByIDV3")
+	}
+	return f.GetSmartGroupMembershipByIDV3Func(ctx, id)
+}
+
+// GetStaticByIDV2 records the call and delegates to GetStaticByIDV2Func.
+func (f *ComputerGroupsFake) GetStaticByIDV2(ctx context.Context, id string) (*computer_groups.ResourceStaticGroup, *resty.Response, error) {
+	f.Record("GetStaticByIDV2", ctx, id)
+	if f.GetStaticByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetStaticByIDV2")
+	}
+	return f.GetStaticByIDV2Func(ctx, id)
+}
+
+// GetStaticByIDV3 records the call and delegates to GetStaticByIDV3Func.
+func (f *ComputerGroupsFake) GetStaticByIDV3(ctx context.Context, id string) (*computer_groups.ResourceStaticGroupV3, *resty.Response, error) {
+	f.Record("GetStaticByIDV3", ctx, id)
+	if f.GetStaticByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.GetStaticByIDV3")
+	}
+	return f.GetStaticByIDV3Func(ctx, id)
+}
+
+// ListAllV1 records the call and delegates to ListAllV1Func.
+func (f *ComputerGroupsFake) ListAllV1(ctx context.Context) ([]computer_groups.ResourceGroupV1, *resty.Response, error) {
+	f.Record("ListAllV1", ctx)
+	if f.ListAllV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.ListAllV1")
+	}
+	return f.ListAllV1Func(ctx)
+}
+
+// ListSmartV2 records the call and delegates to ListSmartV2Func.
+func (f *ComputerGroupsFake) ListSmartV2(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListSmartResponse, *resty.Response, error) {
+	f.Record("ListSmartV2", ctx, rsqlQuery)
+	if f.ListSmartV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.ListSmartV2")
+	}
+	return f.ListSmartV2Func(ctx, rsqlQuery)
+}
+
+// ListSmartV3 records the call and delegates to ListSmartV3Func.
+func (f *ComputerGroupsFake) ListSmartV3(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListSmartV3Response, *resty.Response, error) {
+	f.Record("ListSmartV3", ctx, rsqlQuery)
+	if f.ListSmartV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.ListSmartV3")
+	}
+	return f.ListSmartV3Func(ctx, rsqlQuery)
+}
+
+// ListStaticV2 records the call and delegates to ListStaticV2Func.
+func (f *ComputerGroupsFake) ListStaticV2(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListStaticResponse, *resty.Response, error) {
+	f.Record("ListStaticV2", ctx, rsqlQuery)
+	if f.ListStaticV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.ListStaticV2")
+	}
+	return f.ListStaticV2Func(ctx, rsqlQuery)
+}
+
+// ListStaticV3 records the call and delegates to ListStaticV3Func.
+func (f *ComputerGroupsFake) ListStaticV3(ctx context.Context, rsqlQuery map[string]string) (*computer_groups.ListStaticV3Response, *resty.Response, error) {
+	f.Record("ListStaticV3", ctx, rsqlQuery)
+	if f.ListStaticV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.ListStaticV3")
+	}
+	return f.ListStaticV3Func(ctx, rsqlQuery)
+}
+
+// UpdateSmartByIDV3 records the call and delegates to UpdateSmartByIDV3Func.
+func (f *ComputerGroupsFake) UpdateSmartByIDV3(ctx context.Context, id string, request *computer_groups.RequestSmartGroupV3) (*computer_groups.ResourceSmartGroupV3, *resty.Response, error) {
+	f.Record("UpdateSmartByIDV3", ctx, id, request)
+	if f.UpdateSmartByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.UpdateSmartByIDV3")
+	}
+	return f.UpdateSmartByIDV3Func(ctx, id, request)
+}
+
+// UpdateSmartV2 records the call and delegates to UpdateSmartV2Func.
+func (f *ComputerGroupsFake) UpdateSmartV2(ctx context.Context, id string, request *computer_groups.RequestSmartGroup) (*computer_groups.ResourceSmartGroup, *resty.Response, error) {
+	f.Record("UpdateSmartV2", ctx, id, request)
+	if f.UpdateSmartV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.UpdateSmartV2")
+	}
+	return f.UpdateSmartV2Func(ctx, id, request)
+}
+
+// UpdateStaticByIDV2 records the call and delegates to UpdateStaticByIDV2Func.
+func (f *ComputerGroupsFake) UpdateStaticByIDV2(ctx context.Context, id string, request *computer_groups.RequestStaticGroup) (*computer_groups.ResourceStaticGroup, *resty.Response, error) {
+	f.Record("UpdateStaticByIDV2", ctx, id, request)
+	if f.UpdateStaticByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.UpdateStaticByIDV2")
+	}
+	return f.UpdateStaticByIDV2Func(ctx, id, request)
+}
+
+// UpdateStaticByIDV3 records the call and delegates to UpdateStaticByIDV3Func.
+func (f *ComputerGroupsFake) UpdateStaticByIDV3(ctx context.Context, id string, request *computer_groups.RequestStaticGroupV3) (*computer_groups.ResourceStaticGroupV3, *resty.Response, error) {
+	f.Record("UpdateStaticByIDV3", ctx, id, request)
+	if f.UpdateStaticByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerGroupsFake.UpdateStaticByIDV3")
+	}
+	return f.UpdateStaticByIDV3Func(ctx, id, request)
+}
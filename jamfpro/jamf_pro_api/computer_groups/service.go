@@ -0,0 +1,40 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ComputerGroupsFake implements it for tests.
+type Service interface {
+	CreateSmartV2(ctx context.Context, request *RequestSmartGroup) (*CreateSmartResponse, *resty.Response, error)
+	CreateSmartV3(ctx context.Context, request *RequestSmartGroupV3) (*CreateSmartResponse, *resty.Response, error)
+	CreateStaticV2(ctx context.Context, request *RequestStaticGroup) (*CreateStaticResponse, *resty.Response, error)
+	CreateStaticV3(ctx context.Context, request *RequestStaticGroupV3) (*CreateStaticResponse, *resty.Response, error)
+	DeleteSmartByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteSmartV2(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	GetSmartByIDV2(ctx context.Context, id string) (*ResourceSmartGroup, *resty.Response, error)
+	GetSmartByIDV3(ctx context.Context, id string) (*ResourceSmartGroupV3, *resty.Response, error)
+	GetSmartGroupMembershipByIDV2(ctx context.Context, id string) (*SmartGroupMembershipResponse, *resty.Response, error)
+	GetSmartGroupMembershipByIDV3(ctx context.Context, id string) (*SmartGroupMembershipResponse, *resty.Response, error)
+	GetStaticByIDV2(ctx context.Context, id string) (*ResourceStaticGroup, *resty.Response, error)
+	GetStaticByIDV3(ctx context.Context, id string) (*ResourceStaticGroupV3, *resty.Response, error)
+	ListAllV1(ctx context.Context) ([]ResourceGroupV1, *resty.Response, error)
+	ListSmartV2(ctx context.Context, rsqlQuery map[string]string) (*ListSmartResponse, *resty.Response, error)
+	ListSmartV3(ctx context.Context, rsqlQuery map[string]string) (*ListSmartV3Response, *resty.Response, error)
+	ListStaticV2(ctx context.Context, rsqlQuery map[string]string) (*ListStaticResponse, *resty.Response, error)
+	ListStaticV3(ctx context.Context, rsqlQuery map[string]string) (*ListStaticV3Response, *resty.Response, error)
+	UpdateSmartByIDV3(ctx context.Context, id string, request *RequestSmartGroupV3) (*ResourceSmartGroupV3, *resty.Response, error)
+	UpdateSmartV2(ctx context.Context, id string, request *RequestSmartGroup) (*ResourceSmartGroup, *resty.Response, error)
+	UpdateStaticByIDV2(ctx context.Context, id string, request *RequestStaticGroup) (*ResourceStaticGroup, *resty.Response, error)
+	UpdateStaticByIDV3(ctx context.Context, id string, request *RequestStaticGroupV3) (*ResourceStaticGroupV3, *resty.Response, error)
+}
+
+var _ Service = (*ComputerGroups)(nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
@@ -92,6 +93,27 @@ func (s *ComputerInventory) ListV3(ctx context.Context, rsqlQuery map[string]str
 	return &result, resp, nil
 }
 
+// ListV3Iter streams computer inventory records page by page instead of
+// buffering the whole fleet in memory. Breaking out of the range loop stops
+// pagination without fetching the remaining pages.
+// URL: GET /api/v3/computers-inventory
+// rsqlQuery supports: filter (RSQL), sort, section (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v3-computers-inventory
+//
+// Deprecated: deprecated in Jamf Pro 11.30; use ListV4Iter.
+func (s *ComputerInventory) ListV3Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[ResourceComputerInventory, error] {
+	apilifecycle.DeprecationWarning(s.client.GetLogger(), "jamf_pro_api/computer_inventory.ComputerInventory.ListV3Iter", "11.30", deprecatedV3Replacement)
+
+	endpoint := constants.EndpointJamfProComputerInventoryV3
+
+	req := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery)
+
+	return client.IterateAs[ResourceComputerInventory](req, endpoint)
+}
+
 // GetByIDV3 returns the specified computer inventory by ID.
 // URL: GET /api/v3/computers-inventory/{id}
 // Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v3-computers-inventory-id
@@ -27,6 +27,56 @@ func TestUnit_ComputerInventory_ListV3(t *testing.T) {
 	assert.Equal(t, "C02ABC123DEF", result.Results[0].Hardware.SerialNumber)
 }
 
+func TestUnit_ComputerInventory_ListV3Iter(t *testing.T) {
+	mock := mocks.NewComputerInventoryMock()
+	mock.RegisterListMock()
+
+	svc := NewComputerInventory(mock)
+	ctx := context.Background()
+
+	var names []string
+	for computer, err := range svc.ListV3Iter(ctx, nil) {
+		require.NoError(t, err)
+		names = append(names, computer.General.Name)
+	}
+
+	require.Len(t, names, 2)
+	assert.Equal(t, "Test-Mac-001", names[0])
+}
+
+func TestUnit_ComputerInventory_ListV3Iter_StopEarly(t *testing.T) {
+	mock := mocks.NewComputerInventoryMock()
+	mock.RegisterListMock()
+
+	svc := NewComputerInventory(mock)
+	ctx := context.Background()
+
+	count := 0
+	for _, err := range svc.ListV3Iter(ctx, nil) {
+		require.NoError(t, err)
+		count++
+		break
+	}
+
+	assert.Equal(t, 1, count)
+}
+
+func TestUnit_ComputerInventory_ListV3Iter_ClientError(t *testing.T) {
+	mock := mocks.NewComputerInventoryMock()
+	mock.RegisterListErrorMock()
+
+	svc := NewComputerInventory(mock)
+	ctx := context.Background()
+
+	var iterErr error
+	for _, err := range svc.ListV3Iter(ctx, nil) {
+		iterErr = err
+	}
+
+	require.Error(t, iterErr)
+	assert.Contains(t, iterErr.Error(), "simulated ListV3 API error")
+}
+
 func TestUnit_ComputerInventory_GetByIDV3(t *testing.T) {
 	mock := mocks.NewComputerInventoryMock()
 	mock.RegisterGetByIDMock("1")
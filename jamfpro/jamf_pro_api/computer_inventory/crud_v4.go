@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
 	"resty.dev/v3"
 )
@@ -72,6 +74,23 @@ func (s *ComputerInventory) ListV4(ctx context.Context, rsqlQuery map[string]str
 	return &result, resp, nil
 }
 
+// ListV4Iter streams computer inventory records page by page instead of
+// buffering the whole fleet in memory. Breaking out of the range loop stops
+// pagination without fetching the remaining pages.
+// URL: GET /api/v4/computers-inventory
+// rsqlQuery supports: filter (RSQL), sort, section (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v4-computers-inventory
+func (s *ComputerInventory) ListV4Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[ResourceComputerInventoryV4, error] {
+	endpoint := constants.EndpointJamfProComputerInventoryV4
+
+	req := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery)
+
+	return client.IterateAs[ResourceComputerInventoryV4](req, endpoint)
+}
+
 // GetByIDV4 returns the specified computer inventory by ID.
 // URL: GET /api/v4/computers-inventory/{id}
 // Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v4-computers-inventory-id
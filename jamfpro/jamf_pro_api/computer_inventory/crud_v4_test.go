@@ -29,6 +29,40 @@ func TestUnit_ComputerInventory_ListV4(t *testing.T) {
 	assert.Equal(t, "2018-10-31T19:12:44Z", result.Results[0].General.LastCheckIn)
 }
 
+func TestUnit_ComputerInventory_ListV4Iter(t *testing.T) {
+	mock := mocks.NewComputerInventoryMock()
+	mock.RegisterListV4Mock()
+
+	svc := NewComputerInventory(mock)
+	ctx := context.Background()
+
+	count := 0
+	for computer, err := range svc.ListV4Iter(ctx, map[string]string{"sort": "id:asc"}) {
+		require.NoError(t, err)
+		assert.NotEmpty(t, computer.ID)
+		count++
+	}
+
+	assert.Positive(t, count)
+	assert.Equal(t, "id:asc", mock.LastRSQLQuery["sort"])
+}
+
+func TestUnit_ComputerInventory_ListV4Iter_InvalidJSON(t *testing.T) {
+	mock := mocks.NewComputerInventoryMock()
+	mock.RegisterListV4InvalidJSONMock()
+
+	svc := NewComputerInventory(mock)
+	ctx := context.Background()
+
+	var iterErr error
+	for _, err := range svc.ListV4Iter(ctx, nil) {
+		iterErr = err
+	}
+
+	require.Error(t, iterErr)
+	assert.Contains(t, iterErr.Error(), "failed to unmarshal")
+}
+
 func TestUnit_ComputerInventory_ListV4_Error(t *testing.T) {
 	mock := mocks.NewComputerInventoryMock()
 	mock.RegisterListV4ErrorMock()
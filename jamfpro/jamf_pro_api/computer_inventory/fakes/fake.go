@@ -0,0 +1,344 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_inventory
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"iter"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_inventory"
+	"resty.dev/v3"
+)
+
+// ComputerInventoryFake is a computer_inventory.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerInventoryFake struct {
+	fakes.Journal
+
+	CreateV3Func                      func(ctx context.Context, request *computer_inventory.ResourceComputerInventory) (*computer_inventory.CreateComputerResponse, *resty.Response, error)
+	CreateV4Func                      func(ctx context.Context, request *computer_inventory.ResourceComputerInventoryV4) (*computer_inventory.CreateComputerResponse, *resty.Response, error)
+	DeleteAttachmentByIDV3Func        func(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error)
+	DeleteAttachmentByIDV4Func        func(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error)
+	DeleteByIDV3Func                  func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV4Func                  func(ctx context.Context, id string) (*resty.Response, error)
+	EraseByIDV1Func                   func(ctx context.Context, id string, request *computer_inventory.RequestEraseDeviceComputer) (*resty.Response, error)
+	EraseByIDV4Func                   func(ctx context.Context, id string, request *computer_inventory.RequestEraseDeviceComputer) (*resty.Response, error)
+	GetAttachmentByIDV3Func           func(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error)
+	GetAttachmentByIDV4Func           func(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error)
+	GetByIDV3Func                     func(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventory, *resty.Response, error)
+	GetByIDV4Func                     func(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error)
+	GetDetailByIDV3Func               func(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventory, *resty.Response, error)
+	GetDetailByIDV4Func               func(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error)
+	GetDeviceLockPinByIDV3Func        func(ctx context.Context, id string) (*computer_inventory.ResponseDeviceLockPin, *resty.Response, error)
+	GetDeviceLockPinByIDV4Func        func(ctx context.Context, id string) (*computer_inventory.ResponseDeviceLockPin, *resty.Response, error)
+	GetFileVaultByIDV3Func            func(ctx context.Context, id string) (*computer_inventory.FileVaultInventory, *resty.Response, error)
+	GetFileVaultByIDV4Func            func(ctx context.Context, id string) (*computer_inventory.FileVaultInventory, *resty.Response, error)
+	GetRecoveryLockPasswordByIDV3Func func(ctx context.Context, id string) (*computer_inventory.ResponseRecoveryLockPassword, *resty.Response, error)
+	GetRecoveryLockPasswordByIDV4Func func(ctx context.Context, id string) (*computer_inventory.ResponseRecoveryLockPassword, *resty.Response, error)
+	ListFileVaultV3Func               func(ctx context.Context) (*computer_inventory.FileVaultInventoryList, *resty.Response, error)
+	ListFileVaultV4Func               func(ctx context.Context) (*computer_inventory.FileVaultInventoryList, *resty.Response, error)
+	ListV3Func                        func(ctx context.Context, rsqlQuery map[string]string) (*computer_inventory.ResponseComputerInventoryList, *resty.Response, error)
+	ListV3IterFunc                    func(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[computer_inventory.ResourceComputerInventory, error]
+	ListV4Func                        func(ctx context.Context, rsqlQuery map[string]string) (*computer_inventory.ResponseComputerInventoryListV4, *resty.Response, error)
+	ListV4IterFunc                    func(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[computer_inventory.ResourceComputerInventoryV4, error]
+	RemoveMDMProfileByIDV1Func        func(ctx context.Context, id string) (*computer_inventory.ResponseRemoveMDMProfile, *resty.Response, error)
+	RemoveMDMProfileByIDV4Func        func(ctx context.Context, id string) (*computer_inventory.ResponseRemoveMDMProfile, *resty.Response, error)
+	UpdateByIDV3Func                  func(ctx context.Context, id string, request *computer_inventory.ResourceComputerInventory) (*computer_inventory.ResourceComputerInventory, *resty.Response, error)
+	UpdateByIDV4Func                  func(ctx context.Context, id string, request *computer_inventory.ResourceComputerInventoryV4) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error)
+	UploadAttachmentByIDV3Func        func(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error)
+	UploadAttachmentByIDV4Func        func(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error)
+}
+
+var _ computer_inventory.Service = (*ComputerInventoryFake)(nil)
+
+// CreateV3 records the call and delegates to CreateV3Func.
+func (f *ComputerInventoryFake) CreateV3(ctx context.Context, request *computer_inventory.ResourceComputerInventory) (*computer_inventory.CreateComputerResponse, *resty.Response, error) {
+	f.Record("CreateV3", ctx, request)
+	if f.CreateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.CreateV3")
+	}
+	return f.CreateV3Func(ctx, request)
+}
+
+// CreateV4 records the call and delegates to CreateV4Func.
+func (f *ComputerInventoryFake) CreateV4(ctx context.Context, request *computer_inventory.ResourceComputerInventoryV4) (*computer_inventory.CreateComputerResponse, *resty.Response, error) {
+	f.Record("CreateV4", ctx, request)
+	if f.CreateV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.CreateV4")
+	}
+	return f.CreateV4Func(ctx, request)
+}
+
+// DeleteAttachmentByIDV3 records the call and delegates to DeleteAttachmentByIDV3Func.
+func (f *ComputerInventoryFake) DeleteAttachmentByIDV3(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error) {
+	f.Record("DeleteAttachmentByIDV3", ctx, computerID, attachmentID)
+	if f.DeleteAttachmentByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.DeleteAttachmentByIDV3")
+	}
+	return f.DeleteAttachmentByIDV3Func(ctx, computerID, attachmentID)
+}
+
+// DeleteAttachmentByIDV4 records the call and delegates to DeleteAttachmentByIDV4Func.
+func (f *ComputerInventoryFake) DeleteAttachmentByIDV4(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error) {
+	f.Record("DeleteAttachmentByIDV4", ctx, computerID, attachmentID)
+	if f.DeleteAttachmentByIDV4Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.DeleteAttachmentByIDV4")
+	}
+	return f.DeleteAttachmentByIDV4Func(ctx, computerID, attachmentID)
+}
+
+// DeleteByIDV3 records the call and delegates to DeleteByIDV3Func.
+func (f *ComputerInventoryFake) DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV3", ctx, id)
+	if f.DeleteByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.DeleteByIDV3")
+	}
+	return f.DeleteByIDV3Func(ctx, id)
+}
+
+// DeleteByIDV4 records the call and delegates to DeleteByIDV4Func.
+func (f *ComputerInventoryFake) DeleteByIDV4(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV4", ctx, id)
+	if f.DeleteByIDV4Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.DeleteByIDV4")
+	}
+	return f.DeleteByIDV4Func(ctx, id)
+}
+
+// EraseByIDV1 records the call and delegates to EraseByIDV1Func.
+func (f *ComputerInventoryFake) EraseByIDV1(ctx context.Context, id string, request *computer_inventory.RequestEraseDeviceComputer) (*resty.Response, error) {
+	f.Record("EraseByIDV1", ctx, id, request)
+	if f.EraseByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.EraseByIDV1")
+	}
+	return f.EraseByIDV1Func(ctx, id, request)
+}
+
+// EraseByIDV4 records the call and delegates to EraseByIDV4Func.
+func (f *ComputerInventoryFake) EraseByIDV4(ctx context.Context, id string, request *computer_inventory.RequestEraseDeviceComputer) (*resty.Response, error) {
+	f.Record("EraseByIDV4", ctx, id, request)
+	if f.EraseByIDV4Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.EraseByIDV4")
+	}
+	return f.EraseByIDV4Func(ctx, id, request)
+}
+
+// GetAttachmentByIDV3 records the call and delegates to GetAttachmentByIDV3Func.
+func (f *ComputerInventoryFake) GetAttachmentByIDV3(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error) {
+	f.Record("GetAttachmentByIDV3", ctx, computerID, attachmentID)
+	if f.GetAttachmentByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetAttachmentByIDV3")
+	}
+	return f.GetAttachmentByIDV3Func(ctx, computerID, attachmentID)
+}
+
+// GetAttachmentByIDV4 records the call and delegates to GetAttachmentByIDV4Func.
+func (f *ComputerInventoryFake) GetAttachmentByIDV4(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error) {
+	f.Record("GetAttachmentByIDV4", ctx, computerID, attachmentID)
+	if f.GetAttachmentByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetAttachmentByIDV4")
+	}
+	return f.GetAttachmentByIDV4Func(ctx, computerID, attachmentID)
+}
+
+// GetByIDV3 records the call and delegates to GetByIDV3Func.
+func (f *ComputerInventoryFake) GetByIDV3(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventory, *resty.Response, error) {
+	f.Record("GetByIDV3", ctx, id)
+	if f.GetByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetByIDV3")
+	}
+	return f.GetByIDV3Func(ctx, id)
+}
+
+// GetByIDV4 records the call and delegates to GetByIDV4Func.
+func (f *ComputerInventoryFake) GetByIDV4(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error) {
+	f.Record("GetByIDV4", ctx, id)
+	if f.GetByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetByIDV4")
+	}
+	return f.GetByIDV4Func(ctx, id)
+}
+
+// GetDetailByIDV3 records the call and delegates to GetDetailByIDV3Func.
+func (f *ComputerInventoryFake) GetDetailByIDV3(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventory, *resty.Response, error) {
+	f.Record("GetDetailByIDV3", ctx, id)
+	if f.GetDetailByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetDetailByIDV3")
+	}
+	return f.GetDetailByIDV3Func(ctx, id)
+}
+
+// GetDetailByIDV4 records the call and delegates to GetDetailByIDV4Func.
+func (f *ComputerInventoryFake) GetDetailByIDV4(ctx context.Context, id string) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error) {
+	f.Record("GetDetailByIDV4", ctx, id)
+	if f.GetDetailByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetDetailByIDV4")
+	}
+	return f.GetDetailByIDV4Func(ctx, id)
+}
+
+// GetDeviceLockPinByIDV3 records the call and delegates to GetDeviceLockPinByIDV3Func.
+func (f *ComputerInventoryFake) GetDeviceLockPinByIDV3(ctx context.Context, id string) (*computer_inventory.ResponseDeviceLockPin, *resty.Response, error) {
+	f.Record("GetDeviceLockPinByIDV3", ctx, id)
+	if f.GetDeviceLockPinByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetDeviceLockPinByIDV3")
+	}
+	return f.GetDeviceLockPinByIDV3Func(ctx, id)
+}
+
+// GetDeviceLockPinByIDV4 records the call and delegates to GetDeviceLockPinByIDV4Func.
+func (f *ComputerInventoryFake) GetDeviceLockPinByIDV4(ctx context.Context, id string) (*computer_inventory.ResponseDeviceLockPin, *resty.Response, error) {
+	f.Record("GetDeviceLockPinByIDV4", ctx, id)
+	if f.GetDeviceLockPinByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetDeviceLockPinByIDV4")
+	}
+	return f.GetDeviceLockPinByIDV4Func(ctx, id)
+}
+
+// GetFileVaultByIDV3 records the call and delegates to GetFileVaultByIDV3Func.
+func (f *ComputerInventoryFake) GetFileVaultByIDV3(ctx context.Context, id string) (*computer_inventory.FileVaultInventory, *resty.Response, error) {
+	f.Record("GetFileVaultByIDV3", ctx, id)
+	if f.GetFileVaultByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetFileVaultByIDV3")
+	}
+	return f.GetFileVaultByIDV3Func(ctx, id)
+}
+
+// GetFileVaultByIDV4 records the call and delegates to GetFileVaultByIDV4Func.
+func (f *ComputerInventoryFake) GetFileVaultByIDV4(ctx context.Context, id string) (*computer_inventory.FileVaultInventory, *resty.Response, error) {
+	f.Record("GetFileVaultByIDV4", ctx, id)
+	if f.GetFileVaultByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetFileVaultByIDV4")
+	}
+	return f.GetFileVaultByIDV4Func(ctx, id)
+}
+
+// GetRecoveryLockPasswordByIDV3 records the call and delegates to GetRecoveryLockPasswordByIDV3Func.
+func (f *ComputerInventoryFake) GetRecoveryLockPasswordByIDV3(ctx context.Context, id string) (*computer_inventory.ResponseRecoveryLockPassword, *resty.Response, error) {
+	f.Record("GetRecoveryLockPasswordByIDV3", ctx, id)
+	if f.GetRecoveryLockPasswordByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetRecoveryLockPasswordByIDV3")
+	}
+	return f.GetRecoveryLockPasswordByIDV3Func(ctx, id)
+}
+
+// GetRecoveryLockPasswordByIDV4 records the call and delegates to GetRecoveryLockPasswordByIDV4Func.
+func (f *ComputerInventoryFake) GetRecoveryLockPasswordByIDV4(ctx context.Context, id string) (*computer_inventory.ResponseRecoveryLockPassword, *resty.Response, error) {
+	f.Record("GetRecoveryLockPasswordByIDV4", ctx, id)
+	if f.GetRecoveryLockPasswordByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.GetRecoveryLockPasswordByIDV4")
+	}
+	return f.GetRecoveryLockPasswordByIDV4Func(ctx, id)
+}
+
+// ListFileVaultV3 records the call and delegates to ListFileVaultV3Func.
+func (f *ComputerInventoryFake) ListFileVaultV3(ctx context.Context) (*computer_inventory.FileVaultInventoryList, *resty.Response, error) {
+	f.Record("ListFileVaultV3", ctx)
+	if f.ListFileVaultV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.ListFileVaultV3")
+	}
+	return f.ListFileVaultV3Func(ctx)
+}
+
+// ListFileVaultV4 records the call and delegates to ListFileVaultV4Func.
+func (f *ComputerInventoryFake) ListFileVaultV4(ctx context.Context) (*computer_inventory.FileVaultInventoryList, *resty.Response, error) {
+	f.Record("ListFileVaultV4", ctx)
+	if f.ListFileVaultV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.ListFileVaultV4")
+	}
+	return f.ListFileVaultV4Func(ctx)
+}
+
+// ListV3 records the call and delegates to ListV3Func.
+func (f *ComputerInventoryFake) ListV3(ctx context.Context, rsqlQuery map[string]string) (*computer_inventory.ResponseComputerInventoryList, *resty.Response, error) {
+	f.Record("ListV3", ctx, rsqlQuery)
+	if f.ListV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.ListV3")
+	}
+	return f.ListV3Func(ctx, rsqlQuery)
+}
+
+// ListV3Iter records the call and delegates to ListV3IterFunc.
+func (f *ComputerInventoryFake) ListV3Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[computer_inventory.ResourceComputerInventory, error] {
+	f.Record("ListV3Iter", ctx, rsqlQuery)
+	if f.ListV3IterFunc == nil {
+		return nil
+	}
+	return f.ListV3IterFunc(ctx, rsqlQuery)
+}
+
+// ListV4 records the call and delegates to ListV4Func.
+func (f *ComputerInventoryFake) ListV4(ctx context.Context, rsqlQuery map[string]string) (*computer_inventory.ResponseComputerInventoryListV4, *resty.Response, error) {
+	f.Record("ListV4", ctx, rsqlQuery)
+	if f.ListV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.ListV4")
+	}
+	return f.ListV4Func(ctx, rsqlQuery)
+}
+
+// ListV4Iter records the call and delegates to ListV4IterFunc.
+func (f *ComputerInventoryFake) ListV4Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[computer_inventory.ResourceComputerInventoryV4, error] {
+	f.Record("ListV4Iter", ctx, rsqlQuery)
+	if f.ListV4IterFunc == nil {
+		return nil
+	}
+	return f.ListV4IterFunc(ctx, rsqlQuery)
+}
+
+// RemoveMDMProfileByIDV1 records the call and delegates to RemoveMDMProfileByIDV1Func.
+func (f *ComputerInventoryFake) RemoveMDMProfileByIDV1(ctx context.Context, id string) (*computer_inventory.ResponseRemoveMDMProfile, *resty.Response, error) {
+	f.Record("RemoveMDMProfileByIDV1", ctx, id)
+	if f.RemoveMDMProfileByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.RemoveMDMProfileByIDV1")
+	}
+	return f.RemoveMDMProfileByIDV1Func(ctx, id)
+}
+
+// RemoveMDMProfileByIDV4 records the call and delegates to RemoveMDMProfileByIDV4Func.
+func (f *ComputerInventoryFake) RemoveMDMProfileByIDV4(ctx context.Context, id string) (*computer_inventory.ResponseRemoveMDMProfile, *resty.Response, error) {
+	f.Record("RemoveMDMProfileByIDV4", ctx, id)
+	if f.RemoveMDMProfileByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.RemoveMDMProfileByIDV4")
+	}
+	return f.RemoveMDMProfileByIDV4Func(ctx, id)
+}
+
+// UpdateByIDV3 records the call and delegates to UpdateByIDV3Func.
+func (f *ComputerInventoryFake) UpdateByIDV3(ctx context.Context, id string, request *computer_inventory.ResourceComputerInventory) (*computer_inventory.ResourceComputerInventory, *resty.Response, error) {
+	f.Record("UpdateByIDV3", ctx, id, request)
+	if f.UpdateByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.UpdateByIDV3")
+	}
+	return f.UpdateByIDV3Func(ctx, id, request)
+}
+
+// UpdateByIDV4 records the call and delegates to UpdateByIDV4Func.
+func (f *ComputerInventoryFake) UpdateByIDV4(ctx context.Context, id string, request *computer_inventory.ResourceComputerInventoryV4) (*computer_inventory.ResourceComputerInventoryV4, *resty.Response, error) {
+	f.Record("UpdateByIDV4", ctx, id, request)
+	if f.UpdateByIDV4Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryFake.UpdateByIDV4")
+	}
+	return f.UpdateByIDV4Func(ctx, id, request)
+}
+
+// UploadAttachmentByIDV3 records the call and delegates to UploadAttachmentByIDV3Func.
+func (f *ComputerInventoryFake) UploadAttachmentByIDV3(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error) {
+	f.Record("UploadAttachmentByIDV3", ctx, computerID, attachment)
+	if f.UploadAttachmentByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.UploadAttachmentByIDV3")
+	}
+	return f.UploadAttachmentByIDV3Func(ctx, computerID, attachment)
+}
+
+// UploadAttachmentByIDV4 records the call and delegates to UploadAttachmentByIDV4Func.
+func (f *ComputerInventoryFake) UploadAttachmentByIDV4(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error) {
+	f.Record("UploadAttachmentByIDV4", ctx, computerID, attachment)
+	if f.UploadAttachmentByIDV4Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryFake.UploadAttachmentByIDV4")
+	}
+	return f.UploadAttachmentByIDV4Func(ctx, computerID, attachment)
+}
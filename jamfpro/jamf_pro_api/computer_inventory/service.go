@@ -0,0 +1,50 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_inventory
+
+import (
+	"context"
+	"iter"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerInventory.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ComputerInventoryFake implements it for tests.
+type Service interface {
+	CreateV3(ctx context.Context, request *ResourceComputerInventory) (*CreateComputerResponse, *resty.Response, error)
+	CreateV4(ctx context.Context, request *ResourceComputerInventoryV4) (*CreateComputerResponse, *resty.Response, error)
+	DeleteAttachmentByIDV3(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error)
+	DeleteAttachmentByIDV4(ctx context.Context, computerID string, attachmentID string) (*resty.Response, error)
+	DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV4(ctx context.Context, id string) (*resty.Response, error)
+	EraseByIDV1(ctx context.Context, id string, request *RequestEraseDeviceComputer) (*resty.Response, error)
+	EraseByIDV4(ctx context.Context, id string, request *RequestEraseDeviceComputer) (*resty.Response, error)
+	GetAttachmentByIDV3(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error)
+	GetAttachmentByIDV4(ctx context.Context, computerID string, attachmentID string) ([]byte, *resty.Response, error)
+	GetByIDV3(ctx context.Context, id string) (*ResourceComputerInventory, *resty.Response, error)
+	GetByIDV4(ctx context.Context, id string) (*ResourceComputerInventoryV4, *resty.Response, error)
+	GetDetailByIDV3(ctx context.Context, id string) (*ResourceComputerInventory, *resty.Response, error)
+	GetDetailByIDV4(ctx context.Context, id string) (*ResourceComputerInventoryV4, *resty.Response, error)
+	GetDeviceLockPinByIDV3(ctx context.Context, id string) (*ResponseDeviceLockPin, *resty.Response, error)
+	GetDeviceLockPinByIDV4(ctx context.Context, id string) (*ResponseDeviceLockPin, *resty.Response, error)
+	GetFileVaultByIDV3(ctx context.Context, id string) (*FileVaultInventory, *resty.Response, error)
+	GetFileVaultByIDV4(ctx context.Context, id string) (*FileVaultInventory, *resty.Response, error)
+	GetRecoveryLockPasswordByIDV3(ctx context.Context, id string) (*ResponseRecoveryLockPassword, *resty.Response, error)
+	GetRecoveryLockPasswordByIDV4(ctx context.Context, id string) (*ResponseRecoveryLockPassword, *resty.Response, error)
+	ListFileVaultV3(ctx context.Context) (*FileVaultInventoryList, *resty.Response, error)
+	ListFileVaultV4(ctx context.Context) (*FileVaultInventoryList, *resty.Response, error)
+	ListV3(ctx context.Context, rsqlQuery map[string]string) (*ResponseComputerInventoryList, *resty.Response, error)
+	ListV3Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[ResourceComputerInventory, error]
+	ListV4(ctx context.Context, rsqlQuery map[string]string) (*ResponseComputerInventoryListV4, *resty.Response, error)
+	ListV4Iter(ctx context.Context, rsqlQuery map[string]string) iter.Seq2[ResourceComputerInventoryV4, error]
+	RemoveMDMProfileByIDV1(ctx context.Context, id string) (*ResponseRemoveMDMProfile, *resty.Response, error)
+	RemoveMDMProfileByIDV4(ctx context.Context, id string) (*ResponseRemoveMDMProfile, *resty.Response, error)
+	UpdateByIDV3(ctx context.Context, id string, request *ResourceComputerInventory) (*ResourceComputerInventory, *resty.Response, error)
+	UpdateByIDV4(ctx context.Context, id string, request *ResourceComputerInventoryV4) (*ResourceComputerInventoryV4, *resty.Response, error)
+	UploadAttachmentByIDV3(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error)
+	UploadAttachmentByIDV4(ctx context.Context, computerID string, attachment []byte) (*resty.Response, error)
+}
+
+var _ Service = (*ComputerInventory)(nil)
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_inventory_collection_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_inventory_collection_settings"
+	"resty.dev/v3"
+)
+
+// ComputerInventoryCollectionSettingsFake is a computer_inventory_collection_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerInventoryCollectionSettingsFake struct {
+	fakes.Journal
+
+	CreateCustomPathV2Func     func(ctx context.Context, req *computer_inventory_collection_settings.RequestCustomPath) (*computer_inventory_collection_settings.SubsetPathItem, *resty.Response, error)
+	DeleteCustomPathByIDV2Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetV2Func                  func(ctx context.Context) (*computer_inventory_collection_settings.ResourceComputerInventoryCollectionSettings, *resty.Response, error)
+	UpdateV2Func               func(ctx context.Context, settings *computer_inventory_collection_settings.ResourceComputerInventoryCollectionSettings) (*resty.Response, error)
+}
+
+var _ computer_inventory_collection_settings.Service = (*ComputerInventoryCollectionSettingsFake)(nil)
+
+// CreateCustomPathV2 records the call and delegates to CreateCustomPathV2Func.
+func (f *ComputerInventoryCollectionSettingsFake) CreateCustomPathV2(ctx context.Context, req *computer_inventory_collection_settings.RequestCustomPath) (*computer_inventory_collection_settings.SubsetPathItem, *resty.Response, error) {
+	f.Record("CreateCustomPathV2", ctx, req)
+	if f.CreateCustomPathV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryCollectionSettingsFake.CreateCustomPathV2")
+	}
+	return f.CreateCustomPathV2Func(ctx, req)
+}
+
+// DeleteCustomPathByIDV2 records the call and delegates to DeleteCustomPathByIDV2Func.
+func (f *ComputerInventoryCollectionSettingsFake) DeleteCustomPathByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteCustomPathByIDV2", ctx, id)
+	if f.DeleteCustomPathByIDV2Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryCollectionSettingsFake.DeleteCustomPathByIDV2")
+	}
+	return f.DeleteCustomPathByIDV2Func(ctx, id)
+}
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *ComputerInventoryCollectionSettingsFake) GetV2(ctx context.Context) (*computer_inventory_collection_settings.ResourceComputerInventoryCollectionSettings, *resty.Response, error) {
+	f.Record("GetV2", ctx)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerInventoryCollectionSettingsFake.GetV2")
+	}
+	return f.GetV2Func(ctx)
+}
+
+// UpdateV2 records the call and delegates to UpdateV2Func.
+func (f *ComputerInventoryCollectionSettingsFake) UpdateV2(ctx context.Context, settings *computer_inventory_collection_settings.ResourceComputerInventoryCollectionSettings) (*resty.Response, error) {
+	f.Record("UpdateV2", ctx, settings)
+	if f.UpdateV2Func == nil {
+		return nil, fakes.NotStubbed("ComputerInventoryCollectionSettingsFake.UpdateV2")
+	}
+	return f.UpdateV2Func(ctx, settings)
+}
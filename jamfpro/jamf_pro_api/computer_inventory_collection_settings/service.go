@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_inventory_collection_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerInventoryCollectionSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ComputerInventoryCollectionSettingsFake implements it for tests.
+type Service interface {
+	CreateCustomPathV2(ctx context.Context, req *RequestCustomPath) (*SubsetPathItem, *resty.Response, error)
+	DeleteCustomPathByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	GetV2(ctx context.Context) (*ResourceComputerInventoryCollectionSettings, *resty.Response, error)
+	UpdateV2(ctx context.Context, settings *ResourceComputerInventoryCollectionSettings) (*resty.Response, error)
+}
+
+var _ Service = (*ComputerInventoryCollectionSettings)(nil)
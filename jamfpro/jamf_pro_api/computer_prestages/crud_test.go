@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_prestages/mocks"
+	mockhelpers "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -660,3 +661,36 @@ func TestUnit_ComputerPrestages_CreateV3_ValidUserAccountTypes(t *testing.T) {
 		assert.Equal(t, 200, resp.StatusCode())
 	}
 }
+
+// TestUnit_ComputerPrestages_UpdateByIDV3_RetriesConflictWithRefreshedLock
+// verifies that a 409 on the PUT triggers a re-read and that the retried PUT
+// carries the versionLock from that re-read, not the stale one.
+func TestUnit_ComputerPrestages_UpdateByIDV3_RetriesConflictWithRefreshedLock(t *testing.T) {
+	svc, mock := setupMockService(t)
+	path := "/api/v3/computer-prestages/1"
+	mock.RegisterSequence("GET", path,
+		mockhelpers.MockStep{StatusCode: 200, Body: []byte(`{"id":"1","displayName":"Test Prestage","versionLock":1}`)},
+		mockhelpers.MockStep{StatusCode: 200, Body: []byte(`{"id":"1","displayName":"Test Prestage","versionLock":2}`)},
+	)
+	mock.RegisterSequence("PUT", path,
+		mockhelpers.MockStep{StatusCode: 409, Fixture: "error_conflict.json"},
+		mockhelpers.MockStep{StatusCode: 200, Fixture: "validate_get.json"},
+	)
+
+	_, _, err := svc.UpdateByIDV3(context.Background(), "1", &ResourceComputerPrestage{DisplayName: "Updated"})
+	require.NoError(t, err)
+
+	var puts []ResourceComputerPrestage
+	for _, call := range mock.CallsTo(path) {
+		if call.Method != "PUT" {
+			continue
+		}
+		var sent ResourceComputerPrestage
+		require.NoError(t, call.DecodeBody(&sent))
+		puts = append(puts, sent)
+	}
+	require.Len(t, puts, 2)
+	assert.Equal(t, 1, puts[0].VersionLock)
+	assert.Equal(t, 2, puts[1].VersionLock)
+	assert.Equal(t, "Updated", puts[1].DisplayName)
+}
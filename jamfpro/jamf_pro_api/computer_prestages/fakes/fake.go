@@ -0,0 +1,153 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the computer_prestages
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_prestages"
+	"resty.dev/v3"
+)
+
+// ComputerPrestagesFake is a computer_prestages.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ComputerPrestagesFake struct {
+	fakes.Journal
+
+	AddDeviceScopeByIDV2Func     func(ctx context.Context, id string, request *computer_prestages.AddDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error)
+	CreateV3Func                 func(ctx context.Context, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.CreateResponse, *resty.Response, error)
+	DeleteByIDV3Func             func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV3Func           func(ctx context.Context, name string) (*resty.Response, error)
+	GetAllDeviceScopeV2Func      func(ctx context.Context) (*computer_prestages.AllDeviceScopeResponse, *resty.Response, error)
+	GetByIDV3Func                func(ctx context.Context, id string) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error)
+	GetByNameV3Func              func(ctx context.Context, name string) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error)
+	GetDeviceScopeByIDV2Func     func(ctx context.Context, id string) (*computer_prestages.ResourceDeviceScope, *resty.Response, error)
+	ListV3Func                   func(ctx context.Context, query map[string]string) (*computer_prestages.ListResponse, *resty.Response, error)
+	RemoveDeviceScopeByIDV2Func  func(ctx context.Context, id string, request *computer_prestages.RemoveDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error)
+	ReplaceDeviceScopeByIDV2Func func(ctx context.Context, id string, request *computer_prestages.ReplaceDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error)
+	UpdateByIDV3Func             func(ctx context.Context, id string, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error)
+	UpdateByNameV3Func           func(ctx context.Context, name string, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error)
+}
+
+var _ computer_prestages.Service = (*ComputerPrestagesFake)(nil)
+
+// AddDeviceScopeByIDV2 records the call and delegates to AddDeviceScopeByIDV2Func.
+func (f *ComputerPrestagesFake) AddDeviceScopeByIDV2(ctx context.Context, id string, request *computer_prestages.AddDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("AddDeviceScopeByIDV2", ctx, id, request)
+	if f.AddDeviceScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.AddDeviceScopeByIDV2")
+	}
+	return f.AddDeviceScopeByIDV2Func(ctx, id, request)
+}
+
+// CreateV3 records the call and delegates to CreateV3Func.
+func (f *ComputerPrestagesFake) CreateV3(ctx context.Context, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV3", ctx, request)
+	if f.CreateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.CreateV3")
+	}
+	return f.CreateV3Func(ctx, request)
+}
+
+// DeleteByIDV3 records the call and delegates to DeleteByIDV3Func.
+func (f *ComputerPrestagesFake) DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV3", ctx, id)
+	if f.DeleteByIDV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerPrestagesFake.DeleteByIDV3")
+	}
+	return f.DeleteByIDV3Func(ctx, id)
+}
+
+// DeleteByNameV3 records the call and delegates to DeleteByNameV3Func.
+func (f *ComputerPrestagesFake) DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV3", ctx, name)
+	if f.DeleteByNameV3Func == nil {
+		return nil, fakes.NotStubbed("ComputerPrestagesFake.DeleteByNameV3")
+	}
+	return f.DeleteByNameV3Func(ctx, name)
+}
+
+// GetAllDeviceScopeV2 records the call and delegates to GetAllDeviceScopeV2Func.
+func (f *ComputerPrestagesFake) GetAllDeviceScopeV2(ctx context.Context) (*computer_prestages.AllDeviceScopeResponse, *resty.Response, error) {
+	f.Record("GetAllDeviceScopeV2", ctx)
+	if f.GetAllDeviceScopeV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.GetAllDeviceScopeV2")
+	}
+	return f.GetAllDeviceScopeV2Func(ctx)
+}
+
+// GetByIDV3 records the call and delegates to GetByIDV3Func.
+func (f *ComputerPrestagesFake) GetByIDV3(ctx context.Context, id string) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error) {
+	f.Record("GetByIDV3", ctx, id)
+	if f.GetByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.GetByIDV3")
+	}
+	return f.GetByIDV3Func(ctx, id)
+}
+
+// GetByNameV3 records the call and delegates to GetByNameV3Func.
+func (f *ComputerPrestagesFake) GetByNameV3(ctx context.Context, name string) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error) {
+	f.Record("GetByNameV3", ctx, name)
+	if f.GetByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.GetByNameV3")
+	}
+	return f.GetByNameV3Func(ctx, name)
+}
+
+// GetDeviceScopeByIDV2 records the call and delegates to GetDeviceScopeByIDV2Func.
+func (f *ComputerPrestagesFake) GetDeviceScopeByIDV2(ctx context.Context, id string) (*computer_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("GetDeviceScopeByIDV2", ctx, id)
+	if f.GetDeviceScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.GetDeviceScopeByIDV2")
+	}
+	return f.GetDeviceScopeByIDV2Func(ctx, id)
+}
+
+// ListV3 records the call and delegates to ListV3Func.
+func (f *ComputerPrestagesFake) ListV3(ctx context.Context, query map[string]string) (*computer_prestages.ListResponse, *resty.Response, error) {
+	f.Record("ListV3", ctx, query)
+	if f.ListV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.ListV3")
+	}
+	return f.ListV3Func(ctx, query)
+}
+
+// RemoveDeviceScopeByIDV2 records the call and delegates to RemoveDeviceScopeByIDV2Func.
+func (f *ComputerPrestagesFake) RemoveDeviceScopeByIDV2(ctx context.Context, id string, request *computer_prestages.RemoveDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("RemoveDeviceScopeByIDV2", ctx, id, request)
+	if f.RemoveDeviceScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.RemoveDeviceScopeByIDV2")
+	}
+	return f.RemoveDeviceScopeByIDV2Func(ctx, id, request)
+}
+
+// ReplaceDeviceScopeByIDV2 records the call and delegates to ReplaceDeviceScopeByIDV2Func.
+func (f *ComputerPrestagesFake) ReplaceDeviceScopeByIDV2(ctx context.Context, id string, request *computer_prestages.ReplaceDeviceScopeRequest) (*computer_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("ReplaceDeviceScopeByIDV2", ctx, id, request)
+	if f.ReplaceDeviceScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.ReplaceDeviceScopeByIDV2")
+	}
+	return f.ReplaceDeviceScopeByIDV2Func(ctx, id, request)
+}
+
+// UpdateByIDV3 records the call and delegates to UpdateByIDV3Func.
+func (f *ComputerPrestagesFake) UpdateByIDV3(ctx context.Context, id string, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error) {
+	f.Record("UpdateByIDV3", ctx, id, request)
+	if f.UpdateByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.UpdateByIDV3")
+	}
+	return f.UpdateByIDV3Func(ctx, id, request)
+}
+
+// UpdateByNameV3 records the call and delegates to UpdateByNameV3Func.
+func (f *ComputerPrestagesFake) UpdateByNameV3(ctx context.Context, name string, request *computer_prestages.ResourceComputerPrestage) (*computer_prestages.ResourceComputerPrestage, *resty.Response, error) {
+	f.Record("UpdateByNameV3", ctx, name, request)
+	if f.UpdateByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("ComputerPrestagesFake.UpdateByNameV3")
+	}
+	return f.UpdateByNameV3Func(ctx, name, request)
+}
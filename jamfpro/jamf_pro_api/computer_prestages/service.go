@@ -0,0 +1,30 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package computer_prestages
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ComputerPrestages.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ComputerPrestagesFake implements it for tests.
+type Service interface {
+	AddDeviceScopeByIDV2(ctx context.Context, id string, request *AddDeviceScopeRequest) (*ResourceDeviceScope, *resty.Response, error)
+	CreateV3(ctx context.Context, request *ResourceComputerPrestage) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error)
+	GetAllDeviceScopeV2(ctx context.Context) (*AllDeviceScopeResponse, *resty.Response, error)
+	GetByIDV3(ctx context.Context, id string) (*ResourceComputerPrestage, *resty.Response, error)
+	GetByNameV3(ctx context.Context, name string) (*ResourceComputerPrestage, *resty.Response, error)
+	GetDeviceScopeByIDV2(ctx context.Context, id string) (*ResourceDeviceScope, *resty.Response, error)
+	ListV3(ctx context.Context, query map[string]string) (*ListResponse, *resty.Response, error)
+	RemoveDeviceScopeByIDV2(ctx context.Context, id string, request *RemoveDeviceScopeRequest) (*ResourceDeviceScope, *resty.Response, error)
+	ReplaceDeviceScopeByIDV2(ctx context.Context, id string, request *ReplaceDeviceScopeRequest) (*ResourceDeviceScope, *resty.Response, error)
+	UpdateByIDV3(ctx context.Context, id string, request *ResourceComputerPrestage) (*ResourceComputerPrestage, *resty.Response, error)
+	UpdateByNameV3(ctx context.Context, name string, request *ResourceComputerPrestage) (*ResourceComputerPrestage, *resty.Response, error)
+}
+
+var _ Service = (*ComputerPrestages)(nil)
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the conditional_access
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/conditional_access"
+	"resty.dev/v3"
+)
+
+// ConditionalAccessFake is a conditional_access.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ConditionalAccessFake struct {
+	fakes.Journal
+
+	GetDeviceComplianceFeatureToggleV1Func       func(ctx context.Context) (*conditional_access.ResourceDeviceComplianceStatus, *resty.Response, error)
+	GetDeviceComplianceInformationComputerV1Func func(ctx context.Context, deviceId string) ([]conditional_access.ResourceDeviceComplianceInfo, *resty.Response, error)
+	GetDeviceComplianceInformationMobileV1Func   func(ctx context.Context, deviceId string) ([]conditional_access.ResourceDeviceComplianceInfo, *resty.Response, error)
+}
+
+var _ conditional_access.Service = (*ConditionalAccessFake)(nil)
+
+// GetDeviceComplianceFeatureToggleV1 records the call and delegates to GetDeviceComplianceFeatureToggleV1Func.
+func (f *ConditionalAccessFake) GetDeviceComplianceFeatureToggleV1(ctx context.Context) (*conditional_access.ResourceDeviceComplianceStatus, *resty.Response, error) {
+	f.Record("GetDeviceComplianceFeatureToggleV1", ctx)
+	if f.GetDeviceComplianceFeatureToggleV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ConditionalAccessFake.GetDeviceComplianceFeatureToggleV1")
+	}
+	return f.GetDeviceComplianceFeatureToggleV1Func(ctx)
+}
+
+// GetDeviceComplianceInformationComputerV1 records the call and delegates to GetDeviceComplianceInformationComputerV1Func.
+func (f *ConditionalAccessFake) GetDeviceComplianceInformationComputerV1(ctx context.Context, deviceId string) ([]conditional_access.ResourceDeviceComplianceInfo, *resty.Response, error) {
+	f.Record("GetDeviceComplianceInformationComputerV1", ctx, deviceId)
+	if f.GetDeviceComplianceInformationComputerV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ConditionalAccessFake.GetDeviceComplianceInformationComputerV1")
+	}
+	return f.GetDeviceComplianceInformationComputerV1Func(ctx, deviceId)
+}
+
+// GetDeviceComplianceInformationMobileV1 records the call and delegates to GetDeviceComplianceInformationMobileV1Func.
+func (f *ConditionalAccessFake) GetDeviceComplianceInformationMobileV1(ctx context.Context, deviceId string) ([]conditional_access.ResourceDeviceComplianceInfo, *resty.Response, error) {
+	f.Record("GetDeviceComplianceInformationMobileV1", ctx, deviceId)
+	if f.GetDeviceComplianceInformationMobileV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ConditionalAccessFake.GetDeviceComplianceInformationMobileV1")
+	}
+	return f.GetDeviceComplianceInformationMobileV1Func(ctx, deviceId)
+}
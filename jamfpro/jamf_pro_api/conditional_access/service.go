@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package conditional_access
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ConditionalAccess.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ConditionalAccessFake implements it for tests.
+type Service interface {
+	GetDeviceComplianceFeatureToggleV1(ctx context.Context) (*ResourceDeviceComplianceStatus, *resty.Response, error)
+	GetDeviceComplianceInformationComputerV1(ctx context.Context, deviceId string) ([]ResourceDeviceComplianceInfo, *resty.Response, error)
+	GetDeviceComplianceInformationMobileV1(ctx context.Context, deviceId string) ([]ResourceDeviceComplianceInfo, *resty.Response, error)
+}
+
+var _ Service = (*ConditionalAccess)(nil)
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the csa
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/csa"
+	"resty.dev/v3"
+)
+
+// CsaFake is a csa.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type CsaFake struct {
+	fakes.Journal
+
+	DeleteTokenExchangeV1Func     func(ctx context.Context) (*resty.Response, error)
+	GetTenantIDV1Func             func(ctx context.Context) (*csa.ResourceTenantID, *resty.Response, error)
+	GetTokenExchangeDetailsV1Func func(ctx context.Context) (*csa.ResourceTokenExchangeDetails, *resty.Response, error)
+}
+
+var _ csa.Service = (*CsaFake)(nil)
+
+// DeleteTokenExchangeV1 records the call and delegates to DeleteTokenExchangeV1Func.
+func (f *CsaFake) DeleteTokenExchangeV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("DeleteTokenExchangeV1", ctx)
+	if f.DeleteTokenExchangeV1Func == nil {
+		return nil, fakes.NotStubbed("CsaFake.DeleteTokenExchangeV1")
+	}
+	return f.DeleteTokenExchangeV1Func(ctx)
+}
+
+// GetTenantIDV1 records the call and delegates to GetTenantIDV1Func.
+func (f *CsaFake) GetTenantIDV1(ctx context.Context) (*csa.ResourceTenantID, *resty.Response, error) {
+	f.Record("GetTenantIDV1", ctx)
+	if f.GetTenantIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CsaFake.GetTenantIDV1")
+	}
+	return f.GetTenantIDV1Func(ctx)
+}
+
+// GetTokenExchangeDetailsV1 records the call and delegates to GetTokenExchangeDetailsV1Func.
+func (f *CsaFake) GetTokenExchangeDetailsV1(ctx context.Context) (*csa.ResourceTokenExchangeDetails, *resty.Response, error) {
+	f.Record("GetTokenExchangeDetailsV1", ctx)
+	if f.GetTokenExchangeDetailsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("CsaFake.GetTokenExchangeDetailsV1")
+	}
+	return f.GetTokenExchangeDetailsV1Func(ctx)
+}
@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package csa
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Csa.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.CsaFake implements it for tests.
+type Service interface {
+	DeleteTokenExchangeV1(ctx context.Context) (*resty.Response, error)
+	GetTenantIDV1(ctx context.Context) (*ResourceTenantID, *resty.Response, error)
+	GetTokenExchangeDetailsV1(ctx context.Context) (*ResourceTokenExchangeDetails, *resty.Response, error)
+}
+
+var _ Service = (*Csa)(nil)
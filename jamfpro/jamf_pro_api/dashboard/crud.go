@@ -0,0 +1,84 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the dashboard-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-dashboard
+	Dashboard struct {
+		client client.Client
+	}
+)
+
+func NewDashboard(client client.Client) *Dashboard {
+	return &Dashboard{client: client}
+}
+
+// GetV1 returns the dashboard widgets and setup tasks.
+// URL: GET /api/v1/dashboard
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-dashboard
+func (s *Dashboard) GetV1(ctx context.Context) (*ResourceDashboard, *resty.Response, error) {
+	endpoint := constants.EndpointJamfProDashboardV1
+
+	var result ResourceDashboard
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get dashboard: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// ToggleV1 adds an object to, or removes it from, the dashboard. Adding an object
+// that is already shown is a no-op on the server.
+// URL: POST /api/v1/dashboard/toggle
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-dashboard-toggle
+func (s *Dashboard) ToggleV1(ctx context.Context, request *RequestToggle) (*ResponseToggle, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.ObjectID == "" {
+		return nil, nil, fmt.Errorf("object ID is required")
+	}
+	if _, ok := validObjectTypes[request.ObjectType]; !ok {
+		return nil, nil, fmt.Errorf("invalid object type %q", request.ObjectType)
+	}
+
+	endpoint := constants.EndpointJamfProDashboardToggleV1
+
+	var result ResponseToggle
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to toggle %s %s on dashboard: %w", request.ObjectType, request.ObjectID, err)
+	}
+
+	return &result, resp, nil
+}
+
+// AddObjectV1 shows the given object on the dashboard.
+func (s *Dashboard) AddObjectV1(ctx context.Context, objectType, objectID string) (*ResponseToggle, *resty.Response, error) {
+	return s.ToggleV1(ctx, &RequestToggle{ObjectType: objectType, ObjectID: objectID, Enabled: true})
+}
+
+// RemoveObjectV1 removes the given object from the dashboard.
+func (s *Dashboard) RemoveObjectV1(ctx context.Context, objectType, objectID string) (*ResponseToggle, *resty.Response, error) {
+	return s.ToggleV1(ctx, &RequestToggle{ObjectType: objectType, ObjectID: objectID, Enabled: false})
+}
@@ -0,0 +1,108 @@
+package dashboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dashboard/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*Dashboard, *mocks.DashboardMock) {
+	t.Helper()
+	mock := mocks.NewDashboardMock()
+	mock.RegisterMocks()
+	return NewDashboard(mock), mock
+}
+
+func TestUnit_Dashboard_GetV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+
+	ssl := result.SetupTaskOptions[SetupTaskSSL]
+	require.True(t, ssl.Available)
+	require.NotNil(t, ssl.Error)
+	require.Equal(t, "500", ssl.Error.HTTPStatusCode.String())
+	require.False(t, result.SetupTaskOptions[SetupTaskSMTPServer].Available)
+
+	patch := result.FeatureOptions[ObjectTypePatchSoftwareTitleConfiguration]
+	require.Len(t, patch, 1)
+	require.Equal(t, "Patch Report", *patch[0].Title)
+	require.Len(t, patch[0].Metrics, 2)
+	require.Equal(t, "Remaining", patch[0].Metrics[1].Tag)
+	require.Equal(t, DashboardDetail{Label: "10.2.001.4", Value: "24"}, patch[0].Details[0])
+
+	policy := result.FeatureOptions[ObjectTypePolicy]
+	require.Len(t, policy, 1)
+	require.Nil(t, policy[0].Subtitle)
+	require.False(t, policy[0].Enabled)
+	require.Equal(t, "500", policy[0].Error.HTTPStatusCode.String())
+
+	require.Empty(t, result.FeatureOptions[ObjectTypeComputerGroup])
+}
+
+func TestUnit_Dashboard_GetV1_Error(t *testing.T) {
+	mock := mocks.NewDashboardMock()
+	mock.RegisterErrorMocks()
+	svc := NewDashboard(mock)
+	result, resp, err := svc.GetV1(context.Background())
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Equal(t, 500, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to get dashboard")
+}
+
+func TestUnit_Dashboard_ToggleV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.ToggleV1(context.Background(), &RequestToggle{
+		ObjectID:   "7",
+		ObjectType: ObjectTypePolicy,
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 202, resp.StatusCode())
+	require.Equal(t, "7", result.ID)
+}
+
+func TestUnit_Dashboard_AddAndRemoveObjectV1(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	_, resp, err := svc.AddObjectV1(context.Background(), ObjectTypeComputerGroup, "12")
+	require.NoError(t, err)
+	require.Equal(t, 202, resp.StatusCode())
+
+	_, resp, err = svc.RemoveObjectV1(context.Background(), ObjectTypePatchSoftwareTitleConfiguration, "1")
+	require.NoError(t, err)
+	require.Equal(t, 202, resp.StatusCode())
+}
+
+func TestUnit_Dashboard_ToggleV1_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+	ctx := context.Background()
+
+	_, resp, err := svc.ToggleV1(ctx, nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+
+	_, _, err = svc.ToggleV1(ctx, &RequestToggle{ObjectType: ObjectTypePolicy})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "object ID is required")
+
+	_, _, err = svc.AddObjectV1(ctx, "TYPE_SCRIPT", "1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid object type "TYPE_SCRIPT"`)
+}
+
+func TestUnit_Dashboard_ToggleV1_Error(t *testing.T) {
+	mock := mocks.NewDashboardMock()
+	mock.RegisterErrorMocks()
+	svc := NewDashboard(mock)
+	_, resp, err := svc.AddObjectV1(context.Background(), ObjectTypePolicy, "999")
+	require.Error(t, err)
+	require.Equal(t, 400, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to toggle TYPE_POLICY 999 on dashboard")
+}
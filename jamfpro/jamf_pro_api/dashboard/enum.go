@@ -0,0 +1,38 @@
+package dashboard
+
+// ObjectType* constants identify the kinds of object that can be placed on the dashboard.
+// They are the keys of ResourceDashboard.FeatureOptions and the values accepted by
+// RequestToggle.ObjectType.
+const (
+	ObjectTypeIOSConfigurationProfile         = "TYPE_IOS_CONFIGURATION_PROFILE"
+	ObjectTypeMacOSConfigurationProfile       = "TYPE_MACOS_CONFIGURATION_PROFILE"
+	ObjectTypeUserGroup                       = "TYPE_USER_GROUP"
+	ObjectTypeLicensedSoftware                = "TYPE_LICENSED_SOFTWARE"
+	ObjectTypePatchSoftwareTitleConfiguration = "TYPE_PATCH_SOFTWARE_TITLE_CONFIGURATION"
+	ObjectTypePatchPolicy                     = "TYPE_PATCH_POLICY"
+	ObjectTypePolicy                          = "TYPE_POLICY"
+	ObjectTypeComputerGroup                   = "TYPE_COMPUTER_GROUP"
+	ObjectTypeMobileDeviceGroup               = "TYPE_MOBILE_DEVICE_GROUP"
+	ObjectTypeDigiCertPKIManagerSettings      = "TYPE_DIGICERT_PKI_MANAGER_SETTINGS"
+)
+
+// SetupTask* constants are the keys of ResourceDashboard.SetupTaskOptions.
+const (
+	SetupTaskLDAPServer               = "TYPE_LDAP_SERVER_SETUP_TASK"
+	SetupTaskPushNotificationSettings = "TYPE_PUSH_NOTIFICATION_SETTINGS_SETUP_TASK"
+	SetupTaskSMTPServer               = "TYPE_SMTP_SERVER_SETUP_TASK"
+	SetupTaskSSL                      = "TYPE_SSL_SETUP_TASK"
+)
+
+var validObjectTypes = map[string]struct{}{
+	ObjectTypeIOSConfigurationProfile:         {},
+	ObjectTypeMacOSConfigurationProfile:       {},
+	ObjectTypeUserGroup:                       {},
+	ObjectTypeLicensedSoftware:                {},
+	ObjectTypePatchSoftwareTitleConfiguration: {},
+	ObjectTypePatchPolicy:                     {},
+	ObjectTypePolicy:                          {},
+	ObjectTypeComputerGroup:                   {},
+	ObjectTypeMobileDeviceGroup:               {},
+	ObjectTypeDigiCertPKIManagerSettings:      {},
+}
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the dashboard
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dashboard"
+	"resty.dev/v3"
+)
+
+// DashboardFake is a dashboard.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DashboardFake struct {
+	fakes.Journal
+
+	AddObjectV1Func    func(ctx context.Context, objectType string, objectID string) (*dashboard.ResponseToggle, *resty.Response, error)
+	GetV1Func          func(ctx context.Context) (*dashboard.ResourceDashboard, *resty.Response, error)
+	RemoveObjectV1Func func(ctx context.Context, objectType string, objectID string) (*dashboard.ResponseToggle, *resty.Response, error)
+	ToggleV1Func       func(ctx context.Context, request *dashboard.RequestToggle) (*dashboard.ResponseToggle, *resty.Response, error)
+}
+
+var _ dashboard.Service = (*DashboardFake)(nil)
+
+// AddObjectV1 records the call and delegates to AddObjectV1Func.
+func (f *DashboardFake) AddObjectV1(ctx context.Context, objectType string, objectID string) (*dashboard.ResponseToggle, *resty.Response, error) {
+	f.Record("AddObjectV1", ctx, objectType, objectID)
+	if f.AddObjectV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DashboardFake.AddObjectV1")
+	}
+	return f.AddObjectV1Func(ctx, objectType, objectID)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *DashboardFake) GetV1(ctx context.Context) (*dashboard.ResourceDashboard, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DashboardFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// RemoveObjectV1 records the call and delegates to RemoveObjectV1Func.
+func (f *DashboardFake) RemoveObjectV1(ctx context.Context, objectType string, objectID string) (*dashboard.ResponseToggle, *resty.Response, error) {
+	f.Record("RemoveObjectV1", ctx, objectType, objectID)
+	if f.RemoveObjectV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DashboardFake.RemoveObjectV1")
+	}
+	return f.RemoveObjectV1Func(ctx, objectType, objectID)
+}
+
+// ToggleV1 records the call and delegates to ToggleV1Func.
+func (f *DashboardFake) ToggleV1(ctx context.Context, request *dashboard.RequestToggle) (*dashboard.ResponseToggle, *resty.Response, error) {
+	f.Record("ToggleV1", ctx, request)
+	if f.ToggleV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DashboardFake.ToggleV1")
+	}
+	return f.ToggleV1Func(ctx, request)
+}
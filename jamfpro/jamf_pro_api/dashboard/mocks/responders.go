@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type DashboardMock struct {
+	*mocks.GenericMock
+}
+
+func NewDashboardMock() *DashboardMock {
+	return &DashboardMock{
+		GenericMock: mocks.NewJSONMock("DashboardMock"),
+	}
+}
+
+func (m *DashboardMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/dashboard", 200, "validate_get.json")
+	m.Register("POST", "/api/v1/dashboard/toggle", 202, "validate_toggle.json")
+}
+
+func (m *DashboardMock) RegisterErrorMocks() {
+	m.RegisterError("GET", "/api/v1/dashboard", 500, "error_internal.json", "")
+	m.RegisterError("POST", "/api/v1/dashboard/toggle", 400, "error_bad_request.json", "")
+}
@@ -0,0 +1,63 @@
+package dashboard
+
+import "encoding/json"
+
+// ResourceDashboard is the full dashboard layout: the setup tasks to prompt for and
+// the widgets shown for each object type.
+type ResourceDashboard struct {
+	SetupTaskOptions map[string]SetupTask       `json:"setupTaskOptions"`
+	FeatureOptions   map[string][]DashboardItem `json:"featureOptions"`
+}
+
+// SetupTask reports whether a setup task card is shown on the dashboard.
+type SetupTask struct {
+	Available bool         `json:"available"`
+	Error     *WidgetError `json:"error,omitempty"`
+}
+
+// DashboardItem is a single dashboard widget.
+type DashboardItem struct {
+	ID       string            `json:"id"`
+	Title    *string           `json:"title"`
+	Subtitle *string           `json:"subtitle"`
+	Info     *string           `json:"info"`
+	Enabled  bool              `json:"enabled"`
+	Metrics  []DashboardMetric `json:"metrics,omitempty"`
+	Details  []DashboardDetail `json:"details,omitempty"`
+	Error    *WidgetError      `json:"error,omitempty"`
+}
+
+// DashboardMetric is a tagged count shown on a widget, e.g. "23" Pending.
+type DashboardMetric struct {
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled"`
+	Tag     string `json:"tag"`
+}
+
+// DashboardDetail is a label/value pair shown in a widget legend.
+type DashboardDetail struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// WidgetError describes why the server could not collect data for a widget or
+// setup task. HTTPStatusCode is a json.Number because the spec declares an
+// integer while its own examples send a quoted string; both decode.
+type WidgetError struct {
+	HTTPStatusCode json.Number `json:"httpStatusCode,omitempty"`
+	ID             string      `json:"id"`
+	Description    string      `json:"description"`
+}
+
+// RequestToggle adds (Enabled true) or removes (Enabled false) an object on the dashboard.
+type RequestToggle struct {
+	ObjectID   string `json:"objectId"`
+	ObjectType string `json:"objectType"`
+	Enabled    bool   `json:"enabled"`
+}
+
+// ResponseToggle is returned when a toggle request is accepted.
+type ResponseToggle struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package dashboard
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Dashboard.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DashboardFake implements it for tests.
+type Service interface {
+	AddObjectV1(ctx context.Context, objectType string, objectID string) (*ResponseToggle, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceDashboard, *resty.Response, error)
+	RemoveObjectV1(ctx context.Context, objectType string, objectID string) (*ResponseToggle, *resty.Response, error)
+	ToggleV1(ctx context.Context, request *RequestToggle) (*ResponseToggle, *resty.Response, error)
+}
+
+var _ Service = (*Dashboard)(nil)
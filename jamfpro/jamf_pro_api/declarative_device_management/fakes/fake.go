@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the declarative_device_management
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/declarative_device_management"
+	"resty.dev/v3"
+)
+
+// DeclarativeDeviceManagementFake is a declarative_device_management.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DeclarativeDeviceManagementFake struct {
+	fakes.Journal
+
+	ForceSyncV1Func          func(ctx context.Context, clientManagementID string) (*resty.Response, error)
+	GetStatusItemByKeyV1Func func(ctx context.Context, clientManagementID string, key string) (*declarative_device_management.StatusItem, *resty.Response, error)
+	GetStatusItemsV1Func     func(ctx context.Context, clientManagementID string) (*declarative_device_management.ResourceStatusItems, *resty.Response, error)
+}
+
+var _ declarative_device_management.Service = (*DeclarativeDeviceManagementFake)(nil)
+
+// ForceSyncV1 records the call and delegates to ForceSyncV1Func.
+func (f *DeclarativeDeviceManagementFake) ForceSyncV1(ctx context.Context, clientManagementID string) (*resty.Response, error) {
+	f.Record("ForceSyncV1", ctx, clientManagementID)
+	if f.ForceSyncV1Func == nil {
+		return nil, fakes.NotStubbed("DeclarativeDeviceManagementFake.ForceSyncV1")
+	}
+	return f.ForceSyncV1Func(ctx, clientManagementID)
+}
+
+// GetStatusItemByKeyV1 records the call and delegates to GetStatusItemByKeyV1Func.
+func (f *DeclarativeDeviceManagementFake) GetStatusItemByKeyV1(ctx context.Context, clientManagementID string, key string) (*declarative_device_management.StatusItem, *resty.Response, error) {
+	f.Record("GetStatusItemByKeyV1", ctx, clientManagementID, key)
+	if f.GetStatusItemByKeyV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeclarativeDeviceManagementFake.GetStatusItemByKeyV1")
+	}
+	return f.GetStatusItemByKeyV1Func(ctx, clientManagementID, key)
+}
+
+// GetStatusItemsV1 records the call and delegates to GetStatusItemsV1Func.
+func (f *DeclarativeDeviceManagementFake) GetStatusItemsV1(ctx context.Context, clientManagementID string) (*declarative_device_management.ResourceStatusItems, *resty.Response, error) {
+	f.Record("GetStatusItemsV1", ctx, clientManagementID)
+	if f.GetStatusItemsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeclarativeDeviceManagementFake.GetStatusItemsV1")
+	}
+	return f.GetStatusItemsV1Func(ctx, clientManagementID)
+}
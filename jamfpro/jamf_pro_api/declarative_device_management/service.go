@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package declarative_device_management
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DeclarativeDeviceManagement.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DeclarativeDeviceManagementFake implements it for tests.
+type Service interface {
+	ForceSyncV1(ctx context.Context, clientManagementID string) (*resty.Response, error)
+	GetStatusItemByKeyV1(ctx context.Context, clientManagementID string, key string) (*StatusItem, *resty.Response, error)
+	GetStatusItemsV1(ctx context.Context, clientManagementID string) (*ResourceStatusItems, *resty.Response, error)
+}
+
+var _ Service = (*DeclarativeDeviceManagement)(nil)
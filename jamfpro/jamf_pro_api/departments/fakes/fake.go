@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the departments
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/departments"
+	"resty.dev/v3"
+)
+
+// DepartmentsFake is a departments.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DepartmentsFake struct {
+	fakes.Journal
+
+	AddDepartmentHistoryNotesV1Func func(ctx context.Context, id string, req *departments.AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1Func                    func(ctx context.Context, request *departments.RequestDepartment) (*departments.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func                func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteDepartmentsByIDV1Func     func(ctx context.Context, req *departments.DeleteDepartmentsByIDRequest) (*resty.Response, error)
+	GetByIDV1Func                   func(ctx context.Context, id string) (*departments.ResourceDepartment, *resty.Response, error)
+	GetDepartmentHistoryV1Func      func(ctx context.Context, id string, rsqlQuery map[string]string) (*departments.HistoryResponse, *resty.Response, error)
+	ListV1Func                      func(ctx context.Context, rsqlQuery map[string]string) (*departments.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func                func(ctx context.Context, id string, request *departments.RequestDepartment) (*departments.ResourceDepartment, *resty.Response, error)
+}
+
+var _ departments.Service = (*DepartmentsFake)(nil)
+
+// AddDepartmentHistoryNotesV1 records the call and delegates to AddDepartmentHistoryNotesV1Func.
+func (f *DepartmentsFake) AddDepartmentHistoryNotesV1(ctx context.Context, id string, req *departments.AddHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddDepartmentHistoryNotesV1", ctx, id, req)
+	if f.AddDepartmentHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("DepartmentsFake.AddDepartmentHistoryNotesV1")
+	}
+	return f.AddDepartmentHistoryNotesV1Func(ctx, id, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *DepartmentsFake) CreateV1(ctx context.Context, request *departments.RequestDepartment) (*departments.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DepartmentsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *DepartmentsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("DepartmentsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteDepartmentsByIDV1 records the call and delegates to DeleteDepartmentsByIDV1Func.
+func (f *DepartmentsFake) DeleteDepartmentsByIDV1(ctx context.Context, req *departments.DeleteDepartmentsByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteDepartmentsByIDV1", ctx, req)
+	if f.DeleteDepartmentsByIDV1Func == nil {
+		return nil, fakes.NotStubbed("DepartmentsFake.DeleteDepartmentsByIDV1")
+	}
+	return f.DeleteDepartmentsByIDV1Func(ctx, req)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *DepartmentsFake) GetByIDV1(ctx context.Context, id string) (*departments.ResourceDepartment, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DepartmentsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetDepartmentHistoryV1 records the call and delegates to GetDepartmentHistoryV1Func.
+func (f *DepartmentsFake) GetDepartmentHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*departments.HistoryResponse, *resty.Response, error) {
+	f.Record("GetDepartmentHistoryV1", ctx, id, rsqlQuery)
+	if f.GetDepartmentHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DepartmentsFake.GetDepartmentHistoryV1")
+	}
+	return f.GetDepartmentHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *DepartmentsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*departments.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DepartmentsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *DepartmentsFake) UpdateByIDV1(ctx context.Context, id string, request *departments.RequestDepartment) (*departments.ResourceDepartment, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DepartmentsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package departments
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Departments.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DepartmentsFake implements it for tests.
+type Service interface {
+	AddDepartmentHistoryNotesV1(ctx context.Context, id string, req *AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestDepartment) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteDepartmentsByIDV1(ctx context.Context, req *DeleteDepartmentsByIDRequest) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceDepartment, *resty.Response, error)
+	GetDepartmentHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestDepartment) (*ResourceDepartment, *resty.Response, error)
+}
+
+var _ Service = (*Departments)(nil)
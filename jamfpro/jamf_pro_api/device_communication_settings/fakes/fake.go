@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the device_communication_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/device_communication_settings"
+	"resty.dev/v3"
+)
+
+// DeviceCommunicationSettingsFake is a device_communication_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DeviceCommunicationSettingsFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func func(ctx context.Context, req *device_communication_settings.RequestAddHistoryNotes) (*device_communication_settings.ResponseAddHistoryNotes, *resty.Response, error)
+	GetHistoryV1Func      func(ctx context.Context, rsqlQuery map[string]string) (*device_communication_settings.HistoryResponse, *resty.Response, error)
+	GetV1Func             func(ctx context.Context) (*device_communication_settings.ResourceDeviceCommunicationSettings, *resty.Response, error)
+	UpdateV1Func          func(ctx context.Context, request *device_communication_settings.ResourceDeviceCommunicationSettings) (*device_communication_settings.ResourceDeviceCommunicationSettings, *resty.Response, error)
+}
+
+var _ device_communication_settings.Service = (*DeviceCommunicationSettingsFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *DeviceCommunicationSettingsFake) AddHistoryNotesV1(ctx context.Context, req *device_communication_settings.RequestAddHistoryNotes) (*device_communication_settings.ResponseAddHistoryNotes, *resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, req)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceCommunicationSettingsFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, req)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *DeviceCommunicationSettingsFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*device_communication_settings.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceCommunicationSettingsFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *DeviceCommunicationSettingsFake) GetV1(ctx context.Context) (*device_communication_settings.ResourceDeviceCommunicationSettings, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceCommunicationSettingsFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *DeviceCommunicationSettingsFake) UpdateV1(ctx context.Context, request *device_communication_settings.ResourceDeviceCommunicationSettings) (*device_communication_settings.ResourceDeviceCommunicationSettings, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceCommunicationSettingsFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
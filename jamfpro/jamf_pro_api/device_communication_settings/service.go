@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package device_communication_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DeviceCommunicationSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DeviceCommunicationSettingsFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, req *RequestAddHistoryNotes) (*ResponseAddHistoryNotes, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceDeviceCommunicationSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceDeviceCommunicationSettings) (*ResourceDeviceCommunicationSettings, *resty.Response, error)
+}
+
+var _ Service = (*DeviceCommunicationSettings)(nil)
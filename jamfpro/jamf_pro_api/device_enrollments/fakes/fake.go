@@ -0,0 +1,173 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the device_enrollments
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/device_enrollments"
+	"resty.dev/v3"
+)
+
+// DeviceEnrollmentsFake is a device_enrollments.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DeviceEnrollmentsFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func    func(ctx context.Context, id string, request *device_enrollments.RequestAddHistoryNotes) (*device_enrollments.ResponseAddHistoryNotes, *resty.Response, error)
+	CreateWithTokenV1Func    func(ctx context.Context, request *device_enrollments.RequestTokenUpload) (*device_enrollments.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func         func(ctx context.Context, id string) (*resty.Response, error)
+	DisownDevicesByIDV1Func  func(ctx context.Context, id string, request *device_enrollments.RequestDisown) (*device_enrollments.ResponseDisown, *resty.Response, error)
+	GetAllSyncStatesV1Func   func(ctx context.Context) ([]device_enrollments.ResourceSyncState, *resty.Response, error)
+	GetByIDV1Func            func(ctx context.Context, id string) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error)
+	GetByNameV1Func          func(ctx context.Context, name string) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error)
+	GetDevicesByIDV1Func     func(ctx context.Context, id string) (*device_enrollments.DevicesResponse, *resty.Response, error)
+	GetHistoryV1Func         func(ctx context.Context, id string, rsqlQuery map[string]string) (*device_enrollments.HistoryResponse, *resty.Response, error)
+	GetLatestSyncStateV1Func func(ctx context.Context, id string) (*device_enrollments.ResourceLatestSyncState, *resty.Response, error)
+	GetPublicKeyV1Func       func(ctx context.Context) ([]byte, *resty.Response, error)
+	GetSyncStatesV1Func      func(ctx context.Context, id string) ([]device_enrollments.ResourceSyncState, *resty.Response, error)
+	ListV1Func               func(ctx context.Context, rsqlQuery map[string]string) (*device_enrollments.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func         func(ctx context.Context, id string, request *device_enrollments.RequestUpdate) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error)
+	UpdateTokenByIDV1Func    func(ctx context.Context, id string, request *device_enrollments.RequestTokenUpload) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error)
+}
+
+var _ device_enrollments.Service = (*DeviceEnrollmentsFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *DeviceEnrollmentsFake) AddHistoryNotesV1(ctx context.Context, id string, request *device_enrollments.RequestAddHistoryNotes) (*device_enrollments.ResponseAddHistoryNotes, *resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, id, request)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, id, request)
+}
+
+// CreateWithTokenV1 records the call and delegates to CreateWithTokenV1Func.
+func (f *DeviceEnrollmentsFake) CreateWithTokenV1(ctx context.Context, request *device_enrollments.RequestTokenUpload) (*device_enrollments.CreateResponse, *resty.Response, error) {
+	f.Record("CreateWithTokenV1", ctx, request)
+	if f.CreateWithTokenV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.CreateWithTokenV1")
+	}
+	return f.CreateWithTokenV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *DeviceEnrollmentsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("DeviceEnrollmentsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DisownDevicesByIDV1 records the call and delegates to DisownDevicesByIDV1Func.
+func (f *DeviceEnrollmentsFake) DisownDevicesByIDV1(ctx context.Context, id string, request *device_enrollments.RequestDisown) (*device_enrollments.ResponseDisown, *resty.Response, error) {
+	f.Record("DisownDevicesByIDV1", ctx, id, request)
+	if f.DisownDevicesByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.DisownDevicesByIDV1")
+	}
+	return f.DisownDevicesByIDV1Func(ctx, id, request)
+}
+
+// GetAllSyncStatesV1 records the call and delegates to GetAllSyncStatesV1Func.
+func (f *DeviceEnrollmentsFake) GetAllSyncStatesV1(ctx context.Context) ([]device_enrollments.ResourceSyncState, *resty.Response, error) {
+	f.Record("GetAllSyncStatesV1", ctx)
+	if f.GetAllSyncStatesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetAllSyncStatesV1")
+	}
+	return f.GetAllSyncStatesV1Func(ctx)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *DeviceEnrollmentsFake) GetByIDV1(ctx context.Context, id string) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *DeviceEnrollmentsFake) GetByNameV1(ctx context.Context, name string) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// GetDevicesByIDV1 records the call and delegates to GetDevicesByIDV1Func.
+func (f *DeviceEnrollmentsFake) GetDevicesByIDV1(ctx context.Context, id string) (*device_enrollments.DevicesResponse, *resty.Response, error) {
+	f.Record("GetDevicesByIDV1", ctx, id)
+	if f.GetDevicesByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetDevicesByIDV1")
+	}
+	return f.GetDevicesByIDV1Func(ctx, id)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *DeviceEnrollmentsFake) GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*device_enrollments.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, id, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// GetLatestSyncStateV1 records the call and delegates to GetLatestSyncStateV1Func.
+func (f *DeviceEnrollmentsFake) GetLatestSyncStateV1(ctx context.Context, id string) (*device_enrollments.ResourceLatestSyncState, *resty.Response, error) {
+	f.Record("GetLatestSyncStateV1", ctx, id)
+	if f.GetLatestSyncStateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetLatestSyncStateV1")
+	}
+	return f.GetLatestSyncStateV1Func(ctx, id)
+}
+
+// GetPublicKeyV1 records the call and delegates to GetPublicKeyV1Func.
+func (f *DeviceEnrollmentsFake) GetPublicKeyV1(ctx context.Context) ([]byte, *resty.Response, error) {
+	f.Record("GetPublicKeyV1", ctx)
+	if f.GetPublicKeyV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetPublicKeyV1")
+	}
+	return f.GetPublicKeyV1Func(ctx)
+}
+
+// GetSyncStatesV1 records the call and delegates to GetSyncStatesV1Func.
+func (f *DeviceEnrollmentsFake) GetSyncStatesV1(ctx context.Context, id string) ([]device_enrollments.ResourceSyncState, *resty.Response, error) {
+	f.Record("GetSyncStatesV1", ctx, id)
+	if f.GetSyncStatesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.GetSyncStatesV1")
+	}
+	return f.GetSyncStatesV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *DeviceEnrollmentsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*device_enrollments.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *DeviceEnrollmentsFake) UpdateByIDV1(ctx context.Context, id string, request *device_enrollments.RequestUpdate) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UpdateTokenByIDV1 records the call and delegates to UpdateTokenByIDV1Func.
+func (f *DeviceEnrollmentsFake) UpdateTokenByIDV1(ctx context.Context, id string, request *device_enrollments.RequestTokenUpload) (*device_enrollments.ResourceDeviceEnrollment, *resty.Response, error) {
+	f.Record("UpdateTokenByIDV1", ctx, id, request)
+	if f.UpdateTokenByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DeviceEnrollmentsFake.UpdateTokenByIDV1")
+	}
+	return f.UpdateTokenByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,32 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package device_enrollments
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DeviceEnrollments.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DeviceEnrollmentsFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, id string, request *RequestAddHistoryNotes) (*ResponseAddHistoryNotes, *resty.Response, error)
+	CreateWithTokenV1(ctx context.Context, request *RequestTokenUpload) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DisownDevicesByIDV1(ctx context.Context, id string, request *RequestDisown) (*ResponseDisown, *resty.Response, error)
+	GetAllSyncStatesV1(ctx context.Context) ([]ResourceSyncState, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceDeviceEnrollment, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceDeviceEnrollment, *resty.Response, error)
+	GetDevicesByIDV1(ctx context.Context, id string) (*DevicesResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetLatestSyncStateV1(ctx context.Context, id string) (*ResourceLatestSyncState, *resty.Response, error)
+	GetPublicKeyV1(ctx context.Context) ([]byte, *resty.Response, error)
+	GetSyncStatesV1(ctx context.Context, id string) ([]ResourceSyncState, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestUpdate) (*ResourceDeviceEnrollment, *resty.Response, error)
+	UpdateTokenByIDV1(ctx context.Context, id string, request *RequestTokenUpload) (*ResourceDeviceEnrollment, *resty.Response, error)
+}
+
+var _ Service = (*DeviceEnrollments)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the devices
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/devices"
+	"resty.dev/v3"
+)
+
+// DevicesFake is a devices.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DevicesFake struct {
+	fakes.Journal
+
+	GetGroupsV1Func func(ctx context.Context, id string) ([]devices.ResourceGroup, *resty.Response, error)
+}
+
+var _ devices.Service = (*DevicesFake)(nil)
+
+// GetGroupsV1 records the call and delegates to GetGroupsV1Func.
+func (f *DevicesFake) GetGroupsV1(ctx context.Context, id string) ([]devices.ResourceGroup, *resty.Response, error) {
+	f.Record("GetGroupsV1", ctx, id)
+	if f.GetGroupsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DevicesFake.GetGroupsV1")
+	}
+	return f.GetGroupsV1Func(ctx, id)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package devices
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Devices.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DevicesFake implements it for tests.
+type Service interface {
+	GetGroupsV1(ctx context.Context, id string) ([]ResourceGroup, *resty.Response, error)
+}
+
+var _ Service = (*Devices)(nil)
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the digicert
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/digicert"
+	"resty.dev/v3"
+)
+
+// DigicertFake is a digicert.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DigicertFake struct {
+	fakes.Journal
+
+	CreateFunc                    func(ctx context.Context, request *digicert.ResourceDigicertTrustLifecycleManager) (*digicert.ResponseDigicertTrustLifecycleManagerCreated, *resty.Response, error)
+	DeleteByIDFunc                func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc                   func(ctx context.Context, id string) (*digicert.ResponseDigicertTrustLifecycleManager, *resty.Response, error)
+	GetConnectionStatusByIDFunc   func(ctx context.Context, id string) (*digicert.ConnectionStatusResponse, *resty.Response, error)
+	GetDependenciesByIDFunc       func(ctx context.Context, id string) (*digicert.DependenciesResponse, *resty.Response, error)
+	UpdateByIDFunc                func(ctx context.Context, id string, request *digicert.ResourceDigicertTrustLifecycleManager) (*resty.Response, error)
+	ValidateClientCertificateFunc func(ctx context.Context, request *digicert.ValidateClientCertificateRequest) (*resty.Response, error)
+}
+
+var _ digicert.Service = (*DigicertFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *DigicertFake) Create(ctx context.Context, request *digicert.ResourceDigicertTrustLifecycleManager) (*digicert.ResponseDigicertTrustLifecycleManagerCreated, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("DigicertFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *DigicertFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("DigicertFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *DigicertFake) GetByID(ctx context.Context, id string) (*digicert.ResponseDigicertTrustLifecycleManager, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DigicertFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetConnectionStatusByID records the call and delegates to GetConnectionStatusByIDFunc.
+func (f *DigicertFake) GetConnectionStatusByID(ctx context.Context, id string) (*digicert.ConnectionStatusResponse, *resty.Response, error) {
+	f.Record("GetConnectionStatusByID", ctx, id)
+	if f.GetConnectionStatusByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DigicertFake.GetConnectionStatusByID")
+	}
+	return f.GetConnectionStatusByIDFunc(ctx, id)
+}
+
+// GetDependenciesByID records the call and delegates to GetDependenciesByIDFunc.
+func (f *DigicertFake) GetDependenciesByID(ctx context.Context, id string) (*digicert.DependenciesResponse, *resty.Response, error) {
+	f.Record("GetDependenciesByID", ctx, id)
+	if f.GetDependenciesByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("DigicertFake.GetDependenciesByID")
+	}
+	return f.GetDependenciesByIDFunc(ctx, id)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *DigicertFake) UpdateByID(ctx context.Context, id string, request *digicert.ResourceDigicertTrustLifecycleManager) (*resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, fakes.NotStubbed("DigicertFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
+
+// ValidateClientCertificate records the call and delegates to ValidateClientCertificateFunc.
+func (f *DigicertFake) ValidateClientCertificate(ctx context.Context, request *digicert.ValidateClientCertificateRequest) (*resty.Response, error) {
+	f.Record("ValidateClientCertificate", ctx, request)
+	if f.ValidateClientCertificateFunc == nil {
+		return nil, fakes.NotStubbed("DigicertFake.ValidateClientCertificate")
+	}
+	return f.ValidateClientCertificateFunc(ctx, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package digicert
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Digicert.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DigicertFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *ResourceDigicertTrustLifecycleManager) (*ResponseDigicertTrustLifecycleManagerCreated, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResponseDigicertTrustLifecycleManager, *resty.Response, error)
+	GetConnectionStatusByID(ctx context.Context, id string) (*ConnectionStatusResponse, *resty.Response, error)
+	GetDependenciesByID(ctx context.Context, id string) (*DependenciesResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *ResourceDigicertTrustLifecycleManager) (*resty.Response, error)
+	ValidateClientCertificate(ctx context.Context, request *ValidateClientCertificateRequest) (*resty.Response, error)
+}
+
+var _ Service = (*Digicert)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the distribution_point
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/distribution_point"
+	"resty.dev/v3"
+)
+
+// DistributionPointFake is a distribution_point.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DistributionPointFake struct {
+	fakes.Journal
+
+	CreateHistoryNoteV1Func func(ctx context.Context, id string, note string) (*distribution_point.HistoryEntry, *resty.Response, error)
+	CreateV1Func            func(ctx context.Context, request *distribution_point.RequestDistributionPoint) (*distribution_point.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func        func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteMultipleV1Func    func(ctx context.Context, ids []string) (*resty.Response, error)
+	GetByIDV1Func           func(ctx context.Context, id string) (*distribution_point.ResourceDistributionPoint, *resty.Response, error)
+	GetHistoryByIDV1Func    func(ctx context.Context, id string, rsqlQuery map[string]string) (*distribution_point.HistoryListResponse, *resty.Response, error)
+	ListV1Func              func(ctx context.Context, rsqlQuery map[string]string) (*distribution_point.ListResponse, *resty.Response, error)
+	PatchByIDV1Func         func(ctx context.Context, id string, request *distribution_point.RequestDistributionPoint) (*distribution_point.ResourceDistributionPoint, *resty.Response, error)
+	UpdateByIDV1Func        func(ctx context.Context, id string, request *distribution_point.RequestDistributionPoint) (*distribution_point.ResourceDistributionPoint, *resty.Response, error)
+}
+
+var _ distribution_point.Service = (*DistributionPointFake)(nil)
+
+// CreateHistoryNoteV1 records the call and delegates to CreateHistoryNoteV1Func.
+func (f *DistributionPointFake) CreateHistoryNoteV1(ctx context.Context, id string, note string) (*distribution_point.HistoryEntry, *resty.Response, error) {
+	f.Record("CreateHistoryNoteV1", ctx, id, note)
+	if f.CreateHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.CreateHistoryNoteV1")
+	}
+	return f.CreateHistoryNoteV1Func(ctx, id, note)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *DistributionPointFake) CreateV1(ctx context.Context, request *distribution_point.RequestDistributionPoint) (*distribution_point.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *DistributionPointFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("DistributionPointFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteMultipleV1 records the call and delegates to DeleteMultipleV1Func.
+func (f *DistributionPointFake) DeleteMultipleV1(ctx context.Context, ids []string) (*resty.Response, error) {
+	f.Record("DeleteMultipleV1", ctx, ids)
+	if f.DeleteMultipleV1Func == nil {
+		return nil, fakes.NotStubbed("DistributionPointFake.DeleteMultipleV1")
+	}
+	return f.DeleteMultipleV1Func(ctx, ids)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *DistributionPointFake) GetByIDV1(ctx context.Context, id string) (*distribution_point.ResourceDistributionPoint, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetHistoryByIDV1 records the call and delegates to GetHistoryByIDV1Func.
+func (f *DistributionPointFake) GetHistoryByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*distribution_point.HistoryListResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV1", ctx, id, rsqlQuery)
+	if f.GetHistoryByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.GetHistoryByIDV1")
+	}
+	return f.GetHistoryByIDV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *DistributionPointFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*distribution_point.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// PatchByIDV1 records the call and delegates to PatchByIDV1Func.
+func (f *DistributionPointFake) PatchByIDV1(ctx context.Context, id string, request *distribution_point.RequestDistributionPoint) (*distribution_point.ResourceDistributionPoint, *resty.Response, error) {
+	f.Record("PatchByIDV1", ctx, id, request)
+	if f.PatchByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.PatchByIDV1")
+	}
+	return f.PatchByIDV1Func(ctx, id, request)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *DistributionPointFake) UpdateByIDV1(ctx context.Context, id string, request *distribution_point.RequestDistributionPoint) (*distribution_point.ResourceDistributionPoint, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DistributionPointFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
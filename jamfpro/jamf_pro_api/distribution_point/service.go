@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package distribution_point
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DistributionPoint.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DistributionPointFake implements it for tests.
+type Service interface {
+	CreateHistoryNoteV1(ctx context.Context, id string, note string) (*HistoryEntry, *resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestDistributionPoint) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteMultipleV1(ctx context.Context, ids []string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceDistributionPoint, *resty.Response, error)
+	GetHistoryByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryListResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	PatchByIDV1(ctx context.Context, id string, request *RequestDistributionPoint) (*ResourceDistributionPoint, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestDistributionPoint) (*ResourceDistributionPoint, *resty.Response, error)
+}
+
+var _ Service = (*DistributionPoint)(nil)
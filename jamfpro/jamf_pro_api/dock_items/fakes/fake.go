@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the dock_items
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dock_items"
+	"resty.dev/v3"
+)
+
+// DockItemsFake is a dock_items.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DockItemsFake struct {
+	fakes.Journal
+
+	CreateV1Func     func(ctx context.Context, request *dock_items.RequestDockItem) (*dock_items.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func    func(ctx context.Context, id string) (*dock_items.ResourceDockItem, *resty.Response, error)
+	UpdateByIDV1Func func(ctx context.Context, id string, request *dock_items.RequestDockItem) (*dock_items.ResourceDockItem, *resty.Response, error)
+}
+
+var _ dock_items.Service = (*DockItemsFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *DockItemsFake) CreateV1(ctx context.Context, request *dock_items.RequestDockItem) (*dock_items.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DockItemsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *DockItemsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("DockItemsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *DockItemsFake) GetByIDV1(ctx context.Context, id string) (*dock_items.ResourceDockItem, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DockItemsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *DockItemsFake) UpdateByIDV1(ctx context.Context, id string, request *dock_items.RequestDockItem) (*dock_items.ResourceDockItem, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DockItemsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
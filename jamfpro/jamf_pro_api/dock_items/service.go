@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package dock_items
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DockItems.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DockItemsFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *RequestDockItem) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceDockItem, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestDockItem) (*ResourceDockItem, *resty.Response, error)
+}
+
+var _ Service = (*DockItems)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the dss_declarations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dss_declarations"
+	"resty.dev/v3"
+)
+
+// DssDeclarationsFake is a dss_declarations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type DssDeclarationsFake struct {
+	fakes.Journal
+
+	GetByUUIDV1Func func(ctx context.Context, uuid string) (*dss_declarations.ResponseDSSDeclaration, *resty.Response, error)
+}
+
+var _ dss_declarations.Service = (*DssDeclarationsFake)(nil)
+
+// GetByUUIDV1 records the call and delegates to GetByUUIDV1Func.
+func (f *DssDeclarationsFake) GetByUUIDV1(ctx context.Context, uuid string) (*dss_declarations.ResponseDSSDeclaration, *resty.Response, error) {
+	f.Record("GetByUUIDV1", ctx, uuid)
+	if f.GetByUUIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("DssDeclarationsFake.GetByUUIDV1")
+	}
+	return f.GetByUUIDV1Func(ctx, uuid)
+}
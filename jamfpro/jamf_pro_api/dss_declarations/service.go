@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package dss_declarations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of DssDeclarations.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.DssDeclarationsFake implements it for tests.
+type Service interface {
+	GetByUUIDV1(ctx context.Context, uuid string) (*ResponseDSSDeclaration, *resty.Response, error)
+}
+
+var _ Service = (*DssDeclarations)(nil)
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the ebooks
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/ebooks"
+	"resty.dev/v3"
+)
+
+// EbooksFake is a ebooks.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EbooksFake struct {
+	fakes.Journal
+
+	GetByIDV1Func      func(ctx context.Context, id string) (*ebooks.ResourceEbook, *resty.Response, error)
+	GetScopeByIDV1Func func(ctx context.Context, id string) (*ebooks.ResourceScope, *resty.Response, error)
+	ListV1Func         func(ctx context.Context, rsqlQuery map[string]string) (*ebooks.ListResponse, *resty.Response, error)
+}
+
+var _ ebooks.Service = (*EbooksFake)(nil)
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *EbooksFake) GetByIDV1(ctx context.Context, id string) (*ebooks.ResourceEbook, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetScopeByIDV1 records the call and delegates to GetScopeByIDV1Func.
+func (f *EbooksFake) GetScopeByIDV1(ctx context.Context, id string) (*ebooks.ResourceScope, *resty.Response, error) {
+	f.Record("GetScopeByIDV1", ctx, id)
+	if f.GetScopeByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.GetScopeByIDV1")
+	}
+	return f.GetScopeByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *EbooksFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*ebooks.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("EbooksFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
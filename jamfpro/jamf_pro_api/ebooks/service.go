@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package ebooks
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Ebooks.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EbooksFake implements it for tests.
+type Service interface {
+	GetByIDV1(ctx context.Context, id string) (*ResourceEbook, *resty.Response, error)
+	GetScopeByIDV1(ctx context.Context, id string) (*ResourceScope, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+}
+
+var _ Service = (*Ebooks)(nil)
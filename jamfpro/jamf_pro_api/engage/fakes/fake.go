@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the engage
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/engage"
+	"resty.dev/v3"
+)
+
+// EngageFake is a engage.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EngageFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV2Func func(ctx context.Context, req *engage.RequestAddHistoryNotes) (*engage.ResponseAddHistoryNotes, *resty.Response, error)
+	GetHistoryV2Func      func(ctx context.Context, rsqlQuery map[string]string) (*engage.HistoryResponse, *resty.Response, error)
+	GetV2Func             func(ctx context.Context) (*engage.ResourceEngageSettings, *resty.Response, error)
+	UpdateV2Func          func(ctx context.Context, settings *engage.ResourceEngageSettings) (*engage.ResourceEngageSettings, *resty.Response, error)
+}
+
+var _ engage.Service = (*EngageFake)(nil)
+
+// AddHistoryNotesV2 records the call and delegates to AddHistoryNotesV2Func.
+func (f *EngageFake) AddHistoryNotesV2(ctx context.Context, req *engage.RequestAddHistoryNotes) (*engage.ResponseAddHistoryNotes, *resty.Response, error) {
+	f.Record("AddHistoryNotesV2", ctx, req)
+	if f.AddHistoryNotesV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EngageFake.AddHistoryNotesV2")
+	}
+	return f.AddHistoryNotesV2Func(ctx, req)
+}
+
+// GetHistoryV2 records the call and delegates to GetHistoryV2Func.
+func (f *EngageFake) GetHistoryV2(ctx context.Context, rsqlQuery map[string]string) (*engage.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV2", ctx, rsqlQuery)
+	if f.GetHistoryV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EngageFake.GetHistoryV2")
+	}
+	return f.GetHistoryV2Func(ctx, rsqlQuery)
+}
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *EngageFake) GetV2(ctx context.Context) (*engage.ResourceEngageSettings, *resty.Response, error) {
+	f.Record("GetV2", ctx)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EngageFake.GetV2")
+	}
+	return f.GetV2Func(ctx)
+}
+
+// UpdateV2 records the call and delegates to UpdateV2Func.
+func (f *EngageFake) UpdateV2(ctx context.Context, settings *engage.ResourceEngageSettings) (*engage.ResourceEngageSettings, *resty.Response, error) {
+	f.Record("UpdateV2", ctx, settings)
+	if f.UpdateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EngageFake.UpdateV2")
+	}
+	return f.UpdateV2Func(ctx, settings)
+}
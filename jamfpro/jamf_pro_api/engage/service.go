@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package engage
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Engage.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EngageFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV2(ctx context.Context, req *RequestAddHistoryNotes) (*ResponseAddHistoryNotes, *resty.Response, error)
+	GetHistoryV2(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV2(ctx context.Context) (*ResourceEngageSettings, *resty.Response, error)
+	UpdateV2(ctx context.Context, settings *ResourceEngageSettings) (*ResourceEngageSettings, *resty.Response, error)
+}
+
+var _ Service = (*Engage)(nil)
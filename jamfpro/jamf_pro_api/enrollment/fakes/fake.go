@@ -0,0 +1,213 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the enrollment
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/enrollment"
+	"resty.dev/v3"
+)
+
+// EnrollmentFake is a enrollment.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EnrollmentFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV2Func                func(ctx context.Context, request *enrollment.RequestAddHistoryNotes) (*enrollment.CreateResponse, *resty.Response, error)
+	CreateAccessGroupV3Func              func(ctx context.Context, request *enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup) (*enrollment.CreateResponse, *resty.Response, error)
+	DeleteAccessGroupByIDV3Func          func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteLanguageMessageV3Func          func(ctx context.Context, languageCode string) (*resty.Response, error)
+	DeleteMultipleLanguageMessagesV3Func func(ctx context.Context, request *enrollment.RequestDeleteMultipleLanguages) (*resty.Response, error)
+	ExportHistoryV2Func                  func(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, request *enrollment.RequestExportHistory) ([]byte, *resty.Response, error)
+	GetADUESessionTokenSettingsV1Func    func(ctx context.Context) (*enrollment.ResourceADUESessionTokenSettings, *resty.Response, error)
+	GetAccessGroupByIDV3Func             func(ctx context.Context, id string) (*enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error)
+	GetHistoryV2Func                     func(ctx context.Context, rsqlQuery map[string]string) (*enrollment.HistoryResponse, *resty.Response, error)
+	GetLanguageMessageV3Func             func(ctx context.Context, languageCode string) (*enrollment.ResourceEnrollmentLanguage, *resty.Response, error)
+	GetV4Func                            func(ctx context.Context) (*enrollment.ResourceEnrollment, *resty.Response, error)
+	ListAccessGroupsV3Func               func(ctx context.Context, rsqlQuery map[string]string) (*enrollment.ListResponseAccessGroups, *resty.Response, error)
+	ListFilteredLanguageCodesV3Func      func(ctx context.Context) ([]enrollment.ResourceLanguageCode, *resty.Response, error)
+	ListLanguageCodesV3Func              func(ctx context.Context) ([]enrollment.ResourceLanguageCode, *resty.Response, error)
+	ListLanguageMessagesV3Func           func(ctx context.Context) (*enrollment.ListResponseLanguageMessages, *resty.Response, error)
+	UpdateADUESessionTokenSettingsV1Func func(ctx context.Context, request *enrollment.ResourceADUESessionTokenSettings) (*enrollment.ResourceADUESessionTokenSettings, *resty.Response, error)
+	UpdateAccessGroupByIDV3Func          func(ctx context.Context, id string, request *enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup) (*enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error)
+	UpdateLanguageMessageV3Func          func(ctx context.Context, languageCode string, request *enrollment.ResourceEnrollmentLanguage) (*enrollment.ResourceEnrollmentLanguage, *resty.Response, error)
+	UpdateV4Func                         func(ctx context.Context, request *enrollment.ResourceEnrollment) (*enrollment.ResourceEnrollment, *resty.Response, error)
+}
+
+var _ enrollment.Service = (*EnrollmentFake)(nil)
+
+// AddHistoryNotesV2 records the call and delegates to AddHistoryNotesV2Func.
+func (f *EnrollmentFake) AddHistoryNotesV2(ctx context.Context, request *enrollment.RequestAddHistoryNotes) (*enrollment.CreateResponse, *resty.Response, error) {
+	f.Record("AddHistoryNotesV2", ctx, request)
+	if f.AddHistoryNotesV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.AddHistoryNotesV2")
+	}
+	return f.AddHistoryNotesV2Func(ctx, request)
+}
+
+// CreateAccessGroupV3 records the call and delegates to CreateAccessGroupV3Func.
+func (f *EnrollmentFake) CreateAccessGroupV3(ctx context.Context, request *enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup) (*enrollment.CreateResponse, *resty.Response, error) {
+	f.Record("CreateAccessGroupV3", ctx, request)
+	if f.CreateAccessGroupV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.CreateAccessGroupV3")
+	}
+	return f.CreateAccessGroupV3Func(ctx, request)
+}
+
+// DeleteAccessGroupByIDV3 records the call and delegates to DeleteAccessGroupByIDV3Func.
+func (f *EnrollmentFake) DeleteAccessGroupByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteAccessGroupByIDV3", ctx, id)
+	if f.DeleteAccessGroupByIDV3Func == nil {
+		return nil, fakes.NotStubbed("EnrollmentFake.DeleteAccessGroupByIDV3")
+	}
+	return f.DeleteAccessGroupByIDV3Func(ctx, id)
+}
+
+// DeleteLanguageMessageV3 records the call and delegates to DeleteLanguageMessageV3Func.
+func (f *EnrollmentFake) DeleteLanguageMessageV3(ctx context.Context, languageCode string) (*resty.Response, error) {
+	f.Record("DeleteLanguageMessageV3", ctx, languageCode)
+	if f.DeleteLanguageMessageV3Func == nil {
+		return nil, fakes.NotStubbed("EnrollmentFake.DeleteLanguageMessageV3")
+	}
+	return f.DeleteLanguageMessageV3Func(ctx, languageCode)
+}
+
+// DeleteMultipleLanguageMessagesV3 records the call and delegates to DeleteMultipleLanguageMessagesV3Func.
+func (f *EnrollmentFake) DeleteMultipleLanguageMessagesV3(ctx context.Context, request *enrollment.RequestDeleteMultipleLanguages) (*resty.Response, error) {
+	f.Record("DeleteMultipleLanguageMessagesV3", ctx, request)
+	if f.DeleteMultipleLanguageMessagesV3Func == nil {
+		return nil, fakes.NotStubbed("EnrollmentFake.DeleteMultipleLanguageMessagesV3")
+	}
+	return f.DeleteMultipleLanguageMessagesV3Func(ctx, request)
+}
+
+// ExportHistoryV2 records the call and delegates to ExportHistoryV2Func.
+func (f *EnrollmentFake) ExportHistoryV2(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, request *enrollment.RequestExportHistory) ([]byte, *resty.Response, error) {
+	f.Record("ExportHistoryV2", ctx, acceptHeader, rsqlQuery, request)
+	if f.ExportHistoryV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.ExportHistoryV2")
+	}
+	return f.ExportHistoryV2Func(ctx, acceptHeader, rsqlQuery, request)
+}
+
+// GetADUESessionTokenSettingsV1 records the call and delegates to GetADUESessionTokenSettingsV1Func.
+func (f *EnrollmentFake) GetADUESessionTokenSettingsV1(ctx context.Context) (*enrollment.ResourceADUESessionTokenSettings, *resty.Response, error) {
+	f.Record("GetADUESessionTokenSettingsV1", ctx)
+	if f.GetADUESessionTokenSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.GetADUESessionTokenSettingsV1")
+	}
+	return f.GetADUESessionTokenSettingsV1Func(ctx)
+}
+
+// GetAccessGroupByIDV3 records the call and delegates to GetAccessGroupByIDV3Func.
+func (f *EnrollmentFake) GetAccessGroupByIDV3(ctx context.Context, id string) (*enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error) {
+	f.Record("GetAccessGroupByIDV3", ctx, id)
+	if f.GetAccessGroupByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.GetAccessGroupByIDV3")
+	}
+	return f.GetAccessGroupByIDV3Func(ctx, id)
+}
+
+// GetHistoryV2 records the call and delegates to GetHistoryV2Func.
+func (f *EnrollmentFake) GetHistoryV2(ctx context.Context, rsqlQuery map[string]string) (*enrollment.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV2", ctx, rsqlQuery)
+	if f.GetHistoryV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.GetHistoryV2")
+	}
+	return f.GetHistoryV2Func(ctx, rsqlQuery)
+}
+
+// GetLanguageMessageV3 records the call and delegates to GetLanguageMessageV3Func.
+func (f *EnrollmentFake) GetLanguageMessageV3(ctx context.Context, languageCode string) (*enrollment.ResourceEnrollmentLanguage, *resty.Response, error) {
+	f.Record("GetLanguageMessageV3", ctx, languageCode)
+	if f.GetLanguageMessageV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.GetLanguageMessageV3")
+	}
+	return f.GetLanguageMessageV3Func(ctx, languageCode)
+}
+
+// GetV4 records the call and delegates to GetV4Func.
+func (f *EnrollmentFake) GetV4(ctx context.Context) (*enrollment.ResourceEnrollment, *resty.Response, error) {
+	f.Record("GetV4", ctx)
+	if f.GetV4Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.GetV4")
+	}
+	return f.GetV4Func(ctx)
+}
+
+// ListAccessGroupsV3 records the call and delegates to ListAccessGroupsV3Func.
+func (f *EnrollmentFake) ListAccessGroupsV3(ctx context.Context, rsqlQuery map[string]string) (*enrollment.ListResponseAccessGroups, *resty.Response, error) {
+	f.Record("ListAccessGroupsV3", ctx, rsqlQuery)
+	if f.ListAccessGroupsV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.ListAccessGroupsV3")
+	}
+	return f.ListAccessGroupsV3Func(ctx, rsqlQuery)
+}
+
+// ListFilteredLanguageCodesV3 records the call and delegates to ListFilteredLanguageCodesV3Func.
+func (f *EnrollmentFake) ListFilteredLanguageCodesV3(ctx context.Context) ([]enrollment.ResourceLanguageCode, *resty.Response, error) {
+	f.Record("ListFilteredLanguageCodesV3", ctx)
+	if f.ListFilteredLanguageCodesV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.ListFilteredLanguageCodesV3")
+	}
+	return f.ListFilteredLanguageCodesV3Func(ctx)
+}
+
+// ListLanguageCodesV3 records the call and delegates to ListLanguageCodesV3Func.
+func (f *EnrollmentFake) ListLanguageCodesV3(ctx context.Context) ([]enrollment.ResourceLanguageCode, *resty.Response, error) {
+	f.Record("ListLanguageCodesV3", ctx)
+	if f.ListLanguageCodesV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.ListLanguageCodesV3")
+	}
+	return f.ListLanguageCodesV3Func(ctx)
+}
+
+// ListLanguageMessagesV3 records the call and delegates to ListLanguageMessagesV3Func.
+func (f *EnrollmentFake) ListLanguageMessagesV3(ctx context.Context) (*enrollment.ListResponseLanguageMessages, *resty.Response, error) {
+	f.Record("ListLanguageMessagesV3", ctx)
+	if f.ListLanguageMessagesV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.ListLanguageMessagesV3")
+	}
+	return f.ListLanguageMessagesV3Func(ctx)
+}
+
+// UpdateADUESessionTokenSettingsV1 records the call and delegates to UpdateADUESessionTokenSettingsV1Func.
+func (f *EnrollmentFake) UpdateADUESessionTokenSettingsV1(ctx context.Context, request *enrollment.ResourceADUESessionTokenSettings) (*enrollment.ResourceADUESessionTokenSettings, *resty.Response, error) {
+	f.Record("UpdateADUESessionTokenSettingsV1", ctx, request)
+	if f.UpdateADUESessionTokenSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.UpdateADUESessionTokenSettingsV1")
+	}
+	return f.UpdateADUESessionTokenSettingsV1Func(ctx, request)
+}
+
+// UpdateAccessGroupByIDV3 records the call and delegates to UpdateAccessGroupByIDV3Func.
+func (f *EnrollmentFake) UpdateAccessGroupByIDV3(ctx context.Context, id string, request *enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup) (*enrollment.ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error) {
+	f.Record("UpdateAccessGroupByIDV3", ctx, id, request)
+	if f.UpdateAccessGroupByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.UpdateAccessGroupByIDV3")
+	}
+	return f.UpdateAccessGroupByIDV3Func(ctx, id, request)
+}
+
+// UpdateLanguageMessageV3 records the call and delegates to UpdateLanguageMessageV3Func.
+func (f *EnrollmentFake) UpdateLanguageMessageV3(ctx context.Context, languageCode string, request *enrollment.ResourceEnrollmentLanguage) (*enrollment.ResourceEnrollmentLanguage, *resty.Response, error) {
+	f.Record("UpdateLanguageMessageV3", ctx, languageCode, request)
+	if f.UpdateLanguageMessageV3Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.UpdateLanguageMessageV3")
+	}
+	return f.UpdateLanguageMessageV3Func(ctx, languageCode, request)
+}
+
+// UpdateV4 records the call and delegates to UpdateV4Func.
+func (f *EnrollmentFake) UpdateV4(ctx context.Context, request *enrollment.ResourceEnrollment) (*enrollment.ResourceEnrollment, *resty.Response, error) {
+	f.Record("UpdateV4", ctx, request)
+	if f.UpdateV4Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentFake.UpdateV4")
+	}
+	return f.UpdateV4Func(ctx, request)
+}
@@ -0,0 +1,36 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package enrollment
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Enrollment.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EnrollmentFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV2(ctx context.Context, request *RequestAddHistoryNotes) (*CreateResponse, *resty.Response, error)
+	CreateAccessGroupV3(ctx context.Context, request *ResourceAccountDrivenUserEnrollmentAccessGroup) (*CreateResponse, *resty.Response, error)
+	DeleteAccessGroupByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteLanguageMessageV3(ctx context.Context, languageCode string) (*resty.Response, error)
+	DeleteMultipleLanguageMessagesV3(ctx context.Context, request *RequestDeleteMultipleLanguages) (*resty.Response, error)
+	ExportHistoryV2(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, request *RequestExportHistory) ([]byte, *resty.Response, error)
+	GetADUESessionTokenSettingsV1(ctx context.Context) (*ResourceADUESessionTokenSettings, *resty.Response, error)
+	GetAccessGroupByIDV3(ctx context.Context, id string) (*ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error)
+	GetHistoryV2(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetLanguageMessageV3(ctx context.Context, languageCode string) (*ResourceEnrollmentLanguage, *resty.Response, error)
+	GetV4(ctx context.Context) (*ResourceEnrollment, *resty.Response, error)
+	ListAccessGroupsV3(ctx context.Context, rsqlQuery map[string]string) (*ListResponseAccessGroups, *resty.Response, error)
+	ListFilteredLanguageCodesV3(ctx context.Context) ([]ResourceLanguageCode, *resty.Response, error)
+	ListLanguageCodesV3(ctx context.Context) ([]ResourceLanguageCode, *resty.Response, error)
+	ListLanguageMessagesV3(ctx context.Context) (*ListResponseLanguageMessages, *resty.Response, error)
+	UpdateADUESessionTokenSettingsV1(ctx context.Context, request *ResourceADUESessionTokenSettings) (*ResourceADUESessionTokenSettings, *resty.Response, error)
+	UpdateAccessGroupByIDV3(ctx context.Context, id string, request *ResourceAccountDrivenUserEnrollmentAccessGroup) (*ResourceAccountDrivenUserEnrollmentAccessGroup, *resty.Response, error)
+	UpdateLanguageMessageV3(ctx context.Context, languageCode string, request *ResourceEnrollmentLanguage) (*ResourceEnrollmentLanguage, *resty.Response, error)
+	UpdateV4(ctx context.Context, request *ResourceEnrollment) (*ResourceEnrollment, *resty.Response, error)
+}
+
+var _ Service = (*Enrollment)(nil)
@@ -0,0 +1,193 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the enrollment_customization_preview
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/enrollment_customization_preview"
+	"resty.dev/v3"
+)
+
+// EnrollmentCustomizationPreviewFake is a enrollment_customization_preview.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EnrollmentCustomizationPreviewFake struct {
+	fakes.Journal
+
+	CreateLdapPanelFunc      func(ctx context.Context, id string, request *enrollment_customization_preview.ResourceLdapPanel) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error)
+	CreateSsoPanelFunc       func(ctx context.Context, id string, request *enrollment_customization_preview.ResourceSsoPanel) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error)
+	CreateTextPanelFunc      func(ctx context.Context, id string, request *enrollment_customization_preview.ResourceTextPanel) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error)
+	DeleteLdapPanelFunc      func(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeletePanelFunc          func(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeleteSsoPanelFunc       func(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeleteTextPanelFunc      func(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	GetAllPanelsFunc         func(ctx context.Context, id string) (*enrollment_customization_preview.PanelListResponse, *resty.Response, error)
+	GetLdapPanelFunc         func(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error)
+	GetPanelByIDFunc         func(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourcePanel, *resty.Response, error)
+	GetSsoPanelFunc          func(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error)
+	GetTextPanelFunc         func(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error)
+	GetTextPanelMarkdownFunc func(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResponseTextPanelMarkdown, *resty.Response, error)
+	ParseMarkdownFunc        func(ctx context.Context, request *enrollment_customization_preview.RequestParseMarkdown) (*enrollment_customization_preview.ResponseParseMarkdown, *resty.Response, error)
+	UpdateLdapPanelFunc      func(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceLdapPanel) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error)
+	UpdateSsoPanelFunc       func(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceSsoPanel) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error)
+	UpdateTextPanelFunc      func(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceTextPanel) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error)
+}
+
+var _ enrollment_customization_preview.Service = (*EnrollmentCustomizationPreviewFake)(nil)
+
+// CreateLdapPanel records the call and delegates to CreateLdapPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) CreateLdapPanel(ctx context.Context, id string, request *enrollment_customization_preview.ResourceLdapPanel) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error) {
+	f.Record("CreateLdapPanel", ctx, id, request)
+	if f.CreateLdapPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.CreateLdapPanel")
+	}
+	return f.CreateLdapPanelFunc(ctx, id, request)
+}
+
+// CreateSsoPanel records the call and delegates to CreateSsoPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) CreateSsoPanel(ctx context.Context, id string, request *enrollment_customization_preview.ResourceSsoPanel) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error) {
+	f.Record("CreateSsoPanel", ctx, id, request)
+	if f.CreateSsoPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.CreateSsoPanel")
+	}
+	return f.CreateSsoPanelFunc(ctx, id, request)
+}
+
+// CreateTextPanel records the call and delegates to CreateTextPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) CreateTextPanel(ctx context.Context, id string, request *enrollment_customization_preview.ResourceTextPanel) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error) {
+	f.Record("CreateTextPanel", ctx, id, request)
+	if f.CreateTextPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.CreateTextPanel")
+	}
+	return f.CreateTextPanelFunc(ctx, id, request)
+}
+
+// DeleteLdapPanel records the call and delegates to DeleteLdapPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) DeleteLdapPanel(ctx context.Context, id string, panelID string) (*resty.Response, error) {
+	f.Record("DeleteLdapPanel", ctx, id, panelID)
+	if f.DeleteLdapPanelFunc == nil {
+		return nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.DeleteLdapPanel")
+	}
+	return f.DeleteLdapPanelFunc(ctx, id, panelID)
+}
+
+// DeletePanel records the call and delegates to DeletePanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) DeletePanel(ctx context.Context, id string, panelID string) (*resty.Response, error) {
+	f.Record("DeletePanel", ctx, id, panelID)
+	if f.DeletePanelFunc == nil {
+		return nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.DeletePanel")
+	}
+	return f.DeletePanelFunc(ctx, id, panelID)
+}
+
+// DeleteSsoPanel records the call and delegates to DeleteSsoPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) DeleteSsoPanel(ctx context.Context, id string, panelID string) (*resty.Response, error) {
+	f.Record("DeleteSsoPanel", ctx, id, panelID)
+	if f.DeleteSsoPanelFunc == nil {
+		return nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.DeleteSsoPanel")
+	}
+	return f.DeleteSsoPanelFunc(ctx, id, panelID)
+}
+
+// DeleteTextPanel records the call and delegates to DeleteTextPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) DeleteTextPanel(ctx context.Context, id string, panelID string) (*resty.Response, error) {
+	f.Record("DeleteTextPanel", ctx, id, panelID)
+	if f.DeleteTextPanelFunc == nil {
+		return nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.DeleteTextPanel")
+	}
+	return f.DeleteTextPanelFunc(ctx, id, panelID)
+}
+
+// GetAllPanels records the call and delegates to GetAllPanelsFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetAllPanels(ctx context.Context, id string) (*enrollment_customization_preview.PanelListResponse, *resty.Response, error) {
+	f.Record("GetAllPanels", ctx, id)
+	if f.GetAllPanelsFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetAllPanels")
+	}
+	return f.GetAllPanelsFunc(ctx, id)
+}
+
+// GetLdapPanel records the call and delegates to GetLdapPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetLdapPanel(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error) {
+	f.Record("GetLdapPanel", ctx, id, panelID)
+	if f.GetLdapPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetLdapPanel")
+	}
+	return f.GetLdapPanelFunc(ctx, id, panelID)
+}
+
+// GetPanelByID records the call and delegates to GetPanelByIDFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetPanelByID(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourcePanel, *resty.Response, error) {
+	f.Record("GetPanelByID", ctx, id, panelID)
+	if f.GetPanelByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetPanelByID")
+	}
+	return f.GetPanelByIDFunc(ctx, id, panelID)
+}
+
+// GetSsoPanel records the call and delegates to GetSsoPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetSsoPanel(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error) {
+	f.Record("GetSsoPanel", ctx, id, panelID)
+	if f.GetSsoPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetSsoPanel")
+	}
+	return f.GetSsoPanelFunc(ctx, id, panelID)
+}
+
+// GetTextPanel records the call and delegates to GetTextPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetTextPanel(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error) {
+	f.Record("GetTextPanel", ctx, id, panelID)
+	if f.GetTextPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetTextPanel")
+	}
+	return f.GetTextPanelFunc(ctx, id, panelID)
+}
+
+// GetTextPanelMarkdown records the call and delegates to GetTextPanelMarkdownFunc.
+func (f *EnrollmentCustomizationPreviewFake) GetTextPanelMarkdown(ctx context.Context, id string, panelID string) (*enrollment_customization_preview.ResponseTextPanelMarkdown, *resty.Response, error) {
+	f.Record("GetTextPanelMarkdown", ctx, id, panelID)
+	if f.GetTextPanelMarkdownFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.GetTextPanelMarkdown")
+	}
+	return f.GetTextPanelMarkdownFunc(ctx, id, panelID)
+}
+
+// ParseMarkdown records the call and delegates to ParseMarkdownFunc.
+func (f *EnrollmentCustomizationPreviewFake) ParseMarkdown(ctx context.Context, request *enrollment_customization_preview.RequestParseMarkdown) (*enrollment_customization_preview.ResponseParseMarkdown, *resty.Response, error) {
+	f.Record("ParseMarkdown", ctx, request)
+	if f.ParseMarkdownFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.ParseMarkdown")
+	}
+	return f.ParseMarkdownFunc(ctx, request)
+}
+
+// UpdateLdapPanel records the call and delegates to UpdateLdapPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) UpdateLdapPanel(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceLdapPanel) (*enrollment_customization_preview.ResourceLdapPanel, *resty.Response, error) {
+	f.Record("UpdateLdapPanel", ctx, id, panelID, request)
+	if f.UpdateLdapPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.UpdateLdapPanel")
+	}
+	return f.UpdateLdapPanelFunc(ctx, id, panelID, request)
+}
+
+// UpdateSsoPanel records the call and delegates to UpdateSsoPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) UpdateSsoPanel(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceSsoPanel) (*enrollment_customization_preview.ResourceSsoPanel, *resty.Response, error) {
+	f.Record("UpdateSsoPanel", ctx, id, panelID, request)
+	if f.UpdateSsoPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.UpdateSsoPanel")
+	}
+	return f.UpdateSsoPanelFunc(ctx, id, panelID, request)
+}
+
+// UpdateTextPanel records the call and delegates to UpdateTextPanelFunc.
+func (f *EnrollmentCustomizationPreviewFake) UpdateTextPanel(ctx context.Context, id string, panelID string, request *enrollment_customization_preview.ResourceTextPanel) (*enrollment_customization_preview.ResourceTextPanel, *resty.Response, error) {
+	f.Record("UpdateTextPanel", ctx, id, panelID, request)
+	if f.UpdateTextPanelFunc == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationPreviewFake.UpdateTextPanel")
+	}
+	return f.UpdateTextPanelFunc(ctx, id, panelID, request)
+}
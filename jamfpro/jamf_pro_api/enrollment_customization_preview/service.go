@@ -0,0 +1,34 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package enrollment_customization_preview
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of EnrollmentCustomizationPreview.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EnrollmentCustomizationPreviewFake implements it for tests.
+type Service interface {
+	CreateLdapPanel(ctx context.Context, id string, request *ResourceLdapPanel) (*ResourceLdapPanel, *resty.Response, error)
+	CreateSsoPanel(ctx context.Context, id string, request *ResourceSsoPanel) (*ResourceSsoPanel, *resty.Response, error)
+	CreateTextPanel(ctx context.Context, id string, request *ResourceTextPanel) (*ResourceTextPanel, *resty.Response, error)
+	DeleteLdapPanel(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeletePanel(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeleteSsoPanel(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	DeleteTextPanel(ctx context.Context, id string, panelID string) (*resty.Response, error)
+	GetAllPanels(ctx context.Context, id string) (*PanelListResponse, *resty.Response, error)
+	GetLdapPanel(ctx context.Context, id string, panelID string) (*ResourceLdapPanel, *resty.Response, error)
+	GetPanelByID(ctx context.Context, id string, panelID string) (*ResourcePanel, *resty.Response, error)
+	GetSsoPanel(ctx context.Context, id string, panelID string) (*ResourceSsoPanel, *resty.Response, error)
+	GetTextPanel(ctx context.Context, id string, panelID string) (*ResourceTextPanel, *resty.Response, error)
+	GetTextPanelMarkdown(ctx context.Context, id string, panelID string) (*ResponseTextPanelMarkdown, *resty.Response, error)
+	ParseMarkdown(ctx context.Context, request *RequestParseMarkdown) (*ResponseParseMarkdown, *resty.Response, error)
+	UpdateLdapPanel(ctx context.Context, id string, panelID string, request *ResourceLdapPanel) (*ResourceLdapPanel, *resty.Response, error)
+	UpdateSsoPanel(ctx context.Context, id string, panelID string, request *ResourceSsoPanel) (*ResourceSsoPanel, *resty.Response, error)
+	UpdateTextPanel(ctx context.Context, id string, panelID string, request *ResourceTextPanel) (*ResourceTextPanel, *resty.Response, error)
+}
+
+var _ Service = (*EnrollmentCustomizationPreview)(nil)
@@ -0,0 +1,134 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the enrollment_customizations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/enrollment_customizations"
+	"resty.dev/v3"
+)
+
+// EnrollmentCustomizationsFake is a enrollment_customizations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EnrollmentCustomizationsFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV2Func func(ctx context.Context, id string, req *enrollment_customizations.RequestAddHistoryNotes) (*enrollment_customizations.ResponseAddHistoryNotes, *resty.Response, error)
+	CreateV2Func          func(ctx context.Context, request *enrollment_customizations.ResourceEnrollmentCustomization) (*enrollment_customizations.CreateResponse, *resty.Response, error)
+	DeleteByIDV2Func      func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2Func         func(ctx context.Context, id string) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error)
+	GetByNameV2Func       func(ctx context.Context, name string) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error)
+	GetHistoryV2Func      func(ctx context.Context, id string, rsqlQuery map[string]string) (*enrollment_customizations.HistoryResponse, *resty.Response, error)
+	GetImageByIdV2Func    func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetPrestagesV2Func    func(ctx context.Context, id string) (*enrollment_customizations.PrestagesResponse, *resty.Response, error)
+	ListV2Func            func(ctx context.Context, rsqlQuery map[string]string) (*enrollment_customizations.ListResponse, *resty.Response, error)
+	UpdateByIDV2Func      func(ctx context.Context, id string, request *enrollment_customizations.ResourceEnrollmentCustomization) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error)
+	UploadImageV2Func     func(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*enrollment_customizations.ImageUploadResponse, *resty.Response, error)
+}
+
+var _ enrollment_customizations.Service = (*EnrollmentCustomizationsFake)(nil)
+
+// AddHistoryNotesV2 records the call and delegates to AddHistoryNotesV2Func.
+func (f *EnrollmentCustomizationsFake) AddHistoryNotesV2(ctx context.Context, id string, req *enrollment_customizations.RequestAddHistoryNotes) (*enrollment_customizations.ResponseAddHistoryNotes, *resty.Response, error) {
+	f.Record("AddHistoryNotesV2", ctx, id, req)
+	if f.AddHistoryNotesV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.AddHistoryNotesV2")
+	}
+	return f.AddHistoryNotesV2Func(ctx, id, req)
+}
+
+// CreateV2 records the call and delegates to CreateV2Func.
+func (f *EnrollmentCustomizationsFake) CreateV2(ctx context.Context, request *enrollment_customizations.ResourceEnrollmentCustomization) (*enrollment_customizations.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV2", ctx, request)
+	if f.CreateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.CreateV2")
+	}
+	return f.CreateV2Func(ctx, request)
+}
+
+// DeleteByIDV2 records the call and delegates to DeleteByIDV2Func.
+func (f *EnrollmentCustomizationsFake) DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV2", ctx, id)
+	if f.DeleteByIDV2Func == nil {
+		return nil, fakes.NotStubbed("EnrollmentCustomizationsFake.DeleteByIDV2")
+	}
+	return f.DeleteByIDV2Func(ctx, id)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *EnrollmentCustomizationsFake) GetByIDV2(ctx context.Context, id string) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetByNameV2 records the call and delegates to GetByNameV2Func.
+func (f *EnrollmentCustomizationsFake) GetByNameV2(ctx context.Context, name string) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error) {
+	f.Record("GetByNameV2", ctx, name)
+	if f.GetByNameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.GetByNameV2")
+	}
+	return f.GetByNameV2Func(ctx, name)
+}
+
+// GetHistoryV2 records the call and delegates to GetHistoryV2Func.
+func (f *EnrollmentCustomizationsFake) GetHistoryV2(ctx context.Context, id string, rsqlQuery map[string]string) (*enrollment_customizations.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV2", ctx, id, rsqlQuery)
+	if f.GetHistoryV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.GetHistoryV2")
+	}
+	return f.GetHistoryV2Func(ctx, id, rsqlQuery)
+}
+
+// GetImageByIdV2 records the call and delegates to GetImageByIdV2Func.
+func (f *EnrollmentCustomizationsFake) GetImageByIdV2(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("GetImageByIdV2", ctx, id)
+	if f.GetImageByIdV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.GetImageByIdV2")
+	}
+	return f.GetImageByIdV2Func(ctx, id)
+}
+
+// GetPrestagesV2 records the call and delegates to GetPrestagesV2Func.
+func (f *EnrollmentCustomizationsFake) GetPrestagesV2(ctx context.Context, id string) (*enrollment_customizations.PrestagesResponse, *resty.Response, error) {
+	f.Record("GetPrestagesV2", ctx, id)
+	if f.GetPrestagesV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.GetPrestagesV2")
+	}
+	return f.GetPrestagesV2Func(ctx, id)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *EnrollmentCustomizationsFake) ListV2(ctx context.Context, rsqlQuery map[string]string) (*enrollment_customizations.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx, rsqlQuery)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.ListV2")
+	}
+	return f.ListV2Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *EnrollmentCustomizationsFake) UpdateByIDV2(ctx context.Context, id string, request *enrollment_customizations.ResourceEnrollmentCustomization) (*enrollment_customizations.ResourceEnrollmentCustomization, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, request)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, request)
+}
+
+// UploadImageV2 records the call and delegates to UploadImageV2Func.
+func (f *EnrollmentCustomizationsFake) UploadImageV2(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*enrollment_customizations.ImageUploadResponse, *resty.Response, error) {
+	f.Record("UploadImageV2", ctx, fileReader, fileSize, fileName)
+	if f.UploadImageV2Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentCustomizationsFake.UploadImageV2")
+	}
+	return f.UploadImageV2Func(ctx, fileReader, fileSize, fileName)
+}
@@ -0,0 +1,29 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package enrollment_customizations
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of EnrollmentCustomizations.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EnrollmentCustomizationsFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV2(ctx context.Context, id string, req *RequestAddHistoryNotes) (*ResponseAddHistoryNotes, *resty.Response, error)
+	CreateV2(ctx context.Context, request *ResourceEnrollmentCustomization) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourceEnrollmentCustomization, *resty.Response, error)
+	GetByNameV2(ctx context.Context, name string) (*ResourceEnrollmentCustomization, *resty.Response, error)
+	GetHistoryV2(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetImageByIdV2(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetPrestagesV2(ctx context.Context, id string) (*PrestagesResponse, *resty.Response, error)
+	ListV2(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV2(ctx context.Context, id string, request *ResourceEnrollmentCustomization) (*ResourceEnrollmentCustomization, *resty.Response, error)
+	UploadImageV2(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*ImageUploadResponse, *resty.Response, error)
+}
+
+var _ Service = (*EnrollmentCustomizations)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the enrollment_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/enrollment_settings"
+	"resty.dev/v3"
+)
+
+// EnrollmentSettingsFake is a enrollment_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type EnrollmentSettingsFake struct {
+	fakes.Journal
+
+	GetV4Func func(ctx context.Context) (*enrollment_settings.ResourceEnrollmentSettingsV4, *resty.Response, error)
+}
+
+var _ enrollment_settings.Service = (*EnrollmentSettingsFake)(nil)
+
+// GetV4 records the call and delegates to GetV4Func.
+func (f *EnrollmentSettingsFake) GetV4(ctx context.Context) (*enrollment_settings.ResourceEnrollmentSettingsV4, *resty.Response, error) {
+	f.Record("GetV4", ctx)
+	if f.GetV4Func == nil {
+		return nil, nil, fakes.NotStubbed("EnrollmentSettingsFake.GetV4")
+	}
+	return f.GetV4Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package enrollment_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of EnrollmentSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.EnrollmentSettingsFake implements it for tests.
+type Service interface {
+	GetV4(ctx context.Context) (*ResourceEnrollmentSettingsV4, *resty.Response, error)
+}
+
+var _ Service = (*EnrollmentSettings)(nil)
@@ -0,0 +1,143 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/groups"
+	"resty.dev/v3"
+)
+
+// GroupsFake is a groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type GroupsFake struct {
+	fakes.Journal
+
+	DeleteByIDV1Func             func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV2Func             func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func                func(ctx context.Context, id string) (*groups.ResourceGroup, *resty.Response, error)
+	GetByIDV2Func                func(ctx context.Context, id string) (*groups.ResourceGroup, *resty.Response, error)
+	GetComputerGroupByIDV1Func   func(ctx context.Context, jamfProID string) (*groups.ResourceGroup, *resty.Response, error)
+	GetComputerGroupByNameV1Func func(ctx context.Context, name string) (*groups.ResourceGroup, *resty.Response, error)
+	GetMobileGroupByIDV1Func     func(ctx context.Context, jamfProID string) (*groups.ResourceGroup, *resty.Response, error)
+	GetMobileGroupByNameV1Func   func(ctx context.Context, name string) (*groups.ResourceGroup, *resty.Response, error)
+	ListV1Func                   func(ctx context.Context, rsqlQuery map[string]string) (*groups.ListResponse, *resty.Response, error)
+	ListV2Func                   func(ctx context.Context, rsqlQuery map[string]string) (*groups.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func             func(ctx context.Context, id string, req *groups.RequestUpdateGroup) (*groups.ResourceGroup, *resty.Response, error)
+	UpdateByIDV2Func             func(ctx context.Context, id string, req *groups.RequestUpdateGroupV2) (*groups.ResourceGroup, *resty.Response, error)
+}
+
+var _ groups.Service = (*GroupsFake)(nil)
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *GroupsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("GroupsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteByIDV2 records the call and delegates to DeleteByIDV2Func.
+func (f *GroupsFake) DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV2", ctx, id)
+	if f.DeleteByIDV2Func == nil {
+		return nil, fakes.NotStubbed("GroupsFake.DeleteByIDV2")
+	}
+	return f.DeleteByIDV2Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *GroupsFake) GetByIDV1(ctx context.Context, id string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *GroupsFake) GetByIDV2(ctx context.Context, id string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetComputerGroupByIDV1 records the call and delegates to GetComputerGroupByIDV1Func.
+func (f *GroupsFake) GetComputerGroupByIDV1(ctx context.Context, jamfProID string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetComputerGroupByIDV1", ctx, jamfProID)
+	if f.GetComputerGroupByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetComputerGroupByIDV1")
+	}
+	return f.GetComputerGroupByIDV1Func(ctx, jamfProID)
+}
+
+// GetComputerGroupByNameV1 records the call and delegates to GetComputerGroupByNameV1Func.
+func (f *GroupsFake) GetComputerGroupByNameV1(ctx context.Context, name string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetComputerGroupByNameV1", ctx, name)
+	if f.GetComputerGroupByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetComputerGroupByNameV1")
+	}
+	return f.GetComputerGroupByNameV1Func(ctx, name)
+}
+
+// GetMobileGroupByIDV1 records the call and delegates to GetMobileGroupByIDV1Func.
+func (f *GroupsFake) GetMobileGroupByIDV1(ctx context.Context, jamfProID string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetMobileGroupByIDV1", ctx, jamfProID)
+	if f.GetMobileGroupByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetMobileGroupByIDV1")
+	}
+	return f.GetMobileGroupByIDV1Func(ctx, jamfProID)
+}
+
+// GetMobileGroupByNameV1 records the call and delegates to GetMobileGroupByNameV1Func.
+func (f *GroupsFake) GetMobileGroupByNameV1(ctx context.Context, name string) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("GetMobileGroupByNameV1", ctx, name)
+	if f.GetMobileGroupByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.GetMobileGroupByNameV1")
+	}
+	return f.GetMobileGroupByNameV1Func(ctx, name)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *GroupsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*groups.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *GroupsFake) ListV2(ctx context.Context, rsqlQuery map[string]string) (*groups.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx, rsqlQuery)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.ListV2")
+	}
+	return f.ListV2Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *GroupsFake) UpdateByIDV1(ctx context.Context, id string, req *groups.RequestUpdateGroup) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, req)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, req)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *GroupsFake) UpdateByIDV2(ctx context.Context, id string, req *groups.RequestUpdateGroupV2) (*groups.ResourceGroup, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, req)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("GroupsFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, req)
+}
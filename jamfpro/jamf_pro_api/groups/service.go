@@ -0,0 +1,29 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Groups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.GroupsFake implements it for tests.
+type Service interface {
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceGroup, *resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourceGroup, *resty.Response, error)
+	GetComputerGroupByIDV1(ctx context.Context, jamfProID string) (*ResourceGroup, *resty.Response, error)
+	GetComputerGroupByNameV1(ctx context.Context, name string) (*ResourceGroup, *resty.Response, error)
+	GetMobileGroupByIDV1(ctx context.Context, jamfProID string) (*ResourceGroup, *resty.Response, error)
+	GetMobileGroupByNameV1(ctx context.Context, name string) (*ResourceGroup, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	ListV2(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, req *RequestUpdateGroup) (*ResourceGroup, *resty.Response, error)
+	UpdateByIDV2(ctx context.Context, id string, req *RequestUpdateGroupV2) (*ResourceGroup, *resty.Response, error)
+}
+
+var _ Service = (*Groups)(nil)
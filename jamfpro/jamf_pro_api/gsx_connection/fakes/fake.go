@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the gsx_connection
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/gsx_connection"
+	"resty.dev/v3"
+)
+
+// GsxConnectionFake is a gsx_connection.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type GsxConnectionFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func func(ctx context.Context, request *gsx_connection.AddHistoryNoteRequest) (*gsx_connection.AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1Func     func(ctx context.Context, rsqlQuery map[string]string) (*gsx_connection.HistoryResponse, *resty.Response, error)
+	GetV1Func            func(ctx context.Context) (*gsx_connection.ResourceGSXConnection, *resty.Response, error)
+	ReplaceV1Func        func(ctx context.Context, request *gsx_connection.ResourceGSXConnection) (*gsx_connection.ResourceGSXConnection, *resty.Response, error)
+	TestV1Func           func(ctx context.Context) (*resty.Response, error)
+	UpdateV1Func         func(ctx context.Context, request *gsx_connection.ResourceGSXConnection) (*gsx_connection.ResourceGSXConnection, *resty.Response, error)
+}
+
+var _ gsx_connection.Service = (*GsxConnectionFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *GsxConnectionFake) AddHistoryNoteV1(ctx context.Context, request *gsx_connection.AddHistoryNoteRequest) (*gsx_connection.AddHistoryNoteResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, request)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GsxConnectionFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, request)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *GsxConnectionFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*gsx_connection.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GsxConnectionFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *GsxConnectionFake) GetV1(ctx context.Context) (*gsx_connection.ResourceGSXConnection, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GsxConnectionFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// ReplaceV1 records the call and delegates to ReplaceV1Func.
+func (f *GsxConnectionFake) ReplaceV1(ctx context.Context, request *gsx_connection.ResourceGSXConnection) (*gsx_connection.ResourceGSXConnection, *resty.Response, error) {
+	f.Record("ReplaceV1", ctx, request)
+	if f.ReplaceV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GsxConnectionFake.ReplaceV1")
+	}
+	return f.ReplaceV1Func(ctx, request)
+}
+
+// TestV1 records the call and delegates to TestV1Func.
+func (f *GsxConnectionFake) TestV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("TestV1", ctx)
+	if f.TestV1Func == nil {
+		return nil, fakes.NotStubbed("GsxConnectionFake.TestV1")
+	}
+	return f.TestV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *GsxConnectionFake) UpdateV1(ctx context.Context, request *gsx_connection.ResourceGSXConnection) (*gsx_connection.ResourceGSXConnection, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("GsxConnectionFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package gsx_connection
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of GsxConnection.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.GsxConnectionFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, request *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceGSXConnection, *resty.Response, error)
+	ReplaceV1(ctx context.Context, request *ResourceGSXConnection) (*ResourceGSXConnection, *resty.Response, error)
+	TestV1(ctx context.Context) (*resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceGSXConnection) (*ResourceGSXConnection, *resty.Response, error)
+}
+
+var _ Service = (*GsxConnection)(nil)
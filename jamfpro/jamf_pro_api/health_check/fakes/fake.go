@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the health_check
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/health_check"
+	"resty.dev/v3"
+)
+
+// HealthCheckFake is a health_check.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type HealthCheckFake struct {
+	fakes.Journal
+
+	GetHealthStatusV1Func func(ctx context.Context) (*health_check.ResourceHealthStatus, *resty.Response, error)
+	GetV1Func             func(ctx context.Context) (bool, *resty.Response, error)
+}
+
+var _ health_check.Service = (*HealthCheckFake)(nil)
+
+// GetHealthStatusV1 records the call and delegates to GetHealthStatusV1Func.
+func (f *HealthCheckFake) GetHealthStatusV1(ctx context.Context) (*health_check.ResourceHealthStatus, *resty.Response, error) {
+	f.Record("GetHealthStatusV1", ctx)
+	if f.GetHealthStatusV1Func == nil {
+		return nil, nil, fakes.NotStubbed("HealthCheckFake.GetHealthStatusV1")
+	}
+	return f.GetHealthStatusV1Func(ctx)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *HealthCheckFake) GetV1(ctx context.Context) (bool, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return false, nil, fakes.NotStubbed("HealthCheckFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package health_check
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of HealthCheck.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.HealthCheckFake implements it for tests.
+type Service interface {
+	GetHealthStatusV1(ctx context.Context) (*ResourceHealthStatus, *resty.Response, error)
+	GetV1(ctx context.Context) (bool, *resty.Response, error)
+}
+
+var _ Service = (*HealthCheck)(nil)
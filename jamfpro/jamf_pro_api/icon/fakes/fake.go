@@ -0,0 +1,64 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the icon
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/icon"
+	"resty.dev/v3"
+)
+
+// IconFake is a icon.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type IconFake struct {
+	fakes.Journal
+
+	DownloadV1Func       func(ctx context.Context, id int, res string, scale string) ([]byte, *resty.Response, error)
+	GetByIDV1Func        func(ctx context.Context, id int) (*icon.ResourceIcon, *resty.Response, error)
+	UploadV1Func         func(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*icon.ResourceIcon, *resty.Response, error)
+	UploadV1FromFileFunc func(ctx context.Context, filePath string) (*icon.ResourceIcon, *resty.Response, error)
+}
+
+var _ icon.Service = (*IconFake)(nil)
+
+// DownloadV1 records the call and delegates to DownloadV1Func.
+func (f *IconFake) DownloadV1(ctx context.Context, id int, res string, scale string) ([]byte, *resty.Response, error) {
+	f.Record("DownloadV1", ctx, id, res, scale)
+	if f.DownloadV1Func == nil {
+		return nil, nil, fakes.NotStubbed("IconFake.DownloadV1")
+	}
+	return f.DownloadV1Func(ctx, id, res, scale)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *IconFake) GetByIDV1(ctx context.Context, id int) (*icon.ResourceIcon, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("IconFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// UploadV1 records the call and delegates to UploadV1Func.
+func (f *IconFake) UploadV1(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*icon.ResourceIcon, *resty.Response, error) {
+	f.Record("UploadV1", ctx, fileReader, fileSize, fileName)
+	if f.UploadV1Func == nil {
+		return nil, nil, fakes.NotStubbed("IconFake.UploadV1")
+	}
+	return f.UploadV1Func(ctx, fileReader, fileSize, fileName)
+}
+
+// UploadV1FromFile records the call and delegates to UploadV1FromFileFunc.
+func (f *IconFake) UploadV1FromFile(ctx context.Context, filePath string) (*icon.ResourceIcon, *resty.Response, error) {
+	f.Record("UploadV1FromFile", ctx, filePath)
+	if f.UploadV1FromFileFunc == nil {
+		return nil, nil, fakes.NotStubbed("IconFake.UploadV1FromFile")
+	}
+	return f.UploadV1FromFileFunc(ctx, filePath)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package icon
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Icon.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.IconFake implements it for tests.
+type Service interface {
+	DownloadV1(ctx context.Context, id int, res string, scale string) ([]byte, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id int) (*ResourceIcon, *resty.Response, error)
+	UploadV1(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*ResourceIcon, *resty.Response, error)
+	UploadV1FromFile(ctx context.Context, filePath string) (*ResourceIcon, *resty.Response, error)
+}
+
+var _ Service = (*Icon)(nil)
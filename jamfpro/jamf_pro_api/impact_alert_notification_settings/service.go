@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package impact_alert_notification_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ImpactAlertNotificationSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ImpactAlertNotificationSettingsFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceImpactAlertNotificationSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceImpactAlertNotificationSettings) (*resty.Response, error)
+}
+
+var _ Service = (*ImpactAlertNotificationSettings)(nil)
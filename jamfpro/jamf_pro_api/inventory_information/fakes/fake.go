@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the inventory_information
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/inventory_information"
+	"resty.dev/v3"
+)
+
+// InventoryInformationFake is a inventory_information.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type InventoryInformationFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*inventory_information.ResourceInventoryInformation, *resty.Response, error)
+}
+
+var _ inventory_information.Service = (*InventoryInformationFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *InventoryInformationFake) GetV1(ctx context.Context) (*inventory_information.ResourceInventoryInformation, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("InventoryInformationFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package inventory_information
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of InventoryInformation.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.InventoryInformationFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceInventoryInformation, *resty.Response, error)
+}
+
+var _ Service = (*InventoryInformation)(nil)
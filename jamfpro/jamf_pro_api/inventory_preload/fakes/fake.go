@@ -0,0 +1,174 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the inventory_preload
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/inventory_preload"
+	"resty.dev/v3"
+)
+
+// InventoryPreloadFake is a inventory_preload.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type InventoryPreloadFake struct {
+	fakes.Journal
+
+	AddHistoryNoteFunc    func(ctx context.Context, req *inventory_preload.AddHistoryNoteRequest) (*inventory_preload.AddHistoryNoteResponse, *resty.Response, error)
+	CreateFromCSVFunc     func(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (inventory_preload.CreateFromCSVResponse, *resty.Response, error)
+	CreateFromCSVFileFunc func(ctx context.Context, filePath string) (inventory_preload.CreateFromCSVResponse, *resty.Response, error)
+	CreateRecordFunc      func(ctx context.Context, record *inventory_preload.InventoryPreloadRecord) (*inventory_preload.CreateRecordResponse, *resty.Response, error)
+	DeleteAllRecordsFunc  func(ctx context.Context) (*resty.Response, error)
+	DeleteRecordFunc      func(ctx context.Context, id string) (*resty.Response, error)
+	ExportFunc            func(ctx context.Context, rsqlQuery map[string]string, req *inventory_preload.ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetCSVTemplateFunc    func(ctx context.Context) ([]byte, *resty.Response, error)
+	GetEAColumnsFunc      func(ctx context.Context) (*inventory_preload.ExtensionAttributeColumnResult, *resty.Response, error)
+	GetRecordByIDFunc     func(ctx context.Context, id string) (*inventory_preload.InventoryPreloadRecord, *resty.Response, error)
+	ListHistoryFunc       func(ctx context.Context, rsqlQuery map[string]string) (*inventory_preload.HistoryListResponse, *resty.Response, error)
+	ListRecordsFunc       func(ctx context.Context, rsqlQuery map[string]string) (*inventory_preload.RecordListResponse, *resty.Response, error)
+	UpdateRecordFunc      func(ctx context.Context, id string, record *inventory_preload.InventoryPreloadRecord) (*inventory_preload.InventoryPreloadRecord, *resty.Response, error)
+	ValidateCSVFunc       func(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*inventory_preload.CSVValidationSuccess, *resty.Response, error)
+	ValidateCSVFileFunc   func(ctx context.Context, filePath string) (*inventory_preload.CSVValidationSuccess, *resty.Response, error)
+}
+
+var _ inventory_preload.Service = (*InventoryPreloadFake)(nil)
+
+// AddHistoryNote records the call and delegates to AddHistoryNoteFunc.
+func (f *InventoryPreloadFake) AddHistoryNote(ctx context.Context, req *inventory_preload.AddHistoryNoteRequest) (*inventory_preload.AddHistoryNoteResponse, *resty.Response, error) {
+	f.Record("AddHistoryNote", ctx, req)
+	if f.AddHistoryNoteFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.AddHistoryNote")
+	}
+	return f.AddHistoryNoteFunc(ctx, req)
+}
+
+// CreateFromCSV records the call and delegates to CreateFromCSVFunc.
+func (f *InventoryPreloadFake) CreateFromCSV(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (inventory_preload.CreateFromCSVResponse, *resty.Response, error) {
+	f.Record("CreateFromCSV", ctx, fileReader, fileSize, fileName)
+	if f.CreateFromCSVFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.CreateFromCSV")
+	}
+	return f.CreateFromCSVFunc(ctx, fileReader, fileSize, fileName)
+}
+
+// CreateFromCSVFile records the call and delegates to CreateFromCSVFileFunc.
+func (f *InventoryPreloadFake) CreateFromCSVFile(ctx context.Context, filePath string) (inventory_preload.CreateFromCSVResponse, *resty.Response, error) {
+	f.Record("CreateFromCSVFile", ctx, filePath)
+	if f.CreateFromCSVFileFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.CreateFromCSVFile")
+	}
+	return f.CreateFromCSVFileFunc(ctx, filePath)
+}
+
+// CreateRecord records the call and delegates to CreateRecordFunc.
+func (f *InventoryPreloadFake) CreateRecord(ctx context.Context, record *inventory_preload.InventoryPreloadRecord) (*inventory_preload.CreateRecordResponse, *resty.Response, error) {
+	f.Record("CreateRecord", ctx, record)
+	if f.CreateRecordFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.CreateRecord")
+	}
+	return f.CreateRecordFunc(ctx, record)
+}
+
+// DeleteAllRecords records the call and delegates to DeleteAllRecordsFunc.
+func (f *InventoryPreloadFake) DeleteAllRecords(ctx context.Context) (*resty.Response, error) {
+	f.Record("DeleteAllRecords", ctx)
+	if f.DeleteAllRecordsFunc == nil {
+		return nil, fakes.NotStubbed("InventoryPreloadFake.DeleteAllRecords")
+	}
+	return f.DeleteAllRecordsFunc(ctx)
+}
+
+// DeleteRecord records the call and delegates to DeleteRecordFunc.
+func (f *InventoryPreloadFake) DeleteRecord(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteRecord", ctx, id)
+	if f.DeleteRecordFunc == nil {
+		return nil, fakes.NotStubbed("InventoryPreloadFake.DeleteRecord")
+	}
+	return f.DeleteRecordFunc(ctx, id)
+}
+
+// Export records the call and delegates to ExportFunc.
+func (f *InventoryPreloadFake) Export(ctx context.Context, rsqlQuery map[string]string, req *inventory_preload.ExportRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("Export", ctx, rsqlQuery, req, acceptType)
+	if f.ExportFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.Export")
+	}
+	return f.ExportFunc(ctx, rsqlQuery, req, acceptType)
+}
+
+// GetCSVTemplate records the call and delegates to GetCSVTemplateFunc.
+func (f *InventoryPreloadFake) GetCSVTemplate(ctx context.Context) ([]byte, *resty.Response, error) {
+	f.Record("GetCSVTemplate", ctx)
+	if f.GetCSVTemplateFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.GetCSVTemplate")
+	}
+	return f.GetCSVTemplateFunc(ctx)
+}
+
+// GetEAColumns records the call and delegates to GetEAColumnsFunc.
+func (f *InventoryPreloadFake) GetEAColumns(ctx context.Context) (*inventory_preload.ExtensionAttributeColumnResult, *resty.Response, error) {
+	f.Record("GetEAColumns", ctx)
+	if f.GetEAColumnsFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.GetEAColumns")
+	}
+	return f.GetEAColumnsFunc(ctx)
+}
+
+// GetRecordByID records the call and delegates to GetRecordByIDFunc.
+func (f *InventoryPreloadFake) GetRecordByID(ctx context.Context, id string) (*inventory_preload.InventoryPreloadRecord, *resty.Response, error) {
+	f.Record("GetRecordByID", ctx, id)
+	if f.GetRecordByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.GetRecordByID")
+	}
+	return f.GetRecordByIDFunc(ctx, id)
+}
+
+// ListHistory records the call and delegates to ListHistoryFunc.
+func (f *InventoryPreloadFake) ListHistory(ctx context.Context, rsqlQuery map[string]string) (*inventory_preload.HistoryListResponse, *resty.Response, error) {
+	f.Record("ListHistory", ctx, rsqlQuery)
+	if f.ListHistoryFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.ListHistory")
+	}
+	return f.ListHistoryFunc(ctx, rsqlQuery)
+}
+
+// ListRecords records the call and delegates to ListRecordsFunc.
+func (f *InventoryPreloadFake) ListRecords(ctx context.Context, rsqlQuery map[string]string) (*inventory_preload.RecordListResponse, *resty.Response, error) {
+	f.Record("ListRecords", ctx, rsqlQuery)
+	if f.ListRecordsFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.ListRecords")
+	}
+	return f.ListRecordsFunc(ctx, rsqlQuery)
+}
+
+// UpdateRecord records the call and delegates to UpdateRecordFunc.
+func (f *InventoryPreloadFake) UpdateRecord(ctx context.Context, id string, record *inventory_preload.InventoryPreloadRecord) (*inventory_preload.InventoryPreloadRecord, *resty.Response, error) {
+	f.Record("UpdateRecord", ctx, id, record)
+	if f.UpdateRecordFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.UpdateRecord")
+	}
+	return f.UpdateRecordFunc(ctx, id, record)
+}
+
+// ValidateCSV records the call and delegates to ValidateCSVFunc.
+func (f *InventoryPreloadFake) ValidateCSV(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*inventory_preload.CSVValidationSuccess, *resty.Response, error) {
+	f.Record("ValidateCSV", ctx, fileReader, fileSize, fileName)
+	if f.ValidateCSVFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.ValidateCSV")
+	}
+	return f.ValidateCSVFunc(ctx, fileReader, fileSize, fileName)
+}
+
+// ValidateCSVFile records the call and delegates to ValidateCSVFileFunc.
+func (f *InventoryPreloadFake) ValidateCSVFile(ctx context.Context, filePath string) (*inventory_preload.CSVValidationSuccess, *resty.Response, error) {
+	f.Record("ValidateCSVFile", ctx, filePath)
+	if f.ValidateCSVFileFunc == nil {
+		return nil, nil, fakes.NotStubbed("InventoryPreloadFake.ValidateCSVFile")
+	}
+	return f.ValidateCSVFileFunc(ctx, filePath)
+}
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package inventory_preload
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of InventoryPreload.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.InventoryPreloadFake implements it for tests.
+type Service interface {
+	AddHistoryNote(ctx context.Context, req *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error)
+	CreateFromCSV(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (CreateFromCSVResponse, *resty.Response, error)
+	CreateFromCSVFile(ctx context.Context, filePath string) (CreateFromCSVResponse, *resty.Response, error)
+	CreateRecord(ctx context.Context, record *InventoryPreloadRecord) (*CreateRecordResponse, *resty.Response, error)
+	DeleteAllRecords(ctx context.Context) (*resty.Response, error)
+	DeleteRecord(ctx context.Context, id string) (*resty.Response, error)
+	Export(ctx context.Context, rsqlQuery map[string]string, req *ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetCSVTemplate(ctx context.Context) ([]byte, *resty.Response, error)
+	GetEAColumns(ctx context.Context) (*ExtensionAttributeColumnResult, *resty.Response, error)
+	GetRecordByID(ctx context.Context, id string) (*InventoryPreloadRecord, *resty.Response, error)
+	ListHistory(ctx context.Context, rsqlQuery map[string]string) (*HistoryListResponse, *resty.Response, error)
+	ListRecords(ctx context.Context, rsqlQuery map[string]string) (*RecordListResponse, *resty.Response, error)
+	UpdateRecord(ctx context.Context, id string, record *InventoryPreloadRecord) (*InventoryPreloadRecord, *resty.Response, error)
+	ValidateCSV(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*CSVValidationSuccess, *resty.Response, error)
+	ValidateCSVFile(ctx context.Context, filePath string) (*CSVValidationSuccess, *resty.Response, error)
+}
+
+var _ Service = (*InventoryPreload)(nil)
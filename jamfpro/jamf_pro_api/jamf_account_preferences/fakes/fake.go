@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_account_preferences
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_account_preferences"
+	"resty.dev/v3"
+)
+
+// JamfAccountPreferencesFake is a jamf_account_preferences.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfAccountPreferencesFake struct {
+	fakes.Journal
+
+	GetV3Func    func(ctx context.Context) (*jamf_account_preferences.ResourceAccountPreferences, *resty.Response, error)
+	UpdateV3Func func(ctx context.Context, request *jamf_account_preferences.ResourceAccountPreferences) (*jamf_account_preferences.ResourceAccountPreferences, *resty.Response, error)
+}
+
+var _ jamf_account_preferences.Service = (*JamfAccountPreferencesFake)(nil)
+
+// GetV3 records the call and delegates to GetV3Func.
+func (f *JamfAccountPreferencesFake) GetV3(ctx context.Context) (*jamf_account_preferences.ResourceAccountPreferences, *resty.Response, error) {
+	f.Record("GetV3", ctx)
+	if f.GetV3Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfAccountPreferencesFake.GetV3")
+	}
+	return f.GetV3Func(ctx)
+}
+
+// UpdateV3 records the call and delegates to UpdateV3Func.
+func (f *JamfAccountPreferencesFake) UpdateV3(ctx context.Context, request *jamf_account_preferences.ResourceAccountPreferences) (*jamf_account_preferences.ResourceAccountPreferences, *resty.Response, error) {
+	f.Record("UpdateV3", ctx, request)
+	if f.UpdateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfAccountPreferencesFake.UpdateV3")
+	}
+	return f.UpdateV3Func(ctx, request)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_account_preferences
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfAccountPreferences.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfAccountPreferencesFake implements it for tests.
+type Service interface {
+	GetV3(ctx context.Context) (*ResourceAccountPreferences, *resty.Response, error)
+	UpdateV3(ctx context.Context, request *ResourceAccountPreferences) (*ResourceAccountPreferences, *resty.Response, error)
+}
+
+var _ Service = (*JamfAccountPreferences)(nil)
@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_connect
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_connect"
+	"resty.dev/v3"
+)
+
+// JamfConnectFake is a jamf_connect.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfConnectFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func             func(ctx context.Context, req *jamf_connect.RequestAddHistoryNote) (*resty.Response, error)
+	GetConfigProfileByIDV1Func       func(ctx context.Context, profileID int) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetConfigProfileByNameV1Func     func(ctx context.Context, name string) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetConfigProfileByUUIDV1Func     func(ctx context.Context, uuid string) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetDeploymentTasksByIDV1Func     func(ctx context.Context, id string, rsqlQuery map[string]string) (*jamf_connect.DeploymentTasksResponse, *resty.Response, error)
+	GetHistoryV1Func                 func(ctx context.Context, rsqlQuery map[string]string) (*jamf_connect.HistoryResponse, *resty.Response, error)
+	GetSettingsV1Func                func(ctx context.Context) (*jamf_connect.ResourceJamfConnect, *resty.Response, error)
+	ListConfigProfilesV1Func         func(ctx context.Context, rsqlQuery map[string]string) (*jamf_connect.ListResponse, *resty.Response, error)
+	RetryDeploymentTasksByUUIDV1Func func(ctx context.Context, uuid string, computerIDs []string) (*resty.Response, error)
+	UpdateConfigProfileByUUIDV1Func  func(ctx context.Context, uuid string, request *jamf_connect.ResourceJamfConnectConfigProfileUpdate) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error)
+}
+
+var _ jamf_connect.Service = (*JamfConnectFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *JamfConnectFake) AddHistoryNoteV1(ctx context.Context, req *jamf_connect.RequestAddHistoryNote) (*resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, req)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, fakes.NotStubbed("JamfConnectFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, req)
+}
+
+// GetConfigProfileByIDV1 records the call and delegates to GetConfigProfileByIDV1Func.
+func (f *JamfConnectFake) GetConfigProfileByIDV1(ctx context.Context, profileID int) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error) {
+	f.Record("GetConfigProfileByIDV1", ctx, profileID)
+	if f.GetConfigProfileByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetConfigProfileByIDV1")
+	}
+	return f.GetConfigProfileByIDV1Func(ctx, profileID)
+}
+
+// GetConfigProfileByNameV1 records the call and delegates to GetConfigProfileByNameV1Func.
+func (f *JamfConnectFake) GetConfigProfileByNameV1(ctx context.Context, name string) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error) {
+	f.Record("GetConfigProfileByNameV1", ctx, name)
+	if f.GetConfigProfileByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetConfigProfileByNameV1")
+	}
+	return f.GetConfigProfileByNameV1Func(ctx, name)
+}
+
+// GetConfigProfileByUUIDV1 records the call and delegates to GetConfigProfileByUUIDV1Func.
+func (f *JamfConnectFake) GetConfigProfileByUUIDV1(ctx context.Context, uuid string) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error) {
+	f.Record("GetConfigProfileByUUIDV1", ctx, uuid)
+	if f.GetConfigProfileByUUIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetConfigProfileByUUIDV1")
+	}
+	return f.GetConfigProfileByUUIDV1Func(ctx, uuid)
+}
+
+// GetDeploymentTasksByIDV1 records the call and delegates to GetDeploymentTasksByIDV1Func.
+func (f *JamfConnectFake) GetDeploymentTasksByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*jamf_connect.DeploymentTasksResponse, *resty.Response, error) {
+	f.Record("GetDeploymentTasksByIDV1", ctx, id, rsqlQuery)
+	if f.GetDeploymentTasksByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetDeploymentTasksByIDV1")
+	}
+	return f.GetDeploymentTasksByIDV1Func(ctx, id, rsqlQuery)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *JamfConnectFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*jamf_connect.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetSettingsV1 records the call and delegates to GetSettingsV1Func.
+func (f *JamfConnectFake) GetSettingsV1(ctx context.Context) (*jamf_connect.ResourceJamfConnect, *resty.Response, error) {
+	f.Record("GetSettingsV1", ctx)
+	if f.GetSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.GetSettingsV1")
+	}
+	return f.GetSettingsV1Func(ctx)
+}
+
+// ListConfigProfilesV1 records the call and delegates to ListConfigProfilesV1Func.
+func (f *JamfConnectFake) ListConfigProfilesV1(ctx context.Context, rsqlQuery map[string]string) (*jamf_connect.ListResponse, *resty.Response, error) {
+	f.Record("ListConfigProfilesV1", ctx, rsqlQuery)
+	if f.ListConfigProfilesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.ListConfigProfilesV1")
+	}
+	return f.ListConfigProfilesV1Func(ctx, rsqlQuery)
+}
+
+// RetryDeploymentTasksByUUIDV1 records the call and delegates to RetryDeploymentTasksByUUIDV1Func.
+func (f *JamfConnectFake) RetryDeploymentTasksByUUIDV1(ctx context.Context, uuid string, computerIDs []string) (*resty.Response, error) {
+	f.Record("RetryDeploymentTasksByUUIDV1", ctx, uuid, computerIDs)
+	if f.RetryDeploymentTasksByUUIDV1Func == nil {
+		return nil, fakes.NotStubbed("JamfConnectFake.RetryDeploymentTasksByUUIDV1")
+	}
+	return f.RetryDeploymentTasksByUUIDV1Func(ctx, uuid, computerIDs)
+}
+
+// UpdateConfigProfileByUUIDV1 records the call and delegates to UpdateConfigProfileByUUIDV1Func.
+func (f *JamfConnectFake) UpdateConfigProfileByUUIDV1(ctx context.Context, uuid string, request *jamf_connect.ResourceJamfConnectConfigProfileUpdate) (*jamf_connect.ResourceJamfConnectConfigProfile, *resty.Response, error) {
+	f.Record("UpdateConfigProfileByUUIDV1", ctx, uuid, request)
+	if f.UpdateConfigProfileByUUIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfConnectFake.UpdateConfigProfileByUUIDV1")
+	}
+	return f.UpdateConfigProfileByUUIDV1Func(ctx, uuid, request)
+}
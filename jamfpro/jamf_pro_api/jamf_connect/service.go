@@ -0,0 +1,27 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_connect
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfConnect.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfConnectFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, req *RequestAddHistoryNote) (*resty.Response, error)
+	GetConfigProfileByIDV1(ctx context.Context, profileID int) (*ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetConfigProfileByNameV1(ctx context.Context, name string) (*ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetConfigProfileByUUIDV1(ctx context.Context, uuid string) (*ResourceJamfConnectConfigProfile, *resty.Response, error)
+	GetDeploymentTasksByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*DeploymentTasksResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetSettingsV1(ctx context.Context) (*ResourceJamfConnect, *resty.Response, error)
+	ListConfigProfilesV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	RetryDeploymentTasksByUUIDV1(ctx context.Context, uuid string, computerIDs []string) (*resty.Response, error)
+	UpdateConfigProfileByUUIDV1(ctx context.Context, uuid string, request *ResourceJamfConnectConfigProfileUpdate) (*ResourceJamfConnectConfigProfile, *resty.Response, error)
+}
+
+var _ Service = (*JamfConnect)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_management_framework
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_management_framework"
+	"resty.dev/v3"
+)
+
+// JamfManagementFrameworkFake is a jamf_management_framework.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfManagementFrameworkFake struct {
+	fakes.Journal
+
+	RedeployV1Func func(ctx context.Context, computerID string) (*jamf_management_framework.RedeployResponse, *resty.Response, error)
+}
+
+var _ jamf_management_framework.Service = (*JamfManagementFrameworkFake)(nil)
+
+// RedeployV1 records the call and delegates to RedeployV1Func.
+func (f *JamfManagementFrameworkFake) RedeployV1(ctx context.Context, computerID string) (*jamf_management_framework.RedeployResponse, *resty.Response, error) {
+	f.Record("RedeployV1", ctx, computerID)
+	if f.RedeployV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfManagementFrameworkFake.RedeployV1")
+	}
+	return f.RedeployV1Func(ctx, computerID)
+}
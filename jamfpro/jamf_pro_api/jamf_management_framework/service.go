@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_management_framework
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfManagementFramework.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfManagementFrameworkFake implements it for tests.
+type Service interface {
+	RedeployV1(ctx context.Context, computerID string) (*RedeployResponse, *resty.Response, error)
+}
+
+var _ Service = (*JamfManagementFramework)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_package
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_package"
+	"resty.dev/v3"
+)
+
+// JamfPackageFake is a jamf_package.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfPackageFake struct {
+	fakes.Journal
+
+	GetV2Func  func(ctx context.Context, application string) (*jamf_package.ResourceJamfPackageV2, *resty.Response, error)
+	ListV1Func func(ctx context.Context, application string) (jamf_package.ListV1Response, *resty.Response, error)
+}
+
+var _ jamf_package.Service = (*JamfPackageFake)(nil)
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *JamfPackageFake) GetV2(ctx context.Context, application string) (*jamf_package.ResourceJamfPackageV2, *resty.Response, error) {
+	f.Record("GetV2", ctx, application)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfPackageFake.GetV2")
+	}
+	return f.GetV2Func(ctx, application)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *JamfPackageFake) ListV1(ctx context.Context, application string) (jamf_package.ListV1Response, *resty.Response, error) {
+	f.Record("ListV1", ctx, application)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfPackageFake.ListV1")
+	}
+	return f.ListV1Func(ctx, application)
+}
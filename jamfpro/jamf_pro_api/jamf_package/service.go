@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_package
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfPackage.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfPackageFake implements it for tests.
+type Service interface {
+	GetV2(ctx context.Context, application string) (*ResourceJamfPackageV2, *resty.Response, error)
+	ListV1(ctx context.Context, application string) (ListV1Response, *resty.Response, error)
+}
+
+var _ Service = (*JamfPackage)(nil)
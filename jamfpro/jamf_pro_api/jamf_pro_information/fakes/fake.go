@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_information
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_information"
+	"resty.dev/v3"
+)
+
+// JamfProInformationFake is a jamf_pro_information.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProInformationFake struct {
+	fakes.Journal
+
+	GetV2Func func(ctx context.Context) (*jamf_pro_information.ResourceJamfProInformation, *resty.Response, error)
+}
+
+var _ jamf_pro_information.Service = (*JamfProInformationFake)(nil)
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *JamfProInformationFake) GetV2(ctx context.Context) (*jamf_pro_information.ResourceJamfProInformation, *resty.Response, error) {
+	f.Record("GetV2", ctx)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProInformationFake.GetV2")
+	}
+	return f.GetV2Func(ctx)
+}
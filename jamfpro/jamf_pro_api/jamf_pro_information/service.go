@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_information
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProInformation.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProInformationFake implements it for tests.
+type Service interface {
+	GetV2(ctx context.Context) (*ResourceJamfProInformation, *resty.Response, error)
+}
+
+var _ Service = (*JamfProInformation)(nil)
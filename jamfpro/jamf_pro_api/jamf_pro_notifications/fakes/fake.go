@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_notifications
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_notifications"
+	"resty.dev/v3"
+)
+
+// JamfProNotificationsFake is a jamf_pro_notifications.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProNotificationsFake struct {
+	fakes.Journal
+
+	DeleteByTypeAndIDV1Func func(ctx context.Context, notificationType string, id string) (*resty.Response, error)
+	GetForUserAndSiteV1Func func(ctx context.Context) ([]jamf_pro_notifications.ResourceNotification, *resty.Response, error)
+}
+
+var _ jamf_pro_notifications.Service = (*JamfProNotificationsFake)(nil)
+
+// DeleteByTypeAndIDV1 records the call and delegates to DeleteByTypeAndIDV1Func.
+func (f *JamfProNotificationsFake) DeleteByTypeAndIDV1(ctx context.Context, notificationType string, id string) (*resty.Response, error) {
+	f.Record("DeleteByTypeAndIDV1", ctx, notificationType, id)
+	if f.DeleteByTypeAndIDV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProNotificationsFake.DeleteByTypeAndIDV1")
+	}
+	return f.DeleteByTypeAndIDV1Func(ctx, notificationType, id)
+}
+
+// GetForUserAndSiteV1 records the call and delegates to GetForUserAndSiteV1Func.
+func (f *JamfProNotificationsFake) GetForUserAndSiteV1(ctx context.Context) ([]jamf_pro_notifications.ResourceNotification, *resty.Response, error) {
+	f.Record("GetForUserAndSiteV1", ctx)
+	if f.GetForUserAndSiteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProNotificationsFake.GetForUserAndSiteV1")
+	}
+	return f.GetForUserAndSiteV1Func(ctx)
+}
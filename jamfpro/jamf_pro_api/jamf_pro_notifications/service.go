@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_notifications
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProNotifications.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProNotificationsFake implements it for tests.
+type Service interface {
+	DeleteByTypeAndIDV1(ctx context.Context, notificationType string, id string) (*resty.Response, error)
+	GetForUserAndSiteV1(ctx context.Context) ([]ResourceNotification, *resty.Response, error)
+}
+
+var _ Service = (*JamfProNotifications)(nil)
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_server_url
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_server_url"
+	"resty.dev/v3"
+)
+
+// JamfProServerUrlFake is a jamf_pro_server_url.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProServerUrlFake struct {
+	fakes.Journal
+
+	CreateHistoryNoteV1Func func(ctx context.Context, req *jamf_pro_server_url.CreateHistoryNoteRequest) (*jamf_pro_server_url.HistoryObject, *resty.Response, error)
+	GetHistoryV1Func        func(ctx context.Context, rsqlQuery map[string]string) (*jamf_pro_server_url.HistoryResponse, *resty.Response, error)
+	GetV1Func               func(ctx context.Context) (*jamf_pro_server_url.ResourceJamfProServerURL, *resty.Response, error)
+	UpdateV1Func            func(ctx context.Context, request *jamf_pro_server_url.ResourceJamfProServerURL) (*jamf_pro_server_url.ResourceJamfProServerURL, *resty.Response, error)
+}
+
+var _ jamf_pro_server_url.Service = (*JamfProServerUrlFake)(nil)
+
+// CreateHistoryNoteV1 records the call and delegates to CreateHistoryNoteV1Func.
+func (f *JamfProServerUrlFake) CreateHistoryNoteV1(ctx context.Context, req *jamf_pro_server_url.CreateHistoryNoteRequest) (*jamf_pro_server_url.HistoryObject, *resty.Response, error) {
+	f.Record("CreateHistoryNoteV1", ctx, req)
+	if f.CreateHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProServerUrlFake.CreateHistoryNoteV1")
+	}
+	return f.CreateHistoryNoteV1Func(ctx, req)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *JamfProServerUrlFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*jamf_pro_server_url.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProServerUrlFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *JamfProServerUrlFake) GetV1(ctx context.Context) (*jamf_pro_server_url.ResourceJamfProServerURL, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProServerUrlFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *JamfProServerUrlFake) UpdateV1(ctx context.Context, request *jamf_pro_server_url.ResourceJamfProServerURL) (*jamf_pro_server_url.ResourceJamfProServerURL, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProServerUrlFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_server_url
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProServerUrl.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProServerUrlFake implements it for tests.
+type Service interface {
+	CreateHistoryNoteV1(ctx context.Context, req *CreateHistoryNoteRequest) (*HistoryObject, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceJamfProServerURL, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceJamfProServerURL) (*ResourceJamfProServerURL, *resty.Response, error)
+}
+
+var _ Service = (*JamfProServerUrl)(nil)
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_system_initialization
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_system_initialization"
+	"resty.dev/v3"
+)
+
+// JamfProSystemInitializationFake is a jamf_pro_system_initialization.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProSystemInitializationFake struct {
+	fakes.Journal
+
+	InitializeFunc                   func(ctx context.Context, request *jamf_pro_system_initialization.ResourceSystemInitialize) (*resty.Response, error)
+	InitializeDatabaseConnectionFunc func(ctx context.Context, password string) (*resty.Response, error)
+	PlatformInitializeFunc           func(ctx context.Context, request *jamf_pro_system_initialization.ResourcePlatformInitialize) (*resty.Response, error)
+}
+
+var _ jamf_pro_system_initialization.Service = (*JamfProSystemInitializationFake)(nil)
+
+// Initialize records the call and delegates to InitializeFunc.
+func (f *JamfProSystemInitializationFake) Initialize(ctx context.Context, request *jamf_pro_system_initialization.ResourceSystemInitialize) (*resty.Response, error) {
+	f.Record("Initialize", ctx, request)
+	if f.InitializeFunc == nil {
+		return nil, fakes.NotStubbed("JamfProSystemInitializationFake.Initialize")
+	}
+	return f.InitializeFunc(ctx, request)
+}
+
+// InitializeDatabaseConnection records the call and delegates to InitializeDatabaseConnectionFunc.
+func (f *JamfProSystemInitializationFake) InitializeDatabaseConnection(ctx context.Context, password string) (*resty.Response, error) {
+	f.Record("InitializeDatabaseConnection", ctx, password)
+	if f.InitializeDatabaseConnectionFunc == nil {
+		return nil, fakes.NotStubbed("JamfProSystemInitializationFake.InitializeDatabaseConnection")
+	}
+	return f.InitializeDatabaseConnectionFunc(ctx, password)
+}
+
+// PlatformInitialize records the call and delegates to PlatformInitializeFunc.
+func (f *JamfProSystemInitializationFake) PlatformInitialize(ctx context.Context, request *jamf_pro_system_initialization.ResourcePlatformInitialize) (*resty.Response, error) {
+	f.Record("PlatformInitialize", ctx, request)
+	if f.PlatformInitializeFunc == nil {
+		return nil, fakes.NotStubbed("JamfProSystemInitializationFake.PlatformInitialize")
+	}
+	return f.PlatformInitializeFunc(ctx, request)
+}
@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_system_initialization
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProSystemInitialization.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProSystemInitializationFake implements it for tests.
+type Service interface {
+	Initialize(ctx context.Context, request *ResourceSystemInitialize) (*resty.Response, error)
+	InitializeDatabaseConnection(ctx context.Context, password string) (*resty.Response, error)
+	PlatformInitialize(ctx context.Context, request *ResourcePlatformInitialize) (*resty.Response, error)
+}
+
+var _ Service = (*JamfProSystemInitialization)(nil)
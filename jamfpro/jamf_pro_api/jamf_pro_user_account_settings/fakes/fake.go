@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_user_account_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_user_account_settings"
+	"resty.dev/v3"
+)
+
+// JamfProUserAccountSettingsFake is a jamf_pro_user_account_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProUserAccountSettingsFake struct {
+	fakes.Journal
+
+	DeleteV1Func      func(ctx context.Context, keyID string) (*resty.Response, error)
+	GetSettingsV1Func func(ctx context.Context, keyID string) (*jamf_pro_user_account_settings.ResourceUserPreferencesSettings, *resty.Response, error)
+	GetV1Func         func(ctx context.Context, keyID string) (string, *resty.Response, error)
+	PutV1Func         func(ctx context.Context, keyID string, values jamf_pro_user_account_settings.RequestUserPreferences) (*resty.Response, error)
+}
+
+var _ jamf_pro_user_account_settings.Service = (*JamfProUserAccountSettingsFake)(nil)
+
+// DeleteV1 records the call and delegates to DeleteV1Func.
+func (f *JamfProUserAccountSettingsFake) DeleteV1(ctx context.Context, keyID string) (*resty.Response, error) {
+	f.Record("DeleteV1", ctx, keyID)
+	if f.DeleteV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProUserAccountSettingsFake.DeleteV1")
+	}
+	return f.DeleteV1Func(ctx, keyID)
+}
+
+// GetSettingsV1 records the call and delegates to GetSettingsV1Func.
+func (f *JamfProUserAccountSettingsFake) GetSettingsV1(ctx context.Context, keyID string) (*jamf_pro_user_account_settings.ResourceUserPreferencesSettings, *resty.Response, error) {
+	f.Record("GetSettingsV1", ctx, keyID)
+	if f.GetSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProUserAccountSettingsFake.GetSettingsV1")
+	}
+	return f.GetSettingsV1Func(ctx, keyID)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *JamfProUserAccountSettingsFake) GetV1(ctx context.Context, keyID string) (string, *resty.Response, error) {
+	f.Record("GetV1", ctx, keyID)
+	if f.GetV1Func == nil {
+		return "", nil, fakes.NotStubbed("JamfProUserAccountSettingsFake.GetV1")
+	}
+	return f.GetV1Func(ctx, keyID)
+}
+
+// PutV1 records the call and delegates to PutV1Func.
+func (f *JamfProUserAccountSettingsFake) PutV1(ctx context.Context, keyID string, values jamf_pro_user_account_settings.RequestUserPreferences) (*resty.Response, error) {
+	f.Record("PutV1", ctx, keyID, values)
+	if f.PutV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProUserAccountSettingsFake.PutV1")
+	}
+	return f.PutV1Func(ctx, keyID, values)
+}
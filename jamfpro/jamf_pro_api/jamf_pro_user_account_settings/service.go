@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_user_account_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProUserAccountSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProUserAccountSettingsFake implements it for tests.
+type Service interface {
+	DeleteV1(ctx context.Context, keyID string) (*resty.Response, error)
+	GetSettingsV1(ctx context.Context, keyID string) (*ResourceUserPreferencesSettings, *resty.Response, error)
+	GetV1(ctx context.Context, keyID string) (string, *resty.Response, error)
+	PutV1(ctx context.Context, keyID string, values RequestUserPreferences) (*resty.Response, error)
+}
+
+var _ Service = (*JamfProUserAccountSettings)(nil)
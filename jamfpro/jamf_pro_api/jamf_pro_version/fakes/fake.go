@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_pro_version
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_pro_version"
+	"resty.dev/v3"
+)
+
+// JamfProVersionFake is a jamf_pro_version.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProVersionFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*jamf_pro_version.ResourceJamfProVersion, *resty.Response, error)
+}
+
+var _ jamf_pro_version.Service = (*JamfProVersionFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *JamfProVersionFake) GetV1(ctx context.Context) (*jamf_pro_version.ResourceJamfProVersion, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProVersionFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
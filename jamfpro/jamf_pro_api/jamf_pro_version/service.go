@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_pro_version
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProVersion.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProVersionFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceJamfProVersion, *resty.Response, error)
+}
+
+var _ Service = (*JamfProVersion)(nil)
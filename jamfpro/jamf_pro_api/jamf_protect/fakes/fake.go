@@ -0,0 +1,133 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_protect
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_protect"
+	"resty.dev/v3"
+)
+
+// JamfProtectFake is a jamf_protect.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfProtectFake struct {
+	fakes.Journal
+
+	CreateHistoryNoteV1Func    func(ctx context.Context, request *jamf_protect.RequestJamfProtectHistoryNote) (*jamf_protect.ResourceJamfProtectHistoryCreate, *resty.Response, error)
+	CreateIntegrationV1Func    func(ctx context.Context, registration *jamf_protect.RequestJamfProtectRegistration, autoInstall bool) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error)
+	DeleteIntegrationV1Func    func(ctx context.Context) (*resty.Response, error)
+	GetSettingsV1Func          func(ctx context.Context) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error)
+	ListDeploymentTasksV1Func  func(ctx context.Context, deploymentID string, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectDeploymentTasks, *resty.Response, error)
+	ListHistoryV1Func          func(ctx context.Context, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectHistory, *resty.Response, error)
+	ListPlansV1Func            func(ctx context.Context, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectPlans, *resty.Response, error)
+	RegisterV1Func             func(ctx context.Context, request *jamf_protect.RequestJamfProtectRegistration) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error)
+	RetryDeploymentTasksV1Func func(ctx context.Context, deploymentID string) (*resty.Response, error)
+	SyncPlansV1Func            func(ctx context.Context) (*resty.Response, error)
+	UpdateSettingsV1Func       func(ctx context.Context, request *jamf_protect.RequestJamfProtectSettings) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error)
+}
+
+var _ jamf_protect.Service = (*JamfProtectFake)(nil)
+
+// CreateHistoryNoteV1 records the call and delegates to CreateHistoryNoteV1Func.
+func (f *JamfProtectFake) CreateHistoryNoteV1(ctx context.Context, request *jamf_protect.RequestJamfProtectHistoryNote) (*jamf_protect.ResourceJamfProtectHistoryCreate, *resty.Response, error) {
+	f.Record("CreateHistoryNoteV1", ctx, request)
+	if f.CreateHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.CreateHistoryNoteV1")
+	}
+	return f.CreateHistoryNoteV1Func(ctx, request)
+}
+
+// CreateIntegrationV1 records the call and delegates to CreateIntegrationV1Func.
+func (f *JamfProtectFake) CreateIntegrationV1(ctx context.Context, registration *jamf_protect.RequestJamfProtectRegistration, autoInstall bool) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error) {
+	f.Record("CreateIntegrationV1", ctx, registration, autoInstall)
+	if f.CreateIntegrationV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.CreateIntegrationV1")
+	}
+	return f.CreateIntegrationV1Func(ctx, registration, autoInstall)
+}
+
+// DeleteIntegrationV1 records the call and delegates to DeleteIntegrationV1Func.
+func (f *JamfProtectFake) DeleteIntegrationV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("DeleteIntegrationV1", ctx)
+	if f.DeleteIntegrationV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProtectFake.DeleteIntegrationV1")
+	}
+	return f.DeleteIntegrationV1Func(ctx)
+}
+
+// GetSettingsV1 records the call and delegates to GetSettingsV1Func.
+func (f *JamfProtectFake) GetSettingsV1(ctx context.Context) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error) {
+	f.Record("GetSettingsV1", ctx)
+	if f.GetSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.GetSettingsV1")
+	}
+	return f.GetSettingsV1Func(ctx)
+}
+
+// ListDeploymentTasksV1 records the call and delegates to ListDeploymentTasksV1Func.
+func (f *JamfProtectFake) ListDeploymentTasksV1(ctx context.Context, deploymentID string, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectDeploymentTasks, *resty.Response, error) {
+	f.Record("ListDeploymentTasksV1", ctx, deploymentID, rsqlQuery)
+	if f.ListDeploymentTasksV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.ListDeploymentTasksV1")
+	}
+	return f.ListDeploymentTasksV1Func(ctx, deploymentID, rsqlQuery)
+}
+
+// ListHistoryV1 records the call and delegates to ListHistoryV1Func.
+func (f *JamfProtectFake) ListHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectHistory, *resty.Response, error) {
+	f.Record("ListHistoryV1", ctx, rsqlQuery)
+	if f.ListHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.ListHistoryV1")
+	}
+	return f.ListHistoryV1Func(ctx, rsqlQuery)
+}
+
+// ListPlansV1 records the call and delegates to ListPlansV1Func.
+func (f *JamfProtectFake) ListPlansV1(ctx context.Context, rsqlQuery map[string]string) (*jamf_protect.ListResponseJamfProtectPlans, *resty.Response, error) {
+	f.Record("ListPlansV1", ctx, rsqlQuery)
+	if f.ListPlansV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.ListPlansV1")
+	}
+	return f.ListPlansV1Func(ctx, rsqlQuery)
+}
+
+// RegisterV1 records the call and delegates to RegisterV1Func.
+func (f *JamfProtectFake) RegisterV1(ctx context.Context, request *jamf_protect.RequestJamfProtectRegistration) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error) {
+	f.Record("RegisterV1", ctx, request)
+	if f.RegisterV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.RegisterV1")
+	}
+	return f.RegisterV1Func(ctx, request)
+}
+
+// RetryDeploymentTasksV1 records the call and delegates to RetryDeploymentTasksV1Func.
+func (f *JamfProtectFake) RetryDeploymentTasksV1(ctx context.Context, deploymentID string) (*resty.Response, error) {
+	f.Record("RetryDeploymentTasksV1", ctx, deploymentID)
+	if f.RetryDeploymentTasksV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProtectFake.RetryDeploymentTasksV1")
+	}
+	return f.RetryDeploymentTasksV1Func(ctx, deploymentID)
+}
+
+// SyncPlansV1 records the call and delegates to SyncPlansV1Func.
+func (f *JamfProtectFake) SyncPlansV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("SyncPlansV1", ctx)
+	if f.SyncPlansV1Func == nil {
+		return nil, fakes.NotStubbed("JamfProtectFake.SyncPlansV1")
+	}
+	return f.SyncPlansV1Func(ctx)
+}
+
+// UpdateSettingsV1 records the call and delegates to UpdateSettingsV1Func.
+func (f *JamfProtectFake) UpdateSettingsV1(ctx context.Context, request *jamf_protect.RequestJamfProtectSettings) (*jamf_protect.ResourceJamfProtectSettings, *resty.Response, error) {
+	f.Record("UpdateSettingsV1", ctx, request)
+	if f.UpdateSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfProtectFake.UpdateSettingsV1")
+	}
+	return f.UpdateSettingsV1Func(ctx, request)
+}
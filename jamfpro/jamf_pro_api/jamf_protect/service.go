@@ -0,0 +1,28 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_protect
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfProtect.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfProtectFake implements it for tests.
+type Service interface {
+	CreateHistoryNoteV1(ctx context.Context, request *RequestJamfProtectHistoryNote) (*ResourceJamfProtectHistoryCreate, *resty.Response, error)
+	CreateIntegrationV1(ctx context.Context, registration *RequestJamfProtectRegistration, autoInstall bool) (*ResourceJamfProtectSettings, *resty.Response, error)
+	DeleteIntegrationV1(ctx context.Context) (*resty.Response, error)
+	GetSettingsV1(ctx context.Context) (*ResourceJamfProtectSettings, *resty.Response, error)
+	ListDeploymentTasksV1(ctx context.Context, deploymentID string, rsqlQuery map[string]string) (*ListResponseJamfProtectDeploymentTasks, *resty.Response, error)
+	ListHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponseJamfProtectHistory, *resty.Response, error)
+	ListPlansV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponseJamfProtectPlans, *resty.Response, error)
+	RegisterV1(ctx context.Context, request *RequestJamfProtectRegistration) (*ResourceJamfProtectSettings, *resty.Response, error)
+	RetryDeploymentTasksV1(ctx context.Context, deploymentID string) (*resty.Response, error)
+	SyncPlansV1(ctx context.Context) (*resty.Response, error)
+	UpdateSettingsV1(ctx context.Context, request *RequestJamfProtectSettings) (*ResourceJamfProtectSettings, *resty.Response, error)
+}
+
+var _ Service = (*JamfProtect)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jamf_remote_assist
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jamf_remote_assist"
+	"resty.dev/v3"
+)
+
+// JamfRemoteAssistFake is a jamf_remote_assist.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JamfRemoteAssistFake struct {
+	fakes.Journal
+
+	ExportSessionsV2Func func(ctx context.Context, request *jamf_remote_assist.ExportSessionsRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetSessionByIDV1Func func(ctx context.Context, id string) (*jamf_remote_assist.SessionHistory, *resty.Response, error)
+	GetSessionByIDV2Func func(ctx context.Context, id string) (*jamf_remote_assist.SessionHistory, *resty.Response, error)
+	ListSessionsV1Func   func(ctx context.Context) ([]jamf_remote_assist.SessionHistory, *resty.Response, error)
+	ListSessionsV2Func   func(ctx context.Context, rsqlQuery map[string]string) (*jamf_remote_assist.ListSessionsResponse, *resty.Response, error)
+}
+
+var _ jamf_remote_assist.Service = (*JamfRemoteAssistFake)(nil)
+
+// ExportSessionsV2 records the call and delegates to ExportSessionsV2Func.
+func (f *JamfRemoteAssistFake) ExportSessionsV2(ctx context.Context, request *jamf_remote_assist.ExportSessionsRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("ExportSessionsV2", ctx, request, acceptType)
+	if f.ExportSessionsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfRemoteAssistFake.ExportSessionsV2")
+	}
+	return f.ExportSessionsV2Func(ctx, request, acceptType)
+}
+
+// GetSessionByIDV1 records the call and delegates to GetSessionByIDV1Func.
+func (f *JamfRemoteAssistFake) GetSessionByIDV1(ctx context.Context, id string) (*jamf_remote_assist.SessionHistory, *resty.Response, error) {
+	f.Record("GetSessionByIDV1", ctx, id)
+	if f.GetSessionByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfRemoteAssistFake.GetSessionByIDV1")
+	}
+	return f.GetSessionByIDV1Func(ctx, id)
+}
+
+// GetSessionByIDV2 records the call and delegates to GetSessionByIDV2Func.
+func (f *JamfRemoteAssistFake) GetSessionByIDV2(ctx context.Context, id string) (*jamf_remote_assist.SessionHistory, *resty.Response, error) {
+	f.Record("GetSessionByIDV2", ctx, id)
+	if f.GetSessionByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfRemoteAssistFake.GetSessionByIDV2")
+	}
+	return f.GetSessionByIDV2Func(ctx, id)
+}
+
+// ListSessionsV1 records the call and delegates to ListSessionsV1Func.
+func (f *JamfRemoteAssistFake) ListSessionsV1(ctx context.Context) ([]jamf_remote_assist.SessionHistory, *resty.Response, error) {
+	f.Record("ListSessionsV1", ctx)
+	if f.ListSessionsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfRemoteAssistFake.ListSessionsV1")
+	}
+	return f.ListSessionsV1Func(ctx)
+}
+
+// ListSessionsV2 records the call and delegates to ListSessionsV2Func.
+func (f *JamfRemoteAssistFake) ListSessionsV2(ctx context.Context, rsqlQuery map[string]string) (*jamf_remote_assist.ListSessionsResponse, *resty.Response, error) {
+	f.Record("ListSessionsV2", ctx, rsqlQuery)
+	if f.ListSessionsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("JamfRemoteAssistFake.ListSessionsV2")
+	}
+	return f.ListSessionsV2Func(ctx, rsqlQuery)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jamf_remote_assist
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of JamfRemoteAssist.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JamfRemoteAssistFake implements it for tests.
+type Service interface {
+	ExportSessionsV2(ctx context.Context, request *ExportSessionsRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetSessionByIDV1(ctx context.Context, id string) (*SessionHistory, *resty.Response, error)
+	GetSessionByIDV2(ctx context.Context, id string) (*SessionHistory, *resty.Response, error)
+	ListSessionsV1(ctx context.Context) ([]SessionHistory, *resty.Response, error)
+	ListSessionsV2(ctx context.Context, rsqlQuery map[string]string) (*ListSessionsResponse, *resty.Response, error)
+}
+
+var _ Service = (*JamfRemoteAssist)(nil)
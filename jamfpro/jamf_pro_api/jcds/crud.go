@@ -6,12 +6,11 @@ import (
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"go.uber.org/zap"
 	"resty.dev/v3"
 )
 
@@ -91,47 +90,51 @@ func (s *Jcds) RenewCredentialsV1(ctx context.Context) (*ResourceJCDSUploadCrede
 	return &result, resp, nil
 }
 
-// CreatePackageV1 uploads a package file to JCDS using AWS S3.
-// URL: POST /api/v1/jcds/files (for credentials) + AWS S3 upload
+// GetUploadCredentialsV1 obtains temporary AWS credentials, bucket, and key
+// prefix for writing package files to JCDS.
+// URL: POST /api/v1/jcds/files
 // https://developer.jamf.com/jamf-pro/reference/post_v1-jcds-files
-func (s *Jcds) CreatePackageV1(ctx context.Context, filePath string) (*ResponseJCDSFile, *resty.Response, error) {
-	if filePath == "" {
-		return nil, nil, fmt.Errorf("file path is required")
-	}
-
-	// Step 1: Obtain AWS credentials for the package upload endpoint
-	var uploadCredentials ResourceJCDSUploadCredentials
+func (s *Jcds) GetUploadCredentialsV1(ctx context.Context) (*ResourceJCDSUploadCredentials, *resty.Response, error) {
 	endpoint := constants.EndpointJamfProJCDSV1 + "/files"
 
+	var result ResourceJCDSUploadCredentials
+
 	resp, err := s.client.NewRequest(ctx).
 		SetHeader("Accept", constants.ApplicationJSON).
 		SetHeader("Content-Type", constants.ApplicationJSON).
-		SetResult(&uploadCredentials).
+		SetResult(&result).
 		Post(endpoint)
 	if err != nil {
 		return nil, resp, fmt.Errorf("failed to obtain upload credentials: %w", err)
 	}
 
-	// Validate if we received necessary details
-	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
+	if result.Region == "" || result.BucketName == "" || result.Path == "" {
 		return nil, resp, fmt.Errorf("incomplete upload credentials received")
 	}
 
-	// Step 2: Use the obtained credentials to configure AWS SDK v2
-	cfg, err := config.LoadDefaultConfig(ctx,
-		config.WithRegion(uploadCredentials.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			uploadCredentials.AccessKeyID,
-			uploadCredentials.SecretAccessKey,
-			uploadCredentials.SessionToken,
-		)),
-	)
+	return &result, resp, nil
+}
+
+// CreatePackageV1 uploads a package file to JCDS using AWS S3. Progress is
+// reported to the ProgressReporter in ctx or on the client, if any.
+// URL: POST /api/v1/jcds/files (for credentials) + AWS S3 upload
+// https://developer.jamf.com/jamf-pro/reference/post_v1-jcds-files
+func (s *Jcds) CreatePackageV1(ctx context.Context, filePath string) (*ResponseJCDSFile, *resty.Response, error) {
+	if filePath == "" {
+		return nil, nil, fmt.Errorf("file path is required")
+	}
+
+	// Step 1: Obtain AWS credentials for the package upload endpoint
+	uploadCredentials, resp, err := s.GetUploadCredentialsV1(ctx)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed to create AWS config: %w", err)
+		return nil, resp, err
 	}
 
-	// Create S3 service client
-	s3Client := s3.NewFromConfig(cfg)
+	// Step 2: Use the obtained credentials to create an S3 client
+	s3Client, err := NewS3Client(ctx, uploadCredentials)
+	if err != nil {
+		return nil, resp, err
+	}
 
 	// Step 3: Create an Uploader with the configuration and default options
 	uploader := manager.NewUploader(s3Client)
@@ -142,20 +145,14 @@ func (s *Jcds) CreatePackageV1(ctx context.Context, filePath string) (*ResponseJ
 		return nil, resp, fmt.Errorf("failed to read package file securely: %w", err)
 	}
 
-	// Create a progress reader
-	progressReader := &ProgressReader{
-		reader:     fileReader,
-		totalBytes: fileSize,
-		progressFn: func(read, total int64, unit string) {
-			fmt.Printf("\rUploaded %d / %d %s (%.2f%%)", read, total, unit, float64(read)/float64(total)*100)
-		},
-	}
+	fileName := filepath.Base(filePath)
+	progress := client.ProgressReporterFor(ctx, s.client)
 
 	// Create the upload input
 	uploadInput := &s3.PutObjectInput{
 		Bucket: aws.String(uploadCredentials.BucketName),
-		Key:    aws.String(uploadCredentials.Path + filepath.Base(filePath)),
-		Body:   progressReader,
+		Key:    aws.String(uploadCredentials.Path + fileName),
+		Body:   client.NewProgressReader(fileReader, progress, client.ProgressPhaseUploading, fileName, fileSize),
 	}
 
 	// Step 5: Perform the upload
@@ -164,11 +161,11 @@ func (s *Jcds) CreatePackageV1(ctx context.Context, filePath string) (*ResponseJ
 		return nil, resp, fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	fmt.Println("\nUpload completed successfully")
+	progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseComplete, File: fileName, BytesTransferred: fileSize, TotalBytes: fileSize})
 
 	// Construct the final file upload response
 	finalResponse := &ResponseJCDSFile{
-		URI: fmt.Sprintf("s3://%s/%s%s", uploadCredentials.BucketName, uploadCredentials.Path, filepath.Base(filePath)),
+		URI: fmt.Sprintf("s3://%s/%s%s", uploadCredentials.BucketName, uploadCredentials.Path, fileName),
 	}
 
 	return finalResponse, resp, nil
@@ -200,22 +197,12 @@ func (s *Jcds) DeletePackageV1(ctx context.Context, filePath string) (*resty.Res
 		return resp, fmt.Errorf("incomplete deletion credentials received")
 	}
 
-	// Step 2: Use the obtained credentials to configure AWS SDK v2
-	cfg, err := config.LoadDefaultConfig(ctx,
-		config.WithRegion(uploadCredentials.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			uploadCredentials.AccessKeyID,
-			uploadCredentials.SecretAccessKey,
-			uploadCredentials.SessionToken,
-		)),
-	)
+	// Step 2: Use the obtained credentials to create an S3 client
+	s3Client, err := NewS3Client(ctx, &uploadCredentials)
 	if err != nil {
-		return resp, fmt.Errorf("failed to create AWS config: %w", err)
+		return resp, err
 	}
 
-	// Create S3 service client
-	s3Client := s3.NewFromConfig(cfg)
-
 	// Step 3: Define the object to delete
 	objectToDelete := &s3.DeleteObjectInput{
 		Bucket: aws.String(uploadCredentials.BucketName),
@@ -228,7 +215,7 @@ func (s *Jcds) DeletePackageV1(ctx context.Context, filePath string) (*resty.Res
 		return resp, fmt.Errorf("failed to delete file: %w", err)
 	}
 
-	fmt.Printf("File '%s' successfully deleted from JCDS\n", filepath.Base(filePath))
+	s.client.GetLogger().Debug("Deleted package file from JCDS", zap.String("file", filepath.Base(filePath)))
 	return resp, nil
 }
 
@@ -130,6 +130,30 @@ func TestUnit_Jcds_RefreshInventoryV1_APICError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to refresh JCDS inventory")
 }
 
+func TestUnit_Jcds_GetUploadCredentialsV1_Success(t *testing.T) {
+	mock := mocks.NewJCDSMock()
+	mock.RegisterUploadCredentialsMock()
+	service := NewJcds(mock)
+
+	result, resp, err := service.GetUploadCredentialsV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, result.BucketName)
+	assert.NotEmpty(t, result.Path)
+}
+
+func TestUnit_Jcds_GetUploadCredentialsV1_Incomplete(t *testing.T) {
+	mock := mocks.NewJCDSMock()
+	mock.RegisterIncompleteCredentialsMock()
+	service := NewJcds(mock)
+
+	result, _, err := service.GetUploadCredentialsV1(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "incomplete upload credentials")
+}
+
 func TestUnit_Jcds_CreatePackageV1_EmptyFilePath(t *testing.T) {
 	mock := mocks.NewJCDSMock()
 	service := NewJcds(mock)
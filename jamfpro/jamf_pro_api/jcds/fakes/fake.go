@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the jcds
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jcds"
+	"resty.dev/v3"
+)
+
+// JcdsFake is a jcds.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type JcdsFake struct {
+	fakes.Journal
+
+	CreatePackageV1Func        func(ctx context.Context, filePath string) (*jcds.ResponseJCDSFile, *resty.Response, error)
+	DeletePackageV1Func        func(ctx context.Context, filePath string) (*resty.Response, error)
+	GetPackageURIByNameV1Func  func(ctx context.Context, packageName string) (*jcds.ResponseJCDSFile, *resty.Response, error)
+	GetPackagesV1Func          func(ctx context.Context) ([]jcds.ResourceJCDSFile, *resty.Response, error)
+	GetUploadCredentialsV1Func func(ctx context.Context) (*jcds.ResourceJCDSUploadCredentials, *resty.Response, error)
+	RefreshInventoryV1Func     func(ctx context.Context) (*resty.Response, error)
+	RenewCredentialsV1Func     func(ctx context.Context) (*jcds.ResourceJCDSUploadCredentials, *resty.Response, error)
+}
+
+var _ jcds.Service = (*JcdsFake)(nil)
+
+// CreatePackageV1 records the call and delegates to CreatePackageV1Func.
+func (f *JcdsFake) CreatePackageV1(ctx context.Context, filePath string) (*jcds.ResponseJCDSFile, *resty.Response, error) {
+	f.Record("CreatePackageV1", ctx, filePath)
+	if f.CreatePackageV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JcdsFake.CreatePackageV1")
+	}
+	return f.CreatePackageV1Func(ctx, filePath)
+}
+
+// DeletePackageV1 records the call and delegates to DeletePackageV1Func.
+func (f *JcdsFake) DeletePackageV1(ctx context.Context, filePath string) (*resty.Response, error) {
+	f.Record("DeletePackageV1", ctx, filePath)
+	if f.DeletePackageV1Func == nil {
+		return nil, fakes.NotStubbed("JcdsFake.DeletePackageV1")
+	}
+	return f.DeletePackageV1Func(ctx, filePath)
+}
+
+// GetPackageURIByNameV1 records the call and delegates to GetPackageURIByNameV1Func.
+func (f *JcdsFake) GetPackageURIByNameV1(ctx context.Context, packageName string) (*jcds.ResponseJCDSFile, *resty.Response, error) {
+	f.Record("GetPackageURIByNameV1", ctx, packageName)
+	if f.GetPackageURIByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JcdsFake.GetPackageURIByNameV1")
+	}
+	return f.GetPackageURIByNameV1Func(ctx, packageName)
+}
+
+// GetPackagesV1 records the call and delegates to GetPackagesV1Func.
+func (f *JcdsFake) GetPackagesV1(ctx context.Context) ([]jcds.ResourceJCDSFile, *resty.Response, error) {
+	f.Record("GetPackagesV1", ctx)
+	if f.GetPackagesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JcdsFake.GetPackagesV1")
+	}
+	return f.GetPackagesV1Func(ctx)
+}
+
+// GetUploadCredentialsV1 records the call and delegates to GetUploadCredentialsV1Func.
+func (f *JcdsFake) GetUploadCredentialsV1(ctx context.Context) (*jcds.ResourceJCDSUploadCredentials, *resty.Response, error) {
+	f.Record("GetUploadCredentialsV1", ctx)
+	if f.GetUploadCredentialsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JcdsFake.GetUploadCredentialsV1")
+	}
+	return f.GetUploadCredentialsV1Func(ctx)
+}
+
+// RefreshInventoryV1 records the call and delegates to RefreshInventoryV1Func.
+func (f *JcdsFake) RefreshInventoryV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("RefreshInventoryV1", ctx)
+	if f.RefreshInventoryV1Func == nil {
+		return nil, fakes.NotStubbed("JcdsFake.RefreshInventoryV1")
+	}
+	return f.RefreshInventoryV1Func(ctx)
+}
+
+// RenewCredentialsV1 records the call and delegates to RenewCredentialsV1Func.
+func (f *JcdsFake) RenewCredentialsV1(ctx context.Context) (*jcds.ResourceJCDSUploadCredentials, *resty.Response, error) {
+	f.Record("RenewCredentialsV1", ctx)
+	if f.RenewCredentialsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("JcdsFake.RenewCredentialsV1")
+	}
+	return f.RenewCredentialsV1Func(ctx)
+}
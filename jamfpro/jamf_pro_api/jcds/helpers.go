@@ -2,13 +2,38 @@ package jcds
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
+// NewS3Client returns an S3 client authenticated with the temporary JCDS
+// credentials from GetUploadCredentialsV1 or RenewCredentialsV1.
+func NewS3Client(ctx context.Context, creds *ResourceJCDSUploadCredentials, optFns ...func(*s3.Options)) (*s3.Client, error) {
+	if creds == nil {
+		return nil, fmt.Errorf("JCDS credentials are required")
+	}
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(creds.Region),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			creds.AccessKeyID,
+			creds.SecretAccessKey,
+			creds.SessionToken,
+		)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config: %w", err)
+	}
+	return s3.NewFromConfig(cfg, optFns...), nil
+}
+
 // readJCDSPackageTypes returns a reader and size for a package file securely after applying multiple checks.
 func readJCDSPackageTypes(filePath string) (io.Reader, int64, error) {
 	allowedExtensions := []string{".pkg", ".dmg", ".zip"}
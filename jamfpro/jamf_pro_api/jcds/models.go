@@ -28,6 +28,9 @@ type ResponseJCDSFile struct {
 }
 
 // ProgressReader wraps an io.Reader to report progress on read operations.
+//
+// Deprecated: CreatePackageV1 reports progress through client.ProgressReporter;
+// use client.NewProgressReader for custom transfers.
 type ProgressReader struct {
 	reader     io.Reader
 	totalBytes int64
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package jcds
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Jcds.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.JcdsFake implements it for tests.
+type Service interface {
+	CreatePackageV1(ctx context.Context, filePath string) (*ResponseJCDSFile, *resty.Response, error)
+	DeletePackageV1(ctx context.Context, filePath string) (*resty.Response, error)
+	GetPackageURIByNameV1(ctx context.Context, packageName string) (*ResponseJCDSFile, *resty.Response, error)
+	GetPackagesV1(ctx context.Context) ([]ResourceJCDSFile, *resty.Response, error)
+	GetUploadCredentialsV1(ctx context.Context) (*ResourceJCDSUploadCredentials, *resty.Response, error)
+	RefreshInventoryV1(ctx context.Context) (*resty.Response, error)
+	RenewCredentialsV1(ctx context.Context) (*ResourceJCDSUploadCredentials, *resty.Response, error)
+}
+
+var _ Service = (*Jcds)(nil)
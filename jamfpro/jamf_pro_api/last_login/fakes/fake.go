@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the last_login
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/last_login"
+	"resty.dev/v3"
+)
+
+// LastLoginFake is a last_login.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LastLoginFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*last_login.ResourceLastLogin, *resty.Response, error)
+}
+
+var _ last_login.Service = (*LastLoginFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *LastLoginFake) GetV1(ctx context.Context) (*last_login.ResourceLastLogin, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LastLoginFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
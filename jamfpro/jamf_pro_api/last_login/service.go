@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package last_login
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LastLogin.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LastLoginFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceLastLogin, *resty.Response, error)
+}
+
+var _ Service = (*LastLogin)(nil)
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the ldap
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/ldap"
+	"resty.dev/v3"
+)
+
+// LdapFake is a ldap.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LdapFake struct {
+	fakes.Journal
+
+	GetLdapGroupsV1Func      func(ctx context.Context, rsqlQuery map[string]string) (*ldap.ListGroupsResponseV1, *resty.Response, error)
+	GetLdapServersOnlyV1Func func(ctx context.Context) ([]ldap.ResourceLdapServerV1, *resty.Response, error)
+	GetLdapServersV1Func     func(ctx context.Context) ([]ldap.ResourceLdapServerV1, *resty.Response, error)
+}
+
+var _ ldap.Service = (*LdapFake)(nil)
+
+// GetLdapGroupsV1 records the call and delegates to GetLdapGroupsV1Func.
+func (f *LdapFake) GetLdapGroupsV1(ctx context.Context, rsqlQuery map[string]string) (*ldap.ListGroupsResponseV1, *resty.Response, error) {
+	f.Record("GetLdapGroupsV1", ctx, rsqlQuery)
+	if f.GetLdapGroupsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LdapFake.GetLdapGroupsV1")
+	}
+	return f.GetLdapGroupsV1Func(ctx, rsqlQuery)
+}
+
+// GetLdapServersOnlyV1 records the call and delegates to GetLdapServersOnlyV1Func.
+func (f *LdapFake) GetLdapServersOnlyV1(ctx context.Context) ([]ldap.ResourceLdapServerV1, *resty.Response, error) {
+	f.Record("GetLdapServersOnlyV1", ctx)
+	if f.GetLdapServersOnlyV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LdapFake.GetLdapServersOnlyV1")
+	}
+	return f.GetLdapServersOnlyV1Func(ctx)
+}
+
+// GetLdapServersV1 records the call and delegates to GetLdapServersV1Func.
+func (f *LdapFake) GetLdapServersV1(ctx context.Context) ([]ldap.ResourceLdapServerV1, *resty.Response, error) {
+	f.Record("GetLdapServersV1", ctx)
+	if f.GetLdapServersV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LdapFake.GetLdapServersV1")
+	}
+	return f.GetLdapServersV1Func(ctx)
+}
@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package ldap
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Ldap.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LdapFake implements it for tests.
+type Service interface {
+	GetLdapGroupsV1(ctx context.Context, rsqlQuery map[string]string) (*ListGroupsResponseV1, *resty.Response, error)
+	GetLdapServersOnlyV1(ctx context.Context) ([]ResourceLdapServerV1, *resty.Response, error)
+	GetLdapServersV1(ctx context.Context) ([]ResourceLdapServerV1, *resty.Response, error)
+}
+
+var _ Service = (*Ldap)(nil)
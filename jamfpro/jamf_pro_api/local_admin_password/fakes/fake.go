@@ -0,0 +1,143 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the local_admin_password
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/local_admin_password"
+	"resty.dev/v3"
+)
+
+// LocalAdminPasswordFake is a local_admin_password.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LocalAdminPasswordFake struct {
+	fakes.Journal
+
+	GetAuditByUsernameAndGUIDV2Func              func(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.PasswordHistoryResponse, *resty.Response, error)
+	GetCapableAccountsByClientManagementIDV2Func func(ctx context.Context, clientManagementID string) (*local_admin_password.CapableAccountsResponse, *resty.Response, error)
+	GetCurrentPasswordByClientManagementIDV2Func func(ctx context.Context, clientManagementID string, username string) (*local_admin_password.CurrentPasswordResponse, *resty.Response, error)
+	GetFullHistoryByClientManagementIDV2Func     func(ctx context.Context, clientManagementID string) (*local_admin_password.FullHistoryResponse, *resty.Response, error)
+	GetHistoryByUsernameAndGUIDV2Func            func(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.AccountHistoryResponse, *resty.Response, error)
+	GetHistoryByUsernameV2Func                   func(ctx context.Context, clientManagementID string, username string) (*local_admin_password.AccountHistoryResponse, *resty.Response, error)
+	GetPasswordByUsernameAndGUIDV2Func           func(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.CurrentPasswordResponse, *resty.Response, error)
+	GetPasswordHistoryByClientManagementIDV2Func func(ctx context.Context, clientManagementID string, username string) (*local_admin_password.PasswordHistoryResponse, *resty.Response, error)
+	GetPendingRotationsV2Func                    func(ctx context.Context) (*local_admin_password.PendingRotationsResponse, *resty.Response, error)
+	GetSettingsV2Func                            func(ctx context.Context) (*local_admin_password.SettingsResource, *resty.Response, error)
+	SetPasswordByClientManagementIDV2Func        func(ctx context.Context, clientManagementID string, passwordList *local_admin_password.SetPasswordRequest) (*local_admin_password.SetPasswordResponse, *resty.Response, error)
+	UpdateSettingsV2Func                         func(ctx context.Context, settings *local_admin_password.SettingsResource) (*resty.Response, error)
+}
+
+var _ local_admin_password.Service = (*LocalAdminPasswordFake)(nil)
+
+// GetAuditByUsernameAndGUIDV2 records the call and delegates to GetAuditByUsernameAndGUIDV2Func.
+func (f *LocalAdminPasswordFake) GetAuditByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.PasswordHistoryResponse, *resty.Response, error) {
+	f.Record("GetAuditByUsernameAndGUIDV2", ctx, clientManagementID, username, guid)
+	if f.GetAuditByUsernameAndGUIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetAuditByUsernameAndGUIDV2")
+	}
+	return f.GetAuditByUsernameAndGUIDV2Func(ctx, clientManagementID, username, guid)
+}
+
+// GetCapableAccountsByClientManagementIDV2 records the call and delegates to GetCapableAccountsByClientManagementIDV2Func.
+func (f *LocalAdminPasswordFake) GetCapableAccountsByClientManagementIDV2(ctx context.Context, clientManagementID string) (*local_admin_password.CapableAccountsResponse, *resty.Response, error) {
+	f.Record("GetCapableAccountsByClientManagementIDV2", ctx, clientManagementID)
+	if f.GetCapableAccountsByClientManagementIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetCapableAccountsByClientManagementIDV2")
+	}
+	return f.GetCapableAccountsByClientManagementIDV2Func(ctx, clientManagementID)
+}
+
+// GetCurrentPasswordByClientManagementIDV2 records the call and delegates to GetCurrentPasswordByClientManagementIDV2Func.
+func (f *LocalAdminPasswordFake) GetCurrentPasswordByClientManagementIDV2(ctx context.Context, clientManagementID string, username string) (*local_admin_password.CurrentPasswordResponse, *resty.Response, error) {
+	f.Record("GetCurrentPasswordByClientManagementIDV2", ctx, clientManagementID, username)
+	if f.GetCurrentPasswordByClientManagementIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetCurrentPasswordByClientManagementIDV2")
+	}
+	return f.GetCurrentPasswordByClientManagementIDV2Func(ctx, clientManagementID, username)
+}
+
+// GetFullHistoryByClientManagementIDV2 records the call and delegates to GetFullHistoryByClientManagementIDV2Func.
+func (f *LocalAdminPasswordFake) GetFullHistoryByClientManagementIDV2(ctx context.Context, clientManagementID string) (*local_admin_password.FullHistoryResponse, *resty.Response, error) {
+	f.Record("GetFullHistoryByClientManagementIDV2", ctx, clientManagementID)
+	if f.GetFullHistoryByClientManagementIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetFullHistoryByClientManagementIDV2")
+	}
+	return f.GetFullHistoryByClientManagementIDV2Func(ctx, clientManagementID)
+}
+
+// GetHistoryByUsernameAndGUIDV2 records the call and delegates to GetHistoryByUsernameAndGUIDV2Func.
+func (f *LocalAdminPasswordFake) GetHistoryByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.AccountHistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByUsernameAndGUIDV2", ctx, clientManagementID, username, guid)
+	if f.GetHistoryByUsernameAndGUIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetHistoryByUsernameAndGUIDV2")
+	}
+	return f.GetHistoryByUsernameAndGUIDV2Func(ctx, clientManagementID, username, guid)
+}
+
+// GetHistoryByUsernameV2 records the call and delegates to GetHistoryByUsernameV2Func.
+func (f *LocalAdminPasswordFake) GetHistoryByUsernameV2(ctx context.Context, clientManagementID string, username string) (*local_admin_password.AccountHistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByUsernameV2", ctx, clientManagementID, username)
+	if f.GetHistoryByUsernameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetHistoryByUsernameV2")
+	}
+	return f.GetHistoryByUsernameV2Func(ctx, clientManagementID, username)
+}
+
+// GetPasswordByUsernameAndGUIDV2 records the call and delegates to GetPasswordByUsernameAndGUIDV2Func.
+func (f *LocalAdminPasswordFake) GetPasswordByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*local_admin_password.CurrentPasswordResponse, *resty.Response, error) {
+	f.Record("GetPasswordByUsernameAndGUIDV2", ctx, clientManagementID, username, guid)
+	if f.GetPasswordByUsernameAndGUIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetPasswordByUsernameAndGUIDV2")
+	}
+	return f.GetPasswordByUsernameAndGUIDV2Func(ctx, clientManagementID, username, guid)
+}
+
+// GetPasswordHistoryByClientManagementIDV2 records the call and delegates to GetPasswordHistoryByClientManagementIDV2Func.
+func (f *LocalAdminPasswordFake) GetPasswordHistoryByClientManagementIDV2(ctx context.Context, clientManagementID string, username string) (*local_admin_password.PasswordHistoryResponse, *resty.Response, error) {
+	f.Record("GetPasswordHistoryByClientManagementIDV2", ctx, clientManagementID, username)
+	if f.GetPasswordHistoryByClientManagementIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetPasswordHistoryByClientManagementIDV2")
+	}
+	return f.GetPasswordHistoryByClientManagementIDV2Func(ctx, clientManagementID, username)
+}
+
+// GetPendingRotationsV2 records the call and delegates to GetPendingRotationsV2Func.
+func (f *LocalAdminPasswordFake) GetPendingRotationsV2(ctx context.Context) (*local_admin_password.PendingRotationsResponse, *resty.Response, error) {
+	f.Record("GetPendingRotationsV2", ctx)
+	if f.GetPendingRotationsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetPendingRotationsV2")
+	}
+	return f.GetPendingRotationsV2Func(ctx)
+}
+
+// GetSettingsV2 records the call and delegates to GetSettingsV2Func.
+func (f *LocalAdminPasswordFake) GetSettingsV2(ctx context.Context) (*local_admin_password.SettingsResource, *resty.Response, error) {
+	f.Record("GetSettingsV2", ctx)
+	if f.GetSettingsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.GetSettingsV2")
+	}
+	return f.GetSettingsV2Func(ctx)
+}
+
+// SetPasswordByClientManagementIDV2 records the call and delegates to SetPasswordByClientManagementIDV2Func.
+func (f *LocalAdminPasswordFake) SetPasswordByClientManagementIDV2(ctx context.Context, clientManagementID string, passwordList *local_admin_password.SetPasswordRequest) (*local_admin_password.SetPasswordResponse, *resty.Response, error) {
+	f.Record("SetPasswordByClientManagementIDV2", ctx, clientManagementID, passwordList)
+	if f.SetPasswordByClientManagementIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalAdminPasswordFake.SetPasswordByClientManagementIDV2")
+	}
+	return f.SetPasswordByClientManagementIDV2Func(ctx, clientManagementID, passwordList)
+}
+
+// UpdateSettingsV2 records the call and delegates to UpdateSettingsV2Func.
+func (f *LocalAdminPasswordFake) UpdateSettingsV2(ctx context.Context, settings *local_admin_password.SettingsResource) (*resty.Response, error) {
+	f.Record("UpdateSettingsV2", ctx, settings)
+	if f.UpdateSettingsV2Func == nil {
+		return nil, fakes.NotStubbed("LocalAdminPasswordFake.UpdateSettingsV2")
+	}
+	return f.UpdateSettingsV2Func(ctx, settings)
+}
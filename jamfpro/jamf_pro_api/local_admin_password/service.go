@@ -0,0 +1,29 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package local_admin_password
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LocalAdminPassword.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LocalAdminPasswordFake implements it for tests.
+type Service interface {
+	GetAuditByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*PasswordHistoryResponse, *resty.Response, error)
+	GetCapableAccountsByClientManagementIDV2(ctx context.Context, clientManagementID string) (*CapableAccountsResponse, *resty.Response, error)
+	GetCurrentPasswordByClientManagementIDV2(ctx context.Context, clientManagementID string, username string) (*CurrentPasswordResponse, *resty.Response, error)
+	GetFullHistoryByClientManagementIDV2(ctx context.Context, clientManagementID string) (*FullHistoryResponse, *resty.Response, error)
+	GetHistoryByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*AccountHistoryResponse, *resty.Response, error)
+	GetHistoryByUsernameV2(ctx context.Context, clientManagementID string, username string) (*AccountHistoryResponse, *resty.Response, error)
+	GetPasswordByUsernameAndGUIDV2(ctx context.Context, clientManagementID string, username string, guid string) (*CurrentPasswordResponse, *resty.Response, error)
+	GetPasswordHistoryByClientManagementIDV2(ctx context.Context, clientManagementID string, username string) (*PasswordHistoryResponse, *resty.Response, error)
+	GetPendingRotationsV2(ctx context.Context) (*PendingRotationsResponse, *resty.Response, error)
+	GetSettingsV2(ctx context.Context) (*SettingsResource, *resty.Response, error)
+	SetPasswordByClientManagementIDV2(ctx context.Context, clientManagementID string, passwordList *SetPasswordRequest) (*SetPasswordResponse, *resty.Response, error)
+	UpdateSettingsV2(ctx context.Context, settings *SettingsResource) (*resty.Response, error)
+}
+
+var _ Service = (*LocalAdminPassword)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the locales
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/locales"
+	"resty.dev/v3"
+)
+
+// LocalesFake is a locales.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LocalesFake struct {
+	fakes.Journal
+
+	ListV1Func func(ctx context.Context) ([]locales.ResourceLocale, *resty.Response, error)
+}
+
+var _ locales.Service = (*LocalesFake)(nil)
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *LocalesFake) ListV1(ctx context.Context) ([]locales.ResourceLocale, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LocalesFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package locales
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Locales.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LocalesFake implements it for tests.
+type Service interface {
+	ListV1(ctx context.Context) ([]ResourceLocale, *resty.Response, error)
+}
+
+var _ Service = (*Locales)(nil)
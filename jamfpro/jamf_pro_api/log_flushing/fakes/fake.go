@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the log_flushing
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/log_flushing"
+	"resty.dev/v3"
+)
+
+// LogFlushingFake is a log_flushing.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LogFlushingFake struct {
+	fakes.Journal
+
+	DeleteTaskByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetSettingsV1Func    func(ctx context.Context) (*log_flushing.ResourceLogFlushingSettings, *resty.Response, error)
+	GetTaskByIDV1Func    func(ctx context.Context, id string) (*log_flushing.ResourceLogFlushingTask, *resty.Response, error)
+	ListTasksV1Func      func(ctx context.Context) ([]log_flushing.ResourceLogFlushingTask, *resty.Response, error)
+	QueueTaskV1Func      func(ctx context.Context, request *log_flushing.RequestLogFlushingTask) (*log_flushing.CreateResponse, *resty.Response, error)
+}
+
+var _ log_flushing.Service = (*LogFlushingFake)(nil)
+
+// DeleteTaskByIDV1 records the call and delegates to DeleteTaskByIDV1Func.
+func (f *LogFlushingFake) DeleteTaskByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteTaskByIDV1", ctx, id)
+	if f.DeleteTaskByIDV1Func == nil {
+		return nil, fakes.NotStubbed("LogFlushingFake.DeleteTaskByIDV1")
+	}
+	return f.DeleteTaskByIDV1Func(ctx, id)
+}
+
+// GetSettingsV1 records the call and delegates to GetSettingsV1Func.
+func (f *LogFlushingFake) GetSettingsV1(ctx context.Context) (*log_flushing.ResourceLogFlushingSettings, *resty.Response, error) {
+	f.Record("GetSettingsV1", ctx)
+	if f.GetSettingsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LogFlushingFake.GetSettingsV1")
+	}
+	return f.GetSettingsV1Func(ctx)
+}
+
+// GetTaskByIDV1 records the call and delegates to GetTaskByIDV1Func.
+func (f *LogFlushingFake) GetTaskByIDV1(ctx context.Context, id string) (*log_flushing.ResourceLogFlushingTask, *resty.Response, error) {
+	f.Record("GetTaskByIDV1", ctx, id)
+	if f.GetTaskByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LogFlushingFake.GetTaskByIDV1")
+	}
+	return f.GetTaskByIDV1Func(ctx, id)
+}
+
+// ListTasksV1 records the call and delegates to ListTasksV1Func.
+func (f *LogFlushingFake) ListTasksV1(ctx context.Context) ([]log_flushing.ResourceLogFlushingTask, *resty.Response, error) {
+	f.Record("ListTasksV1", ctx)
+	if f.ListTasksV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LogFlushingFake.ListTasksV1")
+	}
+	return f.ListTasksV1Func(ctx)
+}
+
+// QueueTaskV1 records the call and delegates to QueueTaskV1Func.
+func (f *LogFlushingFake) QueueTaskV1(ctx context.Context, request *log_flushing.RequestLogFlushingTask) (*log_flushing.CreateResponse, *resty.Response, error) {
+	f.Record("QueueTaskV1", ctx, request)
+	if f.QueueTaskV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LogFlushingFake.QueueTaskV1")
+	}
+	return f.QueueTaskV1Func(ctx, request)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package log_flushing
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LogFlushing.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LogFlushingFake implements it for tests.
+type Service interface {
+	DeleteTaskByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetSettingsV1(ctx context.Context) (*ResourceLogFlushingSettings, *resty.Response, error)
+	GetTaskByIDV1(ctx context.Context, id string) (*ResourceLogFlushingTask, *resty.Response, error)
+	ListTasksV1(ctx context.Context) ([]ResourceLogFlushingTask, *resty.Response, error)
+	QueueTaskV1(ctx context.Context, request *RequestLogFlushingTask) (*CreateResponse, *resty.Response, error)
+}
+
+var _ Service = (*LogFlushing)(nil)
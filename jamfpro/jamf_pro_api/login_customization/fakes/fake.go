@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the login_customization
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/login_customization"
+	"resty.dev/v3"
+)
+
+// LoginCustomizationFake is a login_customization.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type LoginCustomizationFake struct {
+	fakes.Journal
+
+	GetV1Func    func(ctx context.Context) (*login_customization.ResourceLoginCustomizationV1, *resty.Response, error)
+	UpdateV1Func func(ctx context.Context, request *login_customization.ResourceLoginCustomizationV1) (*login_customization.ResourceLoginCustomizationV1, *resty.Response, error)
+}
+
+var _ login_customization.Service = (*LoginCustomizationFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *LoginCustomizationFake) GetV1(ctx context.Context) (*login_customization.ResourceLoginCustomizationV1, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LoginCustomizationFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *LoginCustomizationFake) UpdateV1(ctx context.Context, request *login_customization.ResourceLoginCustomizationV1) (*login_customization.ResourceLoginCustomizationV1, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("LoginCustomizationFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
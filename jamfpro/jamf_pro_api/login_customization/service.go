@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package login_customization
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of LoginCustomization.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.LoginCustomizationFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceLoginCustomizationV1, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceLoginCustomizationV1) (*ResourceLoginCustomizationV1, *resty.Response, error)
+}
+
+var _ Service = (*LoginCustomization)(nil)
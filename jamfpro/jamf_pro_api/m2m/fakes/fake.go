@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the m2m
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/m2m"
+	"resty.dev/v3"
+)
+
+// M2MFake is a m2m.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type M2MFake struct {
+	fakes.Journal
+
+	GetTenantIdV1Func func(ctx context.Context) (*m2m.ResourceM2mTenantId, *resty.Response, error)
+}
+
+var _ m2m.Service = (*M2MFake)(nil)
+
+// GetTenantIdV1 records the call and delegates to GetTenantIdV1Func.
+func (f *M2MFake) GetTenantIdV1(ctx context.Context) (*m2m.ResourceM2mTenantId, *resty.Response, error) {
+	f.Record("GetTenantIdV1", ctx)
+	if f.GetTenantIdV1Func == nil {
+		return nil, nil, fakes.NotStubbed("M2MFake.GetTenantIdV1")
+	}
+	return f.GetTenantIdV1Func(ctx)
+}
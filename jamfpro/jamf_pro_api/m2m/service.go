@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package m2m
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of M2M.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.M2MFake implements it for tests.
+type Service interface {
+	GetTenantIdV1(ctx context.Context) (*ResourceM2mTenantId, *resty.Response, error)
+}
+
+var _ Service = (*M2M)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the macos_configuration_profiles
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/macos_configuration_profiles"
+	"resty.dev/v3"
+)
+
+// MacosConfigurationProfilesFake is a macos_configuration_profiles.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MacosConfigurationProfilesFake struct {
+	fakes.Journal
+
+	CreateFunc              func(ctx context.Context, profile *macos_configuration_profiles.ResourceConfigProfile) (*macos_configuration_profiles.ResponseConfigProfileCreate, *resty.Response, error)
+	DeleteByPayloadUUIDFunc func(ctx context.Context, id string) (*resty.Response, error)
+	GetByPayloadUUIDFunc    func(ctx context.Context, id string) (*macos_configuration_profiles.ResourceConfigProfile, *resty.Response, error)
+	GetSchemaListFunc       func(ctx context.Context) (*macos_configuration_profiles.ResponseCustomSettingsSchemaList, *resty.Response, error)
+	UpdateByPayloadUUIDFunc func(ctx context.Context, id string, profile *macos_configuration_profiles.ResourceConfigProfile) (*macos_configuration_profiles.ResponseConfigProfileCreate, *resty.Response, error)
+}
+
+var _ macos_configuration_profiles.Service = (*MacosConfigurationProfilesFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *MacosConfigurationProfilesFake) Create(ctx context.Context, profile *macos_configuration_profiles.ResourceConfigProfile) (*macos_configuration_profiles.ResponseConfigProfileCreate, *resty.Response, error) {
+	f.Record("Create", ctx, profile)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.Create")
+	}
+	return f.CreateFunc(ctx, profile)
+}
+
+// DeleteByPayloadUUID records the call and delegates to DeleteByPayloadUUIDFunc.
+func (f *MacosConfigurationProfilesFake) DeleteByPayloadUUID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByPayloadUUID", ctx, id)
+	if f.DeleteByPayloadUUIDFunc == nil {
+		return nil, fakes.NotStubbed("MacosConfigurationProfilesFake.DeleteByPayloadUUID")
+	}
+	return f.DeleteByPayloadUUIDFunc(ctx, id)
+}
+
+// GetByPayloadUUID records the call and delegates to GetByPayloadUUIDFunc.
+func (f *MacosConfigurationProfilesFake) GetByPayloadUUID(ctx context.Context, id string) (*macos_configuration_profiles.ResourceConfigProfile, *resty.Response, error) {
+	f.Record("GetByPayloadUUID", ctx, id)
+	if f.GetByPayloadUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.GetByPayloadUUID")
+	}
+	return f.GetByPayloadUUIDFunc(ctx, id)
+}
+
+// GetSchemaList records the call and delegates to GetSchemaListFunc.
+func (f *MacosConfigurationProfilesFake) GetSchemaList(ctx context.Context) (*macos_configuration_profiles.ResponseCustomSettingsSchemaList, *resty.Response, error) {
+	f.Record("GetSchemaList", ctx)
+	if f.GetSchemaListFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.GetSchemaList")
+	}
+	return f.GetSchemaListFunc(ctx)
+}
+
+// UpdateByPayloadUUID records the call and delegates to UpdateByPayloadUUIDFunc.
+func (f *MacosConfigurationProfilesFake) UpdateByPayloadUUID(ctx context.Context, id string, profile *macos_configuration_profiles.ResourceConfigProfile) (*macos_configuration_profiles.ResponseConfigProfileCreate, *resty.Response, error) {
+	f.Record("UpdateByPayloadUUID", ctx, id, profile)
+	if f.UpdateByPayloadUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("MacosConfigurationProfilesFake.UpdateByPayloadUUID")
+	}
+	return f.UpdateByPayloadUUIDFunc(ctx, id, profile)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package macos_configuration_profiles
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MacosConfigurationProfiles.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MacosConfigurationProfilesFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, profile *ResourceConfigProfile) (*ResponseConfigProfileCreate, *resty.Response, error)
+	DeleteByPayloadUUID(ctx context.Context, id string) (*resty.Response, error)
+	GetByPayloadUUID(ctx context.Context, id string) (*ResourceConfigProfile, *resty.Response, error)
+	GetSchemaList(ctx context.Context) (*ResponseCustomSettingsSchemaList, *resty.Response, error)
+	UpdateByPayloadUUID(ctx context.Context, id string, profile *ResourceConfigProfile) (*ResponseConfigProfileCreate, *resty.Response, error)
+}
+
+var _ Service = (*MacosConfigurationProfiles)(nil)
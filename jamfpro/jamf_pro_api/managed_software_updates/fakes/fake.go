@@ -0,0 +1,194 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the managed_software_updates
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/managed_software_updates"
+	"resty.dev/v3"
+)
+
+// ManagedSoftwareUpdatesFake is a managed_software_updates.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ManagedSoftwareUpdatesFake struct {
+	fakes.Journal
+
+	CreatePlanByDeviceIDFunc                 func(ctx context.Context, plan *managed_software_updates.RequestPlanCreate) (*managed_software_updates.ResponsePlanCreate, *resty.Response, error)
+	CreatePlanByGroupIDFunc                  func(ctx context.Context, plan *managed_software_updates.RequestPlanCreate) (*managed_software_updates.ResponsePlanCreate, *resty.Response, error)
+	ForceStopFeatureToggleProcessFunc        func(ctx context.Context) (*managed_software_updates.ResponseError, *resty.Response, error)
+	GetAvailableUpdatesFunc                  func(ctx context.Context) (*managed_software_updates.ResponseAvailableUpdates, *resty.Response, error)
+	GetDeclarationsByPlanUUIDFunc            func(ctx context.Context, uuid string) (*managed_software_updates.ResponseDeclarationsList, *resty.Response, error)
+	GetFeatureToggleFunc                     func(ctx context.Context) (*managed_software_updates.RequestFeatureToggle, *resty.Response, error)
+	GetFeatureToggleStatusFunc               func(ctx context.Context) (*managed_software_updates.ResponseFeatureToggleStatus, *resty.Response, error)
+	GetPlanByUUIDFunc                        func(ctx context.Context, uuid string) (*managed_software_updates.ResourcePlan, *resty.Response, error)
+	GetPlanEventsByUUIDFunc                  func(ctx context.Context, uuid string) (*managed_software_updates.ResponsePlanEvents, *resty.Response, error)
+	GetPlansFunc                             func(ctx context.Context, params url.Values) (*managed_software_updates.ResponsePlanList, *resty.Response, error)
+	GetPlansByGroupIDFunc                    func(ctx context.Context, groupID string, groupType string) (*managed_software_updates.ResponsePlanList, *resty.Response, error)
+	GetUpdateStatusesFunc                    func(ctx context.Context, params url.Values) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByComputerFunc          func(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByComputerGroupFunc     func(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByMobileDeviceFunc      func(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByMobileDeviceGroupFunc func(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error)
+	UpdateFeatureToggleFunc                  func(ctx context.Context, toggle *managed_software_updates.RequestFeatureToggle) (*managed_software_updates.ResponseFeatureToggle, *resty.Response, error)
+}
+
+var _ managed_software_updates.Service = (*ManagedSoftwareUpdatesFake)(nil)
+
+// CreatePlanByDeviceID records the call and delegates to CreatePlanByDeviceIDFunc.
+func (f *ManagedSoftwareUpdatesFake) CreatePlanByDeviceID(ctx context.Context, plan *managed_software_updates.RequestPlanCreate) (*managed_software_updates.ResponsePlanCreate, *resty.Response, error) {
+	f.Record("CreatePlanByDeviceID", ctx, plan)
+	if f.CreatePlanByDeviceIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.CreatePlanByDeviceID")
+	}
+	return f.CreatePlanByDeviceIDFunc(ctx, plan)
+}
+
+// CreatePlanByGroupID records the call and delegates to CreatePlanByGroupIDFunc.
+func (f *ManagedSoftwareUpdatesFake) CreatePlanByGroupID(ctx context.Context, plan *managed_software_updates.RequestPlanCreate) (*managed_software_updates.ResponsePlanCreate, *resty.Response, error) {
+	f.Record("CreatePlanByGroupID", ctx, plan)
+	if f.CreatePlanByGroupIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.CreatePlanByGroupID")
+	}
+	return f.CreatePlanByGroupIDFunc(ctx, plan)
+}
+
+// ForceStopFeatureToggleProcess records the call and delegates to ForceStopFeatureToggleProcessFunc.
+func (f *ManagedSoftwareUpdatesFake) ForceStopFeatureToggleProcess(ctx context.Context) (*managed_software_updates.ResponseError, *resty.Response, error) {
+	f.Record("ForceStopFeatureToggleProcess", ctx)
+	if f.ForceStopFeatureToggleProcessFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.ForceStopFeatureToggleProcess")
+	}
+	return f.ForceStopFeatureToggleProcessFunc(ctx)
+}
+
+// GetAvailableUpdates records the call and delegates to GetAvailableUpdatesFunc.
+func (f *ManagedSoftwareUpdatesFake) GetAvailableUpdates(ctx context.Context) (*managed_software_updates.ResponseAvailableUpdates, *resty.Response, error) {
+	f.Record("GetAvailableUpdates", ctx)
+	if f.GetAvailableUpdatesFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetAvailableUpdates")
+	}
+	return f.GetAvailableUpdatesFunc(ctx)
+}
+
+// GetDeclarationsByPlanUUID records the call and delegates to GetDeclarationsByPlanUUIDFunc.
+func (f *ManagedSoftwareUpdatesFake) GetDeclarationsByPlanUUID(ctx context.Context, uuid string) (*managed_software_updates.ResponseDeclarationsList, *resty.Response, error) {
+	f.Record("GetDeclarationsByPlanUUID", ctx, uuid)
+	if f.GetDeclarationsByPlanUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetDeclarationsByPlanUUID")
+	}
+	return f.GetDeclarationsByPlanUUIDFunc(ctx, uuid)
+}
+
+// GetFeatureToggle records the call and delegates to GetFeatureToggleFunc.
+func (f *ManagedSoftwareUpdatesFake) GetFeatureToggle(ctx context.Context) (*managed_software_updates.RequestFeatureToggle, *resty.Response, error) {
+	f.Record("GetFeatureToggle", ctx)
+	if f.GetFeatureToggleFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetFeatureToggle")
+	}
+	return f.GetFeatureToggleFunc(ctx)
+}
+
+// GetFeatureToggleStatus records the call and delegates to GetFeatureToggleStatusFunc.
+func (f *ManagedSoftwareUpdatesFake) GetFeatureToggleStatus(ctx context.Context) (*managed_software_updates.ResponseFeatureToggleStatus, *resty.Response, error) {
+	f.Record("GetFeatureToggleStatus", ctx)
+	if f.GetFeatureToggleStatusFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetFeatureToggleStatus")
+	}
+	return f.GetFeatureToggleStatusFunc(ctx)
+}
+
+// GetPlanByUUID records the call and delegates to GetPlanByUUIDFunc.
+func (f *ManagedSoftwareUpdatesFake) GetPlanByUUID(ctx context.Context, uuid string) (*managed_software_updates.ResourcePlan, *resty.Response, error) {
+	f.Record("GetPlanByUUID", ctx, uuid)
+	if f.GetPlanByUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetPlanByUUID")
+	}
+	return f.GetPlanByUUIDFunc(ctx, uuid)
+}
+
+// GetPlanEventsByUUID records the call and delegates to GetPlanEventsByUUIDFunc.
+func (f *ManagedSoftwareUpdatesFake) GetPlanEventsByUUID(ctx context.Context, uuid string) (*managed_software_updates.ResponsePlanEvents, *resty.Response, error) {
+	f.Record("GetPlanEventsByUUID", ctx, uuid)
+	if f.GetPlanEventsByUUIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetPlanEventsByUUID")
+	}
+	return f.GetPlanEventsByUUIDFunc(ctx, uuid)
+}
+
+// GetPlans records the call and delegates to GetPlansFunc.
+func (f *ManagedSoftwareUpdatesFake) GetPlans(ctx context.Context, params url.Values) (*managed_software_updates.ResponsePlanList, *resty.Response, error) {
+	f.Record("GetPlans", ctx, params)
+	if f.GetPlansFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetPlans")
+	}
+	return f.GetPlansFunc(ctx, params)
+}
+
+// GetPlansByGroupID records the call and delegates to GetPlansByGroupIDFunc.
+func (f *ManagedSoftwareUpdatesFake) GetPlansByGroupID(ctx context.Context, groupID string, groupType string) (*managed_software_updates.ResponsePlanList, *resty.Response, error) {
+	f.Record("GetPlansByGroupID", ctx, groupID, groupType)
+	if f.GetPlansByGroupIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetPlansByGroupID")
+	}
+	return f.GetPlansByGroupIDFunc(ctx, groupID, groupType)
+}
+
+// GetUpdateStatuses records the call and delegates to GetUpdateStatusesFunc.
+func (f *ManagedSoftwareUpdatesFake) GetUpdateStatuses(ctx context.Context, params url.Values) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error) {
+	f.Record("GetUpdateStatuses", ctx, params)
+	if f.GetUpdateStatusesFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetUpdateStatuses")
+	}
+	return f.GetUpdateStatusesFunc(ctx, params)
+}
+
+// GetUpdateStatusesByComputer records the call and delegates to GetUpdateStatusesByComputerFunc.
+func (f *ManagedSoftwareUpdatesFake) GetUpdateStatusesByComputer(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error) {
+	f.Record("GetUpdateStatusesByComputer", ctx, id)
+	if f.GetUpdateStatusesByComputerFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetUpdateStatusesByComputer")
+	}
+	return f.GetUpdateStatusesByComputerFunc(ctx, id)
+}
+
+// GetUpdateStatusesByComputerGroup records the call and delegates to GetUpdateStatusesByComputerGroupFunc.
+func (f *ManagedSoftwareUpdatesFake) GetUpdateStatusesByComputerGroup(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error) {
+	f.Record("GetUpdateStatusesByComputerGroup", ctx, id)
+	if f.GetUpdateStatusesByComputerGroupFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetUpdateStatusesByComputerGroup")
+	}
+	return f.GetUpdateStatusesByComputerGroupFunc(ctx, id)
+}
+
+// GetUpdateStatusesByMobileDevice records the call and delegates to GetUpdateStatusesByMobileDeviceFunc.
+func (f *ManagedSoftwareUpdatesFake) GetUpdateStatusesByMobileDevice(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error) {
+	f.Record("GetUpdateStatusesByMobileDevice", ctx, id)
+	if f.GetUpdateStatusesByMobileDeviceFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetUpdateStatusesByMobileDevice")
+	}
+	return f.GetUpdateStatusesByMobileDeviceFunc(ctx, id)
+}
+
+// GetUpdateStatusesByMobileDeviceGroup records the call and delegates to GetUpdateStatusesByMobileDeviceGroupFunc.
+func (f *ManagedSoftwareUpdatesFake) GetUpdateStatusesByMobileDeviceGroup(ctx context.Context, id string) (*managed_software_updates.ResponseUpdateStatusList, *resty.Response, error) {
+	f.Record("GetUpdateStatusesByMobileDeviceGroup", ctx, id)
+	if f.GetUpdateStatusesByMobileDeviceGroupFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.GetUpdateStatusesByMobileDeviceGroup")
+	}
+	return f.GetUpdateStatusesByMobileDeviceGroupFunc(ctx, id)
+}
+
+// UpdateFeatureToggle records the call and delegates to UpdateFeatureToggleFunc.
+func (f *ManagedSoftwareUpdatesFake) UpdateFeatureToggle(ctx context.Context, toggle *managed_software_updates.RequestFeatureToggle) (*managed_software_updates.ResponseFeatureToggle, *resty.Response, error) {
+	f.Record("UpdateFeatureToggle", ctx, toggle)
+	if f.UpdateFeatureToggleFunc == nil {
+		return nil, nil, fakes.NotStubbed("ManagedSoftwareUpdatesFake.UpdateFeatureToggle")
+	}
+	return f.UpdateFeatureToggleFunc(ctx, toggle)
+}
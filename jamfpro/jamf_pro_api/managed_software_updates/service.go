@@ -0,0 +1,35 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package managed_software_updates
+
+import (
+	"context"
+	"net/url"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ManagedSoftwareUpdates.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ManagedSoftwareUpdatesFake implements it for tests.
+type Service interface {
+	CreatePlanByDeviceID(ctx context.Context, plan *RequestPlanCreate) (*ResponsePlanCreate, *resty.Response, error)
+	CreatePlanByGroupID(ctx context.Context, plan *RequestPlanCreate) (*ResponsePlanCreate, *resty.Response, error)
+	ForceStopFeatureToggleProcess(ctx context.Context) (*ResponseError, *resty.Response, error)
+	GetAvailableUpdates(ctx context.Context) (*ResponseAvailableUpdates, *resty.Response, error)
+	GetDeclarationsByPlanUUID(ctx context.Context, uuid string) (*ResponseDeclarationsList, *resty.Response, error)
+	GetFeatureToggle(ctx context.Context) (*RequestFeatureToggle, *resty.Response, error)
+	GetFeatureToggleStatus(ctx context.Context) (*ResponseFeatureToggleStatus, *resty.Response, error)
+	GetPlanByUUID(ctx context.Context, uuid string) (*ResourcePlan, *resty.Response, error)
+	GetPlanEventsByUUID(ctx context.Context, uuid string) (*ResponsePlanEvents, *resty.Response, error)
+	GetPlans(ctx context.Context, params url.Values) (*ResponsePlanList, *resty.Response, error)
+	GetPlansByGroupID(ctx context.Context, groupID string, groupType string) (*ResponsePlanList, *resty.Response, error)
+	GetUpdateStatuses(ctx context.Context, params url.Values) (*ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByComputer(ctx context.Context, id string) (*ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByComputerGroup(ctx context.Context, id string) (*ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByMobileDevice(ctx context.Context, id string) (*ResponseUpdateStatusList, *resty.Response, error)
+	GetUpdateStatusesByMobileDeviceGroup(ctx context.Context, id string) (*ResponseUpdateStatusList, *resty.Response, error)
+	UpdateFeatureToggle(ctx context.Context, toggle *RequestFeatureToggle) (*ResponseFeatureToggle, *resty.Response, error)
+}
+
+var _ Service = (*ManagedSoftwareUpdates)(nil)
@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mdm
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mdm"
+	"resty.dev/v3"
+)
+
+// MdmFake is a mdm.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MdmFake struct {
+	fakes.Journal
+
+	BlankPushFunc      func(ctx context.Context, clientManagementIDs []string) (*mdm.BlankPushResponse, *resty.Response, error)
+	DeployPackageFunc  func(ctx context.Context, req *mdm.DeployPackageRequest) (*mdm.DeployPackageResponse, *resty.Response, error)
+	ListCommandsV1Func func(ctx context.Context, query map[string]string) ([]mdm.ResourceMdmCommand, *resty.Response, error)
+	ListCommandsV2Func func(ctx context.Context, rsqlQuery map[string]string) (*mdm.ListCommandsResponse, *resty.Response, error)
+	RenewProfileFunc   func(ctx context.Context, req *mdm.RenewProfileRequest) (*mdm.RenewProfileResponse, *resty.Response, error)
+	SendCommandFunc    func(ctx context.Context, req *mdm.CommandRequest) (*mdm.CommandResponse, *resty.Response, error)
+}
+
+var _ mdm.Service = (*MdmFake)(nil)
+
+// BlankPush records the call and delegates to BlankPushFunc.
+func (f *MdmFake) BlankPush(ctx context.Context, clientManagementIDs []string) (*mdm.BlankPushResponse, *resty.Response, error) {
+	f.Record("BlankPush", ctx, clientManagementIDs)
+	if f.BlankPushFunc == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.BlankPush")
+	}
+	return f.BlankPushFunc(ctx, clientManagementIDs)
+}
+
+// DeployPackage records the call and delegates to DeployPackageFunc.
+func (f *MdmFake) DeployPackage(ctx context.Context, req *mdm.DeployPackageRequest) (*mdm.DeployPackageResponse, *resty.Response, error) {
+	f.Record("DeployPackage", ctx, req)
+	if f.DeployPackageFunc == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.DeployPackage")
+	}
+	return f.DeployPackageFunc(ctx, req)
+}
+
+// ListCommandsV1 records the call and delegates to ListCommandsV1Func.
+func (f *MdmFake) ListCommandsV1(ctx context.Context, query map[string]string) ([]mdm.ResourceMdmCommand, *resty.Response, error) {
+	f.Record("ListCommandsV1", ctx, query)
+	if f.ListCommandsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.ListCommandsV1")
+	}
+	return f.ListCommandsV1Func(ctx, query)
+}
+
+// ListCommandsV2 records the call and delegates to ListCommandsV2Func.
+func (f *MdmFake) ListCommandsV2(ctx context.Context, rsqlQuery map[string]string) (*mdm.ListCommandsResponse, *resty.Response, error) {
+	f.Record("ListCommandsV2", ctx, rsqlQuery)
+	if f.ListCommandsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.ListCommandsV2")
+	}
+	return f.ListCommandsV2Func(ctx, rsqlQuery)
+}
+
+// RenewProfile records the call and delegates to RenewProfileFunc.
+func (f *MdmFake) RenewProfile(ctx context.Context, req *mdm.RenewProfileRequest) (*mdm.RenewProfileResponse, *resty.Response, error) {
+	f.Record("RenewProfile", ctx, req)
+	if f.RenewProfileFunc == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.RenewProfile")
+	}
+	return f.RenewProfileFunc(ctx, req)
+}
+
+// SendCommand records the call and delegates to SendCommandFunc.
+func (f *MdmFake) SendCommand(ctx context.Context, req *mdm.CommandRequest) (*mdm.CommandResponse, *resty.Response, error) {
+	f.Record("SendCommand", ctx, req)
+	if f.SendCommandFunc == nil {
+		return nil, nil, fakes.NotStubbed("MdmFake.SendCommand")
+	}
+	return f.SendCommandFunc(ctx, req)
+}
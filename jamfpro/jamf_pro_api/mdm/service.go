@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mdm
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Mdm.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MdmFake implements it for tests.
+type Service interface {
+	BlankPush(ctx context.Context, clientManagementIDs []string) (*BlankPushResponse, *resty.Response, error)
+	DeployPackage(ctx context.Context, req *DeployPackageRequest) (*DeployPackageResponse, *resty.Response, error)
+	ListCommandsV1(ctx context.Context, query map[string]string) ([]ResourceMdmCommand, *resty.Response, error)
+	ListCommandsV2(ctx context.Context, rsqlQuery map[string]string) (*ListCommandsResponse, *resty.Response, error)
+	RenewProfile(ctx context.Context, req *RenewProfileRequest) (*RenewProfileResponse, *resty.Response, error)
+	SendCommand(ctx context.Context, req *CommandRequest) (*CommandResponse, *resty.Response, error)
+}
+
+var _ Service = (*Mdm)(nil)
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mdm_renewal
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mdm_renewal"
+	"resty.dev/v3"
+)
+
+// MdmRenewalFake is a mdm_renewal.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MdmRenewalFake struct {
+	fakes.Journal
+
+	DeleteRenewalStrategiesV1Func   func(ctx context.Context, clientManagementID string) (*resty.Response, error)
+	GetDeviceCommonDetailsV1Func    func(ctx context.Context, clientManagementID string) (*mdm_renewal.DeviceCommonDetails, *resty.Response, error)
+	GetRenewalStrategiesV1Func      func(ctx context.Context, clientManagementID string) ([]mdm_renewal.RenewalErrorWithStrategies, *resty.Response, error)
+	UpdateDeviceCommonDetailsV1Func func(ctx context.Context, request *mdm_renewal.RequestDeviceCommonDetailsUpdate) (*resty.Response, error)
+}
+
+var _ mdm_renewal.Service = (*MdmRenewalFake)(nil)
+
+// DeleteRenewalStrategiesV1 records the call and delegates to DeleteRenewalStrategiesV1Func.
+func (f *MdmRenewalFake) DeleteRenewalStrategiesV1(ctx context.Context, clientManagementID string) (*resty.Response, error) {
+	f.Record("DeleteRenewalStrategiesV1", ctx, clientManagementID)
+	if f.DeleteRenewalStrategiesV1Func == nil {
+		return nil, fakes.NotStubbed("MdmRenewalFake.DeleteRenewalStrategiesV1")
+	}
+	return f.DeleteRenewalStrategiesV1Func(ctx, clientManagementID)
+}
+
+// GetDeviceCommonDetailsV1 records the call and delegates to GetDeviceCommonDetailsV1Func.
+func (f *MdmRenewalFake) GetDeviceCommonDetailsV1(ctx context.Context, clientManagementID string) (*mdm_renewal.DeviceCommonDetails, *resty.Response, error) {
+	f.Record("GetDeviceCommonDetailsV1", ctx, clientManagementID)
+	if f.GetDeviceCommonDetailsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MdmRenewalFake.GetDeviceCommonDetailsV1")
+	}
+	return f.GetDeviceCommonDetailsV1Func(ctx, clientManagementID)
+}
+
+// GetRenewalStrategiesV1 records the call and delegates to GetRenewalStrategiesV1Func.
+func (f *MdmRenewalFake) GetRenewalStrategiesV1(ctx context.Context, clientManagementID string) ([]mdm_renewal.RenewalErrorWithStrategies, *resty.Response, error) {
+	f.Record("GetRenewalStrategiesV1", ctx, clientManagementID)
+	if f.GetRenewalStrategiesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MdmRenewalFake.GetRenewalStrategiesV1")
+	}
+	return f.GetRenewalStrategiesV1Func(ctx, clientManagementID)
+}
+
+// UpdateDeviceCommonDetailsV1 records the call and delegates to UpdateDeviceCommonDetailsV1Func.
+func (f *MdmRenewalFake) UpdateDeviceCommonDetailsV1(ctx context.Context, request *mdm_renewal.RequestDeviceCommonDetailsUpdate) (*resty.Response, error) {
+	f.Record("UpdateDeviceCommonDetailsV1", ctx, request)
+	if f.UpdateDeviceCommonDetailsV1Func == nil {
+		return nil, fakes.NotStubbed("MdmRenewalFake.UpdateDeviceCommonDetailsV1")
+	}
+	return f.UpdateDeviceCommonDetailsV1Func(ctx, request)
+}
@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mdm_renewal
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MdmRenewal.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MdmRenewalFake implements it for tests.
+type Service interface {
+	DeleteRenewalStrategiesV1(ctx context.Context, clientManagementID string) (*resty.Response, error)
+	GetDeviceCommonDetailsV1(ctx context.Context, clientManagementID string) (*DeviceCommonDetails, *resty.Response, error)
+	GetRenewalStrategiesV1(ctx context.Context, clientManagementID string) ([]RenewalErrorWithStrategies, *resty.Response, error)
+	UpdateDeviceCommonDetailsV1(ctx context.Context, request *RequestDeviceCommonDetailsUpdate) (*resty.Response, error)
+}
+
+var _ Service = (*MdmRenewal)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_apps
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_device_apps"
+	"resty.dev/v3"
+)
+
+// MobileDeviceAppsFake is a mobile_device_apps.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceAppsFake struct {
+	fakes.Journal
+
+	ReinstallAppConfigV1Func func(ctx context.Context, request *mobile_device_apps.RequestReinstallAppConfig) (*resty.Response, error)
+}
+
+var _ mobile_device_apps.Service = (*MobileDeviceAppsFake)(nil)
+
+// ReinstallAppConfigV1 records the call and delegates to ReinstallAppConfigV1Func.
+func (f *MobileDeviceAppsFake) ReinstallAppConfigV1(ctx context.Context, request *mobile_device_apps.RequestReinstallAppConfig) (*resty.Response, error) {
+	f.Record("ReinstallAppConfigV1", ctx, request)
+	if f.ReinstallAppConfigV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceAppsFake.ReinstallAppConfigV1")
+	}
+	return f.ReinstallAppConfigV1Func(ctx, request)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_apps
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceApps.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MobileDeviceAppsFake implements it for tests.
+type Service interface {
+	ReinstallAppConfigV1(ctx context.Context, request *RequestReinstallAppConfig) (*resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceApps)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_enrollment_profile
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_device_enrollment_profile"
+	"resty.dev/v3"
+)
+
+// MobileDeviceEnrollmentProfileFake is a mobile_device_enrollment_profile.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceEnrollmentProfileFake struct {
+	fakes.Journal
+
+	GetDownloadProfileV1Func func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+}
+
+var _ mobile_device_enrollment_profile.Service = (*MobileDeviceEnrollmentProfileFake)(nil)
+
+// GetDownloadProfileV1 records the call and delegates to GetDownloadProfileV1Func.
+func (f *MobileDeviceEnrollmentProfileFake) GetDownloadProfileV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("GetDownloadProfileV1", ctx, id)
+	if f.GetDownloadProfileV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceEnrollmentProfileFake.GetDownloadProfileV1")
+	}
+	return f.GetDownloadProfileV1Func(ctx, id)
+}
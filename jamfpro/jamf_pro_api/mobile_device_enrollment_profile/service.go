@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_enrollment_profile
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceEnrollmentProfile.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MobileDeviceEnrollmentProfileFake implements it for tests.
+type Service interface {
+	GetDownloadProfileV1(ctx context.Context, id string) ([]byte, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceEnrollmentProfile)(nil)
@@ -0,0 +1,113 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_extension_attributes
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_device_extension_attributes"
+	"resty.dev/v3"
+)
+
+// MobileDeviceExtensionAttributesFake is a mobile_device_extension_attributes.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceExtensionAttributesFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV1Func                        func(ctx context.Context, id string, req *mobile_device_extension_attributes.AddHistoryNoteRequest) (*resty.Response, error)
+	CreateV1Func                                    func(ctx context.Context, request *mobile_device_extension_attributes.RequestMobileDeviceExtensionAttribute) (*mobile_device_extension_attributes.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func                                func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteMobileDeviceExtensionAttributesByIDV1Func func(ctx context.Context, req *mobile_device_extension_attributes.DeleteMobileDeviceExtensionAttributesByIDRequest) (*resty.Response, error)
+	GetByIDV1Func                                   func(ctx context.Context, id string) (*mobile_device_extension_attributes.ResourceMobileDeviceExtensionAttribute, *resty.Response, error)
+	GetDataDependencyByIDV1Func                     func(ctx context.Context, id string) (*mobile_device_extension_attributes.DataDependencyResponse, *resty.Response, error)
+	GetHistoryByIDV1Func                            func(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_extension_attributes.HistoryResponse, *resty.Response, error)
+	ListV1Func                                      func(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_extension_attributes.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func                                func(ctx context.Context, id string, request *mobile_device_extension_attributes.RequestMobileDeviceExtensionAttribute) (*mobile_device_extension_attributes.ResourceMobileDeviceExtensionAttribute, *resty.Response, error)
+}
+
+var _ mobile_device_extension_attributes.Service = (*MobileDeviceExtensionAttributesFake)(nil)
+
+// AddHistoryNoteByIDV1 records the call and delegates to AddHistoryNoteByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) AddHistoryNoteByIDV1(ctx context.Context, id string, req *mobile_device_extension_attributes.AddHistoryNoteRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV1", ctx, id, req)
+	if f.AddHistoryNoteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.AddHistoryNoteByIDV1")
+	}
+	return f.AddHistoryNoteByIDV1Func(ctx, id, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *MobileDeviceExtensionAttributesFake) CreateV1(ctx context.Context, request *mobile_device_extension_attributes.RequestMobileDeviceExtensionAttribute) (*mobile_device_extension_attributes.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteMobileDeviceExtensionAttributesByIDV1 records the call and delegates to DeleteMobileDeviceExtensionAttributesByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) DeleteMobileDeviceExtensionAttributesByIDV1(ctx context.Context, req *mobile_device_extension_attributes.DeleteMobileDeviceExtensionAttributesByIDRequest) (*resty.Response, error) {
+	f.Record("DeleteMobileDeviceExtensionAttributesByIDV1", ctx, req)
+	if f.DeleteMobileDeviceExtensionAttributesByIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.DeleteMobileDeviceExtensionAttributesByIDV1")
+	}
+	return f.DeleteMobileDeviceExtensionAttributesByIDV1Func(ctx, req)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) GetByIDV1(ctx context.Context, id string) (*mobile_device_extension_attributes.ResourceMobileDeviceExtensionAttribute, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetDataDependencyByIDV1 records the call and delegates to GetDataDependencyByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) GetDataDependencyByIDV1(ctx context.Context, id string) (*mobile_device_extension_attributes.DataDependencyResponse, *resty.Response, error) {
+	f.Record("GetDataDependencyByIDV1", ctx, id)
+	if f.GetDataDependencyByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.GetDataDependencyByIDV1")
+	}
+	return f.GetDataDependencyByIDV1Func(ctx, id)
+}
+
+// GetHistoryByIDV1 records the call and delegates to GetHistoryByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) GetHistoryByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_extension_attributes.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV1", ctx, id, rsqlQuery)
+	if f.GetHistoryByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.GetHistoryByIDV1")
+	}
+	return f.GetHistoryByIDV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *MobileDeviceExtensionAttributesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_extension_attributes.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *MobileDeviceExtensionAttributesFake) UpdateByIDV1(ctx context.Context, id string, request *mobile_device_extension_attributes.RequestMobileDeviceExtensionAttribute) (*mobile_device_extension_attributes.ResourceMobileDeviceExtensionAttribute, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceExtensionAttributesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
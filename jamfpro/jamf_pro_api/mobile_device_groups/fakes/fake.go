@@ -0,0 +1,303 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_device_groups"
+	"resty.dev/v3"
+)
+
+// MobileDeviceGroupsFake is a mobile_device_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDeviceGroupsFake struct {
+	fakes.Journal
+
+	CreateSmartV1Func              func(ctx context.Context, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.CreateSmartResponse, *resty.Response, error)
+	CreateSmartV2Func              func(ctx context.Context, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.CreateSmartResponse, *resty.Response, error)
+	CreateStaticV1Func             func(ctx context.Context, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.CreateStaticResponse, *resty.Response, error)
+	CreateStaticV2Func             func(ctx context.Context, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.CreateStaticResponse, *resty.Response, error)
+	DeleteSmartByIDV1Func          func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteSmartByIDV2Func          func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV1Func         func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV2Func         func(ctx context.Context, id string) (*resty.Response, error)
+	EraseDevicesByGroupIDV1Func    func(ctx context.Context, id string, request *mobile_device_groups.RequestEraseDevices) (*resty.Response, error)
+	EraseDevicesByGroupIDV2Func    func(ctx context.Context, id string, request *mobile_device_groups.RequestEraseDevices) (*resty.Response, error)
+	GetSmartByIDV1Func             func(ctx context.Context, id string) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetSmartByIDV2Func             func(ctx context.Context, id string) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetSmartGroupMembershipV1Func  func(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error)
+	GetSmartGroupMembershipV2Func  func(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error)
+	GetStaticByIDV1Func            func(ctx context.Context, id string) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	GetStaticByIDV2Func            func(ctx context.Context, id string) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	GetStaticGroupMembershipV1Func func(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error)
+	GetStaticGroupMembershipV2Func func(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error)
+	ListAllV1Func                  func(ctx context.Context) ([]mobile_device_groups.ResourceMobileDeviceGroupSummary, *resty.Response, error)
+	ListAllV2Func                  func(ctx context.Context) ([]mobile_device_groups.ResourceMobileDeviceGroupSummary, *resty.Response, error)
+	ListSmartV1Func                func(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListSmartResponse, *resty.Response, error)
+	ListSmartV2Func                func(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListSmartResponse, *resty.Response, error)
+	ListStaticV1Func               func(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListStaticResponse, *resty.Response, error)
+	ListStaticV2Func               func(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListStaticResponse, *resty.Response, error)
+	UpdateSmartByIDV1Func          func(ctx context.Context, id string, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	UpdateSmartByIDV2Func          func(ctx context.Context, id string, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	UpdateStaticByIDV1Func         func(ctx context.Context, id string, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	UpdateStaticByIDV2Func         func(ctx context.Context, id string, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ mobile_device_groups.Service = (*MobileDeviceGroupsFake)(nil)
+
+// CreateSmartV1 records the call and delegates to CreateSmartV1Func.
+func (f *MobileDeviceGroupsFake) CreateSmartV1(ctx context.Context, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.CreateSmartResponse, *resty.Response, error) {
+	f.Record("CreateSmartV1", ctx, request)
+	if f.CreateSmartV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.CreateSmartV1")
+	}
+	return f.CreateSmartV1Func(ctx, request)
+}
+
+// CreateSmartV2 records the call and delegates to CreateSmartV2Func.
+func (f *MobileDeviceGroupsFake) CreateSmartV2(ctx context.Context, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.CreateSmartResponse, *resty.Response, error) {
+	f.Record("CreateSmartV2", ctx, request)
+	if f.CreateSmartV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.CreateSmartV2")
+	}
+	return f.CreateSmartV2Func(ctx, request)
+}
+
+// CreateStaticV1 records the call and delegates to CreateStaticV1Func.
+func (f *MobileDeviceGroupsFake) CreateStaticV1(ctx context.Context, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.CreateStaticResponse, *resty.Response, error) {
+	f.Record("CreateStaticV1", ctx, request)
+	if f.CreateStaticV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.CreateStaticV1")
+	}
+	return f.CreateStaticV1Func(ctx, request)
+}
+
+// CreateStaticV2 records the call and delegates to CreateStaticV2Func.
+func (f *MobileDeviceGroupsFake) CreateStaticV2(ctx context.Context, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.CreateStaticResponse, *resty.Response, error) {
+	f.Record("CreateStaticV2", ctx, request)
+	if f.CreateStaticV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.CreateStaticV2")
+	}
+	return f.CreateStaticV2Func(ctx, request)
+}
+
+// DeleteSmartByIDV1 records the call and delegates to DeleteSmartByIDV1Func.
+func (f *MobileDeviceGroupsFake) DeleteSmartByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteSmartByIDV1", ctx, id)
+	if f.DeleteSmartByIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteSmartByIDV1")
+	}
+	return f.DeleteSmartByIDV1Func(ctx, id)
+}
+
+// DeleteSmartByIDV2 records the call and delegates to DeleteSmartByIDV2Func.
+func (f *MobileDeviceGroupsFake) DeleteSmartByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteSmartByIDV2", ctx, id)
+	if f.DeleteSmartByIDV2Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteSmartByIDV2")
+	}
+	return f.DeleteSmartByIDV2Func(ctx, id)
+}
+
+// DeleteStaticByIDV1 records the call and delegates to DeleteStaticByIDV1Func.
+func (f *MobileDeviceGroupsFake) DeleteStaticByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteStaticByIDV1", ctx, id)
+	if f.DeleteStaticByIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteStaticByIDV1")
+	}
+	return f.DeleteStaticByIDV1Func(ctx, id)
+}
+
+// DeleteStaticByIDV2 records the call and delegates to DeleteStaticByIDV2Func.
+func (f *MobileDeviceGroupsFake) DeleteStaticByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteStaticByIDV2", ctx, id)
+	if f.DeleteStaticByIDV2Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.DeleteStaticByIDV2")
+	}
+	return f.DeleteStaticByIDV2Func(ctx, id)
+}
+
+// EraseDevicesByGroupIDV1 records the call and delegates to EraseDevicesByGroupIDV1Func.
+func (f *MobileDeviceGroupsFake) EraseDevicesByGroupIDV1(ctx context.Context, id string, request *mobile_device_groups.RequestEraseDevices) (*resty.Response, error) {
+	f.Record("EraseDevicesByGroupIDV1", ctx, id, request)
+	if f.EraseDevicesByGroupIDV1Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.EraseDevicesByGroupIDV1")
+	}
+	return f.EraseDevicesByGroupIDV1Func(ctx, id, request)
+}
+
+// EraseDevicesByGroupIDV2 records the call and delegates to EraseDevicesByGroupIDV2Func.
+func (f *MobileDeviceGroupsFake) EraseDevicesByGroupIDV2(ctx context.Context, id string, request *mobile_device_groups.RequestEraseDevices) (*resty.Response, error) {
+	f.Record("EraseDevicesByGroupIDV2", ctx, id, request)
+	if f.EraseDevicesByGroupIDV2Func == nil {
+		return nil, fakes.NotStubbed("MobileDeviceGroupsFake.EraseDevicesByGroupIDV2")
+	}
+	return f.EraseDevicesByGroupIDV2Func(ctx, id, request)
+}
+
+// GetSmartByIDV1 records the call and delegates to GetSmartByIDV1Func.
+func (f *MobileDeviceGroupsFake) GetSmartByIDV1(ctx context.Context, id string) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetSmartByIDV1", ctx, id)
+	if f.GetSmartByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetSmartByIDV1")
+	}
+	return f.GetSmartByIDV1Func(ctx, id)
+}
+
+// GetSmartByIDV2 records the call and delegates to GetSmartByIDV2Func.
+func (f *MobileDeviceGroupsFake) GetSmartByIDV2(ctx context.Context, id string) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetSmartByIDV2", ctx, id)
+	if f.GetSmartByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetSmartByIDV2")
+	}
+	return f.GetSmartByIDV2Func(ctx, id)
+}
+
+// GetSmartGroupMembershipV1 records the call and delegates to GetSmartGroupMembershipV1Func.
+func (f *MobileDeviceGroupsFake) GetSmartGroupMembershipV1(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetSmartGroupMembershipV1", ctx, id, rsqlQuery)
+	if f.GetSmartGroupMembershipV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetSmartGroupMembershipV1")
+	}
+	return f.GetSmartGroupMembershipV1Func(ctx, id, rsqlQuery)
+}
+
+// GetSmartGroupMembershipV2 records the call and delegates to GetSmartGroupMembershipV2Func.
+func (f *MobileDeviceGroupsFake) GetSmartGroupMembershipV2(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetSmartGroupMembershipV2", ctx, id, rsqlQuery)
+	if f.GetSmartGroupMembershipV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetSmartGroupMembershipV2")
+	}
+	return f.GetSmartGroupMembershipV2Func(ctx, id, rsqlQuery)
+}
+
+// GetStaticByIDV1 records the call and delegates to GetStaticByIDV1Func.
+func (f *MobileDeviceGroupsFake) GetStaticByIDV1(ctx context.Context, id string) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetStaticByIDV1", ctx, id)
+	if f.GetStaticByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetStaticByIDV1")
+	}
+	return f.GetStaticByIDV1Func(ctx, id)
+}
+
+// GetStaticByIDV2 records the call and delegates to GetStaticByIDV2Func.
+func (f *MobileDeviceGroupsFake) GetStaticByIDV2(ctx context.Context, id string) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetStaticByIDV2", ctx, id)
+	if f.GetStaticByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetStaticByIDV2")
+	}
+	return f.GetStaticByIDV2Func(ctx, id)
+}
+
+// GetStaticGroupMembershipV1 records the call and delegates to GetStaticGroupMembershipV1Func.
+func (f *MobileDeviceGroupsFake) GetStaticGroupMembershipV1(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetStaticGroupMembershipV1", ctx, id, rsqlQuery)
+	if f.GetStaticGroupMembershipV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetStaticGroupMembershipV1")
+	}
+	return f.GetStaticGroupMembershipV1Func(ctx, id, rsqlQuery)
+}
+
+// GetStaticGroupMembershipV2 records the call and delegates to GetStaticGroupMembershipV2Func.
+func (f *MobileDeviceGroupsFake) GetStaticGroupMembershipV2(ctx context.Context, id string, rsqlQuery map[string]string) (*mobile_device_groups.GroupMembershipResponse, *resty.Response, error) {
+	f.Record("GetStaticGroupMembershipV2", ctx, id, rsqlQuery)
+	if f.GetStaticGroupMembershipV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.GetStaticGroupMembershipV2")
+	}
+	return f.GetStaticGroupMembershipV2Func(ctx, id, rsqlQuery)
+}
+
+// ListAllV1 records the call and delegates to ListAllV1Func.
+func (f *MobileDeviceGroupsFake) ListAllV1(ctx context.Context) ([]mobile_device_groups.ResourceMobileDeviceGroupSummary, *resty.Response, error) {
+	f.Record("ListAllV1", ctx)
+	if f.ListAllV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListAllV1")
+	}
+	return f.ListAllV1Func(ctx)
+}
+
+// ListAllV2 records the call and delegates to ListAllV2Func.
+func (f *MobileDeviceGroupsFake) ListAllV2(ctx context.Context) ([]mobile_device_groups.ResourceMobileDeviceGroupSummary, *resty.Response, error) {
+	f.Record("ListAllV2", ctx)
+	if f.ListAllV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListAllV2")
+	}
+	return f.ListAllV2Func(ctx)
+}
+
+// ListSmartV1 records the call and delegates to ListSmartV1Func.
+func (f *MobileDeviceGroupsFake) ListSmartV1(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListSmartResponse, *resty.Response, error) {
+	f.Record("ListSmartV1", ctx, rsqlQuery)
+	if f.ListSmartV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListSmartV1")
+	}
+	return f.ListSmartV1Func(ctx, rsqlQuery)
+}
+
+// ListSmartV2 records the call and delegates to ListSmartV2Func.
+func (f *MobileDeviceGroupsFake) ListSmartV2(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListSmartResponse, *resty.Response, error) {
+	f.Record("ListSmartV2", ctx, rsqlQuery)
+	if f.ListSmartV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListSmartV2")
+	}
+	return f.ListSmartV2Func(ctx, rsqlQuery)
+}
+
+// ListStaticV1 records the call and delegates to ListStaticV1Func.
+func (f *MobileDeviceGroupsFake) ListStaticV1(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListStaticResponse, *resty.Response, error) {
+	f.Record("ListStaticV1", ctx, rsqlQuery)
+	if f.ListStaticV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListStaticV1")
+	}
+	return f.ListStaticV1Func(ctx, rsqlQuery)
+}
+
+// ListStaticV2 records the call and delegates to ListStaticV2Func.
+func (f *MobileDeviceGroupsFake) ListStaticV2(ctx context.Context, rsqlQuery map[string]string) (*mobile_device_groups.ListStaticResponse, *resty.Response, error) {
+	f.Record("ListStaticV2", ctx, rsqlQuery)
+	if f.ListStaticV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.ListStaticV2")
+	}
+	return f.ListStaticV2Func(ctx, rsqlQuery)
+}
+
+// UpdateSmartByIDV1 records the call and delegates to UpdateSmartByIDV1Func.
+func (f *MobileDeviceGroupsFake) UpdateSmartByIDV1(ctx context.Context, id string, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateSmartByIDV1", ctx, id, request)
+	if f.UpdateSmartByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateSmartByIDV1")
+	}
+	return f.UpdateSmartByIDV1Func(ctx, id, request)
+}
+
+// UpdateSmartByIDV2 records the call and delegates to UpdateSmartByIDV2Func.
+func (f *MobileDeviceGroupsFake) UpdateSmartByIDV2(ctx context.Context, id string, request *mobile_device_groups.RequestSmartMobileDeviceGroup) (*mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateSmartByIDV2", ctx, id, request)
+	if f.UpdateSmartByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateSmartByIDV2")
+	}
+	return f.UpdateSmartByIDV2Func(ctx, id, request)
+}
+
+// UpdateStaticByIDV1 records the call and delegates to UpdateStaticByIDV1Func.
+func (f *MobileDeviceGroupsFake) UpdateStaticByIDV1(ctx context.Context, id string, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateStaticByIDV1", ctx, id, request)
+	if f.UpdateStaticByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateStaticByIDV1")
+	}
+	return f.UpdateStaticByIDV1Func(ctx, id, request)
+}
+
+// UpdateStaticByIDV2 records the call and delegates to UpdateStaticByIDV2Func.
+func (f *MobileDeviceGroupsFake) UpdateStaticByIDV2(ctx context.Context, id string, request *mobile_device_groups.RequestStaticMobileDeviceGroup) (*mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateStaticByIDV2", ctx, id, request)
+	if f.UpdateStaticByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDeviceGroupsFake.UpdateStaticByIDV2")
+	}
+	return f.UpdateStaticByIDV2Func(ctx, id, request)
+}
@@ -0,0 +1,45 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDeviceGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MobileDeviceGroupsFake implements it for tests.
+type Service interface {
+	CreateSmartV1(ctx context.Context, request *RequestSmartMobileDeviceGroup) (*CreateSmartResponse, *resty.Response, error)
+	CreateSmartV2(ctx context.Context, request *RequestSmartMobileDeviceGroup) (*CreateSmartResponse, *resty.Response, error)
+	CreateStaticV1(ctx context.Context, request *RequestStaticMobileDeviceGroup) (*CreateStaticResponse, *resty.Response, error)
+	CreateStaticV2(ctx context.Context, request *RequestStaticMobileDeviceGroup) (*CreateStaticResponse, *resty.Response, error)
+	DeleteSmartByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteSmartByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteStaticByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	EraseDevicesByGroupIDV1(ctx context.Context, id string, request *RequestEraseDevices) (*resty.Response, error)
+	EraseDevicesByGroupIDV2(ctx context.Context, id string, request *RequestEraseDevices) (*resty.Response, error)
+	GetSmartByIDV1(ctx context.Context, id string) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetSmartByIDV2(ctx context.Context, id string) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetSmartGroupMembershipV1(ctx context.Context, id string, rsqlQuery map[string]string) (*GroupMembershipResponse, *resty.Response, error)
+	GetSmartGroupMembershipV2(ctx context.Context, id string, rsqlQuery map[string]string) (*GroupMembershipResponse, *resty.Response, error)
+	GetStaticByIDV1(ctx context.Context, id string) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	GetStaticByIDV2(ctx context.Context, id string) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	GetStaticGroupMembershipV1(ctx context.Context, id string, rsqlQuery map[string]string) (*GroupMembershipResponse, *resty.Response, error)
+	GetStaticGroupMembershipV2(ctx context.Context, id string, rsqlQuery map[string]string) (*GroupMembershipResponse, *resty.Response, error)
+	ListAllV1(ctx context.Context) ([]ResourceMobileDeviceGroupSummary, *resty.Response, error)
+	ListAllV2(ctx context.Context) ([]ResourceMobileDeviceGroupSummary, *resty.Response, error)
+	ListSmartV1(ctx context.Context, rsqlQuery map[string]string) (*ListSmartResponse, *resty.Response, error)
+	ListSmartV2(ctx context.Context, rsqlQuery map[string]string) (*ListSmartResponse, *resty.Response, error)
+	ListStaticV1(ctx context.Context, rsqlQuery map[string]string) (*ListStaticResponse, *resty.Response, error)
+	ListStaticV2(ctx context.Context, rsqlQuery map[string]string) (*ListStaticResponse, *resty.Response, error)
+	UpdateSmartByIDV1(ctx context.Context, id string, request *RequestSmartMobileDeviceGroup) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	UpdateSmartByIDV2(ctx context.Context, id string, request *RequestSmartMobileDeviceGroup) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	UpdateStaticByIDV1(ctx context.Context, id string, request *RequestStaticMobileDeviceGroup) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	UpdateStaticByIDV2(ctx context.Context, id string, request *RequestStaticMobileDeviceGroup) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ Service = (*MobileDeviceGroups)(nil)
@@ -0,0 +1,234 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_device_prestages
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_device_prestages"
+	"resty.dev/v3"
+)
+
+// MobileDevicePrestagesFake is a mobile_device_prestages.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDevicePrestagesFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV3Func       func(ctx context.Context, id string, request *mobile_device_prestages.RequestAddHistoryNote) (*mobile_device_prestages.ResponseAddHistoryNote, *resty.Response, error)
+	AddScopeByIDV2Func             func(ctx context.Context, id string, request *mobile_device_prestages.RequestAddScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error)
+	CreateV3Func                   func(ctx context.Context, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.CreateResponse, *resty.Response, error)
+	DeleteAttachmentsByIDV3Func    func(ctx context.Context, id string, request *mobile_device_prestages.RequestDeleteAttachments) (*resty.Response, error)
+	DeleteByIDV3Func               func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV3Func             func(ctx context.Context, name string) (*resty.Response, error)
+	GetAllSyncsV2Func              func(ctx context.Context) ([]mobile_device_prestages.ResourcePrestageSync, *resty.Response, error)
+	GetAttachmentsByIDV3Func       func(ctx context.Context, id string) ([]mobile_device_prestages.ResourceAttachment, *resty.Response, error)
+	GetByIDV3Func                  func(ctx context.Context, id string) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error)
+	GetByNameV3Func                func(ctx context.Context, name string) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error)
+	GetHistoryByIDV3Func           func(ctx context.Context, id string, query map[string]string) (*mobile_device_prestages.HistoryResponse, *resty.Response, error)
+	GetLatestSyncByIDV2Func        func(ctx context.Context, id string) (*mobile_device_prestages.ResourcePrestageSync, *resty.Response, error)
+	GetScopeByIDV2Func             func(ctx context.Context, id string) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error)
+	GetSyncsByIDV2Func             func(ctx context.Context, id string) ([]mobile_device_prestages.ResourcePrestageSync, *resty.Response, error)
+	ListV3Func                     func(ctx context.Context) (*mobile_device_prestages.ListResponse, *resty.Response, error)
+	RemoveScopeByIDV2Func          func(ctx context.Context, id string, request *mobile_device_prestages.RequestRemoveScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error)
+	ReplaceScopeByIDV2Func         func(ctx context.Context, id string, request *mobile_device_prestages.RequestReplaceScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error)
+	UpdateByIDV3Func               func(ctx context.Context, id string, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error)
+	UpdateByNameV3Func             func(ctx context.Context, name string, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error)
+	UploadAttachmentFromFileV3Func func(ctx context.Context, id string, filePath string) (*mobile_device_prestages.ResourceAttachmentUpload, *resty.Response, error)
+	UploadAttachmentV3Func         func(ctx context.Context, id string, fileReader io.Reader, fileSize int64, fileName string) (*mobile_device_prestages.ResourceAttachmentUpload, *resty.Response, error)
+}
+
+var _ mobile_device_prestages.Service = (*MobileDevicePrestagesFake)(nil)
+
+// AddHistoryNoteByIDV3 records the call and delegates to AddHistoryNoteByIDV3Func.
+func (f *MobileDevicePrestagesFake) AddHistoryNoteByIDV3(ctx context.Context, id string, request *mobile_device_prestages.RequestAddHistoryNote) (*mobile_device_prestages.ResponseAddHistoryNote, *resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV3", ctx, id, request)
+	if f.AddHistoryNoteByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.AddHistoryNoteByIDV3")
+	}
+	return f.AddHistoryNoteByIDV3Func(ctx, id, request)
+}
+
+// AddScopeByIDV2 records the call and delegates to AddScopeByIDV2Func.
+func (f *MobileDevicePrestagesFake) AddScopeByIDV2(ctx context.Context, id string, request *mobile_device_prestages.RequestAddScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("AddScopeByIDV2", ctx, id, request)
+	if f.AddScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.AddScopeByIDV2")
+	}
+	return f.AddScopeByIDV2Func(ctx, id, request)
+}
+
+// CreateV3 records the call and delegates to CreateV3Func.
+func (f *MobileDevicePrestagesFake) CreateV3(ctx context.Context, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV3", ctx, prestage)
+	if f.CreateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.CreateV3")
+	}
+	return f.CreateV3Func(ctx, prestage)
+}
+
+// DeleteAttachmentsByIDV3 records the call and delegates to DeleteAttachmentsByIDV3Func.
+func (f *MobileDevicePrestagesFake) DeleteAttachmentsByIDV3(ctx context.Context, id string, request *mobile_device_prestages.RequestDeleteAttachments) (*resty.Response, error) {
+	f.Record("DeleteAttachmentsByIDV3", ctx, id, request)
+	if f.DeleteAttachmentsByIDV3Func == nil {
+		return nil, fakes.NotStubbed("MobileDevicePrestagesFake.DeleteAttachmentsByIDV3")
+	}
+	return f.DeleteAttachmentsByIDV3Func(ctx, id, request)
+}
+
+// DeleteByIDV3 records the call and delegates to DeleteByIDV3Func.
+func (f *MobileDevicePrestagesFake) DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV3", ctx, id)
+	if f.DeleteByIDV3Func == nil {
+		return nil, fakes.NotStubbed("MobileDevicePrestagesFake.DeleteByIDV3")
+	}
+	return f.DeleteByIDV3Func(ctx, id)
+}
+
+// DeleteByNameV3 records the call and delegates to DeleteByNameV3Func.
+func (f *MobileDevicePrestagesFake) DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV3", ctx, name)
+	if f.DeleteByNameV3Func == nil {
+		return nil, fakes.NotStubbed("MobileDevicePrestagesFake.DeleteByNameV3")
+	}
+	return f.DeleteByNameV3Func(ctx, name)
+}
+
+// GetAllSyncsV2 records the call and delegates to GetAllSyncsV2Func.
+func (f *MobileDevicePrestagesFake) GetAllSyncsV2(ctx context.Context) ([]mobile_device_prestages.ResourcePrestageSync, *resty.Response, error) {
+	f.Record("GetAllSyncsV2", ctx)
+	if f.GetAllSyncsV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetAllSyncsV2")
+	}
+	return f.GetAllSyncsV2Func(ctx)
+}
+
+// GetAttachmentsByIDV3 records the call and delegates to GetAttachmentsByIDV3Func.
+func (f *MobileDevicePrestagesFake) GetAttachmentsByIDV3(ctx context.Context, id string) ([]mobile_device_prestages.ResourceAttachment, *resty.Response, error) {
+	f.Record("GetAttachmentsByIDV3", ctx, id)
+	if f.GetAttachmentsByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetAttachmentsByIDV3")
+	}
+	return f.GetAttachmentsByIDV3Func(ctx, id)
+}
+
+// GetByIDV3 records the call and delegates to GetByIDV3Func.
+func (f *MobileDevicePrestagesFake) GetByIDV3(ctx context.Context, id string) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error) {
+	f.Record("GetByIDV3", ctx, id)
+	if f.GetByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetByIDV3")
+	}
+	return f.GetByIDV3Func(ctx, id)
+}
+
+// GetByNameV3 records the call and delegates to GetByNameV3Func.
+func (f *MobileDevicePrestagesFake) GetByNameV3(ctx context.Context, name string) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error) {
+	f.Record("GetByNameV3", ctx, name)
+	if f.GetByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetByNameV3")
+	}
+	return f.GetByNameV3Func(ctx, name)
+}
+
+// GetHistoryByIDV3 records the call and delegates to GetHistoryByIDV3Func.
+func (f *MobileDevicePrestagesFake) GetHistoryByIDV3(ctx context.Context, id string, query map[string]string) (*mobile_device_prestages.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV3", ctx, id, query)
+	if f.GetHistoryByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetHistoryByIDV3")
+	}
+	return f.GetHistoryByIDV3Func(ctx, id, query)
+}
+
+// GetLatestSyncByIDV2 records the call and delegates to GetLatestSyncByIDV2Func.
+func (f *MobileDevicePrestagesFake) GetLatestSyncByIDV2(ctx context.Context, id string) (*mobile_device_prestages.ResourcePrestageSync, *resty.Response, error) {
+	f.Record("GetLatestSyncByIDV2", ctx, id)
+	if f.GetLatestSyncByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetLatestSyncByIDV2")
+	}
+	return f.GetLatestSyncByIDV2Func(ctx, id)
+}
+
+// GetScopeByIDV2 records the call and delegates to GetScopeByIDV2Func.
+func (f *MobileDevicePrestagesFake) GetScopeByIDV2(ctx context.Context, id string) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("GetScopeByIDV2", ctx, id)
+	if f.GetScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetScopeByIDV2")
+	}
+	return f.GetScopeByIDV2Func(ctx, id)
+}
+
+// GetSyncsByIDV2 records the call and delegates to GetSyncsByIDV2Func.
+func (f *MobileDevicePrestagesFake) GetSyncsByIDV2(ctx context.Context, id string) ([]mobile_device_prestages.ResourcePrestageSync, *resty.Response, error) {
+	f.Record("GetSyncsByIDV2", ctx, id)
+	if f.GetSyncsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.GetSyncsByIDV2")
+	}
+	return f.GetSyncsByIDV2Func(ctx, id)
+}
+
+// ListV3 records the call and delegates to ListV3Func.
+func (f *MobileDevicePrestagesFake) ListV3(ctx context.Context) (*mobile_device_prestages.ListResponse, *resty.Response, error) {
+	f.Record("ListV3", ctx)
+	if f.ListV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.ListV3")
+	}
+	return f.ListV3Func(ctx)
+}
+
+// RemoveScopeByIDV2 records the call and delegates to RemoveScopeByIDV2Func.
+func (f *MobileDevicePrestagesFake) RemoveScopeByIDV2(ctx context.Context, id string, request *mobile_device_prestages.RequestRemoveScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("RemoveScopeByIDV2", ctx, id, request)
+	if f.RemoveScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.RemoveScopeByIDV2")
+	}
+	return f.RemoveScopeByIDV2Func(ctx, id, request)
+}
+
+// ReplaceScopeByIDV2 records the call and delegates to ReplaceScopeByIDV2Func.
+func (f *MobileDevicePrestagesFake) ReplaceScopeByIDV2(ctx context.Context, id string, request *mobile_device_prestages.RequestReplaceScope) (*mobile_device_prestages.ResourceDeviceScope, *resty.Response, error) {
+	f.Record("ReplaceScopeByIDV2", ctx, id, request)
+	if f.ReplaceScopeByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.ReplaceScopeByIDV2")
+	}
+	return f.ReplaceScopeByIDV2Func(ctx, id, request)
+}
+
+// UpdateByIDV3 records the call and delegates to UpdateByIDV3Func.
+func (f *MobileDevicePrestagesFake) UpdateByIDV3(ctx context.Context, id string, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error) {
+	f.Record("UpdateByIDV3", ctx, id, prestage)
+	if f.UpdateByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.UpdateByIDV3")
+	}
+	return f.UpdateByIDV3Func(ctx, id, prestage)
+}
+
+// UpdateByNameV3 records the call and delegates to UpdateByNameV3Func.
+func (f *MobileDevicePrestagesFake) UpdateByNameV3(ctx context.Context, name string, prestage *mobile_device_prestages.ResourceMobileDevicePrestage) (*mobile_device_prestages.ResourceMobileDevicePrestage, *resty.Response, error) {
+	f.Record("UpdateByNameV3", ctx, name, prestage)
+	if f.UpdateByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.UpdateByNameV3")
+	}
+	return f.UpdateByNameV3Func(ctx, name, prestage)
+}
+
+// UploadAttachmentFromFileV3 records the call and delegates to UploadAttachmentFromFileV3Func.
+func (f *MobileDevicePrestagesFake) UploadAttachmentFromFileV3(ctx context.Context, id string, filePath string) (*mobile_device_prestages.ResourceAttachmentUpload, *resty.Response, error) {
+	f.Record("UploadAttachmentFromFileV3", ctx, id, filePath)
+	if f.UploadAttachmentFromFileV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.UploadAttachmentFromFileV3")
+	}
+	return f.UploadAttachmentFromFileV3Func(ctx, id, filePath)
+}
+
+// UploadAttachmentV3 records the call and delegates to UploadAttachmentV3Func.
+func (f *MobileDevicePrestagesFake) UploadAttachmentV3(ctx context.Context, id string, fileReader io.Reader, fileSize int64, fileName string) (*mobile_device_prestages.ResourceAttachmentUpload, *resty.Response, error) {
+	f.Record("UploadAttachmentV3", ctx, id, fileReader, fileSize, fileName)
+	if f.UploadAttachmentV3Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicePrestagesFake.UploadAttachmentV3")
+	}
+	return f.UploadAttachmentV3Func(ctx, id, fileReader, fileSize, fileName)
+}
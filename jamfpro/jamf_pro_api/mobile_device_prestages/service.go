@@ -0,0 +1,39 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_device_prestages
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDevicePrestages.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MobileDevicePrestagesFake implements it for tests.
+type Service interface {
+	AddHistoryNoteByIDV3(ctx context.Context, id string, request *RequestAddHistoryNote) (*ResponseAddHistoryNote, *resty.Response, error)
+	AddScopeByIDV2(ctx context.Context, id string, request *RequestAddScope) (*ResourceDeviceScope, *resty.Response, error)
+	CreateV3(ctx context.Context, prestage *ResourceMobileDevicePrestage) (*CreateResponse, *resty.Response, error)
+	DeleteAttachmentsByIDV3(ctx context.Context, id string, request *RequestDeleteAttachments) (*resty.Response, error)
+	DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error)
+	GetAllSyncsV2(ctx context.Context) ([]ResourcePrestageSync, *resty.Response, error)
+	GetAttachmentsByIDV3(ctx context.Context, id string) ([]ResourceAttachment, *resty.Response, error)
+	GetByIDV3(ctx context.Context, id string) (*ResourceMobileDevicePrestage, *resty.Response, error)
+	GetByNameV3(ctx context.Context, name string) (*ResourceMobileDevicePrestage, *resty.Response, error)
+	GetHistoryByIDV3(ctx context.Context, id string, query map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetLatestSyncByIDV2(ctx context.Context, id string) (*ResourcePrestageSync, *resty.Response, error)
+	GetScopeByIDV2(ctx context.Context, id string) (*ResourceDeviceScope, *resty.Response, error)
+	GetSyncsByIDV2(ctx context.Context, id string) ([]ResourcePrestageSync, *resty.Response, error)
+	ListV3(ctx context.Context) (*ListResponse, *resty.Response, error)
+	RemoveScopeByIDV2(ctx context.Context, id string, request *RequestRemoveScope) (*ResourceDeviceScope, *resty.Response, error)
+	ReplaceScopeByIDV2(ctx context.Context, id string, request *RequestReplaceScope) (*ResourceDeviceScope, *resty.Response, error)
+	UpdateByIDV3(ctx context.Context, id string, prestage *ResourceMobileDevicePrestage) (*ResourceMobileDevicePrestage, *resty.Response, error)
+	UpdateByNameV3(ctx context.Context, name string, prestage *ResourceMobileDevicePrestage) (*ResourceMobileDevicePrestage, *resty.Response, error)
+	UploadAttachmentFromFileV3(ctx context.Context, id string, filePath string) (*ResourceAttachmentUpload, *resty.Response, error)
+	UploadAttachmentV3(ctx context.Context, id string, fileReader io.Reader, fileSize int64, fileName string) (*ResourceAttachmentUpload, *resty.Response, error)
+}
+
+var _ Service = (*MobileDevicePrestages)(nil)
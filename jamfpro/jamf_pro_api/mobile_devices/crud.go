@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
@@ -55,6 +56,23 @@ func (s *MobileDevices) ListV2(ctx context.Context, query map[string]string) (*M
 	return &result, resp, nil
 }
 
+// ListV2Iter streams basic mobile device records page by page instead of
+// buffering every page in memory. Breaking out of the range loop stops
+// pagination without fetching the remaining pages.
+// URL: GET /api/v2/mobile-devices
+// query supports: sort, filter (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-mobile-devices
+func (s *MobileDevices) ListV2Iter(ctx context.Context, query map[string]string) iter.Seq2[ResourceMobileDevice, error] {
+	endpoint := constants.EndpointJamfProMobileDevicesV2
+
+	req := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(query)
+
+	return client.IterateAs[ResourceMobileDevice](req, endpoint)
+}
+
 // GetByIDV2 returns the specified basic mobile device record by ID.
 // URL: GET /api/v2/mobile-devices/{id}
 // Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-mobile-devices-id
@@ -125,6 +143,23 @@ func (s *MobileDevices) GetDetailV2(ctx context.Context, query map[string]string
 	return &result, resp, nil
 }
 
+// GetDetailV2Iter streams full mobile device inventory records page by page
+// instead of buffering every page in memory. Breaking out of the range loop
+// stops pagination without fetching the remaining pages.
+// URL: GET /api/v2/mobile-devices/detail
+// query supports the same keys as GetDetailV2 except page and page-size.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-mobile-devices-detail
+func (s *MobileDevices) GetDetailV2Iter(ctx context.Context, query map[string]string) iter.Seq2[ResourceMobileDeviceDetail, error] {
+	endpoint := constants.EndpointJamfProMobileDevicesDetailV2
+
+	req := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(query)
+
+	return client.IterateAs[ResourceMobileDeviceDetail](req, endpoint)
+}
+
 // GetDetailByIDV2 returns the full mobile device inventory record for the
 // specified ID.
 // URL: GET /api/v2/mobile-devices/{id}/detail
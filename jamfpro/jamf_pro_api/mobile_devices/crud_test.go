@@ -30,6 +30,24 @@ func TestUnit_MobileDevices_ListV2(t *testing.T) {
 	assert.Equal(t, "tvos", result.Results[1].Type)
 }
 
+func TestUnit_MobileDevices_ListV2Iter(t *testing.T) {
+	mock := mocks.NewMobileDevicesMock()
+	mock.RegisterListMock()
+
+	svc := NewMobileDevices(mock)
+	ctx := context.Background()
+
+	var devices []ResourceMobileDevice
+	for device, err := range svc.ListV2Iter(ctx, nil) {
+		require.NoError(t, err)
+		devices = append(devices, device)
+	}
+
+	require.Len(t, devices, 2)
+	assert.Equal(t, "iPad", devices[0].Name)
+	assert.Equal(t, "tvos", devices[1].Type)
+}
+
 func TestUnit_MobileDevices_GetByIDV2(t *testing.T) {
 	mock := mocks.NewMobileDevicesMock()
 	mock.RegisterGetByIDMock("1")
@@ -110,6 +128,23 @@ func TestUnit_MobileDevices_GetDetailV2(t *testing.T) {
 	assert.True(t, device.Applications[0].AppClip)
 }
 
+func TestUnit_MobileDevices_GetDetailV2Iter(t *testing.T) {
+	mock := mocks.NewMobileDevicesMock()
+	mock.RegisterGetDetailMock()
+
+	svc := NewMobileDevices(mock)
+	ctx := context.Background()
+
+	var devices []ResourceMobileDeviceDetail
+	for device, err := range svc.GetDetailV2Iter(ctx, nil) {
+		require.NoError(t, err)
+		devices = append(devices, device)
+	}
+
+	require.Len(t, devices, 1)
+	assert.Equal(t, "1", devices[0].MobileDeviceID)
+}
+
 func TestUnit_MobileDevices_GetDetailByIDV2(t *testing.T) {
 	mock := mocks.NewMobileDevicesMock()
 	mock.RegisterGetDetailByIDMock("1")
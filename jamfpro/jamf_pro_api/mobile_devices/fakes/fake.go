@@ -0,0 +1,104 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the mobile_devices
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"iter"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/mobile_devices"
+	"resty.dev/v3"
+)
+
+// MobileDevicesFake is a mobile_devices.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type MobileDevicesFake struct {
+	fakes.Journal
+
+	GetByIDV2Func              func(ctx context.Context, id string) (*mobile_devices.ResourceMobileDevice, *resty.Response, error)
+	GetDetailByIDV2Func        func(ctx context.Context, id string, query map[string]string) (*mobile_devices.ResourceMobileDeviceDetailsV2, *resty.Response, error)
+	GetDetailV2Func            func(ctx context.Context, query map[string]string) (*mobile_devices.MobileDeviceDetailListResponse, *resty.Response, error)
+	GetDetailV2IterFunc        func(ctx context.Context, query map[string]string) iter.Seq2[mobile_devices.ResourceMobileDeviceDetail, error]
+	GetPairedDevicesByIDV2Func func(ctx context.Context, id string, query map[string]string) (*mobile_devices.MobileDeviceDetailListResponse, *resty.Response, error)
+	ListV2Func                 func(ctx context.Context, query map[string]string) (*mobile_devices.MobileDeviceListResponse, *resty.Response, error)
+	ListV2IterFunc             func(ctx context.Context, query map[string]string) iter.Seq2[mobile_devices.ResourceMobileDevice, error]
+	UpdateByIDV2Func           func(ctx context.Context, id string, request *mobile_devices.RequestMobileDeviceUpdateV2) (*mobile_devices.ResourceMobileDeviceDetailsV2, *resty.Response, error)
+}
+
+var _ mobile_devices.Service = (*MobileDevicesFake)(nil)
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *MobileDevicesFake) GetByIDV2(ctx context.Context, id string) (*mobile_devices.ResourceMobileDevice, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetDetailByIDV2 records the call and delegates to GetDetailByIDV2Func.
+func (f *MobileDevicesFake) GetDetailByIDV2(ctx context.Context, id string, query map[string]string) (*mobile_devices.ResourceMobileDeviceDetailsV2, *resty.Response, error) {
+	f.Record("GetDetailByIDV2", ctx, id, query)
+	if f.GetDetailByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetDetailByIDV2")
+	}
+	return f.GetDetailByIDV2Func(ctx, id, query)
+}
+
+// GetDetailV2 records the call and delegates to GetDetailV2Func.
+func (f *MobileDevicesFake) GetDetailV2(ctx context.Context, query map[string]string) (*mobile_devices.MobileDeviceDetailListResponse, *resty.Response, error) {
+	f.Record("GetDetailV2", ctx, query)
+	if f.GetDetailV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetDetailV2")
+	}
+	return f.GetDetailV2Func(ctx, query)
+}
+
+// GetDetailV2Iter records the call and delegates to GetDetailV2IterFunc.
+func (f *MobileDevicesFake) GetDetailV2Iter(ctx context.Context, query map[string]string) iter.Seq2[mobile_devices.ResourceMobileDeviceDetail, error] {
+	f.Record("GetDetailV2Iter", ctx, query)
+	if f.GetDetailV2IterFunc == nil {
+		return nil
+	}
+	return f.GetDetailV2IterFunc(ctx, query)
+}
+
+// GetPairedDevicesByIDV2 records the call and delegates to GetPairedDevicesByIDV2Func.
+func (f *MobileDevicesFake) GetPairedDevicesByIDV2(ctx context.Context, id string, query map[string]string) (*mobile_devices.MobileDeviceDetailListResponse, *resty.Response, error) {
+	f.Record("GetPairedDevicesByIDV2", ctx, id, query)
+	if f.GetPairedDevicesByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.GetPairedDevicesByIDV2")
+	}
+	return f.GetPairedDevicesByIDV2Func(ctx, id, query)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *MobileDevicesFake) ListV2(ctx context.Context, query map[string]string) (*mobile_devices.MobileDeviceListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx, query)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.ListV2")
+	}
+	return f.ListV2Func(ctx, query)
+}
+
+// ListV2Iter records the call and delegates to ListV2IterFunc.
+func (f *MobileDevicesFake) ListV2Iter(ctx context.Context, query map[string]string) iter.Seq2[mobile_devices.ResourceMobileDevice, error] {
+	f.Record("ListV2Iter", ctx, query)
+	if f.ListV2IterFunc == nil {
+		return nil
+	}
+	return f.ListV2IterFunc(ctx, query)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *MobileDevicesFake) UpdateByIDV2(ctx context.Context, id string, request *mobile_devices.RequestMobileDeviceUpdateV2) (*mobile_devices.ResourceMobileDeviceDetailsV2, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, request)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("MobileDevicesFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, request)
+}
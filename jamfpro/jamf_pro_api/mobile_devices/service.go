@@ -0,0 +1,26 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package mobile_devices
+
+import (
+	"context"
+	"iter"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of MobileDevices.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.MobileDevicesFake implements it for tests.
+type Service interface {
+	GetByIDV2(ctx context.Context, id string) (*ResourceMobileDevice, *resty.Response, error)
+	GetDetailByIDV2(ctx context.Context, id string, query map[string]string) (*ResourceMobileDeviceDetailsV2, *resty.Response, error)
+	GetDetailV2(ctx context.Context, query map[string]string) (*MobileDeviceDetailListResponse, *resty.Response, error)
+	GetDetailV2Iter(ctx context.Context, query map[string]string) iter.Seq2[ResourceMobileDeviceDetail, error]
+	GetPairedDevicesByIDV2(ctx context.Context, id string, query map[string]string) (*MobileDeviceDetailListResponse, *resty.Response, error)
+	ListV2(ctx context.Context, query map[string]string) (*MobileDeviceListResponse, *resty.Response, error)
+	ListV2Iter(ctx context.Context, query map[string]string) iter.Seq2[ResourceMobileDevice, error]
+	UpdateByIDV2(ctx context.Context, id string, request *RequestMobileDeviceUpdateV2) (*ResourceMobileDeviceDetailsV2, *resty.Response, error)
+}
+
+var _ Service = (*MobileDevices)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the notifications
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/notifications"
+	"resty.dev/v3"
+)
+
+// NotificationsFake is a notifications.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type NotificationsFake struct {
+	fakes.Journal
+
+	DeleteByTypeAndIDV1Func func(ctx context.Context, notificationType string, id string) (*resty.Response, error)
+	ListV1Func              func(ctx context.Context) ([]notifications.ResourceNotification, *resty.Response, error)
+}
+
+var _ notifications.Service = (*NotificationsFake)(nil)
+
+// DeleteByTypeAndIDV1 records the call and delegates to DeleteByTypeAndIDV1Func.
+func (f *NotificationsFake) DeleteByTypeAndIDV1(ctx context.Context, notificationType string, id string) (*resty.Response, error) {
+	f.Record("DeleteByTypeAndIDV1", ctx, notificationType, id)
+	if f.DeleteByTypeAndIDV1Func == nil {
+		return nil, fakes.NotStubbed("NotificationsFake.DeleteByTypeAndIDV1")
+	}
+	return f.DeleteByTypeAndIDV1Func(ctx, notificationType, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *NotificationsFake) ListV1(ctx context.Context) ([]notifications.ResourceNotification, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("NotificationsFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
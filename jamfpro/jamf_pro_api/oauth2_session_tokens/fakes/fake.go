@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the oauth2_session_tokens
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/oauth2_session_tokens"
+	"resty.dev/v3"
+)
+
+// Oauth2SessionTokensFake is a oauth2_session_tokens.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type Oauth2SessionTokensFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*oauth2_session_tokens.SessionTokenResponse, *resty.Response, error)
+}
+
+var _ oauth2_session_tokens.Service = (*Oauth2SessionTokensFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *Oauth2SessionTokensFake) GetV1(ctx context.Context) (*oauth2_session_tokens.SessionTokenResponse, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("Oauth2SessionTokensFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
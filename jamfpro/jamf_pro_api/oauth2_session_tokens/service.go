@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package oauth2_session_tokens
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Oauth2SessionTokens.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.Oauth2SessionTokensFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*SessionTokenResponse, *resty.Response, error)
+}
+
+var _ Service = (*Oauth2SessionTokens)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the oidc
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/oidc"
+	"resty.dev/v3"
+)
+
+// OidcFake is a oidc.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type OidcFake struct {
+	fakes.Journal
+
+	GenerateCertificateV1Func  func(ctx context.Context) (*resty.Response, error)
+	GetDirectIdPLoginURLV1Func func(ctx context.Context) (*oidc.ResourceOIDCDirectIdPLoginURL, *resty.Response, error)
+	GetPublicFeaturesV1Func    func(ctx context.Context) (*oidc.ResourcePublicFeatures, *resty.Response, error)
+	GetPublicKeyV1Func         func(ctx context.Context) (*oidc.ResourceOIDCPublicKey, *resty.Response, error)
+	GetRedirectURLV1Func       func(ctx context.Context, request *oidc.RequestOIDCRedirectURL) (*oidc.ResourceOIDCRedirectURL, *resty.Response, error)
+}
+
+var _ oidc.Service = (*OidcFake)(nil)
+
+// GenerateCertificateV1 records the call and delegates to GenerateCertificateV1Func.
+func (f *OidcFake) GenerateCertificateV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("GenerateCertificateV1", ctx)
+	if f.GenerateCertificateV1Func == nil {
+		return nil, fakes.NotStubbed("OidcFake.GenerateCertificateV1")
+	}
+	return f.GenerateCertificateV1Func(ctx)
+}
+
+// GetDirectIdPLoginURLV1 records the call and delegates to GetDirectIdPLoginURLV1Func.
+func (f *OidcFake) GetDirectIdPLoginURLV1(ctx context.Context) (*oidc.ResourceOIDCDirectIdPLoginURL, *resty.Response, error) {
+	f.Record("GetDirectIdPLoginURLV1", ctx)
+	if f.GetDirectIdPLoginURLV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OidcFake.GetDirectIdPLoginURLV1")
+	}
+	return f.GetDirectIdPLoginURLV1Func(ctx)
+}
+
+// GetPublicFeaturesV1 records the call and delegates to GetPublicFeaturesV1Func.
+func (f *OidcFake) GetPublicFeaturesV1(ctx context.Context) (*oidc.ResourcePublicFeatures, *resty.Response, error) {
+	f.Record("GetPublicFeaturesV1", ctx)
+	if f.GetPublicFeaturesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OidcFake.GetPublicFeaturesV1")
+	}
+	return f.GetPublicFeaturesV1Func(ctx)
+}
+
+// GetPublicKeyV1 records the call and delegates to GetPublicKeyV1Func.
+func (f *OidcFake) GetPublicKeyV1(ctx context.Context) (*oidc.ResourceOIDCPublicKey, *resty.Response, error) {
+	f.Record("GetPublicKeyV1", ctx)
+	if f.GetPublicKeyV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OidcFake.GetPublicKeyV1")
+	}
+	return f.GetPublicKeyV1Func(ctx)
+}
+
+// GetRedirectURLV1 records the call and delegates to GetRedirectURLV1Func.
+func (f *OidcFake) GetRedirectURLV1(ctx context.Context, request *oidc.RequestOIDCRedirectURL) (*oidc.ResourceOIDCRedirectURL, *resty.Response, error) {
+	f.Record("GetRedirectURLV1", ctx, request)
+	if f.GetRedirectURLV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OidcFake.GetRedirectURLV1")
+	}
+	return f.GetRedirectURLV1Func(ctx, request)
+}
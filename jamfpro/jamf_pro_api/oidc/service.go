@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package oidc
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Oidc.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.OidcFake implements it for tests.
+type Service interface {
+	GenerateCertificateV1(ctx context.Context) (*resty.Response, error)
+	GetDirectIdPLoginURLV1(ctx context.Context) (*ResourceOIDCDirectIdPLoginURL, *resty.Response, error)
+	GetPublicFeaturesV1(ctx context.Context) (*ResourcePublicFeatures, *resty.Response, error)
+	GetPublicKeyV1(ctx context.Context) (*ResourceOIDCPublicKey, *resty.Response, error)
+	GetRedirectURLV1(ctx context.Context, request *RequestOIDCRedirectURL) (*ResourceOIDCRedirectURL, *resty.Response, error)
+}
+
+var _ Service = (*Oidc)(nil)
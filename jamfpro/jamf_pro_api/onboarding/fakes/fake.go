@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the onboarding
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/onboarding"
+	"resty.dev/v3"
+)
+
+// OnboardingFake is a onboarding.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type OnboardingFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func                  func(ctx context.Context, req *onboarding.RequestAddHistoryNotes) (*onboarding.ResponseAddHistoryNotes, *resty.Response, error)
+	ExportHistoryV1Func                    func(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, req *onboarding.RequestExportHistory) ([]byte, *resty.Response, error)
+	GetEligibleAppsV1Func                  func(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error)
+	GetEligibleConfigurationProfilesV1Func func(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error)
+	GetEligiblePoliciesV1Func              func(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error)
+	GetHistoryV1Func                       func(ctx context.Context, rsqlQuery map[string]string) (*onboarding.HistoryResponse, *resty.Response, error)
+	GetV1Func                              func(ctx context.Context) (*onboarding.ResponseOnboardingSettings, *resty.Response, error)
+	UpdateV1Func                           func(ctx context.Context, request *onboarding.ResourceUpdateOnboardingSettings) (*onboarding.ResponseOnboardingSettings, *resty.Response, error)
+}
+
+var _ onboarding.Service = (*OnboardingFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *OnboardingFake) AddHistoryNotesV1(ctx context.Context, req *onboarding.RequestAddHistoryNotes) (*onboarding.ResponseAddHistoryNotes, *resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, req)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, req)
+}
+
+// ExportHistoryV1 records the call and delegates to ExportHistoryV1Func.
+func (f *OnboardingFake) ExportHistoryV1(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, req *onboarding.RequestExportHistory) ([]byte, *resty.Response, error) {
+	f.Record("ExportHistoryV1", ctx, acceptHeader, rsqlQuery, req)
+	if f.ExportHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.ExportHistoryV1")
+	}
+	return f.ExportHistoryV1Func(ctx, acceptHeader, rsqlQuery, req)
+}
+
+// GetEligibleAppsV1 records the call and delegates to GetEligibleAppsV1Func.
+func (f *OnboardingFake) GetEligibleAppsV1(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error) {
+	f.Record("GetEligibleAppsV1", ctx, query)
+	if f.GetEligibleAppsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.GetEligibleAppsV1")
+	}
+	return f.GetEligibleAppsV1Func(ctx, query)
+}
+
+// GetEligibleConfigurationProfilesV1 records the call and delegates to GetEligibleConfigurationProfilesV1Func.
+func (f *OnboardingFake) GetEligibleConfigurationProfilesV1(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error) {
+	f.Record("GetEligibleConfigurationProfilesV1", ctx, query)
+	if f.GetEligibleConfigurationProfilesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.GetEligibleConfigurationProfilesV1")
+	}
+	return f.GetEligibleConfigurationProfilesV1Func(ctx, query)
+}
+
+// GetEligiblePoliciesV1 records the call and delegates to GetEligiblePoliciesV1Func.
+func (f *OnboardingFake) GetEligiblePoliciesV1(ctx context.Context, query map[string]string) (*onboarding.ResponseEligibilityList, *resty.Response, error) {
+	f.Record("GetEligiblePoliciesV1", ctx, query)
+	if f.GetEligiblePoliciesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.GetEligiblePoliciesV1")
+	}
+	return f.GetEligiblePoliciesV1Func(ctx, query)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *OnboardingFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*onboarding.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *OnboardingFake) GetV1(ctx context.Context) (*onboarding.ResponseOnboardingSettings, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *OnboardingFake) UpdateV1(ctx context.Context, request *onboarding.ResourceUpdateOnboardingSettings) (*onboarding.ResponseOnboardingSettings, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("OnboardingFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
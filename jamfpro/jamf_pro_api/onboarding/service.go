@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package onboarding
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Onboarding.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.OnboardingFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, req *RequestAddHistoryNotes) (*ResponseAddHistoryNotes, *resty.Response, error)
+	ExportHistoryV1(ctx context.Context, acceptHeader string, rsqlQuery map[string]string, req *RequestExportHistory) ([]byte, *resty.Response, error)
+	GetEligibleAppsV1(ctx context.Context, query map[string]string) (*ResponseEligibilityList, *resty.Response, error)
+	GetEligibleConfigurationProfilesV1(ctx context.Context, query map[string]string) (*ResponseEligibilityList, *resty.Response, error)
+	GetEligiblePoliciesV1(ctx context.Context, query map[string]string) (*ResponseEligibilityList, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResponseOnboardingSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceUpdateOnboardingSettings) (*ResponseOnboardingSettings, *resty.Response, error)
+}
+
+var _ Service = (*Onboarding)(nil)
@@ -12,7 +12,6 @@ import (
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/cloud_distribution_point"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/crypto"
-	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/tools/upload_counter"
 	"resty.dev/v3"
 )
 
@@ -126,7 +125,8 @@ func (s *Packages) CreateV1(ctx context.Context, request *RequestPackage) (*Crea
 	return &result, resp, nil
 }
 
-// UploadV1 uploads a package file to an existing package record.
+// UploadV1 uploads a package file to an existing package record. Progress is
+// reported to the client's ProgressReporter, if any.
 // URL: POST /api/v1/packages/{id}/upload
 // https://developer.jamf.com/jamf-pro/reference/post_v1-packages-id-upload
 func (s *Packages) UploadV1(ctx context.Context, id string, filePath string) (*CreateResponse, *resty.Response, error) {
@@ -156,11 +156,10 @@ func (s *Packages) UploadV1(ctx context.Context, id string, filePath string) (*C
 
 	var result CreateResponse
 
-	bar := upload_counter.New(nil)
 	resp, err := s.client.NewRequest(ctx).
 		SetHeader("Accept", constants.ApplicationJSON).
 		SetHeader("Content-Type", constants.ApplicationJSON).
-		SetMultipartFile("file", fileName, f, info.Size(), client.MultipartProgressCallback(bar.Callback)).
+		SetMultipartFile("file", fileName, f, info.Size(), nil).
 		SetResult(&result).
 		Post(endpoint)
 	if err != nil {
@@ -0,0 +1,518 @@
+package packages
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jcds"
+)
+
+// Package downloader defaults.
+const (
+	// DefaultDownloadConcurrency is how many files DownloadAll fetches at once.
+	DefaultDownloadConcurrency = 4
+
+	// DefaultDownloadRetries is how many times an interrupted transfer is
+	// resumed with a ranged GET before the download fails.
+	DefaultDownloadRetries = 3
+
+	// partialDownloadSuffix is appended to the destination path while a file
+	// download is in progress.
+	partialDownloadSuffix = ".part"
+)
+
+// DownloadURLResolver returns a URL the package file fileName can be fetched
+// from with a plain GET.
+type DownloadURLResolver func(ctx context.Context, fileName string) (string, error)
+
+// PackageDownloader streams package files out of the cloud distribution
+// point and checks them against the hashType/hashValue of their package
+// record.
+//
+// By default file URLs come from JCDS (GetPackageURIByNameV1), which returns
+// a short-lived signed S3 URL. Interrupted transfers are resumed with ranged
+// GETs from the last byte received, and DownloadToFile also resumes from a
+// partial file left behind by an earlier failed run.
+type PackageDownloader struct {
+	packages    *Packages
+	httpClient  *http.Client
+	resolveURL  DownloadURLResolver
+	concurrency int
+	retries     int
+}
+
+// PackageDownloaderOption configures a PackageDownloader.
+type PackageDownloaderOption func(*PackageDownloader)
+
+// WithDownloadConcurrency sets how many files DownloadAll fetches at once.
+// Values below 1 keep the default.
+func WithDownloadConcurrency(n int) PackageDownloaderOption {
+	return func(d *PackageDownloader) {
+		if n > 0 {
+			d.concurrency = n
+		}
+	}
+}
+
+// WithDownloadRetries sets how many times an interrupted transfer is resumed
+// before giving up. Negative values keep the default.
+func WithDownloadRetries(n int) PackageDownloaderOption {
+	return func(d *PackageDownloader) {
+		if n >= 0 {
+			d.retries = n
+		}
+	}
+}
+
+// WithDownloadHTTPClient sets the HTTP client used to fetch file contents.
+// The Jamf Pro client is not used for this, as file URLs are signed and must
+// not carry the Jamf Pro bearer token.
+func WithDownloadHTTPClient(c *http.Client) PackageDownloaderOption {
+	return func(d *PackageDownloader) {
+		if c != nil {
+			d.httpClient = c
+		}
+	}
+}
+
+// WithDownloadURLResolver replaces the JCDS URL lookup, for instances whose
+// cloud distribution point serves files from another location.
+func WithDownloadURLResolver(resolver DownloadURLResolver) PackageDownloaderOption {
+	return func(d *PackageDownloader) {
+		if resolver != nil {
+			d.resolveURL = resolver
+		}
+	}
+}
+
+// NewPackageDownloader returns a PackageDownloader using client for the Jamf
+// Pro API calls.
+func NewPackageDownloader(client client.Client, opts ...PackageDownloaderOption) *PackageDownloader {
+	files := jcds.NewJcds(client)
+	d := &PackageDownloader{
+		packages:    NewPackages(client),
+		httpClient:  http.DefaultClient,
+		concurrency: DefaultDownloadConcurrency,
+		retries:     DefaultDownloadRetries,
+		resolveURL: func(ctx context.Context, fileName string) (string, error) {
+			file, _, err := files.GetPackageURIByNameV1(ctx, fileName)
+			if err != nil {
+				return "", err
+			}
+			if file.URI == "" {
+				return "", fmt.Errorf("no download URI returned for %s", fileName)
+			}
+			return file.URI, nil
+		},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// PackageDownloadResult describes the outcome of one package download.
+type PackageDownloadResult struct {
+	// PackageID is the ID of the package record.
+	PackageID string
+	// FileName is the file name on the package record.
+	FileName string
+	// Path is the local file written by DownloadToFile and DownloadAll.
+	Path string
+	// Size is the number of bytes in the downloaded file.
+	Size int64
+	// ResumedFrom is the offset the first request started at when a partial
+	// file from an earlier run was reused.
+	ResumedFrom int64
+	// Resumes is the number of ranged GETs made after interruptions.
+	Resumes int
+	// HashType and HashValue are the checksum the file was verified against.
+	// Both are empty when the record carries no checksum, in which case
+	// Verified is false.
+	HashType  string
+	HashValue string
+	// Verified is true when the file matched the record's checksum.
+	Verified bool
+	// Err is the error for this package in DownloadAll results.
+	Err error
+}
+
+// Download streams the file of package packageID to w and verifies it
+// against the record's checksum. Because bytes already written to w cannot
+// be taken back, a checksum mismatch is reported only after the whole file
+// has been written; use DownloadToFile to discard bad files automatically.
+func (d *PackageDownloader) Download(ctx context.Context, packageID string, w io.Writer) (*PackageDownloadResult, error) {
+	if w == nil {
+		return nil, fmt.Errorf("writer is required")
+	}
+	result, url, verifier, err := d.prepare(ctx, packageID)
+	if err != nil {
+		return result, err
+	}
+
+	sink := &downloadSink{w: w, hash: verifier}
+	if err := d.transfer(ctx, url, sink, result); err != nil {
+		return result, err
+	}
+	return result, d.finish(ctx, sink, result)
+}
+
+// DownloadToFile downloads the file of package packageID to path, creating
+// or replacing it. Data is written to path + ".part" and renamed once the
+// checksum matches; a .part file left by an interrupted run is resumed
+// rather than fetched again. On a checksum mismatch the partial file is
+// removed and ErrChecksumMismatch is returned.
+func (d *PackageDownloader) DownloadToFile(ctx context.Context, packageID string, path string) (*PackageDownloadResult, error) {
+	if path == "" {
+		return nil, fmt.Errorf("destination path is required")
+	}
+	result, url, verifier, err := d.prepare(ctx, packageID)
+	if err != nil {
+		return result, err
+	}
+	return result, d.writeFile(ctx, url, verifier, path, result)
+}
+
+// writeFile downloads url to path through path + ".part".
+func (d *PackageDownloader) writeFile(ctx context.Context, url string, verifier hash.Hash, path string, result *PackageDownloadResult) error {
+	result.Path = path
+
+	partPath := path + partialDownloadSuffix
+	f, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("open partial download: %w", err)
+	}
+	defer f.Close()
+
+	sink := &downloadSink{w: f, hash: verifier, truncate: func() error {
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		_, err := f.Seek(0, io.SeekStart)
+		return err
+	}}
+
+	// Feed any bytes kept from an earlier run through the checksum so the
+	// transfer can continue from where it stopped.
+	kept, err := io.Copy(io.Discard, &hashingReader{r: f, h: verifier})
+	if err != nil {
+		return fmt.Errorf("read partial download: %w", err)
+	}
+	sink.written = kept
+	result.ResumedFrom = kept
+
+	if err := d.transfer(ctx, url, sink, result); err != nil {
+		return err
+	}
+	if err := d.finish(ctx, sink, result); err != nil {
+		if errors.Is(err, ErrChecksumMismatch) {
+			f.Close()
+			os.Remove(partPath)
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close partial download: %w", err)
+	}
+	if err := os.Rename(partPath, path); err != nil {
+		return fmt.Errorf("move download into place: %w", err)
+	}
+	return nil
+}
+
+// DownloadAll downloads the files of packageIDs into dir, each under the file
+// name on its record, with at most the configured number of transfers in
+// flight. It returns one result per ID, in order, with Err set for packages
+// that failed, and an error joining every failure.
+func (d *PackageDownloader) DownloadAll(ctx context.Context, packageIDs []string, dir string) ([]*PackageDownloadResult, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("destination directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create destination directory: %w", err)
+	}
+
+	results := make([]*PackageDownloadResult, len(packageIDs))
+	sem := make(chan struct{}, d.concurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range packageIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = &PackageDownloadResult{PackageID: id, Err: ctx.Err()}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = d.downloadInto(ctx, id, dir)
+		}(i, id)
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("package %s: %w", r.PackageID, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// downloadInto downloads one package into dir for DownloadAll.
+func (d *PackageDownloader) downloadInto(ctx context.Context, packageID string, dir string) *PackageDownloadResult {
+	result, url, verifier, err := d.prepare(ctx, packageID)
+	if result == nil {
+		result = &PackageDownloadResult{PackageID: packageID}
+	}
+	if err == nil {
+		// The record's file name is used as-is for the local name, minus
+		// any directory part.
+		result.Err = d.writeFile(ctx, url, verifier, filepath.Join(dir, filepath.Base(result.FileName)), result)
+	} else {
+		result.Err = err
+	}
+	return result
+}
+
+// prepare looks up the package record and file URL, and returns a hash for
+// the record's checksum (nil when it has none).
+func (d *PackageDownloader) prepare(ctx context.Context, packageID string) (*PackageDownloadResult, string, hash.Hash, error) {
+	if packageID == "" {
+		return nil, "", nil, fmt.Errorf("package ID is required")
+	}
+	record, _, err := d.packages.GetByIDV1(ctx, packageID)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if record.FileName == "" {
+		return nil, "", nil, fmt.Errorf("package %s has no file name", packageID)
+	}
+	result := &PackageDownloadResult{PackageID: packageID, FileName: record.FileName}
+
+	var verifier hash.Hash
+	switch {
+	case record.HashType == HashTypeSHA512 && record.HashValue != "":
+		result.HashType, result.HashValue, verifier = HashTypeSHA512, record.HashValue, sha512.New()
+	case record.HashType == HashTypeMD5 && record.HashValue != "":
+		result.HashType, result.HashValue, verifier = HashTypeMD5, record.HashValue, md5.New()
+	case record.MD5 != "":
+		result.HashType, result.HashValue, verifier = HashTypeMD5, record.MD5, md5.New()
+	}
+
+	url, err := d.resolveURL(ctx, record.FileName)
+	if err != nil {
+		return result, "", nil, fmt.Errorf("resolve download URL for %s: %w", record.FileName, err)
+	}
+	return result, url, verifier, nil
+}
+
+// finish compares the downloaded bytes with the expected checksum.
+func (d *PackageDownloader) finish(ctx context.Context, sink *downloadSink, result *PackageDownloadResult) error {
+	progress := client.ProgressReporterFor(ctx, d.packages.client)
+	result.Size = sink.written
+
+	if sink.hash != nil {
+		progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseVerifying, File: result.FileName, BytesTransferred: sink.written, TotalBytes: sink.written})
+		if got := hexSum(sink.hash); !strings.EqualFold(got, result.HashValue) {
+			return fmt.Errorf("%w: %s has %s %s, package record has %s",
+				ErrChecksumMismatch, result.FileName, result.HashType, got, result.HashValue)
+		}
+		result.Verified = true
+	}
+
+	progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseComplete, File: result.FileName, BytesTransferred: sink.written, TotalBytes: sink.written})
+	return nil
+}
+
+// transfer fetches url into sink, resuming with a ranged GET after each
+// retryable interruption.
+func (d *PackageDownloader) transfer(ctx context.Context, url string, sink *downloadSink, result *PackageDownloadResult) error {
+	sink.progress = client.ProgressReporterFor(ctx, d.packages.client)
+	sink.file = result.FileName
+
+	var err error
+	for attempt := 0; attempt <= d.retries; attempt++ {
+		if attempt > 0 {
+			result.Resumes++
+		}
+		err = d.fetch(ctx, url, sink)
+		var retry *retryableDownloadError
+		if err == nil || !errors.As(err, &retry) || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("download %s: %w", result.FileName, err)
+	}
+	return nil
+}
+
+// fetch makes one GET for the bytes sink does not have yet.
+func (d *PackageDownloader) fetch(ctx context.Context, url string, sink *downloadSink) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if sink.written > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", sink.written))
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return &retryableDownloadError{err}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+		start, total, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != sink.written {
+			return fmt.Errorf("unexpected Content-Range %q for offset %d", resp.Header.Get("Content-Range"), sink.written)
+		}
+		sink.total = total
+	case resp.StatusCode == http.StatusOK:
+		// The server ignored the Range header and is sending the whole file.
+		if sink.written > 0 {
+			if err := sink.reset(); err != nil {
+				return err
+			}
+		}
+		sink.total = resp.ContentLength
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && sink.written > 0:
+		// Nothing left to fetch; the checksum decides whether what we have
+		// is the whole file.
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return &retryableDownloadError{fmt.Errorf("unexpected status %s", resp.Status)}
+	default:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	if _, err := io.Copy(sink, &retryableReader{r: resp.Body}); err != nil {
+		return err
+	}
+	if sink.total > 0 && sink.written < sink.total {
+		return &retryableDownloadError{io.ErrUnexpectedEOF}
+	}
+	return nil
+}
+
+// parseContentRange parses "bytes start-end/total". total is -1 when the
+// server sends "*".
+func parseContentRange(v string) (start, total int64, ok bool) {
+	spec, found := strings.CutPrefix(v, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	rng, size, found := strings.Cut(spec, "/")
+	if !found {
+		return 0, 0, false
+	}
+	first, _, found := strings.Cut(rng, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if size == "*" {
+		return start, -1, true
+	}
+	total, err = strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
+
+// downloadSink writes downloaded bytes to the destination, the checksum, and
+// the progress reporter.
+type downloadSink struct {
+	w        io.Writer
+	hash     hash.Hash
+	written  int64
+	total    int64
+	truncate func() error // nil when w cannot be rewound
+
+	progress client.ProgressReporter
+	file     string
+}
+
+func (s *downloadSink) Write(p []byte) (int, error) {
+	n, err := s.w.Write(p)
+	if s.hash != nil {
+		s.hash.Write(p[:n])
+	}
+	s.written += int64(n)
+	s.progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseDownloading, File: s.file, BytesTransferred: s.written, TotalBytes: max(s.total, 0)})
+	return n, err
+}
+
+// reset discards everything written so far.
+func (s *downloadSink) reset() error {
+	if s.truncate == nil {
+		return fmt.Errorf("server does not support ranged requests; cannot resume after %d bytes", s.written)
+	}
+	if err := s.truncate(); err != nil {
+		return fmt.Errorf("discard partial download: %w", err)
+	}
+	if s.hash != nil {
+		s.hash.Reset()
+	}
+	s.written = 0
+	return nil
+}
+
+// retryableDownloadError marks failures a ranged GET may get past: network
+// errors, bodies cut short, and throttling or server errors.
+type retryableDownloadError struct {
+	err error
+}
+
+func (e *retryableDownloadError) Error() string { return e.err.Error() }
+
+func (e *retryableDownloadError) Unwrap() error { return e.err }
+
+// retryableReader marks read errors from a response body as retryable, so
+// they can be told apart from errors writing to the destination.
+type retryableReader struct {
+	r io.Reader
+}
+
+func (r *retryableReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != io.EOF {
+		err = &retryableDownloadError{err}
+	}
+	return n, err
+}
+
+// hashingReader feeds everything read from r into h, when h is set.
+type hashingReader struct {
+	r io.Reader
+	h hash.Hash
+}
+
+func (r *hashingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if r.h != nil {
+		r.h.Write(p[:n])
+	}
+	return n, err
+}
@@ -0,0 +1,322 @@
+package packages
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jcds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// downloaderTestServer fakes the Jamf Pro package and JCDS endpoints, and
+// serves the signed file URLs JCDS hands out.
+type downloaderTestServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	records map[string]*ResourcePackage
+	files   map[string][]byte
+
+	// cutAfter makes the next cuts file responses stop after that many bytes.
+	cutAfter    int
+	cuts        int
+	ignoreRange bool
+	ranges      []string
+	inFlight    int
+	maxInFlight int
+	delay       time.Duration
+}
+
+func newDownloaderTestServer(t *testing.T) *downloaderTestServer {
+	t.Helper()
+	s := &downloaderTestServer{records: map[string]*ResourcePackage{}, files: map[string][]byte{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// addPackage stores a file and a record for it carrying a SHA-512 hash.
+func (s *downloaderTestServer) addPackage(id, fileName, content string) {
+	sum := sha512.Sum512([]byte(content))
+	s.records[id] = &ResourcePackage{ID: id, FileName: fileName, HashType: HashTypeSHA512, HashValue: hex.EncodeToString(sum[:])}
+	s.files[fileName] = []byte(content)
+}
+
+func (s *downloaderTestServer) serve(w http.ResponseWriter, r *http.Request) {
+	if name, ok := strings.CutPrefix(r.URL.Path, "/signed/"); ok {
+		s.serveFile(w, r, name)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	write := func(v any) { _ = json.NewEncoder(w).Encode(v) }
+
+	switch {
+	case r.URL.Path == "/api/v1/oauth/token":
+		write(map[string]any{"access_token": "t", "expires_in": 3600})
+	case strings.HasPrefix(r.URL.Path, "/api/v1/packages/"):
+		rec, ok := s.records[strings.TrimPrefix(r.URL.Path, "/api/v1/packages/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(rec)
+	case strings.HasPrefix(r.URL.Path, "/api/v1/jcds/files/"):
+		name := strings.TrimPrefix(r.URL.Path, "/api/v1/jcds/files/")
+		write(jcds.ResponseJCDSFile{URI: s.URL + "/signed/" + name})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *downloaderTestServer) serveFile(w http.ResponseWriter, r *http.Request, name string) {
+	if r.Header.Get("Authorization") != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	data, ok := s.files[name]
+	s.ranges = append(s.ranges, r.Header.Get("Range"))
+	cut := s.cuts > 0
+	if cut {
+		s.cuts--
+	}
+	ignoreRange := s.ignoreRange
+	s.inFlight++
+	s.maxInFlight = max(s.maxInFlight, s.inFlight)
+	delay := s.delay
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+
+	time.Sleep(delay)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if ignoreRange {
+		r.Header.Del("Range")
+	}
+	if cut {
+		// Promise the rest of the file but drop the connection part-way.
+		var offset int
+		status := http.StatusOK
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &offset); err == nil {
+			status = http.StatusPartialContent
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(data)-1, len(data)))
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)-offset))
+		w.WriteHeader(status)
+		_, _ = w.Write(data[offset:min(offset+s.cutAfter, len(data)-1)])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(data))
+}
+
+func newTestDownloader(t *testing.T, srv *downloaderTestServer, opts ...PackageDownloaderOption) *PackageDownloader {
+	t.Helper()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := client.NewTransport(cfg)
+	require.NoError(t, err)
+	return NewPackageDownloader(tr, opts...)
+}
+
+func TestUnit_PackageDownloader_Download_StreamsAndVerifies(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	d := newTestDownloader(t, srv)
+
+	var phases []client.ProgressPhase
+	ctx := client.ContextWithProgressReporter(context.Background(), client.ProgressReporterFunc(func(e client.ProgressEvent) {
+		if len(phases) == 0 || phases[len(phases)-1] != e.Phase {
+			phases = append(phases, e.Phase)
+		}
+	}))
+
+	var buf bytes.Buffer
+	result, err := d.Download(ctx, "1", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", buf.String())
+	assert.True(t, result.Verified)
+	assert.Equal(t, HashTypeSHA512, result.HashType)
+	assert.Equal(t, int64(10), result.Size)
+	assert.Equal(t, []client.ProgressPhase{client.ProgressPhaseDownloading, client.ProgressPhaseVerifying, client.ProgressPhaseComplete}, phases)
+}
+
+func TestUnit_PackageDownloader_Download_ResumesWithRange(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	srv.cuts, srv.cutAfter = 1, 4
+	d := newTestDownloader(t, srv)
+
+	var buf bytes.Buffer
+	result, err := d.Download(context.Background(), "1", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", buf.String())
+	assert.Equal(t, 1, result.Resumes)
+	assert.Equal(t, []string{"", "bytes=4-"}, srv.ranges)
+}
+
+func TestUnit_PackageDownloader_Download_GivesUpAfterRetries(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	srv.cuts, srv.cutAfter = 5, 1
+	d := newTestDownloader(t, srv, WithDownloadRetries(2))
+
+	_, err := d.Download(context.Background(), "1", &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Len(t, srv.ranges, 3)
+}
+
+func TestUnit_PackageDownloader_Download_NoRangeSupportCannotRewindWriter(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	srv.cuts, srv.cutAfter = 1, 4
+	srv.ignoreRange = true
+	d := newTestDownloader(t, srv)
+
+	_, err := d.Download(context.Background(), "1", &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ranged requests")
+}
+
+func TestUnit_PackageDownloader_Download_MD5Record(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	sum := md5.Sum([]byte("abc"))
+	srv.records["2"] = &ResourcePackage{ID: "2", FileName: "legacy.dmg", MD5: hex.EncodeToString(sum[:])}
+	srv.files["legacy.dmg"] = []byte("abc")
+	d := newTestDownloader(t, srv)
+
+	result, err := d.Download(context.Background(), "2", &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Equal(t, HashTypeMD5, result.HashType)
+}
+
+func TestUnit_PackageDownloader_DownloadToFile_ResumesPartialFile(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	d := newTestDownloader(t, srv)
+
+	dest := filepath.Join(t.TempDir(), "app.pkg")
+	require.NoError(t, os.WriteFile(dest+".part", []byte("012345"), 0o644))
+
+	result, err := d.DownloadToFile(context.Background(), "1", dest)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), result.ResumedFrom)
+	assert.True(t, result.Verified)
+	assert.Equal(t, []string{"bytes=6-"}, srv.ranges)
+
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(got))
+	assert.NoFileExists(t, dest+".part")
+}
+
+func TestUnit_PackageDownloader_DownloadToFile_RestartsWhenRangeIgnored(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	srv.ignoreRange = true
+	d := newTestDownloader(t, srv)
+
+	dest := filepath.Join(t.TempDir(), "app.pkg")
+	require.NoError(t, os.WriteFile(dest+".part", []byte("0123"), 0o644))
+
+	_, err := d.DownloadToFile(context.Background(), "1", dest)
+	require.NoError(t, err)
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(got))
+}
+
+func TestUnit_PackageDownloader_DownloadToFile_ChecksumMismatch(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	srv.addPackage("1", "app.pkg", "0123456789")
+	srv.files["app.pkg"] = []byte("tampered!!")
+	d := newTestDownloader(t, srv)
+
+	dest := filepath.Join(t.TempDir(), "app.pkg")
+	_, err := d.DownloadToFile(context.Background(), "1", dest)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.NoFileExists(t, dest)
+	assert.NoFileExists(t, dest+".part")
+}
+
+func TestUnit_PackageDownloader_DownloadAll_LimitsConcurrency(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	for i, name := range []string{"a.pkg", "b.pkg", "c.pkg", "d.pkg", "e.pkg"} {
+		srv.addPackage(string(rune('1'+i)), name, "content of "+name)
+	}
+	srv.delay = 20 * time.Millisecond
+	d := newTestDownloader(t, srv, WithDownloadConcurrency(2))
+
+	dir := t.TempDir()
+	results, err := d.DownloadAll(context.Background(), []string{"1", "2", "3", "4", "5", "99"}, dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "package 99")
+	require.Len(t, results, 6)
+	assert.LessOrEqual(t, srv.maxInFlight, 2)
+
+	for _, r := range results[:5] {
+		require.NoError(t, r.Err)
+		assert.True(t, r.Verified)
+		got, err := os.ReadFile(filepath.Join(dir, r.FileName))
+		require.NoError(t, err)
+		assert.Equal(t, "content of "+r.FileName, string(got))
+	}
+	assert.Equal(t, "99", results[5].PackageID)
+	assert.Error(t, results[5].Err)
+}
+
+func TestUnit_PackageDownloader_Validation(t *testing.T) {
+	srv := newDownloaderTestServer(t)
+	d := newTestDownloader(t, srv)
+	ctx := context.Background()
+
+	_, err := d.Download(ctx, "", &bytes.Buffer{})
+	assert.Error(t, err)
+	_, err = d.Download(ctx, "1", nil)
+	assert.Error(t, err)
+	_, err = d.DownloadToFile(ctx, "1", "")
+	assert.Error(t, err)
+	_, err = d.DownloadAll(ctx, []string{"1"}, "")
+	assert.Error(t, err)
+}
+
+func TestUnit_ParseContentRange(t *testing.T) {
+	start, total, ok := parseContentRange("bytes 4-9/10")
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), start)
+	assert.Equal(t, int64(10), total)
+
+	_, total, ok = parseContentRange("bytes 0-9/*")
+	assert.True(t, ok)
+	assert.Equal(t, int64(-1), total)
+
+	_, _, ok = parseContentRange("items 0-9/10")
+	assert.False(t, ok)
+}
@@ -5,3 +5,9 @@ const (
 	ManifestHashTypeMD5    = "MD5"
 	ManifestHashTypeSHA256 = "SHA256"
 )
+
+// HashType* constants represent the hashType values of a package record.
+const (
+	HashTypeMD5    = "MD5"
+	HashTypeSHA512 = "SHA_512"
+)
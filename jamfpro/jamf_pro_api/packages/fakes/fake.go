@@ -0,0 +1,234 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the packages
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages"
+	"resty.dev/v3"
+)
+
+// PackagesFake is a packages.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PackagesFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func         func(ctx context.Context, id string, req *packages.AddHistoryNotesRequest) (*resty.Response, error)
+	AssignManifestToPackageV1Func func(ctx context.Context, id string, manifestPath string) (*packages.CreateResponse, *resty.Response, error)
+	CreateAndUploadFunc           func(ctx context.Context, filePath string, req *packages.RequestPackage) (*packages.CreateResponse, *resty.Response, error)
+	CreateV1Func                  func(ctx context.Context, request *packages.RequestPackage) (*packages.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func              func(ctx context.Context, id string) (*resty.Response, error)
+	DeletePackageManifestV1Func   func(ctx context.Context, id string) (*resty.Response, error)
+	DeletePackagesByIDV1Func      func(ctx context.Context, req *packages.DeletePackagesByIDRequest) (*resty.Response, error)
+	ExportHistoryV1Func           func(ctx context.Context, id string, rsqlQuery map[string]string, body *packages.ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	ExportV1Func                  func(ctx context.Context, rsqlQuery map[string]string, body *packages.ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetByIDV1Func                 func(ctx context.Context, id string) (*packages.ResourcePackage, *resty.Response, error)
+	GetHistoryV1Func              func(ctx context.Context, id string, rsqlQuery map[string]string) (*packages.HistoryResponse, *resty.Response, error)
+	ListV1Func                    func(ctx context.Context, rsqlQuery map[string]string) (*packages.ListResponse, *resty.Response, error)
+	UpdateAndUploadFunc           func(ctx context.Context, id string, filePath string, req *packages.ResourcePackage) (*packages.ResourcePackage, *resty.Response, error)
+	UpdateByIDV1Func              func(ctx context.Context, id string, request *packages.ResourcePackage) (*packages.ResourcePackage, *resty.Response, error)
+	UploadV1Func                  func(ctx context.Context, id string, filePath string) (*packages.CreateResponse, *resty.Response, error)
+}
+
+var _ packages.Service = (*PackagesFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *PackagesFake) AddHistoryNotesV1(ctx context.Context, id string, req *packages.AddHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, id, req)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("PackagesFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, id, req)
+}
+
+// AssignManifestToPackageV1 records the call and delegates to AssignManifestToPackageV1Func.
+func (f *PackagesFake) AssignManifestToPackageV1(ctx context.Context, id string, manifestPath string) (*packages.CreateResponse, *resty.Response, error) {
+	f.Record("AssignManifestToPackageV1", ctx, id, manifestPath)
+	if f.AssignManifestToPackageV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.AssignManifestToPackageV1")
+	}
+	return f.AssignManifestToPackageV1Func(ctx, id, manifestPath)
+}
+
+// CreateAndUpload records the call and delegates to CreateAndUploadFunc.
+func (f *PackagesFake) CreateAndUpload(ctx context.Context, filePath string, req *packages.RequestPackage) (*packages.CreateResponse, *resty.Response, error) {
+	f.Record("CreateAndUpload", ctx, filePath, req)
+	if f.CreateAndUploadFunc == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.CreateAndUpload")
+	}
+	return f.CreateAndUploadFunc(ctx, filePath, req)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *PackagesFake) CreateV1(ctx context.Context, request *packages.RequestPackage) (*packages.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *PackagesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("PackagesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeletePackageManifestV1 records the call and delegates to DeletePackageManifestV1Func.
+func (f *PackagesFake) DeletePackageManifestV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeletePackageManifestV1", ctx, id)
+	if f.DeletePackageManifestV1Func == nil {
+		return nil, fakes.NotStubbed("PackagesFake.DeletePackageManifestV1")
+	}
+	return f.DeletePackageManifestV1Func(ctx, id)
+}
+
+// DeletePackagesByIDV1 records the call and delegates to DeletePackagesByIDV1Func.
+func (f *PackagesFake) DeletePackagesByIDV1(ctx context.Context, req *packages.DeletePackagesByIDRequest) (*resty.Response, error) {
+	f.Record("DeletePackagesByIDV1", ctx, req)
+	if f.DeletePackagesByIDV1Func == nil {
+		return nil, fakes.NotStubbed("PackagesFake.DeletePackagesByIDV1")
+	}
+	return f.DeletePackagesByIDV1Func(ctx, req)
+}
+
+// ExportHistoryV1 records the call and delegates to ExportHistoryV1Func.
+func (f *PackagesFake) ExportHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string, body *packages.ExportRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("ExportHistoryV1", ctx, id, rsqlQuery, body, acceptType)
+	if f.ExportHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.ExportHistoryV1")
+	}
+	return f.ExportHistoryV1Func(ctx, id, rsqlQuery, body, acceptType)
+}
+
+// ExportV1 records the call and delegates to ExportV1Func.
+func (f *PackagesFake) ExportV1(ctx context.Context, rsqlQuery map[string]string, body *packages.ExportRequest, acceptType string) ([]byte, *resty.Response, error) {
+	f.Record("ExportV1", ctx, rsqlQuery, body, acceptType)
+	if f.ExportV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.ExportV1")
+	}
+	return f.ExportV1Func(ctx, rsqlQuery, body, acceptType)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *PackagesFake) GetByIDV1(ctx context.Context, id string) (*packages.ResourcePackage, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *PackagesFake) GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*packages.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, id, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *PackagesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*packages.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateAndUpload records the call and delegates to UpdateAndUploadFunc.
+func (f *PackagesFake) UpdateAndUpload(ctx context.Context, id string, filePath string, req *packages.ResourcePackage) (*packages.ResourcePackage, *resty.Response, error) {
+	f.Record("UpdateAndUpload", ctx, id, filePath, req)
+	if f.UpdateAndUploadFunc == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.UpdateAndUpload")
+	}
+	return f.UpdateAndUploadFunc(ctx, id, filePath, req)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *PackagesFake) UpdateByIDV1(ctx context.Context, id string, request *packages.ResourcePackage) (*packages.ResourcePackage, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UploadV1 records the call and delegates to UploadV1Func.
+func (f *PackagesFake) UploadV1(ctx context.Context, id string, filePath string) (*packages.CreateResponse, *resty.Response, error) {
+	f.Record("UploadV1", ctx, id, filePath)
+	if f.UploadV1Func == nil {
+		return nil, nil, fakes.NotStubbed("PackagesFake.UploadV1")
+	}
+	return f.UploadV1Func(ctx, id, filePath)
+}
+
+// PackageUploaderFake is a packages.PackageUploaderService whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PackageUploaderFake struct {
+	fakes.Journal
+
+	UploadFunc func(ctx context.Context, filePath string, req *packages.RequestPackage) (*packages.PackageUploadResult, error)
+}
+
+var _ packages.PackageUploaderService = (*PackageUploaderFake)(nil)
+
+// Upload records the call and delegates to UploadFunc.
+func (f *PackageUploaderFake) Upload(ctx context.Context, filePath string, req *packages.RequestPackage) (*packages.PackageUploadResult, error) {
+	f.Record("Upload", ctx, filePath, req)
+	if f.UploadFunc == nil {
+		return nil, fakes.NotStubbed("PackageUploaderFake.Upload")
+	}
+	return f.UploadFunc(ctx, filePath, req)
+}
+
+// PackageDownloaderFake is a packages.PackageDownloaderService whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PackageDownloaderFake struct {
+	fakes.Journal
+
+	DownloadFunc       func(ctx context.Context, packageID string, w io.Writer) (*packages.PackageDownloadResult, error)
+	DownloadAllFunc    func(ctx context.Context, packageIDs []string, dir string) ([]*packages.PackageDownloadResult, error)
+	DownloadToFileFunc func(ctx context.Context, packageID string, path string) (*packages.PackageDownloadResult, error)
+}
+
+var _ packages.PackageDownloaderService = (*PackageDownloaderFake)(nil)
+
+// Download records the call and delegates to DownloadFunc.
+func (f *PackageDownloaderFake) Download(ctx context.Context, packageID string, w io.Writer) (*packages.PackageDownloadResult, error) {
+	f.Record("Download", ctx, packageID, w)
+	if f.DownloadFunc == nil {
+		return nil, fakes.NotStubbed("PackageDownloaderFake.Download")
+	}
+	return f.DownloadFunc(ctx, packageID, w)
+}
+
+// DownloadAll records the call and delegates to DownloadAllFunc.
+func (f *PackageDownloaderFake) DownloadAll(ctx context.Context, packageIDs []string, dir string) ([]*packages.PackageDownloadResult, error) {
+	f.Record("DownloadAll", ctx, packageIDs, dir)
+	if f.DownloadAllFunc == nil {
+		return nil, fakes.NotStubbed("PackageDownloaderFake.DownloadAll")
+	}
+	return f.DownloadAllFunc(ctx, packageIDs, dir)
+}
+
+// DownloadToFile records the call and delegates to DownloadToFileFunc.
+func (f *PackageDownloaderFake) DownloadToFile(ctx context.Context, packageID string, path string) (*packages.PackageDownloadResult, error) {
+	f.Record("DownloadToFile", ctx, packageID, path)
+	if f.DownloadToFileFunc == nil {
+		return nil, fakes.NotStubbed("PackageDownloaderFake.DownloadToFile")
+	}
+	return f.DownloadToFileFunc(ctx, packageID, path)
+}
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package packages
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Packages.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PackagesFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, id string, req *AddHistoryNotesRequest) (*resty.Response, error)
+	AssignManifestToPackageV1(ctx context.Context, id string, manifestPath string) (*CreateResponse, *resty.Response, error)
+	CreateAndUpload(ctx context.Context, filePath string, req *RequestPackage) (*CreateResponse, *resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestPackage) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeletePackageManifestV1(ctx context.Context, id string) (*resty.Response, error)
+	DeletePackagesByIDV1(ctx context.Context, req *DeletePackagesByIDRequest) (*resty.Response, error)
+	ExportHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string, body *ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	ExportV1(ctx context.Context, rsqlQuery map[string]string, body *ExportRequest, acceptType string) ([]byte, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourcePackage, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateAndUpload(ctx context.Context, id string, filePath string, req *ResourcePackage) (*ResourcePackage, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourcePackage) (*ResourcePackage, *resty.Response, error)
+	UploadV1(ctx context.Context, id string, filePath string) (*CreateResponse, *resty.Response, error)
+}
+
+var _ Service = (*Packages)(nil)
+
+// PackageUploaderService is the method set of PackageUploader.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PackageUploaderFake implements it for tests.
+type PackageUploaderService interface {
+	Upload(ctx context.Context, filePath string, req *RequestPackage) (*PackageUploadResult, error)
+}
+
+var _ PackageUploaderService = (*PackageUploader)(nil)
+
+// PackageDownloaderService is the method set of PackageDownloader.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PackageDownloaderFake implements it for tests.
+type PackageDownloaderService interface {
+	Download(ctx context.Context, packageID string, w io.Writer) (*PackageDownloadResult, error)
+	DownloadAll(ctx context.Context, packageIDs []string, dir string) ([]*PackageDownloadResult, error)
+	DownloadToFile(ctx context.Context, packageID string, path string) (*PackageDownloadResult, error)
+}
+
+var _ PackageDownloaderService = (*PackageDownloader)(nil)
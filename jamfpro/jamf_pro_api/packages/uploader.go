@@ -0,0 +1,503 @@
+package packages
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha3"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jcds"
+)
+
+// Package uploader defaults.
+const (
+	// MinUploadPartSize is the smallest multipart part S3 accepts (except
+	// for the last part).
+	MinUploadPartSize int64 = 5 * 1024 * 1024
+
+	// DefaultUploadPartSize is the multipart part size used by PackageUploader.
+	DefaultUploadPartSize int64 = 16 * 1024 * 1024
+
+	// DefaultVerifyPollInterval is the wait between JCDS inventory checks
+	// while verifying an upload.
+	DefaultVerifyPollInterval = 3 * time.Second
+
+	// DefaultVerifyPollAttempts is how many JCDS inventory checks are made
+	// before verification gives up.
+	DefaultVerifyPollAttempts = 60
+)
+
+// ErrChecksumMismatch is returned when a file stored in JCDS does not match
+// the local file (PackageUploader.Upload) or the checksum on its package
+// record (PackageDownloader).
+var ErrChecksumMismatch = errors.New("package checksum mismatch")
+
+// s3MultipartAPI is the subset of the S3 client used for multipart uploads.
+type s3MultipartAPI interface {
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	ListMultipartUploads(ctx context.Context, params *s3.ListMultipartUploadsInput, optFns ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)
+	ListParts(ctx context.Context, params *s3.ListPartsInput, optFns ...func(*s3.Options)) (*s3.ListPartsOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+}
+
+// PackageUploader gets a package file into Jamf Pro in one call: it creates
+// or updates the package record, uploads the file to the Jamf Cloud
+// Distribution Service (JCDS) with S3 multipart, verifies the stored file
+// against local checksums, and writes hashType/hashValue back to the record.
+//
+// Upload is idempotent and resumable. A file already present in JCDS with
+// matching checksums is not uploaded again, and an interrupted multipart
+// upload is picked up where it stopped: parts already in S3 whose MD5 matches
+// the local data are kept and only the rest are sent.
+type PackageUploader struct {
+	packages     *Packages
+	jcds         *jcds.Jcds
+	partSize     int64
+	pollInterval time.Duration
+	pollAttempts int
+	newS3Client  func(ctx context.Context, creds *jcds.ResourceJCDSUploadCredentials) (s3MultipartAPI, error)
+}
+
+// PackageUploaderOption configures a PackageUploader.
+type PackageUploaderOption func(*PackageUploader)
+
+// WithUploadPartSize sets the multipart part size. Values below
+// MinUploadPartSize are raised to it. Larger parts mean fewer requests but
+// more data re-sent when a part is interrupted.
+func WithUploadPartSize(size int64) PackageUploaderOption {
+	return func(u *PackageUploader) {
+		u.partSize = max(size, MinUploadPartSize)
+	}
+}
+
+// WithVerifyPolling sets how often and how many times the JCDS inventory is
+// checked for the uploaded file. Non-positive values keep the defaults.
+func WithVerifyPolling(interval time.Duration, attempts int) PackageUploaderOption {
+	return func(u *PackageUploader) {
+		if interval > 0 {
+			u.pollInterval = interval
+		}
+		if attempts > 0 {
+			u.pollAttempts = attempts
+		}
+	}
+}
+
+// NewPackageUploader returns a PackageUploader using client for the Jamf Pro
+// API calls. The instance must use Jamf Cloud (JCDS) as its cloud
+// distribution point.
+func NewPackageUploader(client client.Client, opts ...PackageUploaderOption) *PackageUploader {
+	u := &PackageUploader{
+		packages:     NewPackages(client),
+		jcds:         jcds.NewJcds(client),
+		partSize:     DefaultUploadPartSize,
+		pollInterval: DefaultVerifyPollInterval,
+		pollAttempts: DefaultVerifyPollAttempts,
+		newS3Client: func(ctx context.Context, creds *jcds.ResourceJCDSUploadCredentials) (s3MultipartAPI, error) {
+			// Part integrity is checked with Content-MD5, so skip the SDK's
+			// default flexible checksums, which multipart completion would
+			// otherwise have to repeat for every part.
+			return jcds.NewS3Client(ctx, creds, func(o *s3.Options) {
+				o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+			})
+		},
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// PackageUploadResult describes the outcome of PackageUploader.Upload.
+type PackageUploadResult struct {
+	// PackageID is the ID of the package record.
+	PackageID string
+	// FileName is the file name of the package in JCDS and on the record.
+	FileName string
+	// Created is true when the package record was created rather than updated.
+	Created bool
+	// Uploaded is false when an identical file was already present in JCDS.
+	Uploaded bool
+	// ResumedParts is the number of parts kept from an interrupted upload.
+	ResumedParts int
+	// HashType and HashValue are the checksum written to the package record.
+	HashType  string
+	HashValue string
+}
+
+// packageDigests holds the checksums of a local package file.
+type packageDigests struct {
+	size    int64
+	md5     string
+	sha512  string
+	sha3512 string
+}
+
+// Upload creates or updates the package record for filePath, uploads the file
+// to JCDS, verifies it, and records its SHA-512 as hashType/hashValue.
+//
+// The record is matched on file name (the base name of filePath): an existing
+// record is updated with the fields of req, otherwise a new one is created
+// from req. req.FileName is ignored. When Upload fails part-way through the
+// file transfer, call it again with the same file to resume. Errors after the
+// record step are returned with the partial result, so PackageID is known.
+func (u *PackageUploader) Upload(ctx context.Context, filePath string, req *RequestPackage) (*PackageUploadResult, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("file path is required")
+	}
+	if req == nil {
+		return nil, fmt.Errorf("request is required")
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open package file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat package file: %w", err)
+	}
+	if info.Size() == 0 {
+		return nil, fmt.Errorf("package file %s is empty", filePath)
+	}
+
+	result := &PackageUploadResult{FileName: filepath.Base(filePath)}
+	progress := client.ProgressReporterFor(ctx, u.packages.client)
+
+	digests, err := digestPackage(client.NewProgressReader(f, progress, client.ProgressPhaseHashing, result.FileName, info.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 1: create or update the package record.
+	record, err := u.upsertRecord(ctx, result, req, digests)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: upload unless JCDS already holds this exact file.
+	files, _, err := u.jcds.GetPackagesV1(ctx)
+	if err != nil {
+		return result, err
+	}
+	if existing := findJCDSFile(files, result.FileName); existing == nil || !digests.matches(existing) {
+		resumed, err := u.uploadFile(ctx, f, result.FileName, digests.size, progress)
+		if err != nil {
+			return result, err
+		}
+		result.Uploaded = true
+		result.ResumedParts = resumed
+
+		// Step 3: verify the stored file against the local checksums.
+		progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseVerifying, File: result.FileName, TotalBytes: digests.size})
+		if err := u.verify(ctx, result.FileName, digests); err != nil {
+			return result, err
+		}
+	}
+
+	// Step 4: write the verified checksum back to the record.
+	result.HashType = HashTypeSHA512
+	result.HashValue = digests.sha512
+	if record.HashType != HashTypeSHA512 || !strings.EqualFold(record.HashValue, digests.sha512) {
+		record.HashType = HashTypeSHA512
+		record.HashValue = digests.sha512
+		record.MD5 = digests.md5
+		if _, _, err := u.packages.UpdateByIDV1(ctx, result.PackageID, record); err != nil {
+			return result, fmt.Errorf("write package checksum: %w", err)
+		}
+	}
+
+	progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseComplete, File: result.FileName, BytesTransferred: digests.size, TotalBytes: digests.size})
+	return result, nil
+}
+
+// upsertRecord updates the record whose fileName matches, or creates one,
+// and returns the record as stored.
+func (u *PackageUploader) upsertRecord(ctx context.Context, result *PackageUploadResult, req *RequestPackage, digests *packageDigests) (*ResourcePackage, error) {
+	filter := map[string]string{"filter": fmt.Sprintf(`fileName=="%s"`, result.FileName)}
+	list, _, err := u.packages.ListV1(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("find package record: %w", err)
+	}
+
+	for _, existing := range list.Results {
+		if existing.FileName != result.FileName {
+			continue
+		}
+		result.PackageID = existing.ID
+		merged := mergePackageRequest(existing, req)
+		if _, _, err := u.packages.UpdateByIDV1(ctx, existing.ID, &merged); err != nil {
+			return nil, fmt.Errorf("update package record: %w", err)
+		}
+		return &merged, nil
+	}
+
+	createReq := *req
+	createReq.FileName = result.FileName
+	createReq.MD5 = digests.md5
+	created, _, err := u.packages.CreateV1(ctx, &createReq)
+	if err != nil {
+		return nil, fmt.Errorf("create package record: %w", err)
+	}
+	result.PackageID = created.ID
+	result.Created = true
+
+	record, _, err := u.packages.GetByIDV1(ctx, created.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get package record: %w", err)
+	}
+	return record, nil
+}
+
+// mergePackageRequest applies the fields set in req to a copy of existing.
+func mergePackageRequest(existing ResourcePackage, req *RequestPackage) ResourcePackage {
+	merged := existing
+	if req.PackageName != "" {
+		merged.PackageName = req.PackageName
+	}
+	if req.CategoryID != "" {
+		merged.CategoryID = req.CategoryID
+	}
+	if req.Info != "" {
+		merged.Info = req.Info
+	}
+	if req.Notes != "" {
+		merged.Notes = req.Notes
+	}
+	if req.Priority != 0 {
+		merged.Priority = req.Priority
+	}
+	if req.OSRequirements != "" {
+		merged.OSRequirements = req.OSRequirements
+	}
+	for _, b := range []struct{ dst, src **bool }{
+		{&merged.FillUserTemplate, &req.FillUserTemplate},
+		{&merged.FillExistingUsers, &req.FillExistingUsers},
+		{&merged.RebootRequired, &req.RebootRequired},
+		{&merged.OSInstall, &req.OSInstall},
+		{&merged.SuppressUpdates, &req.SuppressUpdates},
+		{&merged.SuppressFromDock, &req.SuppressFromDock},
+		{&merged.SuppressEula, &req.SuppressEula},
+		{&merged.SuppressRegistration, &req.SuppressRegistration},
+	} {
+		if *b.src != nil {
+			*b.dst = *b.src
+		}
+	}
+	return merged
+}
+
+// uploadFile sends f to JCDS with S3 multipart, resuming an interrupted
+// upload of the same key when there is one. It returns the number of parts
+// reused from the interrupted upload. Progress is reported once per part.
+func (u *PackageUploader) uploadFile(ctx context.Context, f *os.File, fileName string, size int64, progress client.ProgressReporter) (int, error) {
+	creds, _, err := u.jcds.GetUploadCredentialsV1(ctx)
+	if err != nil {
+		return 0, err
+	}
+	api, err := u.newS3Client(ctx, creds)
+	if err != nil {
+		return 0, err
+	}
+	bucket := creds.BucketName
+	key := creds.Path + fileName
+
+	uploadID, uploaded := u.findInterruptedUpload(ctx, api, bucket, key)
+	if uploadID == "" {
+		out, err := api.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("start multipart upload: %w", err)
+		}
+		uploadID = aws.ToString(out.UploadId)
+	}
+
+	partCount := int32((size + u.partSize - 1) / u.partSize)
+	completed := make([]types.CompletedPart, 0, partCount)
+	resumed := 0
+	for number := int32(1); number <= partCount; number++ {
+		if err := ctx.Err(); err != nil {
+			return resumed, fmt.Errorf("upload interrupted after %d of %d parts (call Upload again to resume): %w", number-1, partCount, err)
+		}
+		offset := int64(number-1) * u.partSize
+		length := min(u.partSize, size-offset)
+
+		sum := md5.New()
+		if _, err := io.Copy(sum, io.NewSectionReader(f, offset, length)); err != nil {
+			return resumed, fmt.Errorf("read part %d: %w", number, err)
+		}
+		partMD5 := sum.Sum(nil)
+
+		if prev, ok := uploaded[number]; ok && aws.ToInt64(prev.Size) == length &&
+			strings.EqualFold(strings.Trim(aws.ToString(prev.ETag), `"`), hex.EncodeToString(partMD5)) {
+			completed = append(completed, types.CompletedPart{PartNumber: aws.Int32(number), ETag: prev.ETag})
+			resumed++
+			progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseUploading, File: fileName, BytesTransferred: offset + length, TotalBytes: size})
+			continue
+		}
+
+		out, err := api.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(bucket),
+			Key:           aws.String(key),
+			UploadId:      aws.String(uploadID),
+			PartNumber:    aws.Int32(number),
+			Body:          io.NewSectionReader(f, offset, length),
+			ContentLength: aws.Int64(length),
+			ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(partMD5)),
+		})
+		if err != nil {
+			return resumed, fmt.Errorf("upload part %d of %d (call Upload again to resume): %w", number, partCount, err)
+		}
+		completed = append(completed, types.CompletedPart{PartNumber: aws.Int32(number), ETag: out.ETag})
+		progress.Report(client.ProgressEvent{Phase: client.ProgressPhaseUploading, File: fileName, BytesTransferred: offset + length, TotalBytes: size})
+	}
+
+	_, err = api.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return resumed, fmt.Errorf("complete multipart upload: %w", err)
+	}
+	return resumed, nil
+}
+
+// findInterruptedUpload returns the most recent unfinished multipart upload of
+// key and its parts by number. Listing failures (e.g. credentials without
+// list permission) are not fatal: the upload simply starts from scratch.
+func (u *PackageUploader) findInterruptedUpload(ctx context.Context, api s3MultipartAPI, bucket, key string) (string, map[int32]types.Part) {
+	out, err := api.ListMultipartUploads(ctx, &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	})
+	if err != nil {
+		return "", nil
+	}
+
+	var latest *types.MultipartUpload
+	for i := range out.Uploads {
+		upload := &out.Uploads[i]
+		if aws.ToString(upload.Key) != key {
+			continue
+		}
+		if latest == nil || aws.ToTime(upload.Initiated).After(aws.ToTime(latest.Initiated)) {
+			latest = upload
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+	uploadID := aws.ToString(latest.UploadId)
+
+	parts := make(map[int32]types.Part)
+	input := &s3.ListPartsInput{Bucket: aws.String(bucket), Key: aws.String(key), UploadId: aws.String(uploadID)}
+	for {
+		page, err := api.ListParts(ctx, input)
+		if err != nil {
+			return "", nil
+		}
+		for _, part := range page.Parts {
+			parts[aws.ToInt32(part.PartNumber)] = part
+		}
+		if !aws.ToBool(page.IsTruncated) {
+			break
+		}
+		input.PartNumberMarker = page.NextPartNumberMarker
+	}
+	return uploadID, parts
+}
+
+// verify polls the JCDS inventory until the uploaded file is listed with
+// checksums, and compares them with the local file.
+func (u *PackageUploader) verify(ctx context.Context, fileName string, digests *packageDigests) error {
+	for attempt := 1; attempt <= u.pollAttempts; attempt++ {
+		if _, err := u.jcds.RefreshInventoryV1(ctx); err != nil {
+			return err
+		}
+		files, _, err := u.jcds.GetPackagesV1(ctx)
+		if err != nil {
+			return err
+		}
+		if file := findJCDSFile(files, fileName); file != nil && file.Length == digests.size && (file.MD5 != "" || file.SHA3 != "") {
+			if !digests.matches(file) {
+				return fmt.Errorf("%w: %s in JCDS has md5=%s sha3=%s, local file has md5=%s sha3=%s",
+					ErrChecksumMismatch, fileName, file.MD5, file.SHA3, digests.md5, digests.sha3512)
+			}
+			return nil
+		}
+		if attempt == u.pollAttempts {
+			break
+		}
+		select {
+		case <-time.After(u.pollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return fmt.Errorf("timed out waiting for %s to appear in JCDS after %d attempts", fileName, u.pollAttempts)
+}
+
+// digestPackage reads r once to compute its size and checksums.
+func digestPackage(r io.Reader) (*packageDigests, error) {
+	md5Sum, sha512Sum, sha3Sum := md5.New(), sha512.New(), sha3.New512()
+	size, err := io.Copy(io.MultiWriter(md5Sum, sha512Sum, sha3Sum), r)
+	if err != nil {
+		return nil, fmt.Errorf("hash package file: %w", err)
+	}
+	return &packageDigests{
+		size:    size,
+		md5:     hexSum(md5Sum),
+		sha512:  hexSum(sha512Sum),
+		sha3512: hexSum(sha3Sum),
+	}, nil
+}
+
+func hexSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// matches reports whether a JCDS file has this size and every checksum JCDS
+// reports for it agrees. A file listed without checksums does not match.
+func (d *packageDigests) matches(file *jcds.ResourceJCDSFile) bool {
+	if file.Length != d.size || (file.MD5 == "" && file.SHA3 == "") {
+		return false
+	}
+	if file.MD5 != "" && !strings.EqualFold(file.MD5, d.md5) {
+		return false
+	}
+	if file.SHA3 != "" && !strings.EqualFold(file.SHA3, d.sha3512) {
+		return false
+	}
+	return true
+}
+
+// findJCDSFile returns the file named fileName, or nil.
+func findJCDSFile(files []jcds.ResourceJCDSFile, fileName string) *jcds.ResourceJCDSFile {
+	for i := range files {
+		if files[i].FileName == fileName {
+			return &files[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package packages
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/jcds"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploaderTestServer fakes the Jamf Pro endpoints used by PackageUploader
+// together with the S3 bucket behind JCDS. Completing a multipart upload
+// publishes the object to the JCDS file listing, as Jamf Pro does after an
+// inventory refresh.
+type uploaderTestServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	records    map[string]*ResourcePackage
+	nextID     int
+	jcdsFiles  map[string]jcds.ResourceJCDSFile
+	corruptMD5 bool
+
+	// S3 state
+	uploads     map[string]map[int32][]byte // uploadID -> part number -> data
+	uploadKeys  map[string]string
+	objects     map[string][]byte
+	partUploads int
+	failAtPart  int32
+}
+
+func newUploaderTestServer(t *testing.T) *uploaderTestServer {
+	t.Helper()
+	s := &uploaderTestServer{
+		records:    map[string]*ResourcePackage{},
+		nextID:     1,
+		jcdsFiles:  map[string]jcds.ResourceJCDSFile{},
+		uploads:    map[string]map[int32][]byte{},
+		uploadKeys: map[string]string{},
+		objects:    map[string][]byte{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveJamf))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *uploaderTestServer) serveJamf(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	write := func(v any) { _ = json.NewEncoder(w).Encode(v) }
+
+	switch {
+	case r.URL.Path == "/api/v1/oauth/token":
+		write(map[string]any{"access_token": "t", "expires_in": 3600})
+	case r.URL.Path == "/api/v1/cloud-distribution-point":
+		write(map[string]any{"cdnType": "JAMF_CLOUD"})
+	case r.URL.Path == "/api/v1/packages" && r.Method == http.MethodGet:
+		results := []ResourcePackage{}
+		filter := r.URL.Query().Get("filter")
+		for _, rec := range s.records {
+			if filter == fmt.Sprintf(`fileName=="%s"`, rec.FileName) {
+				results = append(results, *rec)
+			}
+		}
+		write(map[string]any{"totalCount": len(results), "results": results})
+	case r.URL.Path == "/api/v1/packages" && r.Method == http.MethodPost:
+		var req RequestPackage
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		id := fmt.Sprint(s.nextID)
+		s.nextID++
+		s.records[id] = &ResourcePackage{ID: id, PackageName: req.PackageName, FileName: req.FileName, CategoryID: req.CategoryID, MD5: req.MD5}
+		w.WriteHeader(http.StatusCreated)
+		write(CreateResponse{ID: id})
+	case strings.HasPrefix(r.URL.Path, "/api/v1/packages/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/packages/")
+		rec, ok := s.records[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			var updated ResourcePackage
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			updated.ID = id
+			*rec = updated
+		}
+		write(rec)
+	case r.URL.Path == "/api/v1/jcds/files" && r.Method == http.MethodGet:
+		files := []jcds.ResourceJCDSFile{}
+		for _, f := range s.jcdsFiles {
+			files = append(files, f)
+		}
+		write(files)
+	case r.URL.Path == "/api/v1/jcds/files" && r.Method == http.MethodPost:
+		write(jcds.ResourceJCDSUploadCredentials{Region: "us-east-1", BucketName: "bucket", Path: "tenant/"})
+	case r.URL.Path == "/api/v1/jcds/refresh-inventory":
+		for key, data := range s.objects {
+			name := strings.TrimPrefix(key, "tenant/")
+			md5Sum := md5.Sum(data)
+			file := jcds.ResourceJCDSFile{FileName: name, Length: int64(len(data)), MD5: hex.EncodeToString(md5Sum[:])}
+			if s.corruptMD5 {
+				file.MD5 = "0000"
+			}
+			s.jcdsFiles[name] = file
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// CreateMultipartUpload implements s3MultipartAPI.
+func (s *uploaderTestServer) CreateMultipartUpload(_ context.Context, in *s3.CreateMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := fmt.Sprintf("upload-%d", len(s.uploadKeys)+1)
+	s.uploads[id] = map[int32][]byte{}
+	s.uploadKeys[id] = aws.ToString(in.Key)
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(id)}, nil
+}
+
+// UploadPart implements s3MultipartAPI.
+func (s *uploaderTestServer) UploadPart(_ context.Context, in *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	data, err := io.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failAtPart != 0 && aws.ToInt32(in.PartNumber) == s.failAtPart {
+		return nil, errors.New("connection reset")
+	}
+	s.partUploads++
+	s.uploads[aws.ToString(in.UploadId)][aws.ToInt32(in.PartNumber)] = data
+	sum := md5.Sum(data)
+	return &s3.UploadPartOutput{ETag: aws.String(`"` + hex.EncodeToString(sum[:]) + `"`)}, nil
+}
+
+// ListMultipartUploads implements s3MultipartAPI.
+func (s *uploaderTestServer) ListMultipartUploads(_ context.Context, in *s3.ListMultipartUploadsInput, _ ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := &s3.ListMultipartUploadsOutput{}
+	for id, key := range s.uploadKeys {
+		if _, open := s.uploads[id]; open && strings.HasPrefix(key, aws.ToString(in.Prefix)) {
+			out.Uploads = append(out.Uploads, types.MultipartUpload{Key: aws.String(key), UploadId: aws.String(id), Initiated: aws.Time(time.Now())})
+		}
+	}
+	return out, nil
+}
+
+// ListParts implements s3MultipartAPI.
+func (s *uploaderTestServer) ListParts(_ context.Context, in *s3.ListPartsInput, _ ...func(*s3.Options)) (*s3.ListPartsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := &s3.ListPartsOutput{}
+	for number, data := range s.uploads[aws.ToString(in.UploadId)] {
+		sum := md5.Sum(data)
+		out.Parts = append(out.Parts, types.Part{
+			PartNumber: aws.Int32(number),
+			Size:       aws.Int64(int64(len(data))),
+			ETag:       aws.String(`"` + hex.EncodeToString(sum[:]) + `"`),
+		})
+	}
+	return out, nil
+}
+
+// CompleteMultipartUpload implements s3MultipartAPI.
+func (s *uploaderTestServer) CompleteMultipartUpload(_ context.Context, in *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := aws.ToString(in.UploadId)
+	parts := in.MultipartUpload.Parts
+	sort.Slice(parts, func(i, j int) bool { return aws.ToInt32(parts[i].PartNumber) < aws.ToInt32(parts[j].PartNumber) })
+	var buf bytes.Buffer
+	for _, p := range parts {
+		buf.Write(s.uploads[id][aws.ToInt32(p.PartNumber)])
+	}
+	s.objects[s.uploadKeys[id]] = buf.Bytes()
+	delete(s.uploads, id)
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func newTestUploader(t *testing.T, srv *uploaderTestServer) *PackageUploader {
+	t.Helper()
+	cfg := &config.AuthConfig{InstanceDomain: srv.URL, AuthMethod: constants.AuthMethodOAuth2, ClientID: "c", ClientSecret: "s"}
+	tr, err := client.NewTransport(cfg)
+	require.NoError(t, err)
+
+	u := NewPackageUploader(tr, WithVerifyPolling(time.Millisecond, 3))
+	u.partSize = 4 // tiny parts keep the fixtures small
+	u.newS3Client = func(context.Context, *jcds.ResourceJCDSUploadCredentials) (s3MultipartAPI, error) {
+		return srv, nil
+	}
+	return u
+}
+
+func writeTestPackage(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "app.pkg")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func testPackageRequest() *RequestPackage {
+	return &RequestPackage{PackageName: "App", CategoryID: "-1", Priority: 10}
+}
+
+func TestUnit_PackageUploader_Upload_CreatesUploadsAndWritesHash(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+
+	result, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	assert.True(t, result.Uploaded)
+	assert.Equal(t, "app.pkg", result.FileName)
+	assert.Equal(t, 3, srv.partUploads)
+	assert.Equal(t, "0123456789", string(srv.objects["tenant/app.pkg"]))
+
+	sha512, err := crypto.CalculateSHA512(path)
+	require.NoError(t, err)
+	assert.Equal(t, HashTypeSHA512, result.HashType)
+	assert.Equal(t, sha512, result.HashValue)
+	rec := srv.records[result.PackageID]
+	assert.Equal(t, HashTypeSHA512, rec.HashType)
+	assert.Equal(t, sha512, rec.HashValue)
+}
+
+func TestUnit_PackageUploader_Upload_ReportsProgress(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+
+	var phases []client.ProgressPhase
+	var lastUpload client.ProgressEvent
+	ctx := client.ContextWithProgressReporter(context.Background(), client.ProgressReporterFunc(func(e client.ProgressEvent) {
+		if len(phases) == 0 || phases[len(phases)-1] != e.Phase {
+			phases = append(phases, e.Phase)
+		}
+		if e.Phase == client.ProgressPhaseUploading {
+			lastUpload = e
+		}
+	}))
+
+	_, err := u.Upload(ctx, path, testPackageRequest())
+	require.NoError(t, err)
+	assert.Equal(t, []client.ProgressPhase{
+		client.ProgressPhaseHashing,
+		client.ProgressPhaseUploading,
+		client.ProgressPhaseVerifying,
+		client.ProgressPhaseComplete,
+	}, phases)
+	assert.Equal(t, int64(10), lastUpload.BytesTransferred)
+	assert.Equal(t, int64(10), lastUpload.TotalBytes)
+	assert.Equal(t, "app.pkg", lastUpload.File)
+}
+
+func TestUnit_PackageUploader_Upload_ResumesInterruptedUpload(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+
+	srv.failAtPart = 3
+	_, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "resume")
+	assert.Equal(t, 2, srv.partUploads)
+
+	srv.failAtPart = 0
+	result, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+	assert.False(t, result.Created, "second attempt must reuse the record")
+	assert.Equal(t, 2, result.ResumedParts)
+	assert.Equal(t, 3, srv.partUploads, "only the missing part is sent")
+	assert.Equal(t, "0123456789", string(srv.objects["tenant/app.pkg"]))
+	assert.Len(t, srv.records, 1)
+}
+
+func TestUnit_PackageUploader_Upload_SkipsIdenticalFile(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+
+	_, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+	uploads := srv.partUploads
+
+	result, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	assert.False(t, result.Uploaded)
+	assert.Equal(t, uploads, srv.partUploads)
+}
+
+func TestUnit_PackageUploader_Upload_ReplacesChangedFile(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+	_, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("abcdefgh"), 0644))
+	result, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.NoError(t, err)
+	assert.True(t, result.Uploaded)
+	assert.Equal(t, "abcdefgh", string(srv.objects["tenant/app.pkg"]))
+
+	sha512, err := crypto.CalculateSHA512(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha512, srv.records[result.PackageID].HashValue)
+}
+
+func TestUnit_PackageUploader_Upload_ChecksumMismatch(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	srv.corruptMD5 = true
+	u := newTestUploader(t, srv)
+	path := writeTestPackage(t, "0123456789")
+
+	_, err := u.Upload(context.Background(), path, testPackageRequest())
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.Empty(t, srv.records["1"].HashValue, "unverified files must not be recorded")
+}
+
+func TestUnit_PackageUploader_Upload_Validation(t *testing.T) {
+	srv := newUploaderTestServer(t)
+	u := newTestUploader(t, srv)
+
+	_, err := u.Upload(context.Background(), "", testPackageRequest())
+	assert.ErrorContains(t, err, "file path is required")
+	_, err = u.Upload(context.Background(), writeTestPackage(t, "x"), nil)
+	assert.ErrorContains(t, err, "request is required")
+	_, err = u.Upload(context.Background(), writeTestPackage(t, ""), testPackageRequest())
+	assert.ErrorContains(t, err, "is empty")
+}
+
+func TestUnit_PackageUploader_Options(t *testing.T) {
+	u := NewPackageUploader(nil, WithUploadPartSize(1), WithVerifyPolling(0, 0))
+	assert.Equal(t, MinUploadPartSize, u.partSize)
+	assert.Equal(t, DefaultVerifyPollInterval, u.pollInterval)
+	assert.Equal(t, DefaultVerifyPollAttempts, u.pollAttempts)
+}
@@ -0,0 +1,127 @@
+package parent_app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the Jamf Parent app settings-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-parent-app
+	ParentApp struct {
+		client client.Client
+	}
+)
+
+func NewParentApp(client client.Client) *ParentApp {
+	return &ParentApp{client: client}
+}
+
+// GetV1 retrieves the current Jamf Parent app settings.
+// URL: GET /api/v1/parent-app
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-parent-app
+func (s *ParentApp) GetV1(ctx context.Context) (*ResourceParentApp, *resty.Response, error) {
+	var result ResourceParentApp
+
+	endpoint := constants.EndpointJamfProParentAppV1
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get parent app settings: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateV1 replaces the Jamf Parent app settings.
+// URL: PUT /api/v1/parent-app
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/put_v1-parent-app
+func (s *ParentApp) UpdateV1(ctx context.Context, request *ResourceParentApp) (*ResourceParentApp, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+
+	var result ResourceParentApp
+
+	endpoint := constants.EndpointJamfProParentAppV1
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Put(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update parent app settings: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetHistoryV1 retrieves the Jamf Parent app settings history.
+// URL: GET /api/v1/parent-app/history
+// rsqlQuery supports: filter (RSQL), sort, page, page-size (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-parent-app-history
+func (s *ParentApp) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error) {
+	endpoint := fmt.Sprintf("%s/history", constants.EndpointJamfProParentAppV1)
+
+	var result HistoryResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []HistoryObject
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get parent app history: %w", err)
+	}
+
+	result.TotalCount = len(result.Results)
+
+	return &result, resp, nil
+}
+
+// AddHistoryNoteV1 adds a note to the Jamf Parent app settings history.
+// URL: POST /api/v1/parent-app/history
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-parent-app-history
+func (s *ParentApp) AddHistoryNoteV1(ctx context.Context, request *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.Note == "" {
+		return nil, nil, fmt.Errorf("note is required")
+	}
+
+	var result AddHistoryNoteResponse
+
+	endpoint := fmt.Sprintf("%s/history", constants.EndpointJamfProParentAppV1)
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to add parent app history note: %w", err)
+	}
+
+	return &result, resp, nil
+}
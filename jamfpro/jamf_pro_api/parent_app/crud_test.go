@@ -0,0 +1,130 @@
+package parent_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/parent_app/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*ParentApp, *mocks.ParentAppMock) {
+	t.Helper()
+	mock := mocks.NewParentAppMock()
+	mock.RegisterMocks()
+	return NewParentApp(mock), mock
+}
+
+func TestUnit_ParentApp_GetV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "Europe/London", result.TimezoneID)
+	require.Equal(t, 3, result.DeviceGroupID)
+	require.True(t, result.IsEnabled)
+	require.Len(t, result.RestrictedTimes, 2)
+	require.Equal(t, TimeFrame{BeginTime: "08:30", EndTime: "15:30"}, result.RestrictedTimes[DayOfWeekMonday])
+	require.Len(t, result.SafelistedApps, 1)
+	require.Equal(t, "com.apple.mobilesafari", result.SafelistedApps[0].BundleID)
+}
+
+func TestUnit_ParentApp_GetV1_Error(t *testing.T) {
+	svc := NewParentApp(mocks.NewParentAppMock())
+	result, _, err := svc.GetV1(context.Background())
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to get parent app settings")
+}
+
+func TestUnit_ParentApp_UpdateV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	request := &ResourceParentApp{
+		TimezoneID:    "America/Chicago",
+		DeviceGroupID: 5,
+		RestrictedTimes: map[string]TimeFrame{
+			DayOfWeekMonday: {BeginTime: "09:00", EndTime: "15:00"},
+		},
+		DisassociateOnWipeAndReEnroll: true,
+	}
+	result, resp, err := svc.UpdateV1(context.Background(), request)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "America/Chicago", result.TimezoneID)
+	require.False(t, result.IsEnabled)
+	require.True(t, result.DisassociateOnWipeAndReEnroll)
+}
+
+func TestUnit_ParentApp_UpdateV1_NilRequest(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.UpdateV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+}
+
+func TestUnit_ParentApp_UpdateV1_Error(t *testing.T) {
+	svc := NewParentApp(mocks.NewParentAppMock())
+	_, _, err := svc.UpdateV1(context.Background(), &ResourceParentApp{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to update parent app settings")
+}
+
+func TestUnit_ParentApp_GetHistoryV1_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"sort": "date:desc", "filter": "username==admin"}
+	result, resp, err := svc.GetHistoryV1(context.Background(), query)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 2, result.TotalCount)
+	require.Len(t, result.Results, 2)
+	require.Equal(t, "admin", result.Results[0].Username)
+	require.NotNil(t, result.Results[0].Details)
+	require.Nil(t, result.Results[1].Details)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_ParentApp_GetHistoryV1_MergePageError(t *testing.T) {
+	mock := mocks.NewParentAppMock()
+	mock.RegisterHistoryInvalidMock()
+	svc := NewParentApp(mock)
+	result, _, err := svc.GetHistoryV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to get parent app history")
+}
+
+func TestUnit_ParentApp_AddHistoryNoteV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{Note: "Reviewed restricted times"})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, 3, result.ID)
+	require.Equal(t, "Reviewed restricted times", result.Note)
+}
+
+func TestUnit_ParentApp_AddHistoryNoteV1_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	_, resp, err := svc.AddHistoryNoteV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+
+	_, resp, err = svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{})
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "note is required")
+}
+
+func TestUnit_ParentApp_AddHistoryNoteV1_Error(t *testing.T) {
+	svc := NewParentApp(mocks.NewParentAppMock())
+	_, _, err := svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{Note: "note"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to add parent app history note")
+}
@@ -0,0 +1,12 @@
+package parent_app
+
+// Day-of-week keys accepted by ResourceParentApp.RestrictedTimes.
+const (
+	DayOfWeekMonday    = "MONDAY"
+	DayOfWeekTuesday   = "TUESDAY"
+	DayOfWeekWednesday = "WEDNESDAY"
+	DayOfWeekThursday  = "THURSDAY"
+	DayOfWeekFriday    = "FRIDAY"
+	DayOfWeekSaturday  = "SATURDAY"
+	DayOfWeekSunday    = "SUNDAY"
+)
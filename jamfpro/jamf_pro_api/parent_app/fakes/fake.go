@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the parent_app
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/parent_app"
+	"resty.dev/v3"
+)
+
+// ParentAppFake is a parent_app.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ParentAppFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func func(ctx context.Context, request *parent_app.AddHistoryNoteRequest) (*parent_app.AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1Func     func(ctx context.Context, rsqlQuery map[string]string) (*parent_app.HistoryResponse, *resty.Response, error)
+	GetV1Func            func(ctx context.Context) (*parent_app.ResourceParentApp, *resty.Response, error)
+	UpdateV1Func         func(ctx context.Context, request *parent_app.ResourceParentApp) (*parent_app.ResourceParentApp, *resty.Response, error)
+}
+
+var _ parent_app.Service = (*ParentAppFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *ParentAppFake) AddHistoryNoteV1(ctx context.Context, request *parent_app.AddHistoryNoteRequest) (*parent_app.AddHistoryNoteResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, request)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ParentAppFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, request)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *ParentAppFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*parent_app.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ParentAppFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *ParentAppFake) GetV1(ctx context.Context) (*parent_app.ResourceParentApp, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ParentAppFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *ParentAppFake) UpdateV1(ctx context.Context, request *parent_app.ResourceParentApp) (*parent_app.ResourceParentApp, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ParentAppFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
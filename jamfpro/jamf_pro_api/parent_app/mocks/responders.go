@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type ParentAppMock struct {
+	*mocks.GenericMock
+}
+
+func NewParentAppMock() *ParentAppMock {
+	return &ParentAppMock{
+		GenericMock: mocks.NewJSONMock("ParentAppMock"),
+	}
+}
+
+func (m *ParentAppMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/parent-app", 200, "validate_get.json")
+	m.Register("PUT", "/api/v1/parent-app", 200, "validate_update.json")
+	m.Register("GET", "/api/v1/parent-app/history", 200, "validate_history.json")
+	m.Register("POST", "/api/v1/parent-app/history", 201, "validate_add_history_note.json")
+}
+
+func (m *ParentAppMock) RegisterHistoryInvalidMock() {
+	m.Register("GET", "/api/v1/parent-app/history", 200, "validate_history_invalid.json")
+}
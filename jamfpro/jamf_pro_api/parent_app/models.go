@@ -0,0 +1,39 @@
+package parent_app
+
+import "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/models"
+
+// ResourceParentApp represents the Jamf Parent app settings.
+type ResourceParentApp struct {
+	TimezoneID                    string               `json:"timezoneId"`
+	RestrictedTimes               map[string]TimeFrame `json:"restrictedTimes"`
+	DeviceGroupID                 int                  `json:"deviceGroupId"`
+	IsEnabled                     bool                 `json:"isEnabled"`
+	AllowTemplates                bool                 `json:"allowTemplates"`
+	DisassociateOnWipeAndReEnroll bool                 `json:"disassociateOnWipeAndReEnroll"`
+	AllowClearPasscode            bool                 `json:"allowClearPasscode"`
+	SafelistedApps                []SafelistedApp      `json:"safelistedApps,omitempty"`
+}
+
+// TimeFrame is a restricted window for a single day, keyed by a DayOfWeek constant.
+type TimeFrame struct {
+	BeginTime string `json:"beginTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// SafelistedApp is an app that stays available while restrictions are in effect.
+type SafelistedApp struct {
+	Name     string `json:"name"`
+	BundleID string `json:"bundleId"`
+}
+
+// HistoryObject is an alias to the shared history item struct.
+type HistoryObject = models.SharedHistoryItem
+
+// HistoryResponse is an alias to the shared history response struct.
+type HistoryResponse = models.SharedHistoryResponse
+
+// AddHistoryNoteRequest is an alias to the shared history note request struct.
+type AddHistoryNoteRequest = models.SharedHistoryNoteRequest
+
+// AddHistoryNoteResponse is the history entry created for the note.
+type AddHistoryNoteResponse = models.SharedHistoryItem
@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package parent_app
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ParentApp.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ParentAppFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, request *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceParentApp, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceParentApp) (*ResourceParentApp, *resty.Response, error)
+}
+
+var _ Service = (*ParentApp)(nil)
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the patch_management
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_management"
+	"resty.dev/v3"
+)
+
+// PatchManagementFake is a patch_management.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PatchManagementFake struct {
+	fakes.Journal
+
+	AcceptDisclaimerV2Func func(ctx context.Context) (*resty.Response, error)
+}
+
+var _ patch_management.Service = (*PatchManagementFake)(nil)
+
+// AcceptDisclaimerV2 records the call and delegates to AcceptDisclaimerV2Func.
+func (f *PatchManagementFake) AcceptDisclaimerV2(ctx context.Context) (*resty.Response, error) {
+	f.Record("AcceptDisclaimerV2", ctx)
+	if f.AcceptDisclaimerV2Func == nil {
+		return nil, fakes.NotStubbed("PatchManagementFake.AcceptDisclaimerV2")
+	}
+	return f.AcceptDisclaimerV2Func(ctx)
+}
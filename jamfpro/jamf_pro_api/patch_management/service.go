@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package patch_management
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PatchManagement.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PatchManagementFake implements it for tests.
+type Service interface {
+	AcceptDisclaimerV2(ctx context.Context) (*resty.Response, error)
+}
+
+var _ Service = (*PatchManagement)(nil)
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the patch_policies
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policies"
+	"resty.dev/v3"
+)
+
+// PatchPoliciesFake is a patch_policies.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PatchPoliciesFake struct {
+	fakes.Journal
+
+	AddToDashboardV2Func      func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2Func             func(ctx context.Context, id string) (*patch_policies.ResourcePatchPolicy, *resty.Response, error)
+	GetByNameV2Func           func(ctx context.Context, name string) (*patch_policies.ResourcePatchPolicy, *resty.Response, error)
+	GetDashboardStatusV2Func  func(ctx context.Context, id string) (*patch_policies.DashboardStatusResponse, *resty.Response, error)
+	ListSummaryV2Func         func(ctx context.Context, rsqlQuery map[string]string) (*patch_policies.ListSummaryResponse, *resty.Response, error)
+	ListV2Func                func(ctx context.Context) (*patch_policies.ListResponse, *resty.Response, error)
+	RemoveFromDashboardV2Func func(ctx context.Context, id string) (*resty.Response, error)
+}
+
+var _ patch_policies.Service = (*PatchPoliciesFake)(nil)
+
+// AddToDashboardV2 records the call and delegates to AddToDashboardV2Func.
+func (f *PatchPoliciesFake) AddToDashboardV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("AddToDashboardV2", ctx, id)
+	if f.AddToDashboardV2Func == nil {
+		return nil, fakes.NotStubbed("PatchPoliciesFake.AddToDashboardV2")
+	}
+	return f.AddToDashboardV2Func(ctx, id)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *PatchPoliciesFake) GetByIDV2(ctx context.Context, id string) (*patch_policies.ResourcePatchPolicy, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPoliciesFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetByNameV2 records the call and delegates to GetByNameV2Func.
+func (f *PatchPoliciesFake) GetByNameV2(ctx context.Context, name string) (*patch_policies.ResourcePatchPolicy, *resty.Response, error) {
+	f.Record("GetByNameV2", ctx, name)
+	if f.GetByNameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPoliciesFake.GetByNameV2")
+	}
+	return f.GetByNameV2Func(ctx, name)
+}
+
+// GetDashboardStatusV2 records the call and delegates to GetDashboardStatusV2Func.
+func (f *PatchPoliciesFake) GetDashboardStatusV2(ctx context.Context, id string) (*patch_policies.DashboardStatusResponse, *resty.Response, error) {
+	f.Record("GetDashboardStatusV2", ctx, id)
+	if f.GetDashboardStatusV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPoliciesFake.GetDashboardStatusV2")
+	}
+	return f.GetDashboardStatusV2Func(ctx, id)
+}
+
+// ListSummaryV2 records the call and delegates to ListSummaryV2Func.
+func (f *PatchPoliciesFake) ListSummaryV2(ctx context.Context, rsqlQuery map[string]string) (*patch_policies.ListSummaryResponse, *resty.Response, error) {
+	f.Record("ListSummaryV2", ctx, rsqlQuery)
+	if f.ListSummaryV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPoliciesFake.ListSummaryV2")
+	}
+	return f.ListSummaryV2Func(ctx, rsqlQuery)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *PatchPoliciesFake) ListV2(ctx context.Context) (*patch_policies.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPoliciesFake.ListV2")
+	}
+	return f.ListV2Func(ctx)
+}
+
+// RemoveFromDashboardV2 records the call and delegates to RemoveFromDashboardV2Func.
+func (f *PatchPoliciesFake) RemoveFromDashboardV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("RemoveFromDashboardV2", ctx, id)
+	if f.RemoveFromDashboardV2Func == nil {
+		return nil, fakes.NotStubbed("PatchPoliciesFake.RemoveFromDashboardV2")
+	}
+	return f.RemoveFromDashboardV2Func(ctx, id)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package patch_policies
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PatchPolicies.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PatchPoliciesFake implements it for tests.
+type Service interface {
+	AddToDashboardV2(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourcePatchPolicy, *resty.Response, error)
+	GetByNameV2(ctx context.Context, name string) (*ResourcePatchPolicy, *resty.Response, error)
+	GetDashboardStatusV2(ctx context.Context, id string) (*DashboardStatusResponse, *resty.Response, error)
+	ListSummaryV2(ctx context.Context, rsqlQuery map[string]string) (*ListSummaryResponse, *resty.Response, error)
+	ListV2(ctx context.Context) (*ListResponse, *resty.Response, error)
+	RemoveFromDashboardV2(ctx context.Context, id string) (*resty.Response, error)
+}
+
+var _ Service = (*PatchPolicies)(nil)
@@ -0,0 +1,181 @@
+package patch_policy_logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the patch policy logs-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-patch-policies-id-logs
+	PatchPolicyLogs struct {
+		client client.Client
+	}
+)
+
+func NewPatchPolicyLogs(client client.Client) *PatchPolicyLogs {
+	return &PatchPolicyLogs{client: client}
+}
+
+func logsEndpoint(patchPolicyID string) string {
+	return fmt.Sprintf("%s/%s/logs", constants.EndpointJamfProPatchPoliciesV2, patchPolicyID)
+}
+
+// ListV2 returns the per-device logs for a patch policy.
+// URL: GET /api/v2/patch-policies/{id}/logs
+// rsqlQuery supports: filter (RSQL), sort, page, page-size (all optional).
+// Filterable fields: deviceId, deviceName, statusCode, statusDate, attemptNumber, ignoredForPatchPolicyId.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-patch-policies-id-logs
+func (s *PatchPolicyLogs) ListV2(ctx context.Context, patchPolicyID string, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, nil, fmt.Errorf("patch policy ID is required")
+	}
+
+	endpoint := logsEndpoint(patchPolicyID)
+
+	var result ListResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []ResourcePatchPolicyLog
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to list patch policy logs for policy %s: %w", patchPolicyID, err)
+	}
+
+	result.TotalCount = len(result.Results)
+	return &result, resp, nil
+}
+
+// GetByDeviceIDV2 returns the log of a patch policy for a single device.
+// URL: GET /api/v2/patch-policies/{id}/logs/{deviceId}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-patch-policies-id-logs-deviceid
+func (s *PatchPolicyLogs) GetByDeviceIDV2(ctx context.Context, patchPolicyID, deviceID string) (*ResourcePatchPolicyLog, *resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, nil, fmt.Errorf("patch policy ID is required")
+	}
+	if deviceID == "" {
+		return nil, nil, fmt.Errorf("device ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", logsEndpoint(patchPolicyID), deviceID)
+
+	var result ResourcePatchPolicyLog
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get patch policy %s log for device %s: %w", patchPolicyID, deviceID, err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetDetailsByDeviceIDV2 returns every install attempt, with its actions, for a device.
+// URL: GET /api/v2/patch-policies/{id}/logs/{deviceId}/details
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-patch-policies-id-logs-deviceid-details
+func (s *PatchPolicyLogs) GetDetailsByDeviceIDV2(ctx context.Context, patchPolicyID, deviceID string) ([]ResourceLogDetail, *resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, nil, fmt.Errorf("patch policy ID is required")
+	}
+	if deviceID == "" {
+		return nil, nil, fmt.Errorf("device ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/details", logsEndpoint(patchPolicyID), deviceID)
+
+	var result []ResourceLogDetail
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get patch policy %s log details for device %s: %w", patchPolicyID, deviceID, err)
+	}
+
+	return result, resp, nil
+}
+
+// GetEligibleRetryCountV2 returns how many of a patch policy's logs can be retried.
+// URL: GET /api/v2/patch-policies/{id}/logs/eligible-retry-count
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v2-patch-policies-id-logs-eligible-retry-count
+func (s *PatchPolicyLogs) GetEligibleRetryCountV2(ctx context.Context, patchPolicyID string) (*ResponseEligibleRetryCount, *resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, nil, fmt.Errorf("patch policy ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/eligible-retry-count", logsEndpoint(patchPolicyID))
+
+	var result ResponseEligibleRetryCount
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get eligible retry count for patch policy %s: %w", patchPolicyID, err)
+	}
+
+	return &result, resp, nil
+}
+
+// RetryV2 retries the patch policy on the given devices.
+// URL: POST /api/v2/patch-policies/{id}/logs/retry
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v2-patch-policies-id-logs-retry
+func (s *PatchPolicyLogs) RetryV2(ctx context.Context, patchPolicyID string, request *RequestRetry) (*resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, fmt.Errorf("patch policy ID is required")
+	}
+	if request == nil || len(request.DeviceIDs) == 0 {
+		return nil, fmt.Errorf("at least one device ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/retry", logsEndpoint(patchPolicyID))
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		Post(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to retry patch policy %s: %w", patchPolicyID, err)
+	}
+
+	return resp, nil
+}
+
+// RetryAllV2 retries the patch policy on every eligible device.
+// URL: POST /api/v2/patch-policies/{id}/logs/retry-all
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v2-patch-policies-id-logs-retry-all
+func (s *PatchPolicyLogs) RetryAllV2(ctx context.Context, patchPolicyID string) (*resty.Response, error) {
+	if patchPolicyID == "" {
+		return nil, fmt.Errorf("patch policy ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/retry-all", logsEndpoint(patchPolicyID))
+
+	resp, err := s.client.NewRequest(ctx).
+		Post(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to retry all devices for patch policy %s: %w", patchPolicyID, err)
+	}
+
+	return resp, nil
+}
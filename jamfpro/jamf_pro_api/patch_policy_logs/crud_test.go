@@ -0,0 +1,126 @@
+package patch_policy_logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policy_logs/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*PatchPolicyLogs, *mocks.PatchPolicyLogsMock) {
+	t.Helper()
+	mock := mocks.NewPatchPolicyLogsMock()
+	mock.RegisterMocks()
+	return NewPatchPolicyLogs(mock), mock
+}
+
+func TestUnit_PatchPolicyLogs_ListV2_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"filter": "statusCode!=1", "sort": "deviceName:asc"}
+	result, resp, err := svc.ListV2(context.Background(), "1", query)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 3, result.TotalCount)
+	require.Equal(t, StatusCompleted, result.Results[0].StatusEnum)
+	require.Equal(t, StatusFailed, result.Results[1].StatusEnum)
+	require.Equal(t, 2, result.Results[1].AttemptNumber)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_PatchPolicyLogs_ListV2_Errors(t *testing.T) {
+	svc, mock := setupMockService(t)
+
+	_, resp, err := svc.ListV2(context.Background(), "", nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "patch policy ID is required")
+
+	mock.RegisterNotFoundErrorMock()
+	_, resp, err = svc.ListV2(context.Background(), "999", nil)
+	require.Error(t, err)
+	require.Equal(t, 404, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to list patch policy logs for policy 999")
+
+	mock.RegisterListInvalidMock()
+	_, _, err = svc.ListV2(context.Background(), "1", nil)
+	require.Error(t, err)
+}
+
+func TestUnit_PatchPolicyLogs_GetByDeviceIDV2(t *testing.T) {
+	svc, mock := setupMockService(t)
+
+	result, resp, err := svc.GetByDeviceIDV2(context.Background(), "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "Lab iMac 04", result.DeviceName)
+	require.Equal(t, StatusFailed, result.StatusEnum)
+
+	mock.RegisterNotFoundErrorMock()
+	_, resp, err = svc.GetByDeviceIDV2(context.Background(), "1", "999")
+	require.Error(t, err)
+	require.Equal(t, 404, resp.StatusCode())
+
+	_, _, err = svc.GetByDeviceIDV2(context.Background(), "1", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "device ID is required")
+}
+
+func TestUnit_PatchPolicyLogs_GetDetailsByDeviceIDV2(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	result, resp, err := svc.GetDetailsByDeviceIDV2(context.Background(), "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result, 2)
+	require.Len(t, result[0].Actions, 2)
+	require.Equal(t, "Installation failed", result[0].Actions[1].Action)
+	require.Equal(t, 2, result[1].AttemptNumber)
+
+	_, _, err = svc.GetDetailsByDeviceIDV2(context.Background(), "", "2")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "patch policy ID is required")
+}
+
+func TestUnit_PatchPolicyLogs_GetEligibleRetryCountV2(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	result, resp, err := svc.GetEligibleRetryCountV2(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 5, result.Count)
+
+	_, _, err = svc.GetEligibleRetryCountV2(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestUnit_PatchPolicyLogs_RetryV2(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	resp, err := svc.RetryV2(context.Background(), "1", &RequestRetry{DeviceIDs: []string{"2", "3"}})
+	require.NoError(t, err)
+	require.Equal(t, 204, resp.StatusCode())
+
+	_, err = svc.RetryV2(context.Background(), "1", &RequestRetry{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at least one device ID is required")
+
+	_, err = svc.RetryV2(context.Background(), "1", nil)
+	require.Error(t, err)
+}
+
+func TestUnit_PatchPolicyLogs_RetryAllV2(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	resp, err := svc.RetryAllV2(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 202, resp.StatusCode())
+
+	_, err = svc.RetryAllV2(context.Background(), "")
+	require.Error(t, err)
+
+	empty := NewPatchPolicyLogs(mocks.NewPatchPolicyLogsMock())
+	_, err = empty.RetryAllV2(context.Background(), "1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to retry all devices for patch policy 1")
+}
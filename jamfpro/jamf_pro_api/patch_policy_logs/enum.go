@@ -0,0 +1,9 @@
+package patch_policy_logs
+
+// Status* constants are the values of ResourcePatchPolicyLog.StatusEnum.
+const (
+	StatusUnknown   = "UNKNOWN"
+	StatusPending   = "PENDING"
+	StatusCompleted = "COMPLETED"
+	StatusFailed    = "FAILED"
+)
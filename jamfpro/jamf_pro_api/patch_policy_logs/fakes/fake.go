@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the patch_policy_logs
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policy_logs"
+	"resty.dev/v3"
+)
+
+// PatchPolicyLogsFake is a patch_policy_logs.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PatchPolicyLogsFake struct {
+	fakes.Journal
+
+	GetByDeviceIDV2Func         func(ctx context.Context, patchPolicyID string, deviceID string) (*patch_policy_logs.ResourcePatchPolicyLog, *resty.Response, error)
+	GetDetailsByDeviceIDV2Func  func(ctx context.Context, patchPolicyID string, deviceID string) ([]patch_policy_logs.ResourceLogDetail, *resty.Response, error)
+	GetEligibleRetryCountV2Func func(ctx context.Context, patchPolicyID string) (*patch_policy_logs.ResponseEligibleRetryCount, *resty.Response, error)
+	ListV2Func                  func(ctx context.Context, patchPolicyID string, rsqlQuery map[string]string) (*patch_policy_logs.ListResponse, *resty.Response, error)
+	RetryAllV2Func              func(ctx context.Context, patchPolicyID string) (*resty.Response, error)
+	RetryV2Func                 func(ctx context.Context, patchPolicyID string, request *patch_policy_logs.RequestRetry) (*resty.Response, error)
+}
+
+var _ patch_policy_logs.Service = (*PatchPolicyLogsFake)(nil)
+
+// GetByDeviceIDV2 records the call and delegates to GetByDeviceIDV2Func.
+func (f *PatchPolicyLogsFake) GetByDeviceIDV2(ctx context.Context, patchPolicyID string, deviceID string) (*patch_policy_logs.ResourcePatchPolicyLog, *resty.Response, error) {
+	f.Record("GetByDeviceIDV2", ctx, patchPolicyID, deviceID)
+	if f.GetByDeviceIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPolicyLogsFake.GetByDeviceIDV2")
+	}
+	return f.GetByDeviceIDV2Func(ctx, patchPolicyID, deviceID)
+}
+
+// GetDetailsByDeviceIDV2 records the call and delegates to GetDetailsByDeviceIDV2Func.
+func (f *PatchPolicyLogsFake) GetDetailsByDeviceIDV2(ctx context.Context, patchPolicyID string, deviceID string) ([]patch_policy_logs.ResourceLogDetail, *resty.Response, error) {
+	f.Record("GetDetailsByDeviceIDV2", ctx, patchPolicyID, deviceID)
+	if f.GetDetailsByDeviceIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPolicyLogsFake.GetDetailsByDeviceIDV2")
+	}
+	return f.GetDetailsByDeviceIDV2Func(ctx, patchPolicyID, deviceID)
+}
+
+// GetEligibleRetryCountV2 records the call and delegates to GetEligibleRetryCountV2Func.
+func (f *PatchPolicyLogsFake) GetEligibleRetryCountV2(ctx context.Context, patchPolicyID string) (*patch_policy_logs.ResponseEligibleRetryCount, *resty.Response, error) {
+	f.Record("GetEligibleRetryCountV2", ctx, patchPolicyID)
+	if f.GetEligibleRetryCountV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPolicyLogsFake.GetEligibleRetryCountV2")
+	}
+	return f.GetEligibleRetryCountV2Func(ctx, patchPolicyID)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *PatchPolicyLogsFake) ListV2(ctx context.Context, patchPolicyID string, rsqlQuery map[string]string) (*patch_policy_logs.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx, patchPolicyID, rsqlQuery)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchPolicyLogsFake.ListV2")
+	}
+	return f.ListV2Func(ctx, patchPolicyID, rsqlQuery)
+}
+
+// RetryAllV2 records the call and delegates to RetryAllV2Func.
+func (f *PatchPolicyLogsFake) RetryAllV2(ctx context.Context, patchPolicyID string) (*resty.Response, error) {
+	f.Record("RetryAllV2", ctx, patchPolicyID)
+	if f.RetryAllV2Func == nil {
+		return nil, fakes.NotStubbed("PatchPolicyLogsFake.RetryAllV2")
+	}
+	return f.RetryAllV2Func(ctx, patchPolicyID)
+}
+
+// RetryV2 records the call and delegates to RetryV2Func.
+func (f *PatchPolicyLogsFake) RetryV2(ctx context.Context, patchPolicyID string, request *patch_policy_logs.RequestRetry) (*resty.Response, error) {
+	f.Record("RetryV2", ctx, patchPolicyID, request)
+	if f.RetryV2Func == nil {
+		return nil, fakes.NotStubbed("PatchPolicyLogsFake.RetryV2")
+	}
+	return f.RetryV2Func(ctx, patchPolicyID, request)
+}
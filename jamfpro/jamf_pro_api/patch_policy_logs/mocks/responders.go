@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type PatchPolicyLogsMock struct {
+	*mocks.GenericMock
+}
+
+func NewPatchPolicyLogsMock() *PatchPolicyLogsMock {
+	return &PatchPolicyLogsMock{
+		GenericMock: mocks.NewJSONMock("PatchPolicyLogsMock"),
+	}
+}
+
+func (m *PatchPolicyLogsMock) RegisterMocks() {
+	m.Register("GET", "/api/v2/patch-policies/1/logs", 200, "validate_list.json")
+	m.Register("GET", "/api/v2/patch-policies/1/logs/2", 200, "validate_get.json")
+	m.Register("GET", "/api/v2/patch-policies/1/logs/2/details", 200, "validate_details.json")
+	m.Register("GET", "/api/v2/patch-policies/1/logs/eligible-retry-count", 200, "validate_eligible_retry_count.json")
+	m.Register("POST", "/api/v2/patch-policies/1/logs/retry", 204, "")
+	m.Register("POST", "/api/v2/patch-policies/1/logs/retry-all", 202, "")
+}
+
+func (m *PatchPolicyLogsMock) RegisterListInvalidMock() {
+	m.Register("GET", "/api/v2/patch-policies/1/logs", 200, "validate_list_invalid.json")
+}
+
+func (m *PatchPolicyLogsMock) RegisterNotFoundErrorMock() {
+	m.RegisterError("GET", "/api/v2/patch-policies/999/logs", 404, "error_not_found.json", "")
+	m.RegisterError("GET", "/api/v2/patch-policies/1/logs/999", 404, "error_not_found.json", "")
+}
@@ -0,0 +1,44 @@
+package patch_policy_logs
+
+// ResourcePatchPolicyLog is the latest install status of a patch policy on a single device.
+type ResourcePatchPolicyLog struct {
+	PatchPolicyID           string `json:"patchPolicyId"`
+	DeviceName              string `json:"deviceName"`
+	DeviceID                string `json:"deviceId"`
+	StatusCode              int    `json:"statusCode"`
+	StatusDate              string `json:"statusDate"`
+	StatusEnum              string `json:"statusEnum"`
+	AttemptNumber           int    `json:"attemptNumber"`
+	IgnoredForPatchPolicyID string `json:"ignoredForPatchPolicyId"`
+}
+
+// ListResponse is the paginated response for listing patch policy logs.
+type ListResponse struct {
+	TotalCount int                      `json:"totalCount"`
+	Results    []ResourcePatchPolicyLog `json:"results"`
+}
+
+// ResourceLogDetail is a single install attempt on a device.
+type ResourceLogDetail struct {
+	ID            string              `json:"id"`
+	AttemptNumber int                 `json:"attemptNumber"`
+	DeviceID      string              `json:"deviceId"`
+	Actions       []ResourceLogAction `json:"actions"`
+}
+
+// ResourceLogAction is one step recorded during an install attempt.
+type ResourceLogAction struct {
+	ID          string `json:"id"`
+	ActionOrder int    `json:"actionOrder"`
+	Action      string `json:"action"`
+}
+
+// ResponseEligibleRetryCount is the number of logs that can be retried.
+type ResponseEligibleRetryCount struct {
+	Count int `json:"count"`
+}
+
+// RequestRetry lists the devices to retry a patch policy on.
+type RequestRetry struct {
+	DeviceIDs []string `json:"deviceIds"`
+}
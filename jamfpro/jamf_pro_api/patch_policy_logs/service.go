@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package patch_policy_logs
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PatchPolicyLogs.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PatchPolicyLogsFake implements it for tests.
+type Service interface {
+	GetByDeviceIDV2(ctx context.Context, patchPolicyID string, deviceID string) (*ResourcePatchPolicyLog, *resty.Response, error)
+	GetDetailsByDeviceIDV2(ctx context.Context, patchPolicyID string, deviceID string) ([]ResourceLogDetail, *resty.Response, error)
+	GetEligibleRetryCountV2(ctx context.Context, patchPolicyID string) (*ResponseEligibleRetryCount, *resty.Response, error)
+	ListV2(ctx context.Context, patchPolicyID string, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	RetryAllV2(ctx context.Context, patchPolicyID string) (*resty.Response, error)
+	RetryV2(ctx context.Context, patchPolicyID string, request *RequestRetry) (*resty.Response, error)
+}
+
+var _ Service = (*PatchPolicyLogs)(nil)
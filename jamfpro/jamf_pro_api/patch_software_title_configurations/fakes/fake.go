@@ -0,0 +1,423 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the patch_software_title_configurations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_software_title_configurations"
+	"resty.dev/v3"
+)
+
+// PatchSoftwareTitleConfigurationsFake is a patch_software_title_configurations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PatchSoftwareTitleConfigurationsFake struct {
+	fakes.Journal
+
+	AddHistoryNoteByIDV2Func         func(ctx context.Context, id string, request *patch_software_title_configurations.RequestAddHistoryNote) (*patch_software_title_configurations.ResponseAddHistoryNote, *resty.Response, error)
+	AddHistoryNoteByIDV3Func         func(ctx context.Context, id string, request *patch_software_title_configurations.RequestAddHistoryNote) (*patch_software_title_configurations.ResponseAddHistoryNote, *resty.Response, error)
+	AddToDashboardByIDV2Func         func(ctx context.Context, id string) (*resty.Response, error)
+	AddToDashboardByIDV3Func         func(ctx context.Context, id string) (*resty.Response, error)
+	CreateV2Func                     func(ctx context.Context, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.CreateResponse, *resty.Response, error)
+	CreateV3Func                     func(ctx context.Context, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.CreateResponse, *resty.Response, error)
+	DeleteByIDV2Func                 func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV3Func                 func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV2Func               func(ctx context.Context, name string) (*resty.Response, error)
+	DeleteByNameV3Func               func(ctx context.Context, name string) (*resty.Response, error)
+	ExportReportByIDV2Func           func(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error)
+	ExportReportByIDV3Func           func(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error)
+	GetByIDV2Func                    func(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByIDV3Func                    func(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByNameV2Func                  func(ctx context.Context, name string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByNameV3Func                  func(ctx context.Context, name string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetDashboardStatusByIDV2Func     func(ctx context.Context, id string) (*patch_software_title_configurations.ResourceDashboardStatus, *resty.Response, error)
+	GetDashboardStatusByIDV3Func     func(ctx context.Context, id string) (*patch_software_title_configurations.ResourceDashboardStatus, *resty.Response, error)
+	GetDefinitionsByIDV2Func         func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DefinitionsResponse, *resty.Response, error)
+	GetDefinitionsByIDV3Func         func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DefinitionsResponse, *resty.Response, error)
+	GetDependenciesByIDV2Func        func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DependenciesResponse, *resty.Response, error)
+	GetDependenciesByIDV3Func        func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DependenciesResponse, *resty.Response, error)
+	GetExtensionAttributesByIDV2Func func(ctx context.Context, id string) ([]patch_software_title_configurations.ResourceExtensionAttribute, *resty.Response, error)
+	GetExtensionAttributesByIDV3Func func(ctx context.Context, id string) ([]patch_software_title_configurations.ResourceExtensionAttribute, *resty.Response, error)
+	GetHistoryByIDV2Func             func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.HistoryResponse, *resty.Response, error)
+	GetHistoryByIDV3Func             func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.HistoryResponse, *resty.Response, error)
+	GetPatchReportByIDV2Func         func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.PatchReportResponse, *resty.Response, error)
+	GetPatchReportByIDV3Func         func(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.PatchReportResponseV3, *resty.Response, error)
+	GetPatchSummaryByIDV2Func        func(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSummary, *resty.Response, error)
+	GetPatchSummaryByIDV3Func        func(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSummary, *resty.Response, error)
+	GetPatchVersionsByIDV2Func       func(ctx context.Context, id string) ([]patch_software_title_configurations.ResourcePatchVersion, *resty.Response, error)
+	GetPatchVersionsByIDV3Func       func(ctx context.Context, id string) ([]patch_software_title_configurations.ResourcePatchVersion, *resty.Response, error)
+	ListV2Func                       func(ctx context.Context) (*patch_software_title_configurations.ListResponse, *resty.Response, error)
+	ListV3Func                       func(ctx context.Context) (*patch_software_title_configurations.ListResponse, *resty.Response, error)
+	RemoveFromDashboardByIDV2Func    func(ctx context.Context, id string) (*resty.Response, error)
+	RemoveFromDashboardByIDV3Func    func(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByIDV2Func                 func(ctx context.Context, id string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByIDV3Func                 func(ctx context.Context, id string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByNameV2Func               func(ctx context.Context, name string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByNameV3Func               func(ctx context.Context, name string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+}
+
+var _ patch_software_title_configurations.Service = (*PatchSoftwareTitleConfigurationsFake)(nil)
+
+// AddHistoryNoteByIDV2 records the call and delegates to AddHistoryNoteByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) AddHistoryNoteByIDV2(ctx context.Context, id string, request *patch_software_title_configurations.RequestAddHistoryNote) (*patch_software_title_configurations.ResponseAddHistoryNote, *resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV2", ctx, id, request)
+	if f.AddHistoryNoteByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.AddHistoryNoteByIDV2")
+	}
+	return f.AddHistoryNoteByIDV2Func(ctx, id, request)
+}
+
+// AddHistoryNoteByIDV3 records the call and delegates to AddHistoryNoteByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) AddHistoryNoteByIDV3(ctx context.Context, id string, request *patch_software_title_configurations.RequestAddHistoryNote) (*patch_software_title_configurations.ResponseAddHistoryNote, *resty.Response, error) {
+	f.Record("AddHistoryNoteByIDV3", ctx, id, request)
+	if f.AddHistoryNoteByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.AddHistoryNoteByIDV3")
+	}
+	return f.AddHistoryNoteByIDV3Func(ctx, id, request)
+}
+
+// AddToDashboardByIDV2 records the call and delegates to AddToDashboardByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) AddToDashboardByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("AddToDashboardByIDV2", ctx, id)
+	if f.AddToDashboardByIDV2Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.AddToDashboardByIDV2")
+	}
+	return f.AddToDashboardByIDV2Func(ctx, id)
+}
+
+// AddToDashboardByIDV3 records the call and delegates to AddToDashboardByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) AddToDashboardByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("AddToDashboardByIDV3", ctx, id)
+	if f.AddToDashboardByIDV3Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.AddToDashboardByIDV3")
+	}
+	return f.AddToDashboardByIDV3Func(ctx, id)
+}
+
+// CreateV2 records the call and delegates to CreateV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) CreateV2(ctx context.Context, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV2", ctx, config)
+	if f.CreateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.CreateV2")
+	}
+	return f.CreateV2Func(ctx, config)
+}
+
+// CreateV3 records the call and delegates to CreateV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) CreateV3(ctx context.Context, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV3", ctx, config)
+	if f.CreateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.CreateV3")
+	}
+	return f.CreateV3Func(ctx, config)
+}
+
+// DeleteByIDV2 records the call and delegates to DeleteByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV2", ctx, id)
+	if f.DeleteByIDV2Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.DeleteByIDV2")
+	}
+	return f.DeleteByIDV2Func(ctx, id)
+}
+
+// DeleteByIDV3 records the call and delegates to DeleteByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV3", ctx, id)
+	if f.DeleteByIDV3Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.DeleteByIDV3")
+	}
+	return f.DeleteByIDV3Func(ctx, id)
+}
+
+// DeleteByNameV2 records the call and delegates to DeleteByNameV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) DeleteByNameV2(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV2", ctx, name)
+	if f.DeleteByNameV2Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.DeleteByNameV2")
+	}
+	return f.DeleteByNameV2Func(ctx, name)
+}
+
+// DeleteByNameV3 records the call and delegates to DeleteByNameV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV3", ctx, name)
+	if f.DeleteByNameV3Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.DeleteByNameV3")
+	}
+	return f.DeleteByNameV3Func(ctx, name)
+}
+
+// ExportReportByIDV2 records the call and delegates to ExportReportByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) ExportReportByIDV2(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error) {
+	f.Record("ExportReportByIDV2", ctx, id, query)
+	if f.ExportReportByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.ExportReportByIDV2")
+	}
+	return f.ExportReportByIDV2Func(ctx, id, query)
+}
+
+// ExportReportByIDV3 records the call and delegates to ExportReportByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) ExportReportByIDV3(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error) {
+	f.Record("ExportReportByIDV3", ctx, id, query)
+	if f.ExportReportByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.ExportReportByIDV3")
+	}
+	return f.ExportReportByIDV3Func(ctx, id, query)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetByIDV2(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetByIDV3 records the call and delegates to GetByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetByIDV3(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("GetByIDV3", ctx, id)
+	if f.GetByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetByIDV3")
+	}
+	return f.GetByIDV3Func(ctx, id)
+}
+
+// GetByNameV2 records the call and delegates to GetByNameV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetByNameV2(ctx context.Context, name string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("GetByNameV2", ctx, name)
+	if f.GetByNameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetByNameV2")
+	}
+	return f.GetByNameV2Func(ctx, name)
+}
+
+// GetByNameV3 records the call and delegates to GetByNameV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetByNameV3(ctx context.Context, name string) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("GetByNameV3", ctx, name)
+	if f.GetByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetByNameV3")
+	}
+	return f.GetByNameV3Func(ctx, name)
+}
+
+// GetDashboardStatusByIDV2 records the call and delegates to GetDashboardStatusByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDashboardStatusByIDV2(ctx context.Context, id string) (*patch_software_title_configurations.ResourceDashboardStatus, *resty.Response, error) {
+	f.Record("GetDashboardStatusByIDV2", ctx, id)
+	if f.GetDashboardStatusByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDashboardStatusByIDV2")
+	}
+	return f.GetDashboardStatusByIDV2Func(ctx, id)
+}
+
+// GetDashboardStatusByIDV3 records the call and delegates to GetDashboardStatusByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDashboardStatusByIDV3(ctx context.Context, id string) (*patch_software_title_configurations.ResourceDashboardStatus, *resty.Response, error) {
+	f.Record("GetDashboardStatusByIDV3", ctx, id)
+	if f.GetDashboardStatusByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDashboardStatusByIDV3")
+	}
+	return f.GetDashboardStatusByIDV3Func(ctx, id)
+}
+
+// GetDefinitionsByIDV2 records the call and delegates to GetDefinitionsByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDefinitionsByIDV2(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DefinitionsResponse, *resty.Response, error) {
+	f.Record("GetDefinitionsByIDV2", ctx, id, query)
+	if f.GetDefinitionsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDefinitionsByIDV2")
+	}
+	return f.GetDefinitionsByIDV2Func(ctx, id, query)
+}
+
+// GetDefinitionsByIDV3 records the call and delegates to GetDefinitionsByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDefinitionsByIDV3(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DefinitionsResponse, *resty.Response, error) {
+	f.Record("GetDefinitionsByIDV3", ctx, id, query)
+	if f.GetDefinitionsByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDefinitionsByIDV3")
+	}
+	return f.GetDefinitionsByIDV3Func(ctx, id, query)
+}
+
+// GetDependenciesByIDV2 records the call and delegates to GetDependenciesByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDependenciesByIDV2(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DependenciesResponse, *resty.Response, error) {
+	f.Record("GetDependenciesByIDV2", ctx, id, query)
+	if f.GetDependenciesByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDependenciesByIDV2")
+	}
+	return f.GetDependenciesByIDV2Func(ctx, id, query)
+}
+
+// GetDependenciesByIDV3 records the call and delegates to GetDependenciesByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetDependenciesByIDV3(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.DependenciesResponse, *resty.Response, error) {
+	f.Record("GetDependenciesByIDV3", ctx, id, query)
+	if f.GetDependenciesByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetDependenciesByIDV3")
+	}
+	return f.GetDependenciesByIDV3Func(ctx, id, query)
+}
+
+// GetExtensionAttributesByIDV2 records the call and delegates to GetExtensionAttributesByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetExtensionAttributesByIDV2(ctx context.Context, id string) ([]patch_software_title_configurations.ResourceExtensionAttribute, *resty.Response, error) {
+	f.Record("GetExtensionAttributesByIDV2", ctx, id)
+	if f.GetExtensionAttributesByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetExtensionAttributesByIDV2")
+	}
+	return f.GetExtensionAttributesByIDV2Func(ctx, id)
+}
+
+// GetExtensionAttributesByIDV3 records the call and delegates to GetExtensionAttributesByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetExtensionAttributesByIDV3(ctx context.Context, id string) ([]patch_software_title_configurations.ResourceExtensionAttribute, *resty.Response, error) {
+	f.Record("GetExtensionAttributesByIDV3", ctx, id)
+	if f.GetExtensionAttributesByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetExtensionAttributesByIDV3")
+	}
+	return f.GetExtensionAttributesByIDV3Func(ctx, id)
+}
+
+// GetHistoryByIDV2 records the call and delegates to GetHistoryByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetHistoryByIDV2(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV2", ctx, id, query)
+	if f.GetHistoryByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetHistoryByIDV2")
+	}
+	return f.GetHistoryByIDV2Func(ctx, id, query)
+}
+
+// GetHistoryByIDV3 records the call and delegates to GetHistoryByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetHistoryByIDV3(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryByIDV3", ctx, id, query)
+	if f.GetHistoryByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetHistoryByIDV3")
+	}
+	return f.GetHistoryByIDV3Func(ctx, id, query)
+}
+
+// GetPatchReportByIDV2 records the call and delegates to GetPatchReportByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchReportByIDV2(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.PatchReportResponse, *resty.Response, error) {
+	f.Record("GetPatchReportByIDV2", ctx, id, query)
+	if f.GetPatchReportByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchReportByIDV2")
+	}
+	return f.GetPatchReportByIDV2Func(ctx, id, query)
+}
+
+// GetPatchReportByIDV3 records the call and delegates to GetPatchReportByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchReportByIDV3(ctx context.Context, id string, query map[string]string) (*patch_software_title_configurations.PatchReportResponseV3, *resty.Response, error) {
+	f.Record("GetPatchReportByIDV3", ctx, id, query)
+	if f.GetPatchReportByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchReportByIDV3")
+	}
+	return f.GetPatchReportByIDV3Func(ctx, id, query)
+}
+
+// GetPatchSummaryByIDV2 records the call and delegates to GetPatchSummaryByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchSummaryByIDV2(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSummary, *resty.Response, error) {
+	f.Record("GetPatchSummaryByIDV2", ctx, id)
+	if f.GetPatchSummaryByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchSummaryByIDV2")
+	}
+	return f.GetPatchSummaryByIDV2Func(ctx, id)
+}
+
+// GetPatchSummaryByIDV3 records the call and delegates to GetPatchSummaryByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchSummaryByIDV3(ctx context.Context, id string) (*patch_software_title_configurations.ResourcePatchSummary, *resty.Response, error) {
+	f.Record("GetPatchSummaryByIDV3", ctx, id)
+	if f.GetPatchSummaryByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchSummaryByIDV3")
+	}
+	return f.GetPatchSummaryByIDV3Func(ctx, id)
+}
+
+// GetPatchVersionsByIDV2 records the call and delegates to GetPatchVersionsByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchVersionsByIDV2(ctx context.Context, id string) ([]patch_software_title_configurations.ResourcePatchVersion, *resty.Response, error) {
+	f.Record("GetPatchVersionsByIDV2", ctx, id)
+	if f.GetPatchVersionsByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchVersionsByIDV2")
+	}
+	return f.GetPatchVersionsByIDV2Func(ctx, id)
+}
+
+// GetPatchVersionsByIDV3 records the call and delegates to GetPatchVersionsByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) GetPatchVersionsByIDV3(ctx context.Context, id string) ([]patch_software_title_configurations.ResourcePatchVersion, *resty.Response, error) {
+	f.Record("GetPatchVersionsByIDV3", ctx, id)
+	if f.GetPatchVersionsByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.GetPatchVersionsByIDV3")
+	}
+	return f.GetPatchVersionsByIDV3Func(ctx, id)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) ListV2(ctx context.Context) (*patch_software_title_configurations.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.ListV2")
+	}
+	return f.ListV2Func(ctx)
+}
+
+// ListV3 records the call and delegates to ListV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) ListV3(ctx context.Context) (*patch_software_title_configurations.ListResponse, *resty.Response, error) {
+	f.Record("ListV3", ctx)
+	if f.ListV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.ListV3")
+	}
+	return f.ListV3Func(ctx)
+}
+
+// RemoveFromDashboardByIDV2 records the call and delegates to RemoveFromDashboardByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) RemoveFromDashboardByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("RemoveFromDashboardByIDV2", ctx, id)
+	if f.RemoveFromDashboardByIDV2Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.RemoveFromDashboardByIDV2")
+	}
+	return f.RemoveFromDashboardByIDV2Func(ctx, id)
+}
+
+// RemoveFromDashboardByIDV3 records the call and delegates to RemoveFromDashboardByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) RemoveFromDashboardByIDV3(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("RemoveFromDashboardByIDV3", ctx, id)
+	if f.RemoveFromDashboardByIDV3Func == nil {
+		return nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.RemoveFromDashboardByIDV3")
+	}
+	return f.RemoveFromDashboardByIDV3Func(ctx, id)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) UpdateByIDV2(ctx context.Context, id string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, config)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, config)
+}
+
+// UpdateByIDV3 records the call and delegates to UpdateByIDV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) UpdateByIDV3(ctx context.Context, id string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("UpdateByIDV3", ctx, id, config)
+	if f.UpdateByIDV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.UpdateByIDV3")
+	}
+	return f.UpdateByIDV3Func(ctx, id, config)
+}
+
+// UpdateByNameV2 records the call and delegates to UpdateByNameV2Func.
+func (f *PatchSoftwareTitleConfigurationsFake) UpdateByNameV2(ctx context.Context, name string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("UpdateByNameV2", ctx, name, config)
+	if f.UpdateByNameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.UpdateByNameV2")
+	}
+	return f.UpdateByNameV2Func(ctx, name, config)
+}
+
+// UpdateByNameV3 records the call and delegates to UpdateByNameV3Func.
+func (f *PatchSoftwareTitleConfigurationsFake) UpdateByNameV3(ctx context.Context, name string, config *patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration) (*patch_software_title_configurations.ResourcePatchSoftwareTitleConfiguration, *resty.Response, error) {
+	f.Record("UpdateByNameV3", ctx, name, config)
+	if f.UpdateByNameV3Func == nil {
+		return nil, nil, fakes.NotStubbed("PatchSoftwareTitleConfigurationsFake.UpdateByNameV3")
+	}
+	return f.UpdateByNameV3Func(ctx, name, config)
+}
@@ -0,0 +1,57 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package patch_software_title_configurations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PatchSoftwareTitleConfigurations.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PatchSoftwareTitleConfigurationsFake implements it for tests.
+type Service interface {
+	AddHistoryNoteByIDV2(ctx context.Context, id string, request *RequestAddHistoryNote) (*ResponseAddHistoryNote, *resty.Response, error)
+	AddHistoryNoteByIDV3(ctx context.Context, id string, request *RequestAddHistoryNote) (*ResponseAddHistoryNote, *resty.Response, error)
+	AddToDashboardByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	AddToDashboardByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	CreateV2(ctx context.Context, config *ResourcePatchSoftwareTitleConfiguration) (*CreateResponse, *resty.Response, error)
+	CreateV3(ctx context.Context, config *ResourcePatchSoftwareTitleConfiguration) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV2(ctx context.Context, name string) (*resty.Response, error)
+	DeleteByNameV3(ctx context.Context, name string) (*resty.Response, error)
+	ExportReportByIDV2(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error)
+	ExportReportByIDV3(ctx context.Context, id string, query map[string]string) ([]byte, *resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByIDV3(ctx context.Context, id string) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByNameV2(ctx context.Context, name string) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetByNameV3(ctx context.Context, name string) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	GetDashboardStatusByIDV2(ctx context.Context, id string) (*ResourceDashboardStatus, *resty.Response, error)
+	GetDashboardStatusByIDV3(ctx context.Context, id string) (*ResourceDashboardStatus, *resty.Response, error)
+	GetDefinitionsByIDV2(ctx context.Context, id string, query map[string]string) (*DefinitionsResponse, *resty.Response, error)
+	GetDefinitionsByIDV3(ctx context.Context, id string, query map[string]string) (*DefinitionsResponse, *resty.Response, error)
+	GetDependenciesByIDV2(ctx context.Context, id string, query map[string]string) (*DependenciesResponse, *resty.Response, error)
+	GetDependenciesByIDV3(ctx context.Context, id string, query map[string]string) (*DependenciesResponse, *resty.Response, error)
+	GetExtensionAttributesByIDV2(ctx context.Context, id string) ([]ResourceExtensionAttribute, *resty.Response, error)
+	GetExtensionAttributesByIDV3(ctx context.Context, id string) ([]ResourceExtensionAttribute, *resty.Response, error)
+	GetHistoryByIDV2(ctx context.Context, id string, query map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetHistoryByIDV3(ctx context.Context, id string, query map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetPatchReportByIDV2(ctx context.Context, id string, query map[string]string) (*PatchReportResponse, *resty.Response, error)
+	GetPatchReportByIDV3(ctx context.Context, id string, query map[string]string) (*PatchReportResponseV3, *resty.Response, error)
+	GetPatchSummaryByIDV2(ctx context.Context, id string) (*ResourcePatchSummary, *resty.Response, error)
+	GetPatchSummaryByIDV3(ctx context.Context, id string) (*ResourcePatchSummary, *resty.Response, error)
+	GetPatchVersionsByIDV2(ctx context.Context, id string) ([]ResourcePatchVersion, *resty.Response, error)
+	GetPatchVersionsByIDV3(ctx context.Context, id string) ([]ResourcePatchVersion, *resty.Response, error)
+	ListV2(ctx context.Context) (*ListResponse, *resty.Response, error)
+	ListV3(ctx context.Context) (*ListResponse, *resty.Response, error)
+	RemoveFromDashboardByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	RemoveFromDashboardByIDV3(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByIDV2(ctx context.Context, id string, config *ResourcePatchSoftwareTitleConfiguration) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByIDV3(ctx context.Context, id string, config *ResourcePatchSoftwareTitleConfiguration) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByNameV2(ctx context.Context, name string, config *ResourcePatchSoftwareTitleConfiguration) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+	UpdateByNameV3(ctx context.Context, name string, config *ResourcePatchSoftwareTitleConfiguration) (*ResourcePatchSoftwareTitleConfiguration, *resty.Response, error)
+}
+
+var _ Service = (*PatchSoftwareTitleConfigurations)(nil)
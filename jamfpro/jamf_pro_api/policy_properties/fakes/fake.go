@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the policy_properties
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/policy_properties"
+	"resty.dev/v3"
+)
+
+// PolicyPropertiesFake is a policy_properties.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type PolicyPropertiesFake struct {
+	fakes.Journal
+
+	GetFunc    func(ctx context.Context) (*policy_properties.ResourcePolicyProperties, *resty.Response, error)
+	UpdateFunc func(ctx context.Context, request *policy_properties.ResourcePolicyProperties) (*policy_properties.ResourcePolicyProperties, *resty.Response, error)
+}
+
+var _ policy_properties.Service = (*PolicyPropertiesFake)(nil)
+
+// Get records the call and delegates to GetFunc.
+func (f *PolicyPropertiesFake) Get(ctx context.Context) (*policy_properties.ResourcePolicyProperties, *resty.Response, error) {
+	f.Record("Get", ctx)
+	if f.GetFunc == nil {
+		return nil, nil, fakes.NotStubbed("PolicyPropertiesFake.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// Update records the call and delegates to UpdateFunc.
+func (f *PolicyPropertiesFake) Update(ctx context.Context, request *policy_properties.ResourcePolicyProperties) (*policy_properties.ResourcePolicyProperties, *resty.Response, error) {
+	f.Record("Update", ctx, request)
+	if f.UpdateFunc == nil {
+		return nil, nil, fakes.NotStubbed("PolicyPropertiesFake.Update")
+	}
+	return f.UpdateFunc(ctx, request)
+}
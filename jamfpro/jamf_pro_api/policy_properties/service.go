@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package policy_properties
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of PolicyProperties.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.PolicyPropertiesFake implements it for tests.
+type Service interface {
+	Get(ctx context.Context) (*ResourcePolicyProperties, *resty.Response, error)
+	Update(ctx context.Context, request *ResourcePolicyProperties) (*ResourcePolicyProperties, *resty.Response, error)
+}
+
+var _ Service = (*PolicyProperties)(nil)
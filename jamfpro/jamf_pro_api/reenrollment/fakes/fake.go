@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the reenrollment
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/reenrollment"
+	"resty.dev/v3"
+)
+
+// ReenrollmentFake is a reenrollment.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ReenrollmentFake struct {
+	fakes.Journal
+
+	AddHistoryNotesFunc func(ctx context.Context, request *reenrollment.AddReenrollmentHistoryNotesRequest) (*reenrollment.ReenrollmentHistoryObject, *resty.Response, error)
+	ExportHistoryFunc   func(ctx context.Context, query map[string]string, body *reenrollment.ExportReenrollmentHistoryRequest) (*resty.Response, []byte, error)
+	GetFunc             func(ctx context.Context) (*reenrollment.ResourceReenrollmentSettings, *resty.Response, error)
+	GetHistoryFunc      func(ctx context.Context, query map[string]string) (*reenrollment.ReenrollmentHistoryResponse, *resty.Response, error)
+	UpdateFunc          func(ctx context.Context, request *reenrollment.ResourceReenrollmentSettings) (*reenrollment.ResourceReenrollmentSettings, *resty.Response, error)
+}
+
+var _ reenrollment.Service = (*ReenrollmentFake)(nil)
+
+// AddHistoryNotes records the call and delegates to AddHistoryNotesFunc.
+func (f *ReenrollmentFake) AddHistoryNotes(ctx context.Context, request *reenrollment.AddReenrollmentHistoryNotesRequest) (*reenrollment.ReenrollmentHistoryObject, *resty.Response, error) {
+	f.Record("AddHistoryNotes", ctx, request)
+	if f.AddHistoryNotesFunc == nil {
+		return nil, nil, fakes.NotStubbed("ReenrollmentFake.AddHistoryNotes")
+	}
+	return f.AddHistoryNotesFunc(ctx, request)
+}
+
+// ExportHistory records the call and delegates to ExportHistoryFunc.
+func (f *ReenrollmentFake) ExportHistory(ctx context.Context, query map[string]string, body *reenrollment.ExportReenrollmentHistoryRequest) (*resty.Response, []byte, error) {
+	f.Record("ExportHistory", ctx, query, body)
+	if f.ExportHistoryFunc == nil {
+		return nil, nil, fakes.NotStubbed("ReenrollmentFake.ExportHistory")
+	}
+	return f.ExportHistoryFunc(ctx, query, body)
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *ReenrollmentFake) Get(ctx context.Context) (*reenrollment.ResourceReenrollmentSettings, *resty.Response, error) {
+	f.Record("Get", ctx)
+	if f.GetFunc == nil {
+		return nil, nil, fakes.NotStubbed("ReenrollmentFake.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// GetHistory records the call and delegates to GetHistoryFunc.
+func (f *ReenrollmentFake) GetHistory(ctx context.Context, query map[string]string) (*reenrollment.ReenrollmentHistoryResponse, *resty.Response, error) {
+	f.Record("GetHistory", ctx, query)
+	if f.GetHistoryFunc == nil {
+		return nil, nil, fakes.NotStubbed("ReenrollmentFake.GetHistory")
+	}
+	return f.GetHistoryFunc(ctx, query)
+}
+
+// Update records the call and delegates to UpdateFunc.
+func (f *ReenrollmentFake) Update(ctx context.Context, request *reenrollment.ResourceReenrollmentSettings) (*reenrollment.ResourceReenrollmentSettings, *resty.Response, error) {
+	f.Record("Update", ctx, request)
+	if f.UpdateFunc == nil {
+		return nil, nil, fakes.NotStubbed("ReenrollmentFake.Update")
+	}
+	return f.UpdateFunc(ctx, request)
+}
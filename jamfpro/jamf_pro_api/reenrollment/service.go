@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package reenrollment
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Reenrollment.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ReenrollmentFake implements it for tests.
+type Service interface {
+	AddHistoryNotes(ctx context.Context, request *AddReenrollmentHistoryNotesRequest) (*ReenrollmentHistoryObject, *resty.Response, error)
+	ExportHistory(ctx context.Context, query map[string]string, body *ExportReenrollmentHistoryRequest) (*resty.Response, []byte, error)
+	Get(ctx context.Context) (*ResourceReenrollmentSettings, *resty.Response, error)
+	GetHistory(ctx context.Context, query map[string]string) (*ReenrollmentHistoryResponse, *resty.Response, error)
+	Update(ctx context.Context, request *ResourceReenrollmentSettings) (*ResourceReenrollmentSettings, *resty.Response, error)
+}
+
+var _ Service = (*Reenrollment)(nil)
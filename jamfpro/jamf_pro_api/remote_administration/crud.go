@@ -0,0 +1,362 @@
+// Package remote_administration provides access to the remote administration
+// (TeamViewer) endpoints of the Jamf Pro API.
+//
+// Note: these endpoints are in preview and may change between Jamf Pro
+// releases. Session endpoints return HTTP 403 Forbidden when the TeamViewer
+// connection is not configured or enabled; callers can use
+// client.IsForbidden(err) to detect this condition.
+package remote_administration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the remote administration-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations
+	RemoteAdministration struct {
+		client client.Client
+	}
+)
+
+func NewRemoteAdministration(client client.Client) *RemoteAdministration {
+	return &RemoteAdministration{client: client}
+}
+
+// -----------------------------------------------------------------------------
+// Jamf Pro API - Remote Administration Configurations (Preview)
+// -----------------------------------------------------------------------------
+
+// ListConfigurationsPreview retrieves all remote administration configurations.
+// URL: GET /api/preview/remote-administration-configurations
+// rsqlQuery supports: page, page-size (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations
+func (s *RemoteAdministration) ListConfigurationsPreview(ctx context.Context, rsqlQuery map[string]string) (*ListConfigurationsResponse, *resty.Response, error) {
+	endpoint := constants.EndpointJamfProRemoteAdministrationPreview
+
+	var result ListConfigurationsResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []ResourceConfiguration
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to list remote administration configurations: %w", err)
+	}
+	result.TotalCount = len(result.Results)
+	return &result, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// Jamf Pro API - TeamViewer Connection Configuration (Preview)
+// -----------------------------------------------------------------------------
+
+// CreateTeamViewerConfigurationPreview creates a TeamViewer connection
+// configuration and initializes the connection between Jamf Pro and TeamViewer.
+// URL: POST /api/preview/remote-administration-configurations/team-viewer
+// Returns 409 if a configuration already exists for the site.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer
+func (s *RemoteAdministration) CreateTeamViewerConfigurationPreview(ctx context.Context, request *RequestTeamViewerConfiguration) (*CreateResponse, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.SessionTimeout < 1 || request.SessionTimeout > 1440 {
+		return nil, nil, fmt.Errorf("sessionTimeout must be between 1 and 1440 minutes, got %d", request.SessionTimeout)
+	}
+
+	endpoint := constants.EndpointJamfProRemoteAdministrationTeamViewerPreview
+
+	var result CreateResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create TeamViewer configuration: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetTeamViewerConfigurationByIDPreview retrieves a TeamViewer connection configuration by ID.
+// URL: GET /api/preview/remote-administration-configurations/team-viewer/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-id
+func (s *RemoteAdministration) GetTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*ResourceTeamViewerConfiguration, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("configuration ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, id)
+
+	var result ResourceTeamViewerConfiguration
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get TeamViewer configuration by ID: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateTeamViewerConfigurationByIDPreview updates a TeamViewer connection configuration (PATCH).
+// URL: PATCH /api/preview/remote-administration-configurations/team-viewer/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/patch_preview-remote-administration-configurations-team-viewer-id
+func (s *RemoteAdministration) UpdateTeamViewerConfigurationByIDPreview(ctx context.Context, id string, request *RequestTeamViewerConfigurationUpdate) (*ResourceTeamViewerConfiguration, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("configuration ID is required")
+	}
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.SessionTimeout < 0 || request.SessionTimeout > 1440 {
+		return nil, nil, fmt.Errorf("sessionTimeout must be between 1 and 1440 minutes, got %d", request.SessionTimeout)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, id)
+
+	var result ResourceTeamViewerConfiguration
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Patch(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update TeamViewer configuration: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteTeamViewerConfigurationByIDPreview deletes a TeamViewer connection configuration.
+// URL: DELETE /api/preview/remote-administration-configurations/team-viewer/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/delete_preview-remote-administration-configurations-team-viewer-id
+func (s *RemoteAdministration) DeleteTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*resty.Response, error) {
+	if id == "" {
+		return nil, fmt.Errorf("configuration ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, id)
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete TeamViewer configuration: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetTeamViewerConfigurationStatusPreview verifies the connection between
+// Jamf Pro and TeamViewer for a configuration.
+// URL: GET /api/preview/remote-administration-configurations/team-viewer/{id}/status
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-id-status
+func (s *RemoteAdministration) GetTeamViewerConfigurationStatusPreview(ctx context.Context, id string) (*ResponseTeamViewerConfigurationStatus, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("configuration ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/status", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, id)
+
+	var result ResponseTeamViewerConfigurationStatus
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get TeamViewer configuration status: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// Jamf Pro API - TeamViewer Sessions (Preview)
+// -----------------------------------------------------------------------------
+
+// ListTeamViewerSessionsPreview retrieves the sessions of a TeamViewer configuration.
+// URL: GET /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions
+// rsqlQuery supports: filter (RSQL), page, page-size (all optional).
+// Fields allowed in filter: deviceId, deviceType, state.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-configurationid-sessions
+func (s *RemoteAdministration) ListTeamViewerSessionsPreview(ctx context.Context, configurationID string, rsqlQuery map[string]string) (*ListTeamViewerSessionsResponse, *resty.Response, error) {
+	if configurationID == "" {
+		return nil, nil, fmt.Errorf("configuration ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/sessions", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, configurationID)
+
+	var result ListTeamViewerSessionsResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []ResourceTeamViewerSession
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to list TeamViewer sessions: %w", err)
+	}
+	result.TotalCount = len(result.Results)
+	return &result, resp, nil
+}
+
+// CreateTeamViewerSessionPreview creates a TeamViewer session between an
+// administrator and the end user of a device.
+// URL: POST /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer-configurationid-sessions
+func (s *RemoteAdministration) CreateTeamViewerSessionPreview(ctx context.Context, configurationID string, request *RequestTeamViewerSession) (*CreateResponse, *resty.Response, error) {
+	if configurationID == "" {
+		return nil, nil, fmt.Errorf("configuration ID is required")
+	}
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.DeviceID == "" {
+		return nil, nil, fmt.Errorf("deviceId is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/sessions", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, configurationID)
+
+	var result CreateResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create TeamViewer session: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetTeamViewerSessionByIDPreview retrieves a TeamViewer session by ID.
+// URL: GET /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions/{sessionId}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-configurationid-sessions-sessionid
+func (s *RemoteAdministration) GetTeamViewerSessionByIDPreview(ctx context.Context, configurationID, sessionID string) (*ResourceTeamViewerSession, *resty.Response, error) {
+	endpoint, err := sessionEndpoint(configurationID, sessionID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result ResourceTeamViewerSession
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get TeamViewer session by ID: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetTeamViewerSessionStatusPreview retrieves the state of a TeamViewer
+// session and whether the end user is online.
+// URL: GET /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions/{sessionId}/status
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-configurationid-sessions-sessionid-status
+func (s *RemoteAdministration) GetTeamViewerSessionStatusPreview(ctx context.Context, configurationID, sessionID string) (*ResponseTeamViewerSessionStatus, *resty.Response, error) {
+	endpoint, err := sessionEndpoint(configurationID, sessionID, "/status")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result ResponseTeamViewerSessionStatus
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get TeamViewer session status: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// CloseTeamViewerSessionPreview closes a TeamViewer session.
+// URL: POST /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions/{sessionId}/close
+// Returns 409 if the session is already closed.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer-configurationid-sessions-sessionid-close
+func (s *RemoteAdministration) CloseTeamViewerSessionPreview(ctx context.Context, configurationID, sessionID string) (*resty.Response, error) {
+	endpoint, err := sessionEndpoint(configurationID, sessionID, "/close")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Post(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to close TeamViewer session: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ResendTeamViewerSessionNotificationPreview resends the session notification
+// to the end user.
+// URL: POST /api/preview/remote-administration-configurations/team-viewer/{configurationId}/sessions/{sessionId}/resend-notification
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer-configurationid-sessions-sessionid-resend-notification
+func (s *RemoteAdministration) ResendTeamViewerSessionNotificationPreview(ctx context.Context, configurationID, sessionID string) (*resty.Response, error) {
+	endpoint, err := sessionEndpoint(configurationID, sessionID, "/resend-notification")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Post(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to resend TeamViewer session notification: %w", err)
+	}
+
+	return resp, nil
+}
+
+// sessionEndpoint builds the URL of a single session, with an optional suffix.
+func sessionEndpoint(configurationID, sessionID, suffix string) (string, error) {
+	if configurationID == "" {
+		return "", fmt.Errorf("configuration ID is required")
+	}
+	if sessionID == "" {
+		return "", fmt.Errorf("session ID is required")
+	}
+	return fmt.Sprintf("%s/%s/sessions/%s%s", constants.EndpointJamfProRemoteAdministrationTeamViewerPreview, configurationID, sessionID, suffix), nil
+}
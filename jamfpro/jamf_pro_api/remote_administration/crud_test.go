@@ -0,0 +1,236 @@
+package remote_administration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*RemoteAdministration, *mocks.RemoteAdministrationMock) {
+	t.Helper()
+	mock := mocks.NewRemoteAdministrationMock()
+	mock.RegisterMocks()
+	return NewRemoteAdministration(mock), mock
+}
+
+func TestUnit_RemoteAdministration_ListConfigurationsPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.ListConfigurationsPreview(context.Background(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 1, result.TotalCount)
+	require.Equal(t, "1", result.Results[0].ID)
+	require.Equal(t, ConfigurationTypeTeamViewer, result.Results[0].Type)
+}
+
+func TestUnit_RemoteAdministration_ListConfigurationsPreview_MergePageError(t *testing.T) {
+	mock := mocks.NewRemoteAdministrationMock()
+	mock.RegisterListConfigurationsInvalidMock()
+	svc := NewRemoteAdministration(mock)
+	_, _, err := svc.ListConfigurationsPreview(context.Background(), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to list remote administration configurations")
+}
+
+func TestUnit_RemoteAdministration_CreateTeamViewerConfigurationPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	req := &RequestTeamViewerConfiguration{
+		SiteID:         "-1",
+		DisplayName:    "teamViewerConfiguration",
+		ScriptToken:    "12847340-nPAX96bsaADH4Gz6K6i2",
+		Enabled:        true,
+		SessionTimeout: 15,
+	}
+	result, resp, err := svc.CreateTeamViewerConfigurationPreview(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, "1", result.ID)
+}
+
+func TestUnit_RemoteAdministration_CreateTeamViewerConfigurationPreview_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+	_, resp, err := svc.CreateTeamViewerConfigurationPreview(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+
+	_, _, err = svc.CreateTeamViewerConfigurationPreview(context.Background(), &RequestTeamViewerConfiguration{SessionTimeout: 1441})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sessionTimeout")
+}
+
+func TestUnit_RemoteAdministration_CreateTeamViewerConfigurationPreview_Conflict(t *testing.T) {
+	mock := mocks.NewRemoteAdministrationMock()
+	mock.RegisterCreateConfigurationConflictMock()
+	svc := NewRemoteAdministration(mock)
+	_, resp, err := svc.CreateTeamViewerConfigurationPreview(context.Background(), &RequestTeamViewerConfiguration{SessionTimeout: 15})
+	require.Error(t, err)
+	require.Equal(t, 409, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to create TeamViewer configuration")
+}
+
+func TestUnit_RemoteAdministration_GetTeamViewerConfigurationByIDPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetTeamViewerConfigurationByIDPreview(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "teamViewerConfiguration", result.DisplayName)
+	require.True(t, result.Enabled)
+	require.NotNil(t, result.SessionTimeout)
+	require.Equal(t, 15, *result.SessionTimeout)
+}
+
+func TestUnit_RemoteAdministration_UpdateTeamViewerConfigurationByIDPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	enabled := true
+	result, resp, err := svc.UpdateTeamViewerConfigurationByIDPreview(context.Background(), "1", &RequestTeamViewerConfigurationUpdate{Enabled: &enabled, SessionTimeout: 15})
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "1", result.ID)
+}
+
+func TestUnit_RemoteAdministration_UpdateTeamViewerConfigurationByIDPreview_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+	_, _, err := svc.UpdateTeamViewerConfigurationByIDPreview(context.Background(), "", &RequestTeamViewerConfigurationUpdate{})
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, _, err = svc.UpdateTeamViewerConfigurationByIDPreview(context.Background(), "1", nil)
+	require.ErrorContains(t, err, "request is required")
+}
+
+func TestUnit_RemoteAdministration_DeleteTeamViewerConfigurationByIDPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	resp, err := svc.DeleteTeamViewerConfigurationByIDPreview(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 204, resp.StatusCode())
+}
+
+func TestUnit_RemoteAdministration_GetTeamViewerConfigurationStatusPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetTeamViewerConfigurationStatusPreview(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "VALID", result.ConnectionVerificationResult)
+}
+
+func TestUnit_RemoteAdministration_ConfigurationByID_EmptyID(t *testing.T) {
+	svc, _ := setupMockService(t)
+	ctx := context.Background()
+
+	_, _, err := svc.GetTeamViewerConfigurationByIDPreview(ctx, "")
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, err = svc.DeleteTeamViewerConfigurationByIDPreview(ctx, "")
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, _, err = svc.GetTeamViewerConfigurationStatusPreview(ctx, "")
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, _, err = svc.ListTeamViewerSessionsPreview(ctx, "", nil)
+	require.ErrorContains(t, err, "configuration ID is required")
+}
+
+func TestUnit_RemoteAdministration_ListTeamViewerSessionsPreview_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"filter": `state=="OPEN"`}
+	result, resp, err := svc.ListTeamViewerSessionsPreview(context.Background(), "1", query)
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 1, result.TotalCount)
+	require.Equal(t, SessionStateOpen, result.Results[0].State)
+	require.Equal(t, "42", result.Results[0].DeviceID)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_RemoteAdministration_ListTeamViewerSessionsPreview_Forbidden(t *testing.T) {
+	mock := mocks.NewRemoteAdministrationMock()
+	mock.RegisterSessionsForbiddenMock()
+	svc := NewRemoteAdministration(mock)
+	_, resp, err := svc.ListTeamViewerSessionsPreview(context.Background(), "1", nil)
+	require.Error(t, err)
+	require.Equal(t, 403, resp.StatusCode())
+}
+
+func TestUnit_RemoteAdministration_CreateTeamViewerSessionPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	req := &RequestTeamViewerSession{DeviceID: "42", DeviceType: DeviceTypeComputer, Description: "Cannot install application X"}
+	result, resp, err := svc.CreateTeamViewerSessionPreview(context.Background(), "1", req)
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, "1", result.ID)
+}
+
+func TestUnit_RemoteAdministration_CreateTeamViewerSessionPreview_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+	ctx := context.Background()
+	_, _, err := svc.CreateTeamViewerSessionPreview(ctx, "", &RequestTeamViewerSession{DeviceID: "42"})
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, _, err = svc.CreateTeamViewerSessionPreview(ctx, "1", nil)
+	require.ErrorContains(t, err, "request is required")
+	_, _, err = svc.CreateTeamViewerSessionPreview(ctx, "1", &RequestTeamViewerSession{})
+	require.ErrorContains(t, err, "deviceId is required")
+}
+
+func TestUnit_RemoteAdministration_GetTeamViewerSessionByIDPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetTeamViewerSessionByIDPreview(context.Background(), "1", "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "s1234-5678", result.Code)
+	require.Equal(t, "https://get.teamviewer.com/v15/en/s12345678", result.EndUserLink)
+}
+
+func TestUnit_RemoteAdministration_GetTeamViewerSessionStatusPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetTeamViewerSessionStatusPreview(context.Background(), "1", "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, SessionStateOpen, result.SessionState)
+	require.True(t, result.Online)
+}
+
+func TestUnit_RemoteAdministration_CloseTeamViewerSessionPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	resp, err := svc.CloseTeamViewerSessionPreview(context.Background(), "1", "1")
+	require.NoError(t, err)
+	require.Equal(t, 204, resp.StatusCode())
+}
+
+func TestUnit_RemoteAdministration_CloseTeamViewerSessionPreview_Conflict(t *testing.T) {
+	mock := mocks.NewRemoteAdministrationMock()
+	mock.RegisterCloseSessionConflictMock()
+	svc := NewRemoteAdministration(mock)
+	resp, err := svc.CloseTeamViewerSessionPreview(context.Background(), "1", "1")
+	require.Error(t, err)
+	require.Equal(t, 409, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to close TeamViewer session")
+}
+
+func TestUnit_RemoteAdministration_ResendTeamViewerSessionNotificationPreview_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	resp, err := svc.ResendTeamViewerSessionNotificationPreview(context.Background(), "1", "1")
+	require.NoError(t, err)
+	require.Equal(t, 204, resp.StatusCode())
+}
+
+func TestUnit_RemoteAdministration_SessionByID_EmptyIDs(t *testing.T) {
+	svc, _ := setupMockService(t)
+	ctx := context.Background()
+
+	_, _, err := svc.GetTeamViewerSessionByIDPreview(ctx, "", "1")
+	require.ErrorContains(t, err, "configuration ID is required")
+	_, _, err = svc.GetTeamViewerSessionStatusPreview(ctx, "1", "")
+	require.ErrorContains(t, err, "session ID is required")
+	_, err = svc.CloseTeamViewerSessionPreview(ctx, "1", "")
+	require.ErrorContains(t, err, "session ID is required")
+	_, err = svc.ResendTeamViewerSessionNotificationPreview(ctx, "", "1")
+	require.ErrorContains(t, err, "configuration ID is required")
+}
+
+func TestUnit_RemoteAdministration_NoMockRegistered(t *testing.T) {
+	mock := mocks.NewRemoteAdministrationMock()
+	svc := NewRemoteAdministration(mock)
+	_, _, err := svc.GetTeamViewerConfigurationByIDPreview(context.Background(), "1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get TeamViewer configuration by ID")
+}
@@ -0,0 +1,18 @@
+package remote_administration
+
+// ConfigurationType* constants represent the remote administration configuration types.
+const (
+	ConfigurationTypeTeamViewer = "team-viewer"
+)
+
+// SessionState* constants represent the state of a TeamViewer session.
+const (
+	SessionStateOpen    = "OPEN"
+	SessionStateClosed  = "CLOSED"
+	SessionStateUnknown = "UNKNOWN"
+)
+
+// DeviceType* constants represent the device types a TeamViewer session can target.
+const (
+	DeviceTypeComputer = "COMPUTER"
+)
@@ -0,0 +1,143 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the remote_administration
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration"
+	"resty.dev/v3"
+)
+
+// RemoteAdministrationFake is a remote_administration.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type RemoteAdministrationFake struct {
+	fakes.Journal
+
+	CloseTeamViewerSessionPreviewFunc              func(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error)
+	CreateTeamViewerConfigurationPreviewFunc       func(ctx context.Context, request *remote_administration.RequestTeamViewerConfiguration) (*remote_administration.CreateResponse, *resty.Response, error)
+	CreateTeamViewerSessionPreviewFunc             func(ctx context.Context, configurationID string, request *remote_administration.RequestTeamViewerSession) (*remote_administration.CreateResponse, *resty.Response, error)
+	DeleteTeamViewerConfigurationByIDPreviewFunc   func(ctx context.Context, id string) (*resty.Response, error)
+	GetTeamViewerConfigurationByIDPreviewFunc      func(ctx context.Context, id string) (*remote_administration.ResourceTeamViewerConfiguration, *resty.Response, error)
+	GetTeamViewerConfigurationStatusPreviewFunc    func(ctx context.Context, id string) (*remote_administration.ResponseTeamViewerConfigurationStatus, *resty.Response, error)
+	GetTeamViewerSessionByIDPreviewFunc            func(ctx context.Context, configurationID string, sessionID string) (*remote_administration.ResourceTeamViewerSession, *resty.Response, error)
+	GetTeamViewerSessionStatusPreviewFunc          func(ctx context.Context, configurationID string, sessionID string) (*remote_administration.ResponseTeamViewerSessionStatus, *resty.Response, error)
+	ListConfigurationsPreviewFunc                  func(ctx context.Context, rsqlQuery map[string]string) (*remote_administration.ListConfigurationsResponse, *resty.Response, error)
+	ListTeamViewerSessionsPreviewFunc              func(ctx context.Context, configurationID string, rsqlQuery map[string]string) (*remote_administration.ListTeamViewerSessionsResponse, *resty.Response, error)
+	ResendTeamViewerSessionNotificationPreviewFunc func(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error)
+	UpdateTeamViewerConfigurationByIDPreviewFunc   func(ctx context.Context, id string, request *remote_administration.RequestTeamViewerConfigurationUpdate) (*remote_administration.ResourceTeamViewerConfiguration, *resty.Response, error)
+}
+
+var _ remote_administration.Service = (*RemoteAdministrationFake)(nil)
+
+// CloseTeamViewerSessionPreview records the call and delegates to CloseTeamViewerSessionPreviewFunc.
+func (f *RemoteAdministrationFake) CloseTeamViewerSessionPreview(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error) {
+	f.Record("CloseTeamViewerSessionPreview", ctx, configurationID, sessionID)
+	if f.CloseTeamViewerSessionPreviewFunc == nil {
+		return nil, fakes.NotStubbed("RemoteAdministrationFake.CloseTeamViewerSessionPreview")
+	}
+	return f.CloseTeamViewerSessionPreviewFunc(ctx, configurationID, sessionID)
+}
+
+// CreateTeamViewerConfigurationPreview records the call and delegates to CreateTeamViewerConfigurationPreviewFunc.
+func (f *RemoteAdministrationFake) CreateTeamViewerConfigurationPreview(ctx context.Context, request *remote_administration.RequestTeamViewerConfiguration) (*remote_administration.CreateResponse, *resty.Response, error) {
+	f.Record("CreateTeamViewerConfigurationPreview", ctx, request)
+	if f.CreateTeamViewerConfigurationPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.CreateTeamViewerConfigurationPreview")
+	}
+	return f.CreateTeamViewerConfigurationPreviewFunc(ctx, request)
+}
+
+// CreateTeamViewerSessionPreview records the call and delegates to CreateTeamViewerSessionPreviewFunc.
+func (f *RemoteAdministrationFake) CreateTeamViewerSessionPreview(ctx context.Context, configurationID string, request *remote_administration.RequestTeamViewerSession) (*remote_administration.CreateResponse, *resty.Response, error) {
+	f.Record("CreateTeamViewerSessionPreview", ctx, configurationID, request)
+	if f.CreateTeamViewerSessionPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.CreateTeamViewerSessionPreview")
+	}
+	return f.CreateTeamViewerSessionPreviewFunc(ctx, configurationID, request)
+}
+
+// DeleteTeamViewerConfigurationByIDPreview records the call and delegates to DeleteTeamViewerConfigurationByIDPreviewFunc.
+func (f *RemoteAdministrationFake) DeleteTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteTeamViewerConfigurationByIDPreview", ctx, id)
+	if f.DeleteTeamViewerConfigurationByIDPreviewFunc == nil {
+		return nil, fakes.NotStubbed("RemoteAdministrationFake.DeleteTeamViewerConfigurationByIDPreview")
+	}
+	return f.DeleteTeamViewerConfigurationByIDPreviewFunc(ctx, id)
+}
+
+// GetTeamViewerConfigurationByIDPreview records the call and delegates to GetTeamViewerConfigurationByIDPreviewFunc.
+func (f *RemoteAdministrationFake) GetTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*remote_administration.ResourceTeamViewerConfiguration, *resty.Response, error) {
+	f.Record("GetTeamViewerConfigurationByIDPreview", ctx, id)
+	if f.GetTeamViewerConfigurationByIDPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.GetTeamViewerConfigurationByIDPreview")
+	}
+	return f.GetTeamViewerConfigurationByIDPreviewFunc(ctx, id)
+}
+
+// GetTeamViewerConfigurationStatusPreview records the call and delegates to GetTeamViewerConfigurationStatusPreviewFunc.
+func (f *RemoteAdministrationFake) GetTeamViewerConfigurationStatusPreview(ctx context.Context, id string) (*remote_administration.ResponseTeamViewerConfigurationStatus, *resty.Response, error) {
+	f.Record("GetTeamViewerConfigurationStatusPreview", ctx, id)
+	if f.GetTeamViewerConfigurationStatusPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.GetTeamViewerConfigurationStatusPreview")
+	}
+	return f.GetTeamViewerConfigurationStatusPreviewFunc(ctx, id)
+}
+
+// GetTeamViewerSessionByIDPreview records the call and delegates to GetTeamViewerSessionByIDPreviewFunc.
+func (f *RemoteAdministrationFake) GetTeamViewerSessionByIDPreview(ctx context.Context, configurationID string, sessionID string) (*remote_administration.ResourceTeamViewerSession, *resty.Response, error) {
+	f.Record("GetTeamViewerSessionByIDPreview", ctx, configurationID, sessionID)
+	if f.GetTeamViewerSessionByIDPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.GetTeamViewerSessionByIDPreview")
+	}
+	return f.GetTeamViewerSessionByIDPreviewFunc(ctx, configurationID, sessionID)
+}
+
+// GetTeamViewerSessionStatusPreview records the call and delegates to GetTeamViewerSessionStatusPreviewFunc.
+func (f *RemoteAdministrationFake) GetTeamViewerSessionStatusPreview(ctx context.Context, configurationID string, sessionID string) (*remote_administration.ResponseTeamViewerSessionStatus, *resty.Response, error) {
+	f.Record("GetTeamViewerSessionStatusPreview", ctx, configurationID, sessionID)
+	if f.GetTeamViewerSessionStatusPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.GetTeamViewerSessionStatusPreview")
+	}
+	return f.GetTeamViewerSessionStatusPreviewFunc(ctx, configurationID, sessionID)
+}
+
+// ListConfigurationsPreview records the call and delegates to ListConfigurationsPreviewFunc.
+func (f *RemoteAdministrationFake) ListConfigurationsPreview(ctx context.Context, rsqlQuery map[string]string) (*remote_administration.ListConfigurationsResponse, *resty.Response, error) {
+	f.Record("ListConfigurationsPreview", ctx, rsqlQuery)
+	if f.ListConfigurationsPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.ListConfigurationsPreview")
+	}
+	return f.ListConfigurationsPreviewFunc(ctx, rsqlQuery)
+}
+
+// ListTeamViewerSessionsPreview records the call and delegates to ListTeamViewerSessionsPreviewFunc.
+func (f *RemoteAdministrationFake) ListTeamViewerSessionsPreview(ctx context.Context, configurationID string, rsqlQuery map[string]string) (*remote_administration.ListTeamViewerSessionsResponse, *resty.Response, error) {
+	f.Record("ListTeamViewerSessionsPreview", ctx, configurationID, rsqlQuery)
+	if f.ListTeamViewerSessionsPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.ListTeamViewerSessionsPreview")
+	}
+	return f.ListTeamViewerSessionsPreviewFunc(ctx, configurationID, rsqlQuery)
+}
+
+// ResendTeamViewerSessionNotificationPreview records the call and delegates to ResendTeamViewerSessionNotificationPreviewFunc.
+func (f *RemoteAdministrationFake) ResendTeamViewerSessionNotificationPreview(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error) {
+	f.Record("ResendTeamViewerSessionNotificationPreview", ctx, configurationID, sessionID)
+	if f.ResendTeamViewerSessionNotificationPreviewFunc == nil {
+		return nil, fakes.NotStubbed("RemoteAdministrationFake.ResendTeamViewerSessionNotificationPreview")
+	}
+	return f.ResendTeamViewerSessionNotificationPreviewFunc(ctx, configurationID, sessionID)
+}
+
+// UpdateTeamViewerConfigurationByIDPreview records the call and delegates to UpdateTeamViewerConfigurationByIDPreviewFunc.
+func (f *RemoteAdministrationFake) UpdateTeamViewerConfigurationByIDPreview(ctx context.Context, id string, request *remote_administration.RequestTeamViewerConfigurationUpdate) (*remote_administration.ResourceTeamViewerConfiguration, *resty.Response, error) {
+	f.Record("UpdateTeamViewerConfigurationByIDPreview", ctx, id, request)
+	if f.UpdateTeamViewerConfigurationByIDPreviewFunc == nil {
+		return nil, nil, fakes.NotStubbed("RemoteAdministrationFake.UpdateTeamViewerConfigurationByIDPreview")
+	}
+	return f.UpdateTeamViewerConfigurationByIDPreviewFunc(ctx, id, request)
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type RemoteAdministrationMock struct {
+	*mocks.GenericMock
+}
+
+func NewRemoteAdministrationMock() *RemoteAdministrationMock {
+	return &RemoteAdministrationMock{
+		GenericMock: mocks.NewJSONMock("RemoteAdministrationMock"),
+	}
+}
+
+const teamViewerPath = "/api/preview/remote-administration-configurations/team-viewer"
+
+func (m *RemoteAdministrationMock) RegisterMocks() {
+	m.Register("GET", "/api/preview/remote-administration-configurations", 200, "validate_list.json")
+	m.Register("POST", teamViewerPath, 201, "validate_create.json")
+	m.Register("GET", teamViewerPath+"/1", 200, "validate_configuration.json")
+	m.Register("PATCH", teamViewerPath+"/1", 200, "validate_configuration.json")
+	m.Register("DELETE", teamViewerPath+"/1", 204, "")
+	m.Register("GET", teamViewerPath+"/1/status", 200, "validate_configuration_status.json")
+	m.Register("GET", teamViewerPath+"/1/sessions", 200, "validate_sessions.json")
+	m.Register("POST", teamViewerPath+"/1/sessions", 201, "validate_create.json")
+	m.Register("GET", teamViewerPath+"/1/sessions/1", 200, "validate_session.json")
+	m.Register("GET", teamViewerPath+"/1/sessions/1/status", 200, "validate_session_status.json")
+	m.Register("POST", teamViewerPath+"/1/sessions/1/close", 204, "")
+	m.Register("POST", teamViewerPath+"/1/sessions/1/resend-notification", 204, "")
+}
+
+func (m *RemoteAdministrationMock) RegisterListConfigurationsInvalidMock() {
+	m.Register("GET", "/api/preview/remote-administration-configurations", 200, "validate_list_invalid.json")
+}
+
+func (m *RemoteAdministrationMock) RegisterCreateConfigurationConflictMock() {
+	m.RegisterConflictError("POST", teamViewerPath)
+}
+
+func (m *RemoteAdministrationMock) RegisterSessionsForbiddenMock() {
+	m.RegisterError("GET", teamViewerPath+"/1/sessions", 403, "error_forbidden.json", "")
+}
+
+func (m *RemoteAdministrationMock) RegisterCloseSessionConflictMock() {
+	m.RegisterConflictError("POST", teamViewerPath+"/1/sessions/1/close")
+}
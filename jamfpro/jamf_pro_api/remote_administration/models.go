@@ -0,0 +1,102 @@
+package remote_administration
+
+// ResourceConfiguration represents a single remote administration configuration.
+type ResourceConfiguration struct {
+	ID          string `json:"id"`
+	SiteID      string `json:"siteId"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"` // team-viewer
+}
+
+// ListConfigurationsResponse is the response for ListConfigurationsPreview.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations
+type ListConfigurationsResponse struct {
+	TotalCount int                     `json:"totalCount"`
+	Results    []ResourceConfiguration `json:"results"`
+}
+
+// CreateResponse is the response for the create operations.
+type CreateResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// RequestTeamViewerConfiguration is the request body for CreateTeamViewerConfigurationPreview.
+// Required: SiteID, DisplayName, ScriptToken, Enabled, SessionTimeout (1-1440 minutes).
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer
+type RequestTeamViewerConfiguration struct {
+	SiteID         string `json:"siteId"`
+	DisplayName    string `json:"displayName"`
+	ScriptToken    string `json:"scriptToken"`
+	Enabled        bool   `json:"enabled"`
+	SessionTimeout int    `json:"sessionTimeout"`
+}
+
+// RequestTeamViewerConfigurationUpdate is the request body for
+// UpdateTeamViewerConfigurationByIDPreview. Only set fields are changed.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/patch_preview-remote-administration-configurations-team-viewer-id
+type RequestTeamViewerConfigurationUpdate struct {
+	DisplayName    string `json:"displayName,omitempty"`
+	Enabled        *bool  `json:"enabled,omitempty"`
+	SessionTimeout int    `json:"sessionTimeout,omitempty"`
+	Token          string `json:"token,omitempty"`
+}
+
+// ResourceTeamViewerConfiguration represents a TeamViewer connection configuration.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-id
+type ResourceTeamViewerConfiguration struct {
+	ID             string `json:"id"`
+	SiteID         string `json:"siteId"`
+	DisplayName    string `json:"displayName"`
+	Enabled        bool   `json:"enabled"`
+	SessionTimeout *int   `json:"sessionTimeout"`
+}
+
+// ResponseTeamViewerConfigurationStatus is the response for GetTeamViewerConfigurationStatusPreview.
+type ResponseTeamViewerConfigurationStatus struct {
+	ConnectionVerificationResult string `json:"connectionVerificationResult"` // e.g. VALID
+}
+
+// RequestTeamViewerSession is the request body for CreateTeamViewerSessionPreview.
+// Required: DeviceID, DeviceType, Description.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_preview-remote-administration-configurations-team-viewer-configurationid-sessions
+type RequestTeamViewerSession struct {
+	DeviceID    string `json:"deviceId"`
+	DeviceType  string `json:"deviceType"` // COMPUTER
+	Description string `json:"description"`
+}
+
+// ResourceTeamViewerSession represents a TeamViewer remote administration session.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer-configurationid-sessions-sessionid
+type ResourceTeamViewerSession struct {
+	ID            string `json:"id"`
+	Code          string `json:"code"`
+	Description   string `json:"description"`
+	SupporterLink string `json:"supporterLink"`
+	EndUserLink   string `json:"endUserLink"`
+	DeviceID      string `json:"deviceId"`
+	DeviceName    string `json:"deviceName"`
+	DeviceType    string `json:"deviceType"` // COMPUTER
+	State         string `json:"state"`      // OPEN, CLOSED, UNKNOWN
+	CreatorID     string `json:"creatorId"`
+	CreatorName   string `json:"creatorName"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// ListTeamViewerSessionsResponse is the response for ListTeamViewerSessionsPreview.
+type ListTeamViewerSessionsResponse struct {
+	TotalCount int                         `json:"totalCount"`
+	Results    []ResourceTeamViewerSession `json:"results"`
+}
+
+// ResponseTeamViewerSessionStatus is the response for GetTeamViewerSessionStatusPreview.
+type ResponseTeamViewerSessionStatus struct {
+	SessionState string `json:"sessionState"` // OPEN, CLOSED, UNKNOWN
+	Online       bool   `json:"online"`
+}
@@ -0,0 +1,29 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package remote_administration
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of RemoteAdministration.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.RemoteAdministrationFake implements it for tests.
+type Service interface {
+	CloseTeamViewerSessionPreview(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error)
+	CreateTeamViewerConfigurationPreview(ctx context.Context, request *RequestTeamViewerConfiguration) (*CreateResponse, *resty.Response, error)
+	CreateTeamViewerSessionPreview(ctx context.Context, configurationID string, request *RequestTeamViewerSession) (*CreateResponse, *resty.Response, error)
+	DeleteTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*resty.Response, error)
+	GetTeamViewerConfigurationByIDPreview(ctx context.Context, id string) (*ResourceTeamViewerConfiguration, *resty.Response, error)
+	GetTeamViewerConfigurationStatusPreview(ctx context.Context, id string) (*ResponseTeamViewerConfigurationStatus, *resty.Response, error)
+	GetTeamViewerSessionByIDPreview(ctx context.Context, configurationID string, sessionID string) (*ResourceTeamViewerSession, *resty.Response, error)
+	GetTeamViewerSessionStatusPreview(ctx context.Context, configurationID string, sessionID string) (*ResponseTeamViewerSessionStatus, *resty.Response, error)
+	ListConfigurationsPreview(ctx context.Context, rsqlQuery map[string]string) (*ListConfigurationsResponse, *resty.Response, error)
+	ListTeamViewerSessionsPreview(ctx context.Context, configurationID string, rsqlQuery map[string]string) (*ListTeamViewerSessionsResponse, *resty.Response, error)
+	ResendTeamViewerSessionNotificationPreview(ctx context.Context, configurationID string, sessionID string) (*resty.Response, error)
+	UpdateTeamViewerConfigurationByIDPreview(ctx context.Context, id string, request *RequestTeamViewerConfigurationUpdate) (*ResourceTeamViewerConfiguration, *resty.Response, error)
+}
+
+var _ Service = (*RemoteAdministration)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the return_to_service
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/return_to_service"
+	"resty.dev/v3"
+)
+
+// ReturnToServiceFake is a return_to_service.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ReturnToServiceFake struct {
+	fakes.Journal
+
+	CreateV1Func     func(ctx context.Context, request *return_to_service.ResourceReturnToServiceConfiguration) (*return_to_service.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func    func(ctx context.Context, id string) (*return_to_service.ResourceReturnToServiceConfiguration, *resty.Response, error)
+	ListV1Func       func(ctx context.Context) (*return_to_service.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func func(ctx context.Context, id string, request *return_to_service.ResourceReturnToServiceConfiguration) (*return_to_service.ResourceReturnToServiceConfiguration, *resty.Response, error)
+}
+
+var _ return_to_service.Service = (*ReturnToServiceFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *ReturnToServiceFake) CreateV1(ctx context.Context, request *return_to_service.ResourceReturnToServiceConfiguration) (*return_to_service.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ReturnToServiceFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *ReturnToServiceFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ReturnToServiceFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *ReturnToServiceFake) GetByIDV1(ctx context.Context, id string) (*return_to_service.ResourceReturnToServiceConfiguration, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ReturnToServiceFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *ReturnToServiceFake) ListV1(ctx context.Context) (*return_to_service.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ReturnToServiceFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *ReturnToServiceFake) UpdateByIDV1(ctx context.Context, id string, request *return_to_service.ResourceReturnToServiceConfiguration) (*return_to_service.ResourceReturnToServiceConfiguration, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ReturnToServiceFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package return_to_service
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ReturnToService.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ReturnToServiceFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceReturnToServiceConfiguration) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceReturnToServiceConfiguration, *resty.Response, error)
+	ListV1(ctx context.Context) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceReturnToServiceConfiguration) (*ResourceReturnToServiceConfiguration, *resty.Response, error)
+}
+
+var _ Service = (*ReturnToService)(nil)
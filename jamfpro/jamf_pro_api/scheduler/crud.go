@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the Jamf Pro Scheduler methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-jobs
+	Scheduler struct {
+		client client.Client
+	}
+)
+
+func NewScheduler(client client.Client) *Scheduler {
+	return &Scheduler{client: client}
+}
+
+// GetJobsV1 returns the keys of all Jamf Pro Scheduler jobs.
+// URL: GET /api/v1/scheduler/jobs
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-jobs
+func (s *Scheduler) GetJobsV1(ctx context.Context) (*JobsResponse, *resty.Response, error) {
+	var result JobsResponse
+
+	endpoint := constants.EndpointJamfProSchedulerV1 + "/jobs"
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get scheduler jobs: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetJobTriggersV1 returns all triggers for the Jamf Pro Scheduler job jobKey.
+// URL: GET /api/v1/scheduler/jobs/{jobKey}/triggers
+// Query params (optional): page, page-size, sort (default nextFireTime:asc), filter (RSQL).
+// Filterable fields: triggerKey, previousFireTime, nextFireTime.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-jobs-jobkey-triggers
+func (s *Scheduler) GetJobTriggersV1(ctx context.Context, jobKey string, rsqlQuery map[string]string) (*TriggersResponse, *resty.Response, error) {
+	if jobKey == "" {
+		return nil, nil, fmt.Errorf("job key is required")
+	}
+
+	var result TriggersResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []ResourceTrigger
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	endpoint := fmt.Sprintf("%s/jobs/%s/triggers", constants.EndpointJamfProSchedulerV1, url.PathEscape(jobKey))
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get triggers for scheduler job %s: %w", jobKey, err)
+	}
+
+	result.TotalCount = len(result.Results)
+	return &result, resp, nil
+}
+
+// GetSummaryV1 returns the number of pending, executing and executed jobs,
+// and whether the Jamf Pro Scheduler is started.
+// URL: GET /api/v1/scheduler/summary
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-summary
+func (s *Scheduler) GetSummaryV1(ctx context.Context) (*ResourceSummary, *resty.Response, error) {
+	var result ResourceSummary
+
+	endpoint := constants.EndpointJamfProSchedulerV1 + "/summary"
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get scheduler summary: %w", err)
+	}
+
+	return &result, resp, nil
+}
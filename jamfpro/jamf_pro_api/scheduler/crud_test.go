@@ -0,0 +1,99 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/scheduler/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*Scheduler, *mocks.SchedulerMock) {
+	t.Helper()
+	mock := mocks.NewSchedulerMock()
+	mock.RegisterMocks()
+	return NewScheduler(mock), mock
+}
+
+func TestUnit_Scheduler_GetJobsV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetJobsV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, []string{"EXAMPLE_JOB", "ComputerInventoryCleanupJob"}, result.JobKeys)
+}
+
+func TestUnit_Scheduler_GetJobTriggersV1_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"sort": "nextFireTime:asc"}
+	result, resp, err := svc.GetJobTriggersV1(context.Background(), "EXAMPLE_JOB", query)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 2, result.TotalCount)
+	require.Len(t, result.Results, 2)
+	require.Equal(t, "EXAMPLE_TRIGGER", result.Results[0].TriggerKey)
+	require.Equal(t, "2023-08-21T21:20:34.35Z", result.Results[0].PreviousFireTime)
+	require.Equal(t, "2023-08-21T21:30:34.35Z", result.Results[0].NextFireTime)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_Scheduler_GetSummaryV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetSummaryV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 1, result.NumberOfPendingJobs)
+	require.Equal(t, 2, result.NumberOfExecutingJobs)
+	require.Equal(t, 3, result.NumberOfExecutedJobs)
+	require.True(t, result.Started)
+}
+
+func TestUnit_Scheduler_GetJobTriggersV1_EmptyJobKey(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetJobTriggersV1(context.Background(), "", nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "job key is required")
+}
+
+func TestUnit_Scheduler_GetJobsV1_Error(t *testing.T) {
+	mock := mocks.NewSchedulerMock()
+	svc := NewScheduler(mock)
+	_, _, err := svc.GetJobsV1(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get scheduler jobs")
+}
+
+func TestUnit_Scheduler_GetJobTriggersV1_Error(t *testing.T) {
+	mock := mocks.NewSchedulerMock()
+	svc := NewScheduler(mock)
+	_, _, err := svc.GetJobTriggersV1(context.Background(), "EXAMPLE_JOB", nil)
+	require.Error(t, err)
+}
+
+func TestUnit_Scheduler_GetJobTriggersV1_MergePageError(t *testing.T) {
+	mock := mocks.NewSchedulerMock()
+	mock.RegisterTriggersInvalidMock()
+	svc := NewScheduler(mock)
+	_, _, err := svc.GetJobTriggersV1(context.Background(), "EXAMPLE_JOB", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get triggers for scheduler job EXAMPLE_JOB")
+}
+
+func TestUnit_Scheduler_GetSummaryV1_Error(t *testing.T) {
+	mock := mocks.NewSchedulerMock()
+	svc := NewScheduler(mock)
+	_, _, err := svc.GetSummaryV1(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get scheduler summary")
+}
+
+func TestUnit_Scheduler_NewService(t *testing.T) {
+	mock := mocks.NewSchedulerMock()
+	svc := NewScheduler(mock)
+	require.NotNil(t, svc)
+}
@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the scheduler
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/scheduler"
+	"resty.dev/v3"
+)
+
+// SchedulerFake is a scheduler.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SchedulerFake struct {
+	fakes.Journal
+
+	GetJobTriggersV1Func func(ctx context.Context, jobKey string, rsqlQuery map[string]string) (*scheduler.TriggersResponse, *resty.Response, error)
+	GetJobsV1Func        func(ctx context.Context) (*scheduler.JobsResponse, *resty.Response, error)
+	GetSummaryV1Func     func(ctx context.Context) (*scheduler.ResourceSummary, *resty.Response, error)
+}
+
+var _ scheduler.Service = (*SchedulerFake)(nil)
+
+// GetJobTriggersV1 records the call and delegates to GetJobTriggersV1Func.
+func (f *SchedulerFake) GetJobTriggersV1(ctx context.Context, jobKey string, rsqlQuery map[string]string) (*scheduler.TriggersResponse, *resty.Response, error) {
+	f.Record("GetJobTriggersV1", ctx, jobKey, rsqlQuery)
+	if f.GetJobTriggersV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SchedulerFake.GetJobTriggersV1")
+	}
+	return f.GetJobTriggersV1Func(ctx, jobKey, rsqlQuery)
+}
+
+// GetJobsV1 records the call and delegates to GetJobsV1Func.
+func (f *SchedulerFake) GetJobsV1(ctx context.Context) (*scheduler.JobsResponse, *resty.Response, error) {
+	f.Record("GetJobsV1", ctx)
+	if f.GetJobsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SchedulerFake.GetJobsV1")
+	}
+	return f.GetJobsV1Func(ctx)
+}
+
+// GetSummaryV1 records the call and delegates to GetSummaryV1Func.
+func (f *SchedulerFake) GetSummaryV1(ctx context.Context) (*scheduler.ResourceSummary, *resty.Response, error) {
+	f.Record("GetSummaryV1", ctx)
+	if f.GetSummaryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SchedulerFake.GetSummaryV1")
+	}
+	return f.GetSummaryV1Func(ctx)
+}
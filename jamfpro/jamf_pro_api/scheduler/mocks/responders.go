@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type SchedulerMock struct {
+	*mocks.GenericMock
+}
+
+func NewSchedulerMock() *SchedulerMock {
+	return &SchedulerMock{
+		GenericMock: mocks.NewJSONMock("SchedulerMock"),
+	}
+}
+
+func (m *SchedulerMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/scheduler/jobs", 200, "validate_jobs.json")
+	m.Register("GET", "/api/v1/scheduler/jobs/EXAMPLE_JOB/triggers", 200, "validate_triggers.json")
+	m.Register("GET", "/api/v1/scheduler/summary", 200, "validate_summary.json")
+}
+
+func (m *SchedulerMock) RegisterTriggersInvalidMock() {
+	m.Register("GET", "/api/v1/scheduler/jobs/EXAMPLE_JOB/triggers", 200, "validate_triggers_invalid.json")
+}
@@ -0,0 +1,33 @@
+package scheduler
+
+// JobsResponse is the response for GetJobsV1.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-jobs
+type JobsResponse struct {
+	JobKeys []string `json:"jobKeys"`
+}
+
+// ResourceTrigger represents a single trigger of a Jamf Pro Scheduler job.
+type ResourceTrigger struct {
+	TriggerKey       string `json:"triggerKey"`
+	PreviousFireTime string `json:"previousFireTime"`
+	NextFireTime     string `json:"nextFireTime"`
+}
+
+// TriggersResponse is the response for GetJobTriggersV1.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-jobs-jobkey-triggers
+type TriggersResponse struct {
+	TotalCount int               `json:"totalCount"`
+	Results    []ResourceTrigger `json:"results"`
+}
+
+// ResourceSummary represents the state of the Jamf Pro Scheduler.
+//
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-scheduler-summary
+type ResourceSummary struct {
+	NumberOfPendingJobs   int  `json:"numberOfPendingJobs"`
+	NumberOfExecutingJobs int  `json:"numberOfExecutingJobs"`
+	NumberOfExecutedJobs  int  `json:"numberOfExecutedJobs"`
+	Started               bool `json:"started"`
+}
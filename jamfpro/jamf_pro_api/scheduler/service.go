@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package scheduler
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Scheduler.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SchedulerFake implements it for tests.
+type Service interface {
+	GetJobTriggersV1(ctx context.Context, jobKey string, rsqlQuery map[string]string) (*TriggersResponse, *resty.Response, error)
+	GetJobsV1(ctx context.Context) (*JobsResponse, *resty.Response, error)
+	GetSummaryV1(ctx context.Context) (*ResourceSummary, *resty.Response, error)
+}
+
+var _ Service = (*Scheduler)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the scripts
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/scripts"
+	"resty.dev/v3"
+)
+
+// ScriptsFake is a scripts.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type ScriptsFake struct {
+	fakes.Journal
+
+	AddScriptHistoryNotesV1Func func(ctx context.Context, id string, req *scripts.AddScriptHistoryNotesRequest) (*resty.Response, error)
+	CreateScriptV1Func          func(ctx context.Context, request *scripts.RequestScript) (*scripts.CreateResponse, *resty.Response, error)
+	DeleteScriptByIDV1Func      func(ctx context.Context, id string) (*resty.Response, error)
+	DownloadScriptByIDV1Func    func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetScriptByIDV1Func         func(ctx context.Context, id string) (*scripts.ResourceScript, *resty.Response, error)
+	GetScriptHistoryV1Func      func(ctx context.Context, id string, rsqlQuery map[string]string) (*scripts.ScriptHistoryResponse, *resty.Response, error)
+	ListScriptsV1Func           func(ctx context.Context, rsqlQuery map[string]string) (*scripts.ListResponse, *resty.Response, error)
+	UpdateScriptByIDV1Func      func(ctx context.Context, id string, request *scripts.RequestScript) (*scripts.ResourceScript, *resty.Response, error)
+}
+
+var _ scripts.Service = (*ScriptsFake)(nil)
+
+// AddScriptHistoryNotesV1 records the call and delegates to AddScriptHistoryNotesV1Func.
+func (f *ScriptsFake) AddScriptHistoryNotesV1(ctx context.Context, id string, req *scripts.AddScriptHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddScriptHistoryNotesV1", ctx, id, req)
+	if f.AddScriptHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("ScriptsFake.AddScriptHistoryNotesV1")
+	}
+	return f.AddScriptHistoryNotesV1Func(ctx, id, req)
+}
+
+// CreateScriptV1 records the call and delegates to CreateScriptV1Func.
+func (f *ScriptsFake) CreateScriptV1(ctx context.Context, request *scripts.RequestScript) (*scripts.CreateResponse, *resty.Response, error) {
+	f.Record("CreateScriptV1", ctx, request)
+	if f.CreateScriptV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.CreateScriptV1")
+	}
+	return f.CreateScriptV1Func(ctx, request)
+}
+
+// DeleteScriptByIDV1 records the call and delegates to DeleteScriptByIDV1Func.
+func (f *ScriptsFake) DeleteScriptByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteScriptByIDV1", ctx, id)
+	if f.DeleteScriptByIDV1Func == nil {
+		return nil, fakes.NotStubbed("ScriptsFake.DeleteScriptByIDV1")
+	}
+	return f.DeleteScriptByIDV1Func(ctx, id)
+}
+
+// DownloadScriptByIDV1 records the call and delegates to DownloadScriptByIDV1Func.
+func (f *ScriptsFake) DownloadScriptByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("DownloadScriptByIDV1", ctx, id)
+	if f.DownloadScriptByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.DownloadScriptByIDV1")
+	}
+	return f.DownloadScriptByIDV1Func(ctx, id)
+}
+
+// GetScriptByIDV1 records the call and delegates to GetScriptByIDV1Func.
+func (f *ScriptsFake) GetScriptByIDV1(ctx context.Context, id string) (*scripts.ResourceScript, *resty.Response, error) {
+	f.Record("GetScriptByIDV1", ctx, id)
+	if f.GetScriptByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.GetScriptByIDV1")
+	}
+	return f.GetScriptByIDV1Func(ctx, id)
+}
+
+// GetScriptHistoryV1 records the call and delegates to GetScriptHistoryV1Func.
+func (f *ScriptsFake) GetScriptHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*scripts.ScriptHistoryResponse, *resty.Response, error) {
+	f.Record("GetScriptHistoryV1", ctx, id, rsqlQuery)
+	if f.GetScriptHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.GetScriptHistoryV1")
+	}
+	return f.GetScriptHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// ListScriptsV1 records the call and delegates to ListScriptsV1Func.
+func (f *ScriptsFake) ListScriptsV1(ctx context.Context, rsqlQuery map[string]string) (*scripts.ListResponse, *resty.Response, error) {
+	f.Record("ListScriptsV1", ctx, rsqlQuery)
+	if f.ListScriptsV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.ListScriptsV1")
+	}
+	return f.ListScriptsV1Func(ctx, rsqlQuery)
+}
+
+// UpdateScriptByIDV1 records the call and delegates to UpdateScriptByIDV1Func.
+func (f *ScriptsFake) UpdateScriptByIDV1(ctx context.Context, id string, request *scripts.RequestScript) (*scripts.ResourceScript, *resty.Response, error) {
+	f.Record("UpdateScriptByIDV1", ctx, id, request)
+	if f.UpdateScriptByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("ScriptsFake.UpdateScriptByIDV1")
+	}
+	return f.UpdateScriptByIDV1Func(ctx, id, request)
+}
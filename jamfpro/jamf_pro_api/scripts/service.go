@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package scripts
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Scripts.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ScriptsFake implements it for tests.
+type Service interface {
+	AddScriptHistoryNotesV1(ctx context.Context, id string, req *AddScriptHistoryNotesRequest) (*resty.Response, error)
+	CreateScriptV1(ctx context.Context, request *RequestScript) (*CreateResponse, *resty.Response, error)
+	DeleteScriptByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DownloadScriptByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetScriptByIDV1(ctx context.Context, id string) (*ResourceScript, *resty.Response, error)
+	GetScriptHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*ScriptHistoryResponse, *resty.Response, error)
+	ListScriptsV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateScriptByIDV1(ctx context.Context, id string, request *RequestScript) (*ResourceScript, *resty.Response, error)
+}
+
+var _ Service = (*Scripts)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the self_service_branding_ios
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_branding_ios"
+	"resty.dev/v3"
+)
+
+// SelfServiceBrandingIosFake is a self_service_branding_ios.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SelfServiceBrandingIosFake struct {
+	fakes.Journal
+
+	CreateV1Func       func(ctx context.Context, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func   func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1Func func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1Func      func(ctx context.Context, id string) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	GetByNameV1Func    func(ctx context.Context, name string) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	ListV1Func         func(ctx context.Context, rsqlQuery map[string]string) (*self_service_branding_ios.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func   func(ctx context.Context, id string, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	UpdateByNameV1Func func(ctx context.Context, name string, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error)
+}
+
+var _ self_service_branding_ios.Service = (*SelfServiceBrandingIosFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *SelfServiceBrandingIosFake) CreateV1(ctx context.Context, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *SelfServiceBrandingIosFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("SelfServiceBrandingIosFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DeleteByNameV1 records the call and delegates to DeleteByNameV1Func.
+func (f *SelfServiceBrandingIosFake) DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByNameV1", ctx, name)
+	if f.DeleteByNameV1Func == nil {
+		return nil, fakes.NotStubbed("SelfServiceBrandingIosFake.DeleteByNameV1")
+	}
+	return f.DeleteByNameV1Func(ctx, name)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *SelfServiceBrandingIosFake) GetByIDV1(ctx context.Context, id string) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *SelfServiceBrandingIosFake) GetByNameV1(ctx context.Context, name string) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *SelfServiceBrandingIosFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*self_service_branding_ios.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *SelfServiceBrandingIosFake) UpdateByIDV1(ctx context.Context, id string, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UpdateByNameV1 records the call and delegates to UpdateByNameV1Func.
+func (f *SelfServiceBrandingIosFake) UpdateByNameV1(ctx context.Context, name string, request *self_service_branding_ios.ResourceSelfServiceBrandingMobile) (*self_service_branding_ios.ResourceSelfServiceBrandingMobile, *resty.Response, error) {
+	f.Record("UpdateByNameV1", ctx, name, request)
+	if f.UpdateByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingIosFake.UpdateByNameV1")
+	}
+	return f.UpdateByNameV1Func(ctx, name, request)
+}
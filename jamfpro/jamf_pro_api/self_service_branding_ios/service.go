@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package self_service_branding_ios
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SelfServiceBrandingIos.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SelfServiceBrandingIosFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *ResourceSelfServiceBrandingMobile) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameV1(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *ResourceSelfServiceBrandingMobile) (*ResourceSelfServiceBrandingMobile, *resty.Response, error)
+	UpdateByNameV1(ctx context.Context, name string, request *ResourceSelfServiceBrandingMobile) (*ResourceSelfServiceBrandingMobile, *resty.Response, error)
+}
+
+var _ Service = (*SelfServiceBrandingIos)(nil)
@@ -0,0 +1,103 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the self_service_branding_macos
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_branding_macos"
+	"resty.dev/v3"
+)
+
+// SelfServiceBrandingMacosFake is a self_service_branding_macos.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SelfServiceBrandingMacosFake struct {
+	fakes.Journal
+
+	CreateFunc       func(ctx context.Context, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	DeleteByIDFunc   func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByNameFunc func(ctx context.Context, name string) (*resty.Response, error)
+	GetByIDFunc      func(ctx context.Context, id string) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	GetByNameFunc    func(ctx context.Context, name string) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	ListFunc         func(ctx context.Context, rsqlQuery map[string]string) (*self_service_branding_macos.ListResponse, *resty.Response, error)
+	UpdateByIDFunc   func(ctx context.Context, id string, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	UpdateByNameFunc func(ctx context.Context, name string, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+}
+
+var _ self_service_branding_macos.Service = (*SelfServiceBrandingMacosFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *SelfServiceBrandingMacosFake) Create(ctx context.Context, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *SelfServiceBrandingMacosFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteByName records the call and delegates to DeleteByNameFunc.
+func (f *SelfServiceBrandingMacosFake) DeleteByName(ctx context.Context, name string) (*resty.Response, error) {
+	f.Record("DeleteByName", ctx, name)
+	if f.DeleteByNameFunc == nil {
+		return nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.DeleteByName")
+	}
+	return f.DeleteByNameFunc(ctx, name)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *SelfServiceBrandingMacosFake) GetByID(ctx context.Context, id string) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *SelfServiceBrandingMacosFake) GetByName(ctx context.Context, name string) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *SelfServiceBrandingMacosFake) List(ctx context.Context, rsqlQuery map[string]string) (*self_service_branding_macos.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx, rsqlQuery)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.List")
+	}
+	return f.ListFunc(ctx, rsqlQuery)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *SelfServiceBrandingMacosFake) UpdateByID(ctx context.Context, id string, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
+
+// UpdateByName records the call and delegates to UpdateByNameFunc.
+func (f *SelfServiceBrandingMacosFake) UpdateByName(ctx context.Context, name string, request *self_service_branding_macos.ResourceSelfServiceBrandingMacOS) (*self_service_branding_macos.ResourceSelfServiceBrandingMacOS, *resty.Response, error) {
+	f.Record("UpdateByName", ctx, name, request)
+	if f.UpdateByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingMacosFake.UpdateByName")
+	}
+	return f.UpdateByNameFunc(ctx, name, request)
+}
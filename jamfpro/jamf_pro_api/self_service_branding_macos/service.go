@@ -0,0 +1,25 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package self_service_branding_macos
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SelfServiceBrandingMacos.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SelfServiceBrandingMacosFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *ResourceSelfServiceBrandingMacOS) (*ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	DeleteByName(ctx context.Context, name string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	List(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *ResourceSelfServiceBrandingMacOS) (*ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+	UpdateByName(ctx context.Context, name string, request *ResourceSelfServiceBrandingMacOS) (*ResourceSelfServiceBrandingMacOS, *resty.Response, error)
+}
+
+var _ Service = (*SelfServiceBrandingMacos)(nil)
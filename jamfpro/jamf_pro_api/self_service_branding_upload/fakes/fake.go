@@ -0,0 +1,44 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the self_service_branding_upload
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+	"io"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_branding_upload"
+	"resty.dev/v3"
+)
+
+// SelfServiceBrandingUploadFake is a self_service_branding_upload.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SelfServiceBrandingUploadFake struct {
+	fakes.Journal
+
+	UploadFunc         func(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*self_service_branding_upload.ResourceBrandingImage, *resty.Response, error)
+	UploadFromFileFunc func(ctx context.Context, filePath string) (*self_service_branding_upload.ResourceBrandingImage, *resty.Response, error)
+}
+
+var _ self_service_branding_upload.Service = (*SelfServiceBrandingUploadFake)(nil)
+
+// Upload records the call and delegates to UploadFunc.
+func (f *SelfServiceBrandingUploadFake) Upload(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*self_service_branding_upload.ResourceBrandingImage, *resty.Response, error) {
+	f.Record("Upload", ctx, fileReader, fileSize, fileName)
+	if f.UploadFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingUploadFake.Upload")
+	}
+	return f.UploadFunc(ctx, fileReader, fileSize, fileName)
+}
+
+// UploadFromFile records the call and delegates to UploadFromFileFunc.
+func (f *SelfServiceBrandingUploadFake) UploadFromFile(ctx context.Context, filePath string) (*self_service_branding_upload.ResourceBrandingImage, *resty.Response, error) {
+	f.Record("UploadFromFile", ctx, filePath)
+	if f.UploadFromFileFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceBrandingUploadFake.UploadFromFile")
+	}
+	return f.UploadFromFileFunc(ctx, filePath)
+}
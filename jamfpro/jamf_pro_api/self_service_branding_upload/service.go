@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package self_service_branding_upload
+
+import (
+	"context"
+	"io"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SelfServiceBrandingUpload.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SelfServiceBrandingUploadFake implements it for tests.
+type Service interface {
+	Upload(ctx context.Context, fileReader io.Reader, fileSize int64, fileName string) (*ResourceBrandingImage, *resty.Response, error)
+	UploadFromFile(ctx context.Context, filePath string) (*ResourceBrandingImage, *resty.Response, error)
+}
+
+var _ Service = (*SelfServiceBrandingUpload)(nil)
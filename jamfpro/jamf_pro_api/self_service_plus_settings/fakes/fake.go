@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the self_service_plus_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_plus_settings"
+	"resty.dev/v3"
+)
+
+// SelfServicePlusSettingsFake is a self_service_plus_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SelfServicePlusSettingsFake struct {
+	fakes.Journal
+
+	GetFeatureToggleEnabledV1Func func(ctx context.Context) (bool, *resty.Response, error)
+	GetV1Func                     func(ctx context.Context) (*self_service_plus_settings.ResourceSelfServicePlusSettings, *resty.Response, error)
+	UpdateV1Func                  func(ctx context.Context, request *self_service_plus_settings.ResourceSelfServicePlusSettings) (*self_service_plus_settings.ResourceSelfServicePlusSettings, *resty.Response, error)
+}
+
+var _ self_service_plus_settings.Service = (*SelfServicePlusSettingsFake)(nil)
+
+// GetFeatureToggleEnabledV1 records the call and delegates to GetFeatureToggleEnabledV1Func.
+func (f *SelfServicePlusSettingsFake) GetFeatureToggleEnabledV1(ctx context.Context) (bool, *resty.Response, error) {
+	f.Record("GetFeatureToggleEnabledV1", ctx)
+	if f.GetFeatureToggleEnabledV1Func == nil {
+		return false, nil, fakes.NotStubbed("SelfServicePlusSettingsFake.GetFeatureToggleEnabledV1")
+	}
+	return f.GetFeatureToggleEnabledV1Func(ctx)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *SelfServicePlusSettingsFake) GetV1(ctx context.Context) (*self_service_plus_settings.ResourceSelfServicePlusSettings, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServicePlusSettingsFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *SelfServicePlusSettingsFake) UpdateV1(ctx context.Context, request *self_service_plus_settings.ResourceSelfServicePlusSettings) (*self_service_plus_settings.ResourceSelfServicePlusSettings, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServicePlusSettingsFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
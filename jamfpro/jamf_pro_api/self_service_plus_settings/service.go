@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package self_service_plus_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SelfServicePlusSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SelfServicePlusSettingsFake implements it for tests.
+type Service interface {
+	GetFeatureToggleEnabledV1(ctx context.Context) (bool, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceSelfServicePlusSettings, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *ResourceSelfServicePlusSettings) (*ResourceSelfServicePlusSettings, *resty.Response, error)
+}
+
+var _ Service = (*SelfServicePlusSettings)(nil)
@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the self_service_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_settings"
+	"resty.dev/v3"
+)
+
+// SelfServiceSettingsFake is a self_service_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SelfServiceSettingsFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func func(ctx context.Context, req *self_service_settings.AddHistoryNotesRequest) (*self_service_settings.AddHistoryNotesResponse, *resty.Response, error)
+	GetFunc               func(ctx context.Context) (*self_service_settings.ResourceSelfServiceSettings, *resty.Response, error)
+	GetHistoryV1Func      func(ctx context.Context, rsqlQuery map[string]string) (*self_service_settings.HistoryResponse, *resty.Response, error)
+	UpdateFunc            func(ctx context.Context, request *self_service_settings.ResourceSelfServiceSettings) (*self_service_settings.ResourceSelfServiceSettings, *resty.Response, error)
+}
+
+var _ self_service_settings.Service = (*SelfServiceSettingsFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *SelfServiceSettingsFake) AddHistoryNotesV1(ctx context.Context, req *self_service_settings.AddHistoryNotesRequest) (*self_service_settings.AddHistoryNotesResponse, *resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, req)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceSettingsFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, req)
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *SelfServiceSettingsFake) Get(ctx context.Context) (*self_service_settings.ResourceSelfServiceSettings, *resty.Response, error) {
+	f.Record("Get", ctx)
+	if f.GetFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceSettingsFake.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *SelfServiceSettingsFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*self_service_settings.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceSettingsFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// Update records the call and delegates to UpdateFunc.
+func (f *SelfServiceSettingsFake) Update(ctx context.Context, request *self_service_settings.ResourceSelfServiceSettings) (*self_service_settings.ResourceSelfServiceSettings, *resty.Response, error) {
+	f.Record("Update", ctx, request)
+	if f.UpdateFunc == nil {
+		return nil, nil, fakes.NotStubbed("SelfServiceSettingsFake.Update")
+	}
+	return f.UpdateFunc(ctx, request)
+}
@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package self_service_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SelfServiceSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SelfServiceSettingsFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, req *AddHistoryNotesRequest) (*AddHistoryNotesResponse, *resty.Response, error)
+	Get(ctx context.Context) (*ResourceSelfServiceSettings, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	Update(ctx context.Context, request *ResourceSelfServiceSettings) (*ResourceSelfServiceSettings, *resty.Response, error)
+}
+
+var _ Service = (*SelfServiceSettings)(nil)
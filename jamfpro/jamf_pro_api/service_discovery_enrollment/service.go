@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package service_discovery_enrollment
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of ServiceDiscoveryEnrollment.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.ServiceDiscoveryEnrollmentFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*WellKnownSettingsResponseV1, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *WellKnownSettingsResponseV1) (*WellKnownSettingsResponseV1, *resty.Response, error)
+}
+
+var _ Service = (*ServiceDiscoveryEnrollment)(nil)
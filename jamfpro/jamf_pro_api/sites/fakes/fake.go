@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the sites
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sites"
+	"resty.dev/v3"
+)
+
+// SitesFake is a sites.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SitesFake struct {
+	fakes.Journal
+
+	GetObjectsByIDV1Func func(ctx context.Context, id string, rsqlQuery map[string]string) (*sites.ObjectsListResponse, *resty.Response, error)
+	ListV1Func           func(ctx context.Context) ([]sites.ResourceSite, *resty.Response, error)
+}
+
+var _ sites.Service = (*SitesFake)(nil)
+
+// GetObjectsByIDV1 records the call and delegates to GetObjectsByIDV1Func.
+func (f *SitesFake) GetObjectsByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*sites.ObjectsListResponse, *resty.Response, error) {
+	f.Record("GetObjectsByIDV1", ctx, id, rsqlQuery)
+	if f.GetObjectsByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SitesFake.GetObjectsByIDV1")
+	}
+	return f.GetObjectsByIDV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *SitesFake) ListV1(ctx context.Context) ([]sites.ResourceSite, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SitesFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
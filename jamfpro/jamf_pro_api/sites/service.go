@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package sites
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Sites.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SitesFake implements it for tests.
+type Service interface {
+	GetObjectsByIDV1(ctx context.Context, id string, rsqlQuery map[string]string) (*ObjectsListResponse, *resty.Response, error)
+	ListV1(ctx context.Context) ([]ResourceSite, *resty.Response, error)
+}
+
+var _ Service = (*Sites)(nil)
@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the slasa
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/slasa"
+	"resty.dev/v3"
+)
+
+// SlasaFake is a slasa.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SlasaFake struct {
+	fakes.Journal
+
+	AcceptV1Func    func(ctx context.Context) (*resty.Response, error)
+	GetStatusV1Func func(ctx context.Context) (*slasa.ResourceSLASAStatus, *resty.Response, error)
+}
+
+var _ slasa.Service = (*SlasaFake)(nil)
+
+// AcceptV1 records the call and delegates to AcceptV1Func.
+func (f *SlasaFake) AcceptV1(ctx context.Context) (*resty.Response, error) {
+	f.Record("AcceptV1", ctx)
+	if f.AcceptV1Func == nil {
+		return nil, fakes.NotStubbed("SlasaFake.AcceptV1")
+	}
+	return f.AcceptV1Func(ctx)
+}
+
+// GetStatusV1 records the call and delegates to GetStatusV1Func.
+func (f *SlasaFake) GetStatusV1(ctx context.Context) (*slasa.ResourceSLASAStatus, *resty.Response, error) {
+	f.Record("GetStatusV1", ctx)
+	if f.GetStatusV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SlasaFake.GetStatusV1")
+	}
+	return f.GetStatusV1Func(ctx)
+}
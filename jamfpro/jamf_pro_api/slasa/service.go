@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package slasa
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Slasa.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SlasaFake implements it for tests.
+type Service interface {
+	AcceptV1(ctx context.Context) (*resty.Response, error)
+	GetStatusV1(ctx context.Context) (*ResourceSLASAStatus, *resty.Response, error)
+}
+
+var _ Service = (*Slasa)(nil)
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the smart_computer_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_computer_groups"
+	"resty.dev/v3"
+)
+
+// SmartComputerGroupsFake is a smart_computer_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SmartComputerGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc        func(ctx context.Context, request *smart_computer_groups.RequestSmartGroup) (*smart_computer_groups.CreateResponse, *resty.Response, error)
+	DeleteByIDFunc    func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc       func(ctx context.Context, id string) (*smart_computer_groups.ResourceSmartGroup, *resty.Response, error)
+	GetByNameFunc     func(ctx context.Context, name string) (*smart_computer_groups.ListItem, *resty.Response, error)
+	GetMembershipFunc func(ctx context.Context, id string) (*smart_computer_groups.MembershipResponse, *resty.Response, error)
+	ListFunc          func(ctx context.Context, rsqlQuery map[string]string) (*smart_computer_groups.ListResponse, *resty.Response, error)
+	UpdateByIDFunc    func(ctx context.Context, id string, request *smart_computer_groups.RequestSmartGroup) (*smart_computer_groups.ResourceSmartGroup, *resty.Response, error)
+}
+
+var _ smart_computer_groups.Service = (*SmartComputerGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *SmartComputerGroupsFake) Create(ctx context.Context, request *smart_computer_groups.RequestSmartGroup) (*smart_computer_groups.CreateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *SmartComputerGroupsFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("SmartComputerGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *SmartComputerGroupsFake) GetByID(ctx context.Context, id string) (*smart_computer_groups.ResourceSmartGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *SmartComputerGroupsFake) GetByName(ctx context.Context, name string) (*smart_computer_groups.ListItem, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetMembership records the call and delegates to GetMembershipFunc.
+func (f *SmartComputerGroupsFake) GetMembership(ctx context.Context, id string) (*smart_computer_groups.MembershipResponse, *resty.Response, error) {
+	f.Record("GetMembership", ctx, id)
+	if f.GetMembershipFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.GetMembership")
+	}
+	return f.GetMembershipFunc(ctx, id)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *SmartComputerGroupsFake) List(ctx context.Context, rsqlQuery map[string]string) (*smart_computer_groups.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx, rsqlQuery)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.List")
+	}
+	return f.ListFunc(ctx, rsqlQuery)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *SmartComputerGroupsFake) UpdateByID(ctx context.Context, id string, request *smart_computer_groups.RequestSmartGroup) (*smart_computer_groups.ResourceSmartGroup, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartComputerGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package smart_computer_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SmartComputerGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SmartComputerGroupsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *RequestSmartGroup) (*CreateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResourceSmartGroup, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ListItem, *resty.Response, error)
+	GetMembership(ctx context.Context, id string) (*MembershipResponse, *resty.Response, error)
+	List(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *RequestSmartGroup) (*ResourceSmartGroup, *resty.Response, error)
+}
+
+var _ Service = (*SmartComputerGroups)(nil)
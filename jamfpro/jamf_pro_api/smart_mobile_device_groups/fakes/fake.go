@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the smart_mobile_device_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_mobile_device_groups"
+	"resty.dev/v3"
+)
+
+// SmartMobileDeviceGroupsFake is a smart_mobile_device_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SmartMobileDeviceGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc        func(ctx context.Context, request *smart_mobile_device_groups.RequestSmartMobileDeviceGroup) (*smart_mobile_device_groups.CreateResponse, *resty.Response, error)
+	DeleteByIDFunc    func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc       func(ctx context.Context, id string) (*smart_mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetByNameFunc     func(ctx context.Context, name string) (*smart_mobile_device_groups.ListItem, *resty.Response, error)
+	GetMembershipFunc func(ctx context.Context, id string, rsqlQuery map[string]string) (*smart_mobile_device_groups.MembershipResponse, *resty.Response, error)
+	ListFunc          func(ctx context.Context, rsqlQuery map[string]string) (*smart_mobile_device_groups.ListResponse, *resty.Response, error)
+	UpdateByIDFunc    func(ctx context.Context, id string, request *smart_mobile_device_groups.RequestSmartMobileDeviceGroup) (*smart_mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ smart_mobile_device_groups.Service = (*SmartMobileDeviceGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *SmartMobileDeviceGroupsFake) Create(ctx context.Context, request *smart_mobile_device_groups.RequestSmartMobileDeviceGroup) (*smart_mobile_device_groups.CreateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *SmartMobileDeviceGroupsFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *SmartMobileDeviceGroupsFake) GetByID(ctx context.Context, id string) (*smart_mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetByName records the call and delegates to GetByNameFunc.
+func (f *SmartMobileDeviceGroupsFake) GetByName(ctx context.Context, name string) (*smart_mobile_device_groups.ListItem, *resty.Response, error) {
+	f.Record("GetByName", ctx, name)
+	if f.GetByNameFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.GetByName")
+	}
+	return f.GetByNameFunc(ctx, name)
+}
+
+// GetMembership records the call and delegates to GetMembershipFunc.
+func (f *SmartMobileDeviceGroupsFake) GetMembership(ctx context.Context, id string, rsqlQuery map[string]string) (*smart_mobile_device_groups.MembershipResponse, *resty.Response, error) {
+	f.Record("GetMembership", ctx, id, rsqlQuery)
+	if f.GetMembershipFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.GetMembership")
+	}
+	return f.GetMembershipFunc(ctx, id, rsqlQuery)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *SmartMobileDeviceGroupsFake) List(ctx context.Context, rsqlQuery map[string]string) (*smart_mobile_device_groups.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx, rsqlQuery)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.List")
+	}
+	return f.ListFunc(ctx, rsqlQuery)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *SmartMobileDeviceGroupsFake) UpdateByID(ctx context.Context, id string, request *smart_mobile_device_groups.RequestSmartMobileDeviceGroup) (*smart_mobile_device_groups.ResourceSmartMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("SmartMobileDeviceGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package smart_mobile_device_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SmartMobileDeviceGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SmartMobileDeviceGroupsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *RequestSmartMobileDeviceGroup) (*CreateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+	GetByName(ctx context.Context, name string) (*ListItem, *resty.Response, error)
+	GetMembership(ctx context.Context, id string, rsqlQuery map[string]string) (*MembershipResponse, *resty.Response, error)
+	List(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *RequestSmartMobileDeviceGroup) (*ResourceSmartMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ Service = (*SmartMobileDeviceGroups)(nil)
@@ -0,0 +1,51 @@
+// Package smart_user_groups covers the smart user group endpoints of the Jamf
+// Pro API. Jamf Pro only exposes recalculation here; smart user group
+// definitions and criteria are managed through the Classic API
+// (classic_api/smart_user_groups).
+package smart_user_groups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the smart user groups-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-smart-user-groups-id-recalculate
+	SmartUserGroups struct {
+		client client.Client
+	}
+)
+
+func NewSmartUserGroups(client client.Client) *SmartUserGroups {
+	return &SmartUserGroups{client: client}
+}
+
+// RecalculateByIDV1 recalculates the membership of the specified smart user
+// group and returns the resulting member count.
+// URL: POST /api/v1/smart-user-groups/{id}/recalculate
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-smart-user-groups-id-recalculate
+func (s *SmartUserGroups) RecalculateByIDV1(ctx context.Context, id string) (*ResponseRecalculation, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("smart user group ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/recalculate", constants.EndpointJamfProSmartUserGroupsV1, id)
+
+	var result ResponseRecalculation
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to recalculate smart user group %s: %w", id, err)
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,44 @@
+package smart_user_groups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_user_groups/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*SmartUserGroups, *mocks.SmartUserGroupsMock) {
+	t.Helper()
+	mock := mocks.NewSmartUserGroupsMock()
+	mock.RegisterMocks()
+	return NewSmartUserGroups(mock), mock
+}
+
+func TestUnit_SmartUserGroups_RecalculateByIDV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.RecalculateByIDV1(context.Background(), "1")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 3, result.Count)
+}
+
+func TestUnit_SmartUserGroups_RecalculateByIDV1_EmptyID(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.RecalculateByIDV1(context.Background(), "")
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "smart user group ID is required")
+}
+
+func TestUnit_SmartUserGroups_RecalculateByIDV1_NotFound(t *testing.T) {
+	svc, mock := setupMockService(t)
+	mock.RegisterBadRequestErrorMock()
+	result, resp, err := svc.RecalculateByIDV1(context.Background(), "999")
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Equal(t, 400, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to recalculate smart user group 999")
+}
@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the smart_user_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_user_groups"
+	"resty.dev/v3"
+)
+
+// SmartUserGroupsFake is a smart_user_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SmartUserGroupsFake struct {
+	fakes.Journal
+
+	RecalculateByIDV1Func func(ctx context.Context, id string) (*smart_user_groups.ResponseRecalculation, *resty.Response, error)
+}
+
+var _ smart_user_groups.Service = (*SmartUserGroupsFake)(nil)
+
+// RecalculateByIDV1 records the call and delegates to RecalculateByIDV1Func.
+func (f *SmartUserGroupsFake) RecalculateByIDV1(ctx context.Context, id string) (*smart_user_groups.ResponseRecalculation, *resty.Response, error) {
+	f.Record("RecalculateByIDV1", ctx, id)
+	if f.RecalculateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SmartUserGroupsFake.RecalculateByIDV1")
+	}
+	return f.RecalculateByIDV1Func(ctx, id)
+}
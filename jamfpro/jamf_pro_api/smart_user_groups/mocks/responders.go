@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type SmartUserGroupsMock struct {
+	*mocks.GenericMock
+}
+
+func NewSmartUserGroupsMock() *SmartUserGroupsMock {
+	return &SmartUserGroupsMock{
+		GenericMock: mocks.NewJSONMock("SmartUserGroupsMock"),
+	}
+}
+
+func (m *SmartUserGroupsMock) RegisterMocks() {
+	m.Register("POST", "/api/v1/smart-user-groups/1/recalculate", 200, "validate_recalculate.json")
+}
+
+func (m *SmartUserGroupsMock) RegisterBadRequestErrorMock() {
+	m.RegisterError("POST", "/api/v1/smart-user-groups/999/recalculate", 400, "error_bad_request.json", "")
+}
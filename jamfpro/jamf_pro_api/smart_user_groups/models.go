@@ -0,0 +1,6 @@
+package smart_user_groups
+
+// ResponseRecalculation is the result of recalculating a smart user group.
+type ResponseRecalculation struct {
+	Count int `json:"count"`
+}
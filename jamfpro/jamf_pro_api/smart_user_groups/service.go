@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package smart_user_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SmartUserGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SmartUserGroupsFake implements it for tests.
+type Service interface {
+	RecalculateByIDV1(ctx context.Context, id string) (*ResponseRecalculation, *resty.Response, error)
+}
+
+var _ Service = (*SmartUserGroups)(nil)
@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the smtp_server
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smtp_server"
+	"resty.dev/v3"
+)
+
+// SmtpServerFake is a smtp_server.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SmtpServerFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func func(ctx context.Context, req *smtp_server.AddHistoryNoteRequest) (*smtp_server.AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1Func     func(ctx context.Context, rsqlQuery map[string]string) (*smtp_server.HistoryResponse, *resty.Response, error)
+	GetV2Func            func(ctx context.Context) (*smtp_server.ResourceSMTPServer, *resty.Response, error)
+	TestV1Func           func(ctx context.Context, req *smtp_server.TestRequest) (*resty.Response, error)
+	UpdateV2Func         func(ctx context.Context, request *smtp_server.ResourceSMTPServer) (*smtp_server.ResourceSMTPServer, *resty.Response, error)
+}
+
+var _ smtp_server.Service = (*SmtpServerFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *SmtpServerFake) AddHistoryNoteV1(ctx context.Context, req *smtp_server.AddHistoryNoteRequest) (*smtp_server.AddHistoryNoteResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, req)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SmtpServerFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, req)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *SmtpServerFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*smtp_server.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SmtpServerFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *SmtpServerFake) GetV2(ctx context.Context) (*smtp_server.ResourceSMTPServer, *resty.Response, error) {
+	f.Record("GetV2", ctx)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SmtpServerFake.GetV2")
+	}
+	return f.GetV2Func(ctx)
+}
+
+// TestV1 records the call and delegates to TestV1Func.
+func (f *SmtpServerFake) TestV1(ctx context.Context, req *smtp_server.TestRequest) (*resty.Response, error) {
+	f.Record("TestV1", ctx, req)
+	if f.TestV1Func == nil {
+		return nil, fakes.NotStubbed("SmtpServerFake.TestV1")
+	}
+	return f.TestV1Func(ctx, req)
+}
+
+// UpdateV2 records the call and delegates to UpdateV2Func.
+func (f *SmtpServerFake) UpdateV2(ctx context.Context, request *smtp_server.ResourceSMTPServer) (*smtp_server.ResourceSMTPServer, *resty.Response, error) {
+	f.Record("UpdateV2", ctx, request)
+	if f.UpdateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SmtpServerFake.UpdateV2")
+	}
+	return f.UpdateV2Func(ctx, request)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package smtp_server
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SmtpServer.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SmtpServerFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, req *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV2(ctx context.Context) (*ResourceSMTPServer, *resty.Response, error)
+	TestV1(ctx context.Context, req *TestRequest) (*resty.Response, error)
+	UpdateV2(ctx context.Context, request *ResourceSMTPServer) (*ResourceSMTPServer, *resty.Response, error)
+}
+
+var _ Service = (*SmtpServer)(nil)
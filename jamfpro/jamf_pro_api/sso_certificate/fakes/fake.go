@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the sso_certificate
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sso_certificate"
+	"resty.dev/v3"
+)
+
+// SsoCertificateFake is a sso_certificate.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SsoCertificateFake struct {
+	fakes.Journal
+
+	CreateV2Func   func(ctx context.Context) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error)
+	DeleteV2Func   func(ctx context.Context) (*resty.Response, error)
+	DownloadV2Func func(ctx context.Context) ([]byte, *resty.Response, error)
+	GetV2Func      func(ctx context.Context) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error)
+	ParseV2Func    func(ctx context.Context, request *sso_certificate.ParseKeystoreRequest) (*sso_certificate.ParseKeystoreResponse, *resty.Response, error)
+	UpdateV2Func   func(ctx context.Context, request *sso_certificate.UpdateKeystoreRequest) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error)
+}
+
+var _ sso_certificate.Service = (*SsoCertificateFake)(nil)
+
+// CreateV2 records the call and delegates to CreateV2Func.
+func (f *SsoCertificateFake) CreateV2(ctx context.Context) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error) {
+	f.Record("CreateV2", ctx)
+	if f.CreateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoCertificateFake.CreateV2")
+	}
+	return f.CreateV2Func(ctx)
+}
+
+// DeleteV2 records the call and delegates to DeleteV2Func.
+func (f *SsoCertificateFake) DeleteV2(ctx context.Context) (*resty.Response, error) {
+	f.Record("DeleteV2", ctx)
+	if f.DeleteV2Func == nil {
+		return nil, fakes.NotStubbed("SsoCertificateFake.DeleteV2")
+	}
+	return f.DeleteV2Func(ctx)
+}
+
+// DownloadV2 records the call and delegates to DownloadV2Func.
+func (f *SsoCertificateFake) DownloadV2(ctx context.Context) ([]byte, *resty.Response, error) {
+	f.Record("DownloadV2", ctx)
+	if f.DownloadV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoCertificateFake.DownloadV2")
+	}
+	return f.DownloadV2Func(ctx)
+}
+
+// GetV2 records the call and delegates to GetV2Func.
+func (f *SsoCertificateFake) GetV2(ctx context.Context) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error) {
+	f.Record("GetV2", ctx)
+	if f.GetV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoCertificateFake.GetV2")
+	}
+	return f.GetV2Func(ctx)
+}
+
+// ParseV2 records the call and delegates to ParseV2Func.
+func (f *SsoCertificateFake) ParseV2(ctx context.Context, request *sso_certificate.ParseKeystoreRequest) (*sso_certificate.ParseKeystoreResponse, *resty.Response, error) {
+	f.Record("ParseV2", ctx, request)
+	if f.ParseV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoCertificateFake.ParseV2")
+	}
+	return f.ParseV2Func(ctx, request)
+}
+
+// UpdateV2 records the call and delegates to UpdateV2Func.
+func (f *SsoCertificateFake) UpdateV2(ctx context.Context, request *sso_certificate.UpdateKeystoreRequest) (*sso_certificate.ResourceSSOKeystoreResponse, *resty.Response, error) {
+	f.Record("UpdateV2", ctx, request)
+	if f.UpdateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoCertificateFake.UpdateV2")
+	}
+	return f.UpdateV2Func(ctx, request)
+}
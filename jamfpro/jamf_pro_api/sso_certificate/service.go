@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package sso_certificate
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SsoCertificate.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SsoCertificateFake implements it for tests.
+type Service interface {
+	CreateV2(ctx context.Context) (*ResourceSSOKeystoreResponse, *resty.Response, error)
+	DeleteV2(ctx context.Context) (*resty.Response, error)
+	DownloadV2(ctx context.Context) ([]byte, *resty.Response, error)
+	GetV2(ctx context.Context) (*ResourceSSOKeystoreResponse, *resty.Response, error)
+	ParseV2(ctx context.Context, request *ParseKeystoreRequest) (*ParseKeystoreResponse, *resty.Response, error)
+	UpdateV2(ctx context.Context, request *UpdateKeystoreRequest) (*ResourceSSOKeystoreResponse, *resty.Response, error)
+}
+
+var _ Service = (*SsoCertificate)(nil)
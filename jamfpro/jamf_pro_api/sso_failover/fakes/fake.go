@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the sso_failover
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sso_failover"
+	"resty.dev/v3"
+)
+
+// SsoFailoverFake is a sso_failover.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SsoFailoverFake struct {
+	fakes.Journal
+
+	GetV1Func        func(ctx context.Context) (*sso_failover.FailoverSettings, *resty.Response, error)
+	RegenerateV1Func func(ctx context.Context) (*sso_failover.FailoverSettings, *resty.Response, error)
+}
+
+var _ sso_failover.Service = (*SsoFailoverFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *SsoFailoverFake) GetV1(ctx context.Context) (*sso_failover.FailoverSettings, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoFailoverFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// RegenerateV1 records the call and delegates to RegenerateV1Func.
+func (f *SsoFailoverFake) RegenerateV1(ctx context.Context) (*sso_failover.FailoverSettings, *resty.Response, error) {
+	f.Record("RegenerateV1", ctx)
+	if f.RegenerateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoFailoverFake.RegenerateV1")
+	}
+	return f.RegenerateV1Func(ctx)
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package sso_failover
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SsoFailover.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SsoFailoverFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*FailoverSettings, *resty.Response, error)
+	RegenerateV1(ctx context.Context) (*FailoverSettings, *resty.Response, error)
+}
+
+var _ Service = (*SsoFailover)(nil)
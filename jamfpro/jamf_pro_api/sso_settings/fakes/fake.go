@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the sso_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sso_settings"
+	"resty.dev/v3"
+)
+
+// SsoSettingsFake is a sso_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SsoSettingsFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV3Func                         func(ctx context.Context, request *sso_settings.AddHistoryNoteRequest) (*sso_settings.CreateResponse, *resty.Response, error)
+	DisableV3Func                                func(ctx context.Context) (*resty.Response, error)
+	DownloadMetadataV3Func                       func(ctx context.Context) ([]byte, *resty.Response, error)
+	GetEnrollmentCustomizationDependenciesV3Func func(ctx context.Context) (*sso_settings.ResponseSsoEnrollmentCustomizationDependencies, *resty.Response, error)
+	GetHistoryV3Func                             func(ctx context.Context, rsqlQuery map[string]string) (*sso_settings.HistoryListResponse, *resty.Response, error)
+	GetV3Func                                    func(ctx context.Context) (*sso_settings.ResourceSsoSettings, *resty.Response, error)
+	UpdateV3Func                                 func(ctx context.Context, request *sso_settings.ResourceSsoSettings) (*sso_settings.ResourceSsoSettings, *resty.Response, error)
+}
+
+var _ sso_settings.Service = (*SsoSettingsFake)(nil)
+
+// AddHistoryNoteV3 records the call and delegates to AddHistoryNoteV3Func.
+func (f *SsoSettingsFake) AddHistoryNoteV3(ctx context.Context, request *sso_settings.AddHistoryNoteRequest) (*sso_settings.CreateResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV3", ctx, request)
+	if f.AddHistoryNoteV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.AddHistoryNoteV3")
+	}
+	return f.AddHistoryNoteV3Func(ctx, request)
+}
+
+// DisableV3 records the call and delegates to DisableV3Func.
+func (f *SsoSettingsFake) DisableV3(ctx context.Context) (*resty.Response, error) {
+	f.Record("DisableV3", ctx)
+	if f.DisableV3Func == nil {
+		return nil, fakes.NotStubbed("SsoSettingsFake.DisableV3")
+	}
+	return f.DisableV3Func(ctx)
+}
+
+// DownloadMetadataV3 records the call and delegates to DownloadMetadataV3Func.
+func (f *SsoSettingsFake) DownloadMetadataV3(ctx context.Context) ([]byte, *resty.Response, error) {
+	f.Record("DownloadMetadataV3", ctx)
+	if f.DownloadMetadataV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.DownloadMetadataV3")
+	}
+	return f.DownloadMetadataV3Func(ctx)
+}
+
+// GetEnrollmentCustomizationDependenciesV3 records the call and delegates to GetEnrollmentCustomizationDependenciesV3Func.
+func (f *SsoSettingsFake) GetEnrollmentCustomizationDependenciesV3(ctx context.Context) (*sso_settings.ResponseSsoEnrollmentCustomizationDependencies, *resty.Response, error) {
+	f.Record("GetEnrollmentCustomizationDependenciesV3", ctx)
+	if f.GetEnrollmentCustomizationDependenciesV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.GetEnrollmentCustomizationDependenciesV3")
+	}
+	return f.GetEnrollmentCustomizationDependenciesV3Func(ctx)
+}
+
+// GetHistoryV3 records the call and delegates to GetHistoryV3Func.
+func (f *SsoSettingsFake) GetHistoryV3(ctx context.Context, rsqlQuery map[string]string) (*sso_settings.HistoryListResponse, *resty.Response, error) {
+	f.Record("GetHistoryV3", ctx, rsqlQuery)
+	if f.GetHistoryV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.GetHistoryV3")
+	}
+	return f.GetHistoryV3Func(ctx, rsqlQuery)
+}
+
+// GetV3 records the call and delegates to GetV3Func.
+func (f *SsoSettingsFake) GetV3(ctx context.Context) (*sso_settings.ResourceSsoSettings, *resty.Response, error) {
+	f.Record("GetV3", ctx)
+	if f.GetV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.GetV3")
+	}
+	return f.GetV3Func(ctx)
+}
+
+// UpdateV3 records the call and delegates to UpdateV3Func.
+func (f *SsoSettingsFake) UpdateV3(ctx context.Context, request *sso_settings.ResourceSsoSettings) (*sso_settings.ResourceSsoSettings, *resty.Response, error) {
+	f.Record("UpdateV3", ctx, request)
+	if f.UpdateV3Func == nil {
+		return nil, nil, fakes.NotStubbed("SsoSettingsFake.UpdateV3")
+	}
+	return f.UpdateV3Func(ctx, request)
+}
@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package sso_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SsoSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SsoSettingsFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV3(ctx context.Context, request *AddHistoryNoteRequest) (*CreateResponse, *resty.Response, error)
+	DisableV3(ctx context.Context) (*resty.Response, error)
+	DownloadMetadataV3(ctx context.Context) ([]byte, *resty.Response, error)
+	GetEnrollmentCustomizationDependenciesV3(ctx context.Context) (*ResponseSsoEnrollmentCustomizationDependencies, *resty.Response, error)
+	GetHistoryV3(ctx context.Context, rsqlQuery map[string]string) (*HistoryListResponse, *resty.Response, error)
+	GetV3(ctx context.Context) (*ResourceSsoSettings, *resty.Response, error)
+	UpdateV3(ctx context.Context, request *ResourceSsoSettings) (*ResourceSsoSettings, *resty.Response, error)
+}
+
+var _ Service = (*SsoSettings)(nil)
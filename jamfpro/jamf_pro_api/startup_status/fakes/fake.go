@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the startup_status
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/startup_status"
+	"resty.dev/v3"
+)
+
+// StartupStatusFake is a startup_status.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type StartupStatusFake struct {
+	fakes.Journal
+
+	GetV1Func func(ctx context.Context) (*startup_status.ResourceStartupStatusV1, *resty.Response, error)
+}
+
+var _ startup_status.Service = (*StartupStatusFake)(nil)
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *StartupStatusFake) GetV1(ctx context.Context) (*startup_status.ResourceStartupStatusV1, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("StartupStatusFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package startup_status
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of StartupStatus.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.StartupStatusFake implements it for tests.
+type Service interface {
+	GetV1(ctx context.Context) (*ResourceStartupStatusV1, *resty.Response, error)
+}
+
+var _ Service = (*StartupStatus)(nil)
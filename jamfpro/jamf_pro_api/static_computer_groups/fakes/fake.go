@@ -0,0 +1,83 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the static_computer_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_computer_groups"
+	"resty.dev/v3"
+)
+
+// StaticComputerGroupsFake is a static_computer_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type StaticComputerGroupsFake struct {
+	fakes.Journal
+
+	CreateV2Func     func(ctx context.Context, request *static_computer_groups.RequestStaticGroup) (*static_computer_groups.CreateResponse, *resty.Response, error)
+	DeleteByIDV2Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2Func    func(ctx context.Context, id string) (*static_computer_groups.ResourceStaticGroup, *resty.Response, error)
+	GetByNameV2Func  func(ctx context.Context, name string) (*static_computer_groups.ResourceStaticGroup, *resty.Response, error)
+	ListV2Func       func(ctx context.Context, rsqlQuery map[string]string) (*static_computer_groups.ListResponse, *resty.Response, error)
+	UpdateByIDV2Func func(ctx context.Context, id string, request *static_computer_groups.RequestStaticGroup) (*static_computer_groups.RequestStaticGroup, *resty.Response, error)
+}
+
+var _ static_computer_groups.Service = (*StaticComputerGroupsFake)(nil)
+
+// CreateV2 records the call and delegates to CreateV2Func.
+func (f *StaticComputerGroupsFake) CreateV2(ctx context.Context, request *static_computer_groups.RequestStaticGroup) (*static_computer_groups.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV2", ctx, request)
+	if f.CreateV2Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticComputerGroupsFake.CreateV2")
+	}
+	return f.CreateV2Func(ctx, request)
+}
+
+// DeleteByIDV2 records the call and delegates to DeleteByIDV2Func.
+func (f *StaticComputerGroupsFake) DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV2", ctx, id)
+	if f.DeleteByIDV2Func == nil {
+		return nil, fakes.NotStubbed("StaticComputerGroupsFake.DeleteByIDV2")
+	}
+	return f.DeleteByIDV2Func(ctx, id)
+}
+
+// GetByIDV2 records the call and delegates to GetByIDV2Func.
+func (f *StaticComputerGroupsFake) GetByIDV2(ctx context.Context, id string) (*static_computer_groups.ResourceStaticGroup, *resty.Response, error) {
+	f.Record("GetByIDV2", ctx, id)
+	if f.GetByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticComputerGroupsFake.GetByIDV2")
+	}
+	return f.GetByIDV2Func(ctx, id)
+}
+
+// GetByNameV2 records the call and delegates to GetByNameV2Func.
+func (f *StaticComputerGroupsFake) GetByNameV2(ctx context.Context, name string) (*static_computer_groups.ResourceStaticGroup, *resty.Response, error) {
+	f.Record("GetByNameV2", ctx, name)
+	if f.GetByNameV2Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticComputerGroupsFake.GetByNameV2")
+	}
+	return f.GetByNameV2Func(ctx, name)
+}
+
+// ListV2 records the call and delegates to ListV2Func.
+func (f *StaticComputerGroupsFake) ListV2(ctx context.Context, rsqlQuery map[string]string) (*static_computer_groups.ListResponse, *resty.Response, error) {
+	f.Record("ListV2", ctx, rsqlQuery)
+	if f.ListV2Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticComputerGroupsFake.ListV2")
+	}
+	return f.ListV2Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV2 records the call and delegates to UpdateByIDV2Func.
+func (f *StaticComputerGroupsFake) UpdateByIDV2(ctx context.Context, id string, request *static_computer_groups.RequestStaticGroup) (*static_computer_groups.RequestStaticGroup, *resty.Response, error) {
+	f.Record("UpdateByIDV2", ctx, id, request)
+	if f.UpdateByIDV2Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticComputerGroupsFake.UpdateByIDV2")
+	}
+	return f.UpdateByIDV2Func(ctx, id, request)
+}
@@ -0,0 +1,23 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package static_computer_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of StaticComputerGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.StaticComputerGroupsFake implements it for tests.
+type Service interface {
+	CreateV2(ctx context.Context, request *RequestStaticGroup) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV2(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV2(ctx context.Context, id string) (*ResourceStaticGroup, *resty.Response, error)
+	GetByNameV2(ctx context.Context, name string) (*ResourceStaticGroup, *resty.Response, error)
+	ListV2(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV2(ctx context.Context, id string, request *RequestStaticGroup) (*RequestStaticGroup, *resty.Response, error)
+}
+
+var _ Service = (*StaticComputerGroups)(nil)
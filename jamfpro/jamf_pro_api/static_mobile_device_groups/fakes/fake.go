@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the static_mobile_device_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_mobile_device_groups"
+	"resty.dev/v3"
+)
+
+// StaticMobileDeviceGroupsFake is a static_mobile_device_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type StaticMobileDeviceGroupsFake struct {
+	fakes.Journal
+
+	CreateFunc     func(ctx context.Context, request *static_mobile_device_groups.RequestStaticMobileDeviceGroup) (*static_mobile_device_groups.CreateResponse, *resty.Response, error)
+	DeleteByIDFunc func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc    func(ctx context.Context, id string) (*static_mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	ListFunc       func(ctx context.Context, rsqlQuery map[string]string) (*static_mobile_device_groups.ListResponse, *resty.Response, error)
+	UpdateByIDFunc func(ctx context.Context, id string, request *static_mobile_device_groups.RequestStaticMobileDeviceGroup) (*static_mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ static_mobile_device_groups.Service = (*StaticMobileDeviceGroupsFake)(nil)
+
+// Create records the call and delegates to CreateFunc.
+func (f *StaticMobileDeviceGroupsFake) Create(ctx context.Context, request *static_mobile_device_groups.RequestStaticMobileDeviceGroup) (*static_mobile_device_groups.CreateResponse, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticMobileDeviceGroupsFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *StaticMobileDeviceGroupsFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("StaticMobileDeviceGroupsFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *StaticMobileDeviceGroupsFake) GetByID(ctx context.Context, id string) (*static_mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticMobileDeviceGroupsFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// List records the call and delegates to ListFunc.
+func (f *StaticMobileDeviceGroupsFake) List(ctx context.Context, rsqlQuery map[string]string) (*static_mobile_device_groups.ListResponse, *resty.Response, error) {
+	f.Record("List", ctx, rsqlQuery)
+	if f.ListFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticMobileDeviceGroupsFake.List")
+	}
+	return f.ListFunc(ctx, rsqlQuery)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *StaticMobileDeviceGroupsFake) UpdateByID(ctx context.Context, id string, request *static_mobile_device_groups.RequestStaticMobileDeviceGroup) (*static_mobile_device_groups.ResourceStaticMobileDeviceGroup, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("StaticMobileDeviceGroupsFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
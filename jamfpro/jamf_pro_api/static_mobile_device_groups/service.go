@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package static_mobile_device_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of StaticMobileDeviceGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.StaticMobileDeviceGroupsFake implements it for tests.
+type Service interface {
+	Create(ctx context.Context, request *RequestStaticMobileDeviceGroup) (*CreateResponse, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+	List(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *RequestStaticMobileDeviceGroup) (*ResourceStaticMobileDeviceGroup, *resty.Response, error)
+}
+
+var _ Service = (*StaticMobileDeviceGroups)(nil)
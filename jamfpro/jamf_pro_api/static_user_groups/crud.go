@@ -0,0 +1,91 @@
+// Package static_user_groups covers the read-only static user group endpoints of
+// the Jamf Pro API. Creating, updating and deleting user groups, and their
+// membership, remain available only through the Classic API
+// (classic_api/static_user_groups).
+package static_user_groups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the static user groups-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-static-user-groups
+	StaticUserGroups struct {
+		client client.Client
+	}
+)
+
+func NewStaticUserGroups(client client.Client) *StaticUserGroups {
+	return &StaticUserGroups{client: client}
+}
+
+// ListV1 returns all static user groups. The endpoint is not paginated and
+// does not accept RSQL filters.
+// URL: GET /api/v1/static-user-groups
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-static-user-groups
+func (s *StaticUserGroups) ListV1(ctx context.Context) ([]ResourceStaticUserGroup, *resty.Response, error) {
+	endpoint := constants.EndpointJamfProStaticUserGroupsV1
+
+	var result []ResourceStaticUserGroup
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to list static user groups: %w", err)
+	}
+
+	return result, resp, nil
+}
+
+// GetByIDV1 returns the specified static user group by ID.
+// URL: GET /api/v1/static-user-groups/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-static-user-groups-id
+func (s *StaticUserGroups) GetByIDV1(ctx context.Context, id string) (*ResourceStaticUserGroup, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("static user group ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProStaticUserGroupsV1, id)
+
+	var result ResourceStaticUserGroup
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get static user group %s: %w", id, err)
+	}
+
+	return &result, resp, nil
+}
+
+// GetByNameV1 returns the static user group with the given name. The list
+// endpoint has no filter support, so the match is made client-side.
+func (s *StaticUserGroups) GetByNameV1(ctx context.Context, name string) (*ResourceStaticUserGroup, *resty.Response, error) {
+	if name == "" {
+		return nil, nil, fmt.Errorf("static user group name is required")
+	}
+
+	groups, resp, err := s.ListV1(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("static user group with name %q not found", name)
+}
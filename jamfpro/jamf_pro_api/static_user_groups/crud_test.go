@@ -0,0 +1,78 @@
+package static_user_groups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_user_groups/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*StaticUserGroups, *mocks.StaticUserGroupsMock) {
+	t.Helper()
+	mock := mocks.NewStaticUserGroupsMock()
+	mock.RegisterMocks()
+	return NewStaticUserGroups(mock), mock
+}
+
+func TestUnit_StaticUserGroups_ListV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.ListV1(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result, 2)
+	require.Equal(t, 1, result[0].ID)
+	require.Equal(t, "Grade School Teachers", result[0].Name)
+}
+
+func TestUnit_StaticUserGroups_ListV1_Error(t *testing.T) {
+	svc := NewStaticUserGroups(mocks.NewStaticUserGroupsMock())
+	result, _, err := svc.ListV1(context.Background())
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to list static user groups")
+}
+
+func TestUnit_StaticUserGroups_GetByIDV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetByIDV1(context.Background(), "1")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "A group containing all grade school teachers", result.Description)
+}
+
+func TestUnit_StaticUserGroups_GetByIDV1_EmptyID(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetByIDV1(context.Background(), "")
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "static user group ID is required")
+}
+
+func TestUnit_StaticUserGroups_GetByIDV1_NotFound(t *testing.T) {
+	svc, mock := setupMockService(t)
+	mock.RegisterNotFoundErrorMock()
+	result, resp, err := svc.GetByIDV1(context.Background(), "999")
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Equal(t, 404, resp.StatusCode())
+}
+
+func TestUnit_StaticUserGroups_GetByNameV1(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	result, _, err := svc.GetByNameV1(context.Background(), "IT Staff")
+	require.NoError(t, err)
+	require.Equal(t, 2, result.ID)
+
+	_, _, err = svc.GetByNameV1(context.Background(), "Nobody")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `static user group with name "Nobody" not found`)
+
+	_, resp, err := svc.GetByNameV1(context.Background(), "")
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "static user group name is required")
+}
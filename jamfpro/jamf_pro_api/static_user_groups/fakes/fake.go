@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the static_user_groups
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_user_groups"
+	"resty.dev/v3"
+)
+
+// StaticUserGroupsFake is a static_user_groups.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type StaticUserGroupsFake struct {
+	fakes.Journal
+
+	GetByIDV1Func   func(ctx context.Context, id string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error)
+	GetByNameV1Func func(ctx context.Context, name string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error)
+	ListV1Func      func(ctx context.Context) ([]static_user_groups.ResourceStaticUserGroup, *resty.Response, error)
+}
+
+var _ static_user_groups.Service = (*StaticUserGroupsFake)(nil)
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *StaticUserGroupsFake) GetByIDV1(ctx context.Context, id string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetByNameV1 records the call and delegates to GetByNameV1Func.
+func (f *StaticUserGroupsFake) GetByNameV1(ctx context.Context, name string) (*static_user_groups.ResourceStaticUserGroup, *resty.Response, error) {
+	f.Record("GetByNameV1", ctx, name)
+	if f.GetByNameV1Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.GetByNameV1")
+	}
+	return f.GetByNameV1Func(ctx, name)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *StaticUserGroupsFake) ListV1(ctx context.Context) ([]static_user_groups.ResourceStaticUserGroup, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("StaticUserGroupsFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
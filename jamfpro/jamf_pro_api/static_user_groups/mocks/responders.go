@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type StaticUserGroupsMock struct {
+	*mocks.GenericMock
+}
+
+func NewStaticUserGroupsMock() *StaticUserGroupsMock {
+	return &StaticUserGroupsMock{
+		GenericMock: mocks.NewJSONMock("StaticUserGroupsMock"),
+	}
+}
+
+func (m *StaticUserGroupsMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/static-user-groups", 200, "validate_list.json")
+	m.Register("GET", "/api/v1/static-user-groups/1", 200, "validate_get.json")
+}
+
+func (m *StaticUserGroupsMock) RegisterNotFoundErrorMock() {
+	m.RegisterError("GET", "/api/v1/static-user-groups/999", 404, "error_not_found.json", "")
+}
@@ -0,0 +1,8 @@
+package static_user_groups
+
+// ResourceStaticUserGroup represents a static user group.
+type ResourceStaticUserGroup struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package static_user_groups
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of StaticUserGroups.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.StaticUserGroupsFake implements it for tests.
+type Service interface {
+	GetByIDV1(ctx context.Context, id string) (*ResourceStaticUserGroup, *resty.Response, error)
+	GetByNameV1(ctx context.Context, name string) (*ResourceStaticUserGroup, *resty.Response, error)
+	ListV1(ctx context.Context) ([]ResourceStaticUserGroup, *resty.Response, error)
+}
+
+var _ Service = (*StaticUserGroups)(nil)
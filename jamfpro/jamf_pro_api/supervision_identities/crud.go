@@ -0,0 +1,215 @@
+package supervision_identities
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the supervision identities-related methods of the Jamf Pro API.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-supervision-identities
+	SupervisionIdentities struct {
+		client client.Client
+	}
+)
+
+func NewSupervisionIdentities(client client.Client) *SupervisionIdentities {
+	return &SupervisionIdentities{client: client}
+}
+
+// ListV1 returns all supervision identities.
+// URL: GET /api/v1/supervision-identities
+// rsqlQuery supports: sort, page, page-size (all optional).
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-supervision-identities
+func (s *SupervisionIdentities) ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error) {
+	endpoint := constants.EndpointJamfProSupervisionIdentitiesV1
+
+	var result ListResponse
+
+	mergePage := func(pageData []byte) error {
+		var pageItems []ResourceSupervisionIdentity
+		if err := json.Unmarshal(pageData, &pageItems); err != nil {
+			return fmt.Errorf("failed to unmarshal page: %w", err)
+		}
+		result.Results = append(result.Results, pageItems...)
+		return nil
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(rsqlQuery).
+		GetPaginated(endpoint, mergePage)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to list supervision identities: %w", err)
+	}
+
+	result.TotalCount = len(result.Results)
+	return &result, resp, nil
+}
+
+// GetByIDV1 returns the specified supervision identity by ID.
+// URL: GET /api/v1/supervision-identities/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-supervision-identities-id
+func (s *SupervisionIdentities) GetByIDV1(ctx context.Context, id string) (*ResourceSupervisionIdentity, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("supervision identity ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProSupervisionIdentitiesV1, id)
+
+	var result ResourceSupervisionIdentity
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get supervision identity %s: %w", id, err)
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 generates a new supervision identity signed by the Jamf Pro built-in CA.
+// URL: POST /api/v1/supervision-identities
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-supervision-identities
+func (s *SupervisionIdentities) CreateV1(ctx context.Context, request *RequestCreate) (*ResourceSupervisionIdentity, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.DisplayName == "" {
+		return nil, nil, fmt.Errorf("display name is required")
+	}
+	if request.Password == "" {
+		return nil, nil, fmt.Errorf("password is required")
+	}
+
+	endpoint := constants.EndpointJamfProSupervisionIdentitiesV1
+
+	var result ResourceSupervisionIdentity
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create supervision identity: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateByIDV1 updates the display name of the specified supervision identity.
+// URL: PUT /api/v1/supervision-identities/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/put_v1-supervision-identities-id
+func (s *SupervisionIdentities) UpdateByIDV1(ctx context.Context, id string, request *RequestUpdate) (*ResourceSupervisionIdentity, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("supervision identity ID is required")
+	}
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.DisplayName == "" {
+		return nil, nil, fmt.Errorf("display name is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProSupervisionIdentitiesV1, id)
+
+	var result ResourceSupervisionIdentity
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Put(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update supervision identity %s: %w", id, err)
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByIDV1 removes the specified supervision identity.
+// URL: DELETE /api/v1/supervision-identities/{id}
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/delete_v1-supervision-identities-id
+func (s *SupervisionIdentities) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	if id == "" {
+		return nil, fmt.Errorf("supervision identity ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", constants.EndpointJamfProSupervisionIdentitiesV1, id)
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete supervision identity %s: %w", id, err)
+	}
+
+	return resp, nil
+}
+
+// UploadV1 uploads an existing PKCS#12 supervision identity. The endpoint takes
+// a JSON body rather than multipart form data; the .p12 bytes travel base64-encoded
+// in RequestUpload.CertificateData.
+// URL: POST /api/v1/supervision-identities/upload
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_v1-supervision-identities-upload
+func (s *SupervisionIdentities) UploadV1(ctx context.Context, request *RequestUpload) (*ResourceSupervisionIdentity, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.DisplayName == "" {
+		return nil, nil, fmt.Errorf("display name is required")
+	}
+	if request.Password == "" {
+		return nil, nil, fmt.Errorf("password is required")
+	}
+	if len(request.CertificateData) == 0 {
+		return nil, nil, fmt.Errorf("certificate data is required")
+	}
+
+	endpoint := constants.EndpointJamfProSupervisionIdentitiesUploadV1
+
+	var result ResourceSupervisionIdentity
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to upload supervision identity: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// DownloadByIDV1 downloads the specified supervision identity as a PKCS#12 (.p12) file.
+// URL: GET /api/v1/supervision-identities/{id}/download
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_v1-supervision-identities-id-download
+func (s *SupervisionIdentities) DownloadByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("supervision identity ID is required")
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/download", constants.EndpointJamfProSupervisionIdentitiesV1, id)
+
+	resp, data, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationOctetStream).
+		GetBytes(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to download supervision identity %s: %w", id, err)
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,155 @@
+package supervision_identities
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/supervision_identities/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*SupervisionIdentities, *mocks.SupervisionIdentitiesMock) {
+	t.Helper()
+	mock := mocks.NewSupervisionIdentitiesMock()
+	mock.RegisterMocks()
+	return NewSupervisionIdentities(mock), mock
+}
+
+func TestUnit_SupervisionIdentities_ListV1_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"sort": "id:desc"}
+	result, resp, err := svc.ListV1(context.Background(), query)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 2, result.TotalCount)
+	require.Equal(t, "Supervision Identity 2026", result.Results[0].DisplayName)
+	require.Equal(t, "2027-10-31", result.Results[0].ExpirationDate)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_SupervisionIdentities_ListV1_MergePageError(t *testing.T) {
+	mock := mocks.NewSupervisionIdentitiesMock()
+	mock.RegisterListInvalidMock()
+	svc := NewSupervisionIdentities(mock)
+	result, _, err := svc.ListV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to list supervision identities")
+}
+
+func TestUnit_SupervisionIdentities_GetByIDV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetByIDV1(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 1, result.ID)
+	require.Equal(t, "Jamf Identity - Supervision Identity 2026", result.CommonName)
+}
+
+func TestUnit_SupervisionIdentities_GetByIDV1_NotFound(t *testing.T) {
+	svc, mock := setupMockService(t)
+	mock.RegisterNotFoundErrorMock()
+	result, resp, err := svc.GetByIDV1(context.Background(), "999")
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Equal(t, 404, resp.StatusCode())
+}
+
+func TestUnit_SupervisionIdentities_CreateV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.CreateV1(context.Background(), &RequestCreate{
+		DisplayName: "Supervision Identity 2027",
+		Password:    "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, 3, result.ID)
+}
+
+func TestUnit_SupervisionIdentities_UpdateByIDV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.UpdateByIDV1(context.Background(), "1", &RequestUpdate{DisplayName: "Supervision Identity 2026 (retired)"})
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "Supervision Identity 2026 (retired)", result.DisplayName)
+}
+
+func TestUnit_SupervisionIdentities_DeleteByIDV1(t *testing.T) {
+	svc, mock := setupMockService(t)
+	resp, err := svc.DeleteByIDV1(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 204, resp.StatusCode())
+
+	mock.RegisterNotFoundErrorMock()
+	resp, err = svc.DeleteByIDV1(context.Background(), "999")
+	require.Error(t, err)
+	require.Equal(t, 404, resp.StatusCode())
+	require.Contains(t, err.Error(), "failed to delete supervision identity 999")
+}
+
+func TestUnit_SupervisionIdentities_UploadV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.UploadV1(context.Background(), &RequestUpload{
+		DisplayName:     "Imported Supervision Identity",
+		Password:        "secret",
+		CertificateData: []byte("p12"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, 4, result.ID)
+}
+
+func TestUnit_SupervisionIdentities_RequestUpload_EncodesCertificateAsBase64(t *testing.T) {
+	body, err := json.Marshal(&RequestUpload{DisplayName: "d", Password: "p", CertificateData: []byte("p12")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"displayName":"d","password":"p","certificateData":"cDEy"}`, string(body))
+}
+
+func TestUnit_SupervisionIdentities_DownloadByIDV1(t *testing.T) {
+	svc, mock := setupMockService(t)
+	data, resp, err := svc.DownloadByIDV1(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, []byte("PKCS12-MOCK-BYTES"), data)
+
+	mock.RegisterNotFoundErrorMock()
+	data, resp, err = svc.DownloadByIDV1(context.Background(), "999")
+	require.Error(t, err)
+	require.Nil(t, data)
+	require.Equal(t, 404, resp.StatusCode())
+}
+
+func TestUnit_SupervisionIdentities_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr string
+	}{
+		{"GetByIDV1 empty ID", func() error { _, _, err := svc.GetByIDV1(ctx, ""); return err }, "supervision identity ID is required"},
+		{"CreateV1 nil request", func() error { _, _, err := svc.CreateV1(ctx, nil); return err }, "request is required"},
+		{"CreateV1 missing display name", func() error { _, _, err := svc.CreateV1(ctx, &RequestCreate{Password: "p"}); return err }, "display name is required"},
+		{"CreateV1 missing password", func() error { _, _, err := svc.CreateV1(ctx, &RequestCreate{DisplayName: "d"}); return err }, "password is required"},
+		{"UpdateByIDV1 empty ID", func() error { _, _, err := svc.UpdateByIDV1(ctx, "", &RequestUpdate{DisplayName: "d"}); return err }, "supervision identity ID is required"},
+		{"UpdateByIDV1 nil request", func() error { _, _, err := svc.UpdateByIDV1(ctx, "1", nil); return err }, "request is required"},
+		{"UpdateByIDV1 missing display name", func() error { _, _, err := svc.UpdateByIDV1(ctx, "1", &RequestUpdate{}); return err }, "display name is required"},
+		{"DeleteByIDV1 empty ID", func() error { _, err := svc.DeleteByIDV1(ctx, ""); return err }, "supervision identity ID is required"},
+		{"UploadV1 nil request", func() error { _, _, err := svc.UploadV1(ctx, nil); return err }, "request is required"},
+		{"UploadV1 missing certificate", func() error {
+			_, _, err := svc.UploadV1(ctx, &RequestUpload{DisplayName: "d", Password: "p"})
+			return err
+		}, "certificate data is required"},
+		{"DownloadByIDV1 empty ID", func() error { _, _, err := svc.DownloadByIDV1(ctx, ""); return err }, "supervision identity ID is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the supervision_identities
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/supervision_identities"
+	"resty.dev/v3"
+)
+
+// SupervisionIdentitiesFake is a supervision_identities.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type SupervisionIdentitiesFake struct {
+	fakes.Journal
+
+	CreateV1Func       func(ctx context.Context, request *supervision_identities.RequestCreate) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error)
+	DeleteByIDV1Func   func(ctx context.Context, id string) (*resty.Response, error)
+	DownloadByIDV1Func func(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetByIDV1Func      func(ctx context.Context, id string) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error)
+	ListV1Func         func(ctx context.Context, rsqlQuery map[string]string) (*supervision_identities.ListResponse, *resty.Response, error)
+	UpdateByIDV1Func   func(ctx context.Context, id string, request *supervision_identities.RequestUpdate) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error)
+	UploadV1Func       func(ctx context.Context, request *supervision_identities.RequestUpload) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error)
+}
+
+var _ supervision_identities.Service = (*SupervisionIdentitiesFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *SupervisionIdentitiesFake) CreateV1(ctx context.Context, request *supervision_identities.RequestCreate) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *SupervisionIdentitiesFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("SupervisionIdentitiesFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// DownloadByIDV1 records the call and delegates to DownloadByIDV1Func.
+func (f *SupervisionIdentitiesFake) DownloadByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error) {
+	f.Record("DownloadByIDV1", ctx, id)
+	if f.DownloadByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.DownloadByIDV1")
+	}
+	return f.DownloadByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *SupervisionIdentitiesFake) GetByIDV1(ctx context.Context, id string) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *SupervisionIdentitiesFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*supervision_identities.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *SupervisionIdentitiesFake) UpdateByIDV1(ctx context.Context, id string, request *supervision_identities.RequestUpdate) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
+
+// UploadV1 records the call and delegates to UploadV1Func.
+func (f *SupervisionIdentitiesFake) UploadV1(ctx context.Context, request *supervision_identities.RequestUpload) (*supervision_identities.ResourceSupervisionIdentity, *resty.Response, error) {
+	f.Record("UploadV1", ctx, request)
+	if f.UploadV1Func == nil {
+		return nil, nil, fakes.NotStubbed("SupervisionIdentitiesFake.UploadV1")
+	}
+	return f.UploadV1Func(ctx, request)
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type SupervisionIdentitiesMock struct {
+	*mocks.GenericMock
+}
+
+func NewSupervisionIdentitiesMock() *SupervisionIdentitiesMock {
+	return &SupervisionIdentitiesMock{
+		GenericMock: mocks.NewJSONMock("SupervisionIdentitiesMock"),
+	}
+}
+
+func (m *SupervisionIdentitiesMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/supervision-identities", 200, "validate_list.json")
+	m.Register("GET", "/api/v1/supervision-identities/1", 200, "validate_get.json")
+	m.Register("POST", "/api/v1/supervision-identities", 201, "validate_create.json")
+	m.Register("PUT", "/api/v1/supervision-identities/1", 200, "validate_update.json")
+	m.Register("DELETE", "/api/v1/supervision-identities/1", 204, "")
+	m.Register("POST", "/api/v1/supervision-identities/upload", 201, "validate_upload.json")
+	m.Register("GET", "/api/v1/supervision-identities/1/download", 200, "validate_download.p12")
+}
+
+func (m *SupervisionIdentitiesMock) RegisterListInvalidMock() {
+	m.Register("GET", "/api/v1/supervision-identities", 200, "validate_list_invalid.json")
+}
+
+func (m *SupervisionIdentitiesMock) RegisterNotFoundErrorMock() {
+	m.RegisterError("GET", "/api/v1/supervision-identities/999", 404, "error_not_found.json", "")
+	m.RegisterError("GET", "/api/v1/supervision-identities/999/download", 404, "error_not_found.json", "")
+	m.RegisterError("DELETE", "/api/v1/supervision-identities/999", 404, "error_not_found.json", "")
+}
@@ -0,0 +1,35 @@
+package supervision_identities
+
+// ResourceSupervisionIdentity represents a supervision identity.
+type ResourceSupervisionIdentity struct {
+	ID             int    `json:"id"`
+	DisplayName    string `json:"displayName"`
+	CommonName     string `json:"commonName"`
+	ExpirationDate string `json:"expirationDate"`
+}
+
+// ListResponse is the paginated response for listing supervision identities.
+type ListResponse struct {
+	TotalCount int                           `json:"totalCount"`
+	Results    []ResourceSupervisionIdentity `json:"results"`
+}
+
+// RequestCreate is the body for generating a new supervision identity.
+// Password protects the generated PKCS#12 file returned by DownloadByIDV1.
+type RequestCreate struct {
+	DisplayName string `json:"displayName"`
+	Password    string `json:"password"`
+}
+
+// RequestUpdate is the body for renaming a supervision identity.
+type RequestUpdate struct {
+	DisplayName string `json:"displayName"`
+}
+
+// RequestUpload is the body for uploading an existing PKCS#12 supervision identity.
+// CertificateData holds the raw .p12 bytes; it is base64-encoded on the wire.
+type RequestUpload struct {
+	DisplayName     string `json:"displayName"`
+	Password        string `json:"password"`
+	CertificateData []byte `json:"certificateData,omitempty"`
+}
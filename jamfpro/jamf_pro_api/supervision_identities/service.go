@@ -0,0 +1,24 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package supervision_identities
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of SupervisionIdentities.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.SupervisionIdentitiesFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *RequestCreate) (*ResourceSupervisionIdentity, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	DownloadByIDV1(ctx context.Context, id string) ([]byte, *resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceSupervisionIdentity, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestUpdate) (*ResourceSupervisionIdentity, *resty.Response, error)
+	UploadV1(ctx context.Context, request *RequestUpload) (*ResourceSupervisionIdentity, *resty.Response, error)
+}
+
+var _ Service = (*SupervisionIdentities)(nil)
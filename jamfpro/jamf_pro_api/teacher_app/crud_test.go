@@ -0,0 +1,123 @@
+package teacher_app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/teacher_app/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*TeacherApp, *mocks.TeacherAppMock) {
+	t.Helper()
+	mock := mocks.NewTeacherAppMock()
+	mock.RegisterMocks()
+	return NewTeacherApp(mock), mock
+}
+
+func TestUnit_TeacherApp_GetV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetV1(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.True(t, result.IsEnabled)
+	require.Equal(t, "Europe/Paris", result.TimezoneID)
+	require.Equal(t, "05:30", result.AutoClear)
+	require.Equal(t, 600, result.MaxRestrictionLengthSeconds)
+	require.True(t, result.Features.IsAllowAppLock)
+	require.False(t, result.Features.IsAllowAttentionScreen)
+	require.Len(t, result.SafelistedApps, 1)
+}
+
+func TestUnit_TeacherApp_GetV1_Error(t *testing.T) {
+	svc := NewTeacherApp(mocks.NewTeacherAppMock())
+	result, _, err := svc.GetV1(context.Background())
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to get teacher app settings")
+}
+
+func TestUnit_TeacherApp_UpdateV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	request := &RequestTeacherApp{
+		TimezoneID:                  "America/Chicago",
+		MaxRestrictionLengthSeconds: 1200,
+	}
+	result, resp, err := svc.UpdateV1(context.Background(), request)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.False(t, result.IsEnabled)
+	require.Empty(t, result.AutoClear)
+	require.Equal(t, 1200, result.MaxRestrictionLengthSeconds)
+}
+
+func TestUnit_TeacherApp_UpdateV1_NilRequest(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.UpdateV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+}
+
+func TestUnit_TeacherApp_UpdateV1_Error(t *testing.T) {
+	svc := NewTeacherApp(mocks.NewTeacherAppMock())
+	_, _, err := svc.UpdateV1(context.Background(), &RequestTeacherApp{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to update teacher app settings")
+}
+
+func TestUnit_TeacherApp_GetHistoryV1_Success(t *testing.T) {
+	svc, mock := setupMockService(t)
+	query := map[string]string{"sort": "date:desc"}
+	result, resp, err := svc.GetHistoryV1(context.Background(), query)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 2, result.TotalCount)
+	require.Equal(t, "Jamf Teacher settings updated", result.Results[0].Note)
+	require.Equal(t, query, mock.LastRSQLQuery)
+}
+
+func TestUnit_TeacherApp_GetHistoryV1_MergePageError(t *testing.T) {
+	mock := mocks.NewTeacherAppMock()
+	mock.RegisterHistoryInvalidMock()
+	svc := NewTeacherApp(mock)
+	result, _, err := svc.GetHistoryV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, result)
+	require.Contains(t, err.Error(), "failed to get teacher app history")
+}
+
+func TestUnit_TeacherApp_AddHistoryNoteV1_Success(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{Note: "Reviewed features"})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, 201, resp.StatusCode())
+	require.Equal(t, "3", result.ID)
+	require.Contains(t, result.Href, "/api/v1/teacher-app/history/3")
+}
+
+func TestUnit_TeacherApp_AddHistoryNoteV1_Validation(t *testing.T) {
+	svc, _ := setupMockService(t)
+
+	_, resp, err := svc.AddHistoryNoteV1(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "request is required")
+
+	_, resp, err = svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{})
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Contains(t, err.Error(), "note is required")
+}
+
+func TestUnit_TeacherApp_AddHistoryNoteV1_Error(t *testing.T) {
+	svc := NewTeacherApp(mocks.NewTeacherAppMock())
+	_, _, err := svc.AddHistoryNoteV1(context.Background(), &AddHistoryNoteRequest{Note: "note"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to add teacher app history note")
+}
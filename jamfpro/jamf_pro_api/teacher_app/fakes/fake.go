@@ -0,0 +1,63 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the teacher_app
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/teacher_app"
+	"resty.dev/v3"
+)
+
+// TeacherAppFake is a teacher_app.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type TeacherAppFake struct {
+	fakes.Journal
+
+	AddHistoryNoteV1Func func(ctx context.Context, request *teacher_app.AddHistoryNoteRequest) (*teacher_app.AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1Func     func(ctx context.Context, rsqlQuery map[string]string) (*teacher_app.HistoryResponse, *resty.Response, error)
+	GetV1Func            func(ctx context.Context) (*teacher_app.ResourceTeacherApp, *resty.Response, error)
+	UpdateV1Func         func(ctx context.Context, request *teacher_app.RequestTeacherApp) (*teacher_app.ResourceTeacherApp, *resty.Response, error)
+}
+
+var _ teacher_app.Service = (*TeacherAppFake)(nil)
+
+// AddHistoryNoteV1 records the call and delegates to AddHistoryNoteV1Func.
+func (f *TeacherAppFake) AddHistoryNoteV1(ctx context.Context, request *teacher_app.AddHistoryNoteRequest) (*teacher_app.AddHistoryNoteResponse, *resty.Response, error) {
+	f.Record("AddHistoryNoteV1", ctx, request)
+	if f.AddHistoryNoteV1Func == nil {
+		return nil, nil, fakes.NotStubbed("TeacherAppFake.AddHistoryNoteV1")
+	}
+	return f.AddHistoryNoteV1Func(ctx, request)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *TeacherAppFake) GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*teacher_app.HistoryResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("TeacherAppFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, rsqlQuery)
+}
+
+// GetV1 records the call and delegates to GetV1Func.
+func (f *TeacherAppFake) GetV1(ctx context.Context) (*teacher_app.ResourceTeacherApp, *resty.Response, error) {
+	f.Record("GetV1", ctx)
+	if f.GetV1Func == nil {
+		return nil, nil, fakes.NotStubbed("TeacherAppFake.GetV1")
+	}
+	return f.GetV1Func(ctx)
+}
+
+// UpdateV1 records the call and delegates to UpdateV1Func.
+func (f *TeacherAppFake) UpdateV1(ctx context.Context, request *teacher_app.RequestTeacherApp) (*teacher_app.ResourceTeacherApp, *resty.Response, error) {
+	f.Record("UpdateV1", ctx, request)
+	if f.UpdateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("TeacherAppFake.UpdateV1")
+	}
+	return f.UpdateV1Func(ctx, request)
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type TeacherAppMock struct {
+	*mocks.GenericMock
+}
+
+func NewTeacherAppMock() *TeacherAppMock {
+	return &TeacherAppMock{
+		GenericMock: mocks.NewJSONMock("TeacherAppMock"),
+	}
+}
+
+func (m *TeacherAppMock) RegisterMocks() {
+	m.Register("GET", "/api/v1/teacher-app", 200, "validate_get.json")
+	m.Register("PUT", "/api/v1/teacher-app", 200, "validate_update.json")
+	m.Register("GET", "/api/v1/teacher-app/history", 200, "validate_history.json")
+	m.Register("POST", "/api/v1/teacher-app/history", 201, "validate_add_history_note.json")
+}
+
+func (m *TeacherAppMock) RegisterHistoryInvalidMock() {
+	m.Register("GET", "/api/v1/teacher-app/history", 200, "validate_history_invalid.json")
+}
@@ -0,0 +1,51 @@
+package teacher_app
+
+import "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/shared/models"
+
+// ResourceTeacherApp represents the Jamf Teacher app settings returned by the server.
+type ResourceTeacherApp struct {
+	IsEnabled                   bool            `json:"isEnabled"`
+	TimezoneID                  string          `json:"timezoneId"`
+	AutoClear                   string          `json:"autoClear,omitempty"`
+	MaxRestrictionLengthSeconds int             `json:"maxRestrictionLengthSeconds"`
+	DisplayNameType             string          `json:"displayNameType,omitempty"`
+	Features                    TeacherFeatures `json:"features"`
+	SafelistedApps              []SafelistedApp `json:"safelistedApps,omitempty"`
+}
+
+// TeacherFeatures lists the classroom features available to teachers.
+type TeacherFeatures struct {
+	IsAllowAppLock         bool `json:"isAllowAppLock"`
+	IsAllowWebLock         bool `json:"isAllowWebLock"`
+	IsAllowRestrictions    bool `json:"isAllowRestrictions"`
+	IsAllowAttentionScreen bool `json:"isAllowAttentionScreen"`
+	IsAllowClearPasscode   bool `json:"isAllowClearPasscode"`
+}
+
+// SafelistedApp is an app that stays available while restrictions are in effect.
+type SafelistedApp struct {
+	Name     string `json:"name"`
+	BundleID string `json:"bundleId"`
+}
+
+// RequestTeacherApp is the body for updating the Jamf Teacher app settings.
+// A nil AutoClear disables automatic clearing of restrictions.
+type RequestTeacherApp struct {
+	IsEnabled                   bool            `json:"isEnabled"`
+	TimezoneID                  string          `json:"timezoneId"`
+	AutoClear                   *string         `json:"autoClear"`
+	MaxRestrictionLengthSeconds int             `json:"maxRestrictionLengthSeconds"`
+	SafelistedApps              []SafelistedApp `json:"safelistedApps,omitempty"`
+}
+
+// HistoryObject is an alias to the shared history item struct.
+type HistoryObject = models.SharedHistoryItem
+
+// HistoryResponse is an alias to the shared history response struct.
+type HistoryResponse = models.SharedHistoryResponse
+
+// AddHistoryNoteRequest is an alias to the shared history note request struct.
+type AddHistoryNoteRequest = models.SharedHistoryNoteRequest
+
+// AddHistoryNoteResponse carries the id and href of the created history entry.
+type AddHistoryNoteResponse = models.SharedHistoryNoteResponse
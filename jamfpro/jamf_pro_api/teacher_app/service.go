@@ -0,0 +1,21 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package teacher_app
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of TeacherApp.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.TeacherAppFake implements it for tests.
+type Service interface {
+	AddHistoryNoteV1(ctx context.Context, request *AddHistoryNoteRequest) (*AddHistoryNoteResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, rsqlQuery map[string]string) (*HistoryResponse, *resty.Response, error)
+	GetV1(ctx context.Context) (*ResourceTeacherApp, *resty.Response, error)
+	UpdateV1(ctx context.Context, request *RequestTeacherApp) (*ResourceTeacherApp, *resty.Response, error)
+}
+
+var _ Service = (*TeacherApp)(nil)
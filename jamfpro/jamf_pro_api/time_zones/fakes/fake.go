@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the time_zones
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/time_zones"
+	"resty.dev/v3"
+)
+
+// TimeZonesFake is a time_zones.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type TimeZonesFake struct {
+	fakes.Journal
+
+	ListV1Func func(ctx context.Context) ([]time_zones.ResourceTimeZone, *resty.Response, error)
+}
+
+var _ time_zones.Service = (*TimeZonesFake)(nil)
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *TimeZonesFake) ListV1(ctx context.Context) ([]time_zones.ResourceTimeZone, *resty.Response, error) {
+	f.Record("ListV1", ctx)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("TimeZonesFake.ListV1")
+	}
+	return f.ListV1Func(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package time_zones
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of TimeZones.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.TimeZonesFake implements it for tests.
+type Service interface {
+	ListV1(ctx context.Context) ([]ResourceTimeZone, *resty.Response, error)
+}
+
+var _ Service = (*TimeZones)(nil)
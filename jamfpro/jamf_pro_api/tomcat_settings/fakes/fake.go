@@ -0,0 +1,33 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the tomcat_settings
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/tomcat_settings"
+	"resty.dev/v3"
+)
+
+// TomcatSettingsFake is a tomcat_settings.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type TomcatSettingsFake struct {
+	fakes.Journal
+
+	IssueTomcatSslCertificateFunc func(ctx context.Context) (*resty.Response, error)
+}
+
+var _ tomcat_settings.Service = (*TomcatSettingsFake)(nil)
+
+// IssueTomcatSslCertificate records the call and delegates to IssueTomcatSslCertificateFunc.
+func (f *TomcatSettingsFake) IssueTomcatSslCertificate(ctx context.Context) (*resty.Response, error) {
+	f.Record("IssueTomcatSslCertificate", ctx)
+	if f.IssueTomcatSslCertificateFunc == nil {
+		return nil, fakes.NotStubbed("TomcatSettingsFake.IssueTomcatSslCertificate")
+	}
+	return f.IssueTomcatSslCertificateFunc(ctx)
+}
@@ -0,0 +1,18 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package tomcat_settings
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of TomcatSettings.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.TomcatSettingsFake implements it for tests.
+type Service interface {
+	IssueTomcatSslCertificate(ctx context.Context) (*resty.Response, error)
+}
+
+var _ Service = (*TomcatSettings)(nil)
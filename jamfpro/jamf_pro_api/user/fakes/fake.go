@@ -0,0 +1,53 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the user
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user"
+	"resty.dev/v3"
+)
+
+// UserFake is a user.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UserFake struct {
+	fakes.Journal
+
+	ChangePasswordFunc func(ctx context.Context, request *user.RequestChangePassword) (*resty.Response, error)
+	GetFunc            func(ctx context.Context) (*user.ResourceUser, *resty.Response, error)
+	UpdateSessionFunc  func(ctx context.Context, request *user.RequestUpdateSession) (*resty.Response, error)
+}
+
+var _ user.Service = (*UserFake)(nil)
+
+// ChangePassword records the call and delegates to ChangePasswordFunc.
+func (f *UserFake) ChangePassword(ctx context.Context, request *user.RequestChangePassword) (*resty.Response, error) {
+	f.Record("ChangePassword", ctx, request)
+	if f.ChangePasswordFunc == nil {
+		return nil, fakes.NotStubbed("UserFake.ChangePassword")
+	}
+	return f.ChangePasswordFunc(ctx, request)
+}
+
+// Get records the call and delegates to GetFunc.
+func (f *UserFake) Get(ctx context.Context) (*user.ResourceUser, *resty.Response, error) {
+	f.Record("Get", ctx)
+	if f.GetFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserFake.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// UpdateSession records the call and delegates to UpdateSessionFunc.
+func (f *UserFake) UpdateSession(ctx context.Context, request *user.RequestUpdateSession) (*resty.Response, error) {
+	f.Record("UpdateSession", ctx, request)
+	if f.UpdateSessionFunc == nil {
+		return nil, fakes.NotStubbed("UserFake.UpdateSession")
+	}
+	return f.UpdateSessionFunc(ctx, request)
+}
@@ -0,0 +1,20 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package user
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of User.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.UserFake implements it for tests.
+type Service interface {
+	ChangePassword(ctx context.Context, request *RequestChangePassword) (*resty.Response, error)
+	Get(ctx context.Context) (*ResourceUser, *resty.Response, error)
+	UpdateSession(ctx context.Context, request *RequestUpdateSession) (*resty.Response, error)
+}
+
+var _ Service = (*User)(nil)
@@ -0,0 +1,88 @@
+package user_session
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"resty.dev/v3"
+)
+
+type (
+	// Service handles communication with the user session-related methods of the Jamf Pro API.
+	//
+	// For the token-level view of the caller (account groups, sites, authentication type)
+	// see the api_authorization package.
+	//
+	// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_api-user
+	UserSession struct {
+		client client.Client
+	}
+)
+
+func NewUserSession(client client.Client) *UserSession {
+	return &UserSession{client: client}
+}
+
+// GetCurrentUser returns the account, privileges and site of the current session.
+// URL: GET /api/user
+// The documented response is a single-element array; servers that return a bare
+// object are handled too.
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/get_api-user
+func (s *UserSession) GetCurrentUser(ctx context.Context) (*ResourceSessionUser, *resty.Response, error) {
+	endpoint := constants.EndpointJamfProUser
+
+	resp, data, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		GetBytes(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get current session user: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var users []ResourceSessionUser
+		if err := json.Unmarshal(data, &users); err != nil {
+			return nil, resp, fmt.Errorf("failed to unmarshal current session user: %w", err)
+		}
+		if len(users) == 0 {
+			return nil, resp, fmt.Errorf("no user returned for the current session")
+		}
+		return &users[0], resp, nil
+	}
+
+	var result ResourceSessionUser
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, resp, fmt.Errorf("failed to unmarshal current session user: %w", err)
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateSession changes values in the current session, such as the active site.
+// URL: POST /api/user/updateSession
+// Jamf Pro API docs: https://developer.jamf.com/jamf-pro/reference/post_api-user-updatesession
+func (s *UserSession) UpdateSession(ctx context.Context, request *RequestUpdateSession) (*ResourceSession, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+
+	endpoint := constants.EndpointJamfProUpdateSession
+
+	var result ResourceSession
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return &result, resp, nil
+}
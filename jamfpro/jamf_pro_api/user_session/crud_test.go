@@ -0,0 +1,91 @@
+package user_session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_session/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockService(t *testing.T) (*UserSession, *mocks.UserSessionMock) {
+	t.Helper()
+	mock := mocks.NewUserSessionMock()
+	mock.RegisterMocks()
+	return NewUserSession(mock), mock
+}
+
+func TestUnit_UserSession_GetCurrentUser_Array(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, resp, err := svc.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "admin", result.Username)
+	require.Equal(t, AccessLevelSiteAccess, result.AccessLevel)
+	require.Equal(t, PrivilegeSetCustom, result.PrivilegeSet)
+	require.Equal(t, "MM/dd/yyyy", result.Preferences.DateFormat)
+	require.Equal(t, []int{1, 3}, result.GroupIDs)
+	require.Equal(t, 1, result.CurrentSiteID)
+}
+
+func TestUnit_UserSession_GetCurrentUser_Object(t *testing.T) {
+	svc, mock := setupMockService(t)
+	mock.RegisterGetCurrentUserObjectMock()
+	result, _, err := svc.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "api-client", result.Username)
+	require.True(t, result.Preferences.IsDisableRelativeDates)
+	require.True(t, result.HasPrivilege(-1, "Update Computers"))
+}
+
+func TestUnit_UserSession_GetCurrentUser_Errors(t *testing.T) {
+	svc, mock := setupMockService(t)
+
+	mock.RegisterGetCurrentUserEmptyMock()
+	_, _, err := svc.GetCurrentUser(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no user returned")
+
+	mock.RegisterGetCurrentUserInvalidMock()
+	_, _, err = svc.GetCurrentUser(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to unmarshal current session user")
+
+	mock.RegisterErrorMocks()
+	_, resp, err := svc.GetCurrentUser(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 401, resp.StatusCode())
+}
+
+func TestUnit_UserSession_Privileges(t *testing.T) {
+	svc, _ := setupMockService(t)
+	result, _, err := svc.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"Read SSO Settings", "Delete eBooks"}, result.PrivilegesForSite(1))
+	require.True(t, result.HasPrivilege(2, "Read Computers"))
+	require.False(t, result.HasPrivilege(1, "Read Computers"))
+	require.Empty(t, result.PrivilegesForSite(99))
+
+	var nilUser *ResourceSessionUser
+	require.Nil(t, nilUser.PrivilegesForSite(1))
+	require.False(t, nilUser.HasPrivilege(1, "Read Computers"))
+}
+
+func TestUnit_UserSession_UpdateSession(t *testing.T) {
+	svc, mock := setupMockService(t)
+
+	result, resp, err := svc.UpdateSession(context.Background(), &RequestUpdateSession{CurrentSiteID: 2})
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, 2, result.CurrentSiteID)
+
+	_, _, err = svc.UpdateSession(context.Background(), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "request is required")
+
+	mock.RegisterErrorMocks()
+	_, resp, err = svc.UpdateSession(context.Background(), &RequestUpdateSession{CurrentSiteID: 2})
+	require.Error(t, err)
+	require.Equal(t, 400, resp.StatusCode())
+}
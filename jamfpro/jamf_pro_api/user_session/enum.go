@@ -0,0 +1,16 @@
+package user_session
+
+// AccessLevel* constants are the values of ResourceSessionUser.AccessLevel.
+const (
+	AccessLevelFullAccess       = "FullAccess"
+	AccessLevelSiteAccess       = "SiteAccess"
+	AccessLevelGroupBasedAccess = "GroupBasedAccess"
+)
+
+// PrivilegeSet* constants are the values of ResourceSessionUser.PrivilegeSet.
+const (
+	PrivilegeSetAdministrator = "ADMINISTRATOR"
+	PrivilegeSetAuditor       = "AUDITOR"
+	PrivilegeSetEnrollment    = "ENROLLMENT"
+	PrivilegeSetCustom        = "CUSTOM"
+)
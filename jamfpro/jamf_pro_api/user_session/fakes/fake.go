@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the user_session
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_session"
+	"resty.dev/v3"
+)
+
+// UserSessionFake is a user_session.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UserSessionFake struct {
+	fakes.Journal
+
+	GetCurrentUserFunc func(ctx context.Context) (*user_session.ResourceSessionUser, *resty.Response, error)
+	UpdateSessionFunc  func(ctx context.Context, request *user_session.RequestUpdateSession) (*user_session.ResourceSession, *resty.Response, error)
+}
+
+var _ user_session.Service = (*UserSessionFake)(nil)
+
+// GetCurrentUser records the call and delegates to GetCurrentUserFunc.
+func (f *UserSessionFake) GetCurrentUser(ctx context.Context) (*user_session.ResourceSessionUser, *resty.Response, error) {
+	f.Record("GetCurrentUser", ctx)
+	if f.GetCurrentUserFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserSessionFake.GetCurrentUser")
+	}
+	return f.GetCurrentUserFunc(ctx)
+}
+
+// UpdateSession records the call and delegates to UpdateSessionFunc.
+func (f *UserSessionFake) UpdateSession(ctx context.Context, request *user_session.RequestUpdateSession) (*user_session.ResourceSession, *resty.Response, error) {
+	f.Record("UpdateSession", ctx, request)
+	if f.UpdateSessionFunc == nil {
+		return nil, nil, fakes.NotStubbed("UserSessionFake.UpdateSession")
+	}
+	return f.UpdateSessionFunc(ctx, request)
+}
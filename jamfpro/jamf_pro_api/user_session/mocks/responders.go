@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/mocks"
+)
+
+type UserSessionMock struct {
+	*mocks.GenericMock
+}
+
+func NewUserSessionMock() *UserSessionMock {
+	return &UserSessionMock{
+		GenericMock: mocks.NewJSONMock("UserSessionMock"),
+	}
+}
+
+func (m *UserSessionMock) RegisterMocks() {
+	m.Register("GET", "/api/user", 200, "validate_get_current_user.json")
+	m.Register("POST", "/api/user/updateSession", 200, "validate_update_session.json")
+}
+
+func (m *UserSessionMock) RegisterGetCurrentUserObjectMock() {
+	m.Register("GET", "/api/user", 200, "validate_get_current_user_object.json")
+}
+
+func (m *UserSessionMock) RegisterGetCurrentUserEmptyMock() {
+	m.RegisterRawBody("GET", "/api/user", 200, []byte(`[]`))
+}
+
+func (m *UserSessionMock) RegisterGetCurrentUserInvalidMock() {
+	m.RegisterRawBody("GET", "/api/user", 200, []byte(`{invalid json`))
+}
+
+func (m *UserSessionMock) RegisterErrorMocks() {
+	m.RegisterError("GET", "/api/user", 401, "error_bad_request.json", "")
+	m.RegisterError("POST", "/api/user/updateSession", 400, "error_bad_request.json", "")
+}
@@ -0,0 +1,54 @@
+package user_session
+
+import (
+	"slices"
+	"strconv"
+)
+
+// ResourceSessionUser is the Jamf Pro account behind the current session.
+type ResourceSessionUser struct {
+	ID               int                 `json:"id"`
+	Username         string              `json:"username"`
+	RealName         string              `json:"realName"`
+	Email            string              `json:"email"`
+	Preferences      SessionPreferences  `json:"preferences"`
+	IsMultiSiteAdmin bool                `json:"isMultiSiteAdmin"`
+	AccessLevel      string              `json:"accessLevel"`
+	PrivilegeSet     string              `json:"privilegeSet"`
+	PrivilegesBySite map[string][]string `json:"privilegesBySite"`
+	GroupIDs         []int               `json:"groupIds"`
+	CurrentSiteID    int                 `json:"currentSiteId"`
+}
+
+// SessionPreferences holds the UI preferences of the session user.
+type SessionPreferences struct {
+	Language               string `json:"language"`
+	DateFormat             string `json:"dateFormat"`
+	Region                 string `json:"region"`
+	Timezone               string `json:"timezone"`
+	IsDisableRelativeDates bool   `json:"isDisableRelativeDates"`
+}
+
+// PrivilegesForSite returns the privileges granted to the user on the given site.
+// Site -1 is the full Jamf Pro server when the user is not site-scoped.
+func (u *ResourceSessionUser) PrivilegesForSite(siteID int) []string {
+	if u == nil {
+		return nil
+	}
+	return u.PrivilegesBySite[strconv.Itoa(siteID)]
+}
+
+// HasPrivilege reports whether the user holds privilege (e.g. "Read Computers") on the given site.
+func (u *ResourceSessionUser) HasPrivilege(siteID int, privilege string) bool {
+	return slices.Contains(u.PrivilegesForSite(siteID), privilege)
+}
+
+// RequestUpdateSession is the request body for changing values in the current session.
+type RequestUpdateSession struct {
+	CurrentSiteID int `json:"currentSiteId"`
+}
+
+// ResourceSession is the session state returned after an update.
+type ResourceSession struct {
+	CurrentSiteID int `json:"currentSiteId"`
+}
@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package user_session
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of UserSession.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.UserSessionFake implements it for tests.
+type Service interface {
+	GetCurrentUser(ctx context.Context) (*ResourceSessionUser, *resty.Response, error)
+	UpdateSession(ctx context.Context, request *RequestUpdateSession) (*ResourceSession, *resty.Response, error)
+}
+
+var _ Service = (*UserSession)(nil)
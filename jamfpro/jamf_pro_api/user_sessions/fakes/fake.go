@@ -0,0 +1,43 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the user_sessions
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_sessions"
+	"resty.dev/v3"
+)
+
+// UserSessionsFake is a user_sessions.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UserSessionsFake struct {
+	fakes.Journal
+
+	GetActiveV1Func func(ctx context.Context) ([]user_sessions.ResourceActiveUserSession, *resty.Response, error)
+	GetCountV1Func  func(ctx context.Context) (*user_sessions.ResourceUserSessionCount, *resty.Response, error)
+}
+
+var _ user_sessions.Service = (*UserSessionsFake)(nil)
+
+// GetActiveV1 records the call and delegates to GetActiveV1Func.
+func (f *UserSessionsFake) GetActiveV1(ctx context.Context) ([]user_sessions.ResourceActiveUserSession, *resty.Response, error) {
+	f.Record("GetActiveV1", ctx)
+	if f.GetActiveV1Func == nil {
+		return nil, nil, fakes.NotStubbed("UserSessionsFake.GetActiveV1")
+	}
+	return f.GetActiveV1Func(ctx)
+}
+
+// GetCountV1 records the call and delegates to GetCountV1Func.
+func (f *UserSessionsFake) GetCountV1(ctx context.Context) (*user_sessions.ResourceUserSessionCount, *resty.Response, error) {
+	f.Record("GetCountV1", ctx)
+	if f.GetCountV1Func == nil {
+		return nil, nil, fakes.NotStubbed("UserSessionsFake.GetCountV1")
+	}
+	return f.GetCountV1Func(ctx)
+}
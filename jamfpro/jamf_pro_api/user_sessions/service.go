@@ -0,0 +1,19 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package user_sessions
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of UserSessions.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.UserSessionsFake implements it for tests.
+type Service interface {
+	GetActiveV1(ctx context.Context) ([]ResourceActiveUserSession, *resty.Response, error)
+	GetCountV1(ctx context.Context) (*ResourceUserSessionCount, *resty.Response, error)
+}
+
+var _ Service = (*UserSessions)(nil)
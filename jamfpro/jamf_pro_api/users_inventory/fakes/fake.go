@@ -0,0 +1,73 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the users_inventory
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/users_inventory"
+	"resty.dev/v3"
+)
+
+// UsersInventoryFake is a users_inventory.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type UsersInventoryFake struct {
+	fakes.Journal
+
+	CreateV1Func     func(ctx context.Context, request *users_inventory.RequestUserInventory) (*users_inventory.CreateUserResponse, *resty.Response, error)
+	DeleteByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func    func(ctx context.Context, id string) (*users_inventory.ResourceUser, *resty.Response, error)
+	ListV1Func       func(ctx context.Context, rsqlQuery map[string]string) (*users_inventory.ListUsersResponse, *resty.Response, error)
+	UpdateByIDV1Func func(ctx context.Context, id string, request *users_inventory.RequestUserInventory) (*resty.Response, error)
+}
+
+var _ users_inventory.Service = (*UsersInventoryFake)(nil)
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *UsersInventoryFake) CreateV1(ctx context.Context, request *users_inventory.RequestUserInventory) (*users_inventory.CreateUserResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("UsersInventoryFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *UsersInventoryFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("UsersInventoryFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *UsersInventoryFake) GetByIDV1(ctx context.Context, id string) (*users_inventory.ResourceUser, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("UsersInventoryFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *UsersInventoryFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*users_inventory.ListUsersResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("UsersInventoryFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *UsersInventoryFake) UpdateByIDV1(ctx context.Context, id string, request *users_inventory.RequestUserInventory) (*resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, fakes.NotStubbed("UsersInventoryFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package users_inventory
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of UsersInventory.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.UsersInventoryFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *RequestUserInventory) (*CreateUserResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceUser, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListUsersResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestUserInventory) (*resty.Response, error)
+}
+
+var _ Service = (*UsersInventory)(nil)
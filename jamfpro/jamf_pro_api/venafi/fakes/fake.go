@@ -0,0 +1,153 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the venafi
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/venafi"
+	"resty.dev/v3"
+)
+
+// VenafiFake is a venafi.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type VenafiFake struct {
+	fakes.Journal
+
+	AddHistoryNoteFunc                func(ctx context.Context, id string, request *venafi.HistoryNoteRequest) (*venafi.ResponseVenafiCreated, *resty.Response, error)
+	CreateFunc                        func(ctx context.Context, request *venafi.ResourceVenafi) (*venafi.ResponseVenafiCreated, *resty.Response, error)
+	DeleteByIDFunc                    func(ctx context.Context, id string) (*resty.Response, error)
+	DeleteProxyTrustStoreByIDV1Func   func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDFunc                       func(ctx context.Context, id string) (*venafi.ResponseVenafi, *resty.Response, error)
+	GetConnectionStatusFunc           func(ctx context.Context, id string) (*venafi.ResponseConnectionStatus, *resty.Response, error)
+	GetDependentProfilesFunc          func(ctx context.Context, id string) (*venafi.ResponseDependentProfiles, *resty.Response, error)
+	GetHistoryFunc                    func(ctx context.Context, id string, query map[string]string) (*venafi.ResponseHistory, *resty.Response, error)
+	GetJamfPublicKeyFunc              func(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetProxyTrustStoreFunc            func(ctx context.Context, id string) (*resty.Response, []byte, error)
+	RegenerateJamfPublicKeyByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByIDFunc                    func(ctx context.Context, id string, request *venafi.ResourceVenafi) (*venafi.ResponseVenafi, *resty.Response, error)
+	UploadProxyTrustStoreByIDV1Func   func(ctx context.Context, id string, pemCertificate []byte) (*resty.Response, error)
+}
+
+var _ venafi.Service = (*VenafiFake)(nil)
+
+// AddHistoryNote records the call and delegates to AddHistoryNoteFunc.
+func (f *VenafiFake) AddHistoryNote(ctx context.Context, id string, request *venafi.HistoryNoteRequest) (*venafi.ResponseVenafiCreated, *resty.Response, error) {
+	f.Record("AddHistoryNote", ctx, id, request)
+	if f.AddHistoryNoteFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.AddHistoryNote")
+	}
+	return f.AddHistoryNoteFunc(ctx, id, request)
+}
+
+// Create records the call and delegates to CreateFunc.
+func (f *VenafiFake) Create(ctx context.Context, request *venafi.ResourceVenafi) (*venafi.ResponseVenafiCreated, *resty.Response, error) {
+	f.Record("Create", ctx, request)
+	if f.CreateFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.Create")
+	}
+	return f.CreateFunc(ctx, request)
+}
+
+// DeleteByID records the call and delegates to DeleteByIDFunc.
+func (f *VenafiFake) DeleteByID(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByID", ctx, id)
+	if f.DeleteByIDFunc == nil {
+		return nil, fakes.NotStubbed("VenafiFake.DeleteByID")
+	}
+	return f.DeleteByIDFunc(ctx, id)
+}
+
+// DeleteProxyTrustStoreByIDV1 records the call and delegates to DeleteProxyTrustStoreByIDV1Func.
+func (f *VenafiFake) DeleteProxyTrustStoreByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteProxyTrustStoreByIDV1", ctx, id)
+	if f.DeleteProxyTrustStoreByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VenafiFake.DeleteProxyTrustStoreByIDV1")
+	}
+	return f.DeleteProxyTrustStoreByIDV1Func(ctx, id)
+}
+
+// GetByID records the call and delegates to GetByIDFunc.
+func (f *VenafiFake) GetByID(ctx context.Context, id string) (*venafi.ResponseVenafi, *resty.Response, error) {
+	f.Record("GetByID", ctx, id)
+	if f.GetByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetByID")
+	}
+	return f.GetByIDFunc(ctx, id)
+}
+
+// GetConnectionStatus records the call and delegates to GetConnectionStatusFunc.
+func (f *VenafiFake) GetConnectionStatus(ctx context.Context, id string) (*venafi.ResponseConnectionStatus, *resty.Response, error) {
+	f.Record("GetConnectionStatus", ctx, id)
+	if f.GetConnectionStatusFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetConnectionStatus")
+	}
+	return f.GetConnectionStatusFunc(ctx, id)
+}
+
+// GetDependentProfiles records the call and delegates to GetDependentProfilesFunc.
+func (f *VenafiFake) GetDependentProfiles(ctx context.Context, id string) (*venafi.ResponseDependentProfiles, *resty.Response, error) {
+	f.Record("GetDependentProfiles", ctx, id)
+	if f.GetDependentProfilesFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetDependentProfiles")
+	}
+	return f.GetDependentProfilesFunc(ctx, id)
+}
+
+// GetHistory records the call and delegates to GetHistoryFunc.
+func (f *VenafiFake) GetHistory(ctx context.Context, id string, query map[string]string) (*venafi.ResponseHistory, *resty.Response, error) {
+	f.Record("GetHistory", ctx, id, query)
+	if f.GetHistoryFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetHistory")
+	}
+	return f.GetHistoryFunc(ctx, id, query)
+}
+
+// GetJamfPublicKey records the call and delegates to GetJamfPublicKeyFunc.
+func (f *VenafiFake) GetJamfPublicKey(ctx context.Context, id string) (*resty.Response, []byte, error) {
+	f.Record("GetJamfPublicKey", ctx, id)
+	if f.GetJamfPublicKeyFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetJamfPublicKey")
+	}
+	return f.GetJamfPublicKeyFunc(ctx, id)
+}
+
+// GetProxyTrustStore records the call and delegates to GetProxyTrustStoreFunc.
+func (f *VenafiFake) GetProxyTrustStore(ctx context.Context, id string) (*resty.Response, []byte, error) {
+	f.Record("GetProxyTrustStore", ctx, id)
+	if f.GetProxyTrustStoreFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.GetProxyTrustStore")
+	}
+	return f.GetProxyTrustStoreFunc(ctx, id)
+}
+
+// RegenerateJamfPublicKeyByIDV1 records the call and delegates to RegenerateJamfPublicKeyByIDV1Func.
+func (f *VenafiFake) RegenerateJamfPublicKeyByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("RegenerateJamfPublicKeyByIDV1", ctx, id)
+	if f.RegenerateJamfPublicKeyByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VenafiFake.RegenerateJamfPublicKeyByIDV1")
+	}
+	return f.RegenerateJamfPublicKeyByIDV1Func(ctx, id)
+}
+
+// UpdateByID records the call and delegates to UpdateByIDFunc.
+func (f *VenafiFake) UpdateByID(ctx context.Context, id string, request *venafi.ResourceVenafi) (*venafi.ResponseVenafi, *resty.Response, error) {
+	f.Record("UpdateByID", ctx, id, request)
+	if f.UpdateByIDFunc == nil {
+		return nil, nil, fakes.NotStubbed("VenafiFake.UpdateByID")
+	}
+	return f.UpdateByIDFunc(ctx, id, request)
+}
+
+// UploadProxyTrustStoreByIDV1 records the call and delegates to UploadProxyTrustStoreByIDV1Func.
+func (f *VenafiFake) UploadProxyTrustStoreByIDV1(ctx context.Context, id string, pemCertificate []byte) (*resty.Response, error) {
+	f.Record("UploadProxyTrustStoreByIDV1", ctx, id, pemCertificate)
+	if f.UploadProxyTrustStoreByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VenafiFake.UploadProxyTrustStoreByIDV1")
+	}
+	return f.UploadProxyTrustStoreByIDV1Func(ctx, id, pemCertificate)
+}
@@ -0,0 +1,30 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package venafi
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of Venafi.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.VenafiFake implements it for tests.
+type Service interface {
+	AddHistoryNote(ctx context.Context, id string, request *HistoryNoteRequest) (*ResponseVenafiCreated, *resty.Response, error)
+	Create(ctx context.Context, request *ResourceVenafi) (*ResponseVenafiCreated, *resty.Response, error)
+	DeleteByID(ctx context.Context, id string) (*resty.Response, error)
+	DeleteProxyTrustStoreByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByID(ctx context.Context, id string) (*ResponseVenafi, *resty.Response, error)
+	GetConnectionStatus(ctx context.Context, id string) (*ResponseConnectionStatus, *resty.Response, error)
+	GetDependentProfiles(ctx context.Context, id string) (*ResponseDependentProfiles, *resty.Response, error)
+	GetHistory(ctx context.Context, id string, query map[string]string) (*ResponseHistory, *resty.Response, error)
+	GetJamfPublicKey(ctx context.Context, id string) (*resty.Response, []byte, error)
+	GetProxyTrustStore(ctx context.Context, id string) (*resty.Response, []byte, error)
+	RegenerateJamfPublicKeyByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByID(ctx context.Context, id string, request *ResourceVenafi) (*ResponseVenafi, *resty.Response, error)
+	UploadProxyTrustStoreByIDV1(ctx context.Context, id string, pemCertificate []byte) (*resty.Response, error)
+}
+
+var _ Service = (*Venafi)(nil)
@@ -0,0 +1,123 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+// Package fakes provides hand-controllable implementations of the volume_purchasing_locations
+// service interfaces for tests.
+package fakes
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/volume_purchasing_locations"
+	"resty.dev/v3"
+)
+
+// VolumePurchasingLocationsFake is a volume_purchasing_locations.Service whose methods are controlled by its Func
+// fields. A method whose Func field is nil returns fakes.ErrNotStubbed, and
+// every call is recorded in the embedded Journal.
+type VolumePurchasingLocationsFake struct {
+	fakes.Journal
+
+	AddHistoryNotesV1Func                            func(ctx context.Context, id string, request *volume_purchasing_locations.AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1Func                                     func(ctx context.Context, request *volume_purchasing_locations.RequestVolumePurchasingLocation) (*volume_purchasing_locations.CreateResponse, *resty.Response, error)
+	DeleteByIDV1Func                                 func(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1Func                                    func(ctx context.Context, id string) (*volume_purchasing_locations.ResourceVolumePurchasingLocation, *resty.Response, error)
+	GetContentV1Func                                 func(ctx context.Context, id string, rsqlQuery map[string]string) (*volume_purchasing_locations.ContentListResponse, *resty.Response, error)
+	GetHistoryV1Func                                 func(ctx context.Context, id string, rsqlQuery map[string]string) (*volume_purchasing_locations.HistoryListResponse, *resty.Response, error)
+	ListV1Func                                       func(ctx context.Context, rsqlQuery map[string]string) (*volume_purchasing_locations.ListResponse, *resty.Response, error)
+	ReclaimVolumePurchasingLocationByIDV1Func        func(ctx context.Context, id string) (*resty.Response, error)
+	RevokeVolumePurchasingLocationLicensesByIDV1Func func(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByIDV1Func                                 func(ctx context.Context, id string, request *volume_purchasing_locations.RequestVolumePurchasingLocation) (*volume_purchasing_locations.ResourceVolumePurchasingLocation, *resty.Response, error)
+}
+
+var _ volume_purchasing_locations.Service = (*VolumePurchasingLocationsFake)(nil)
+
+// AddHistoryNotesV1 records the call and delegates to AddHistoryNotesV1Func.
+func (f *VolumePurchasingLocationsFake) AddHistoryNotesV1(ctx context.Context, id string, request *volume_purchasing_locations.AddHistoryNotesRequest) (*resty.Response, error) {
+	f.Record("AddHistoryNotesV1", ctx, id, request)
+	if f.AddHistoryNotesV1Func == nil {
+		return nil, fakes.NotStubbed("VolumePurchasingLocationsFake.AddHistoryNotesV1")
+	}
+	return f.AddHistoryNotesV1Func(ctx, id, request)
+}
+
+// CreateV1 records the call and delegates to CreateV1Func.
+func (f *VolumePurchasingLocationsFake) CreateV1(ctx context.Context, request *volume_purchasing_locations.RequestVolumePurchasingLocation) (*volume_purchasing_locations.CreateResponse, *resty.Response, error) {
+	f.Record("CreateV1", ctx, request)
+	if f.CreateV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.CreateV1")
+	}
+	return f.CreateV1Func(ctx, request)
+}
+
+// DeleteByIDV1 records the call and delegates to DeleteByIDV1Func.
+func (f *VolumePurchasingLocationsFake) DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("DeleteByIDV1", ctx, id)
+	if f.DeleteByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VolumePurchasingLocationsFake.DeleteByIDV1")
+	}
+	return f.DeleteByIDV1Func(ctx, id)
+}
+
+// GetByIDV1 records the call and delegates to GetByIDV1Func.
+func (f *VolumePurchasingLocationsFake) GetByIDV1(ctx context.Context, id string) (*volume_purchasing_locations.ResourceVolumePurchasingLocation, *resty.Response, error) {
+	f.Record("GetByIDV1", ctx, id)
+	if f.GetByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.GetByIDV1")
+	}
+	return f.GetByIDV1Func(ctx, id)
+}
+
+// GetContentV1 records the call and delegates to GetContentV1Func.
+func (f *VolumePurchasingLocationsFake) GetContentV1(ctx context.Context, id string, rsqlQuery map[string]string) (*volume_purchasing_locations.ContentListResponse, *resty.Response, error) {
+	f.Record("GetContentV1", ctx, id, rsqlQuery)
+	if f.GetContentV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.GetContentV1")
+	}
+	return f.GetContentV1Func(ctx, id, rsqlQuery)
+}
+
+// GetHistoryV1 records the call and delegates to GetHistoryV1Func.
+func (f *VolumePurchasingLocationsFake) GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*volume_purchasing_locations.HistoryListResponse, *resty.Response, error) {
+	f.Record("GetHistoryV1", ctx, id, rsqlQuery)
+	if f.GetHistoryV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.GetHistoryV1")
+	}
+	return f.GetHistoryV1Func(ctx, id, rsqlQuery)
+}
+
+// ListV1 records the call and delegates to ListV1Func.
+func (f *VolumePurchasingLocationsFake) ListV1(ctx context.Context, rsqlQuery map[string]string) (*volume_purchasing_locations.ListResponse, *resty.Response, error) {
+	f.Record("ListV1", ctx, rsqlQuery)
+	if f.ListV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.ListV1")
+	}
+	return f.ListV1Func(ctx, rsqlQuery)
+}
+
+// ReclaimVolumePurchasingLocationByIDV1 records the call and delegates to ReclaimVolumePurchasingLocationByIDV1Func.
+func (f *VolumePurchasingLocationsFake) ReclaimVolumePurchasingLocationByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("ReclaimVolumePurchasingLocationByIDV1", ctx, id)
+	if f.ReclaimVolumePurchasingLocationByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VolumePurchasingLocationsFake.ReclaimVolumePurchasingLocationByIDV1")
+	}
+	return f.ReclaimVolumePurchasingLocationByIDV1Func(ctx, id)
+}
+
+// RevokeVolumePurchasingLocationLicensesByIDV1 records the call and delegates to RevokeVolumePurchasingLocationLicensesByIDV1Func.
+func (f *VolumePurchasingLocationsFake) RevokeVolumePurchasingLocationLicensesByIDV1(ctx context.Context, id string) (*resty.Response, error) {
+	f.Record("RevokeVolumePurchasingLocationLicensesByIDV1", ctx, id)
+	if f.RevokeVolumePurchasingLocationLicensesByIDV1Func == nil {
+		return nil, fakes.NotStubbed("VolumePurchasingLocationsFake.RevokeVolumePurchasingLocationLicensesByIDV1")
+	}
+	return f.RevokeVolumePurchasingLocationLicensesByIDV1Func(ctx, id)
+}
+
+// UpdateByIDV1 records the call and delegates to UpdateByIDV1Func.
+func (f *VolumePurchasingLocationsFake) UpdateByIDV1(ctx context.Context, id string, request *volume_purchasing_locations.RequestVolumePurchasingLocation) (*volume_purchasing_locations.ResourceVolumePurchasingLocation, *resty.Response, error) {
+	f.Record("UpdateByIDV1", ctx, id, request)
+	if f.UpdateByIDV1Func == nil {
+		return nil, nil, fakes.NotStubbed("VolumePurchasingLocationsFake.UpdateByIDV1")
+	}
+	return f.UpdateByIDV1Func(ctx, id, request)
+}
@@ -0,0 +1,27 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package volume_purchasing_locations
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of VolumePurchasingLocations.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.VolumePurchasingLocationsFake implements it for tests.
+type Service interface {
+	AddHistoryNotesV1(ctx context.Context, id string, request *AddHistoryNotesRequest) (*resty.Response, error)
+	CreateV1(ctx context.Context, request *RequestVolumePurchasingLocation) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceVolumePurchasingLocation, *resty.Response, error)
+	GetContentV1(ctx context.Context, id string, rsqlQuery map[string]string) (*ContentListResponse, *resty.Response, error)
+	GetHistoryV1(ctx context.Context, id string, rsqlQuery map[string]string) (*HistoryListResponse, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	ReclaimVolumePurchasingLocationByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	RevokeVolumePurchasingLocationLicensesByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestVolumePurchasingLocation) (*ResourceVolumePurchasingLocation, *resty.Response, error)
+}
+
+var _ Service = (*VolumePurchasingLocations)(nil)
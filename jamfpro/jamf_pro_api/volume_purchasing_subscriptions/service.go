@@ -0,0 +1,22 @@
+// Code generated by tools/service_interfaces; DO NOT EDIT.
+
+package volume_purchasing_subscriptions
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// Service is the method set of VolumePurchasingSubscriptions.
+// jamfpro.JamfProAPIClient exposes the service through it, and
+// fakes.VolumePurchasingSubscriptionsFake implements it for tests.
+type Service interface {
+	CreateV1(ctx context.Context, request *RequestVolumePurchasingSubscription) (*CreateResponse, *resty.Response, error)
+	DeleteByIDV1(ctx context.Context, id string) (*resty.Response, error)
+	GetByIDV1(ctx context.Context, id string) (*ResourceVolumePurchasingSubscription, *resty.Response, error)
+	ListV1(ctx context.Context, rsqlQuery map[string]string) (*ListResponse, *resty.Response, error)
+	UpdateByIDV1(ctx context.Context, id string, request *RequestVolumePurchasingSubscription) (*ResourceVolumePurchasingSubscription, *resty.Response, error)
+}
+
+var _ Service = (*VolumePurchasingSubscriptions)(nil)
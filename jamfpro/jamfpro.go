@@ -1,7 +1,11 @@
 package jamfpro
 
+//go:generate go run ../tools/service_interfaces
+
 import (
+	"context"
 	"fmt"
+	"time"
 
 	classic_accounts "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/accounts"
 	classic_accounts_groups "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/accounts_groups"
@@ -91,6 +95,7 @@ import (
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/computer_prestages"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/conditional_access"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/csa"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/dashboard"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/declarative_device_management"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/departments"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/device_communication_settings"
@@ -148,12 +153,16 @@ import (
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/oidc"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/onboarding"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/packages"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/parent_app"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_management"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policies"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_policy_logs"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/patch_software_title_configurations"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/policy_properties"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/reenrollment"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/remote_administration"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/return_to_service"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/scheduler"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/scripts"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_branding_ios"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/self_service_branding_macos"
@@ -165,6 +174,7 @@ import (
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/slasa"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_computer_groups"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_mobile_device_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smart_user_groups"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/smtp_server"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sso_certificate"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/sso_failover"
@@ -172,9 +182,13 @@ import (
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/startup_status"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_computer_groups"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_mobile_device_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/static_user_groups"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/supervision_identities"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/teacher_app"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/time_zones"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/tomcat_settings"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_session"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/user_sessions"
 	users_inventory "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/users_inventory"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/venafi"
@@ -190,187 +204,201 @@ type Client struct {
 	JamfProAPI *JamfProAPIClient
 }
 
-// ClassicAPIClient groups all Classic API services.
+// ClassicAPIClient groups all Classic API services. Each field holds its
+// package's Service interface, so a fake can stand in for any of them.
 type ClassicAPIClient struct {
-	Accounts                          *classic_accounts.Accounts
-	AccountGroups                     *classic_accounts_groups.AccountsGroups
-	ActivationCode                    *classic_activation_code.ActivationCode
-	AdvancedComputerSearches          *classic_advanced_computer_searches.AdvancedComputerSearches
-	AdvancedUserSearches              *classic_advanced_user_searches.AdvancedUserSearches
-	AllowedFileExtensions             *classic_allowed_file_extensions.AllowedFileExtensions
-	BYOProfiles                       *classic_byoprofiles.Byoprofiles
-	Classes                           *classic_classes.Classes
-	CommandFlush                      *classic_command_flush.CommandFlush
-	ComputerCommands                  *classic_computer_commands.ComputerCommands
-	ComputerGroups                    *classic_computer_groups.ComputerGroups
-	Computers                         *classic_computers.Computers
-	ComputerHistory                   *classic_computer_history.ComputerHistory
-	ComputerInvitations               *classic_computer_invitations.ComputerInvitations
-	ComputerInventoryCollection       *classic_computer_inventory_collection.ComputerInventoryCollection
-	DirectoryBindings                 *classic_directory_bindings.DirectoryBindings
-	DiskEncryptionConfigurations      *classic_disk_encryption_configurations.DiskEncryptionConfigurations
-	DockItems                         *classic_dock_items.DockItems
-	Ebooks                            *classic_ebooks.Ebooks
-	FileShareDistributionPoints       *classic_file_share_distribution_points.FileShareDistributionPoints
-	FileUploads                       *classic_file_uploads.FileUploads
-	IBeacons                          *classic_ibeacons.Ibeacons
-	LdapServers                       *classic_ldap_servers.LdapServers
-	LicensedSoftware                  *classic_licensed_software.LicensedSoftware
-	MacApplications                   *classic_mac_applications.MacApplications
-	MacOSConfigurationProfiles        *classic_macos_configuration_profiles.MacosConfigurationProfiles
-	MobileDeviceApplications          *classic_mobile_device_applications.MobileDeviceApplications
-	MobileDeviceCommands              *classic_mobile_device_commands.MobileDeviceCommands
-	MobileDeviceConfigurationProfiles *classic_mobile_device_configuration_profiles.MobileDeviceConfigurationProfiles
-	MobileDeviceEnrollmentProfiles    *classic_mobile_device_enrollment_profiles.MobileDeviceEnrollmentProfiles
-	MobileDeviceGroups                *classic_mobile_device_groups.MobileDeviceGroups
-	MobileDeviceProvisioningProfiles  *classic_mobile_device_provisioning_profiles.MobileDeviceProvisioningProfiles
-	MobileDevices                     *classic_mobile_devices.MobileDevices
-	NetworkSegments                   *classic_network_segments.NetworkSegments
-	PatchExternalSources              *classic_patch_external_sources.PatchExternalSources
-	Policies                          *classic_policies.Policies
-	Printers                          *classic_printers.Printers
-	RemoveableMacAddresses            *classic_removeable_mac_addresses.RemoveableMacAddresses
-	RestrictedSoftware                *classic_restricted_software.RestrictedSoftware
-	Sites                             *classic_sites.Sites
-	SmartUserGroups                   *classic_smart_user_groups.SmartUserGroups
-	SoftwareUpdateServers             *classic_software_update_servers.SoftwareUpdateServers
-	StaticUserGroups                  *classic_static_user_groups.StaticUserGroups
-	UserExtensionAttributes           *classic_user_extension_attributes.UserExtensionAttributes
-	Users                             *classic_users.Users
-	VppAccounts                       *classic_vpp_accounts.VppAccounts
-	VppAssignments                    *classic_vpp_assignments.VppAssignments
-	Webhooks                          *classic_webhooks.Webhooks
+	Accounts                          classic_accounts.Service
+	AccountGroups                     classic_accounts_groups.Service
+	ActivationCode                    classic_activation_code.Service
+	AdvancedComputerSearches          classic_advanced_computer_searches.Service
+	AdvancedUserSearches              classic_advanced_user_searches.Service
+	AllowedFileExtensions             classic_allowed_file_extensions.Service
+	BYOProfiles                       classic_byoprofiles.Service
+	Classes                           classic_classes.Service
+	CommandFlush                      classic_command_flush.Service
+	ComputerCommands                  classic_computer_commands.Service
+	ComputerGroups                    classic_computer_groups.Service
+	Computers                         classic_computers.Service
+	ComputerHistory                   classic_computer_history.Service
+	ComputerInvitations               classic_computer_invitations.Service
+	ComputerInventoryCollection       classic_computer_inventory_collection.Service
+	DirectoryBindings                 classic_directory_bindings.Service
+	DiskEncryptionConfigurations      classic_disk_encryption_configurations.Service
+	DockItems                         classic_dock_items.Service
+	Ebooks                            classic_ebooks.Service
+	FileShareDistributionPoints       classic_file_share_distribution_points.Service
+	FileUploads                       classic_file_uploads.Service
+	IBeacons                          classic_ibeacons.Service
+	LdapServers                       classic_ldap_servers.Service
+	LicensedSoftware                  classic_licensed_software.Service
+	MacApplications                   classic_mac_applications.Service
+	MacOSConfigurationProfiles        classic_macos_configuration_profiles.Service
+	MobileDeviceApplications          classic_mobile_device_applications.Service
+	MobileDeviceCommands              classic_mobile_device_commands.Service
+	MobileDeviceConfigurationProfiles classic_mobile_device_configuration_profiles.Service
+	MobileDeviceEnrollmentProfiles    classic_mobile_device_enrollment_profiles.Service
+	MobileDeviceGroups                classic_mobile_device_groups.Service
+	MobileDeviceProvisioningProfiles  classic_mobile_device_provisioning_profiles.Service
+	MobileDevices                     classic_mobile_devices.Service
+	NetworkSegments                   classic_network_segments.Service
+	PatchExternalSources              classic_patch_external_sources.Service
+	Policies                          classic_policies.Service
+	Printers                          classic_printers.Service
+	RemoveableMacAddresses            classic_removeable_mac_addresses.Service
+	RestrictedSoftware                classic_restricted_software.Service
+	Sites                             classic_sites.Service
+	SmartUserGroups                   classic_smart_user_groups.Service
+	SoftwareUpdateServers             classic_software_update_servers.Service
+	StaticUserGroups                  classic_static_user_groups.Service
+	UserExtensionAttributes           classic_user_extension_attributes.Service
+	Users                             classic_users.Service
+	VppAccounts                       classic_vpp_accounts.Service
+	VppAssignments                    classic_vpp_assignments.Service
+	Webhooks                          classic_webhooks.Service
 }
 
-// JamfProAPIClient groups all Jamf Pro API services.
+// JamfProAPIClient groups all Jamf Pro API services. Each field holds its
+// package's Service interface, so a fake can stand in for any of them.
 type JamfProAPIClient struct {
-	AccessManagementSettings            *access_management_settings.AccessManagementSettings
-	AccountGroups                       *account_groups.AccountGroups
-	AccountPreferences                  *account_preferences.AccountPreferences
-	Accounts                            *accounts.Accounts
-	ActivationCode                      *activation_code.ActivationCode
-	AdcsSettings                        *adcs_settings.AdcsSettings
-	AdueSessionTokenSettings            *adue_session_token_settings.AdueSessionTokenSettings
-	AdvancedMobileDeviceSearches        *advanced_mobile_device_searches.AdvancedMobileDeviceSearches
-	AdvancedUserContentSearches         *advanced_user_content_searches.AdvancedUserContentSearches
-	ApiAuthorization                    *api_authorization.ApiAuthorization
-	ApiIntegrations                     *api_integrations.ApiIntegrations
-	ApiRolePrivileges                   *api_role_privileges.ApiRolePrivileges
-	ApiRoles                            *api_roles.ApiRoles
-	ApnsClientPushStatus                *apns_client_push_status.ApnsClientPushStatus
-	AppInstallers                       *app_installers.AppInstallers
-	AppRequest                          *app_request.AppRequest
-	AppStoreCountryCodes                *app_store_country_codes.AppStoreCountryCodes
-	Bookmarks                           *bookmarks.Bookmarks
-	Branding                            *branding.Branding
-	Buildings                           *buildings.Buildings
-	CacheSettings                       *cache_settings.CacheSettings
-	Categories                          *categories.Categories
-	CertificateAuthority                *certificate_authority.CertificateAuthority
-	ClassicLdap                         *classic_ldap.ClassicLdap
-	ClientCheckin                       *client_checkin.ClientCheckin
-	CloudAzure                          *cloud_azure.CloudAzure
-	CloudDistributionPoint              *cloud_distribution_point.CloudDistributionPoint
-	CloudIdp                            *cloud_idp.CloudIdp
-	CloudInformation                    *cloud_information.CloudInformation
-	CloudLdap                           *cloud_ldap.CloudLdap
-	CloudLdapKeystore                   *cloud_ldap_keystore.CloudLdapKeystore
-	ComputerExtensionAttributes         *computer_extension_attributes.ComputerExtensionAttributes
-	ComputerGroups                      *computer_groups.ComputerGroups
-	ComputerInventory                   *computer_inventory.ComputerInventory
-	ComputerInventoryCollectionSettings *computer_inventory_collection_settings.ComputerInventoryCollectionSettings
-	ComputerPrestages                   *computer_prestages.ComputerPrestages
-	ConditionalAccess                   *conditional_access.ConditionalAccess
-	Csa                                 *csa.Csa
-	DeclarativeDeviceManagement         *declarative_device_management.DeclarativeDeviceManagement
-	Departments                         *departments.Departments
-	DeviceCommunicationSettings         *device_communication_settings.DeviceCommunicationSettings
-	DeviceEnrollments                   *device_enrollments.DeviceEnrollments
-	Devices                             *devices.Devices
-	Digicert                            *digicert.Digicert
-	DistributionPoint                   *distribution_point.DistributionPoint
-	DockItems                           *dock_items.DockItems
-	DssDeclarations                     *dss_declarations.DssDeclarations
-	Ebooks                              *ebooks.Ebooks
-	Engage                              *engage.Engage
-	Enrollment                          *enrollment.Enrollment
-	EnrollmentCustomizationPreview      *enrollment_customization_preview.EnrollmentCustomizationPreview
-	EnrollmentCustomizations            *enrollment_customizations.EnrollmentCustomizations
-	EnrollmentSettings                  *enrollment_settings.EnrollmentSettings
-	Groups                              *groups.Groups
-	GsxConnection                       *gsx_connection.GsxConnection
-	HealthCheck                         *health_check.HealthCheck
-	Icon                                *icon.Icon
-	ImpactAlertNotificationSettings     *impact_alert_notification_settings.ImpactAlertNotificationSettings
-	InventoryInformation                *inventory_information.InventoryInformation
-	InventoryPreload                    *inventory_preload.InventoryPreload
-	JamfAccountPreferences              *jamf_account_preferences.JamfAccountPreferences
-	JamfConnect                         *jamf_connect.JamfConnect
-	JamfManagementFramework             *jamf_management_framework.JamfManagementFramework
-	JamfPackage                         *jamf_package.JamfPackage
-	JamfProInformation                  *jamf_pro_information.JamfProInformation
-	JamfProNotifications                *jamf_pro_notifications.JamfProNotifications
-	JamfProServerUrl                    *jamf_pro_server_url.JamfProServerUrl
-	JamfProSystemInitialization         *jamf_pro_system_initialization.JamfProSystemInitialization
-	JamfProUserAccountSettings          *jamf_pro_user_account_settings.JamfProUserAccountSettings
-	JamfProVersion                      *jamf_pro_version.JamfProVersion
-	JamfProtect                         *jamf_protect.JamfProtect
-	JamfRemoteAssist                    *jamf_remote_assist.JamfRemoteAssist
-	LastLogin                           *last_login.LastLogin
-	M2M                                 *m2m.M2M
-	Jcds                                *jcds.Jcds
-	Ldap                                *ldap.Ldap
-	LocalAdminPassword                  *local_admin_password.LocalAdminPassword
-	Locales                             *locales.Locales
-	LogFlushing                         *log_flushing.LogFlushing
-	LoginCustomization                  *login_customization.LoginCustomization
-	MacosConfigurationProfiles          *macos_configuration_profiles.MacosConfigurationProfiles
-	ManagedSoftwareUpdates              *managed_software_updates.ManagedSoftwareUpdates
-	Mdm                                 *mdm.Mdm
-	MdmRenewal                          *mdm_renewal.MdmRenewal
-	MobileDeviceApps                    *mobile_device_apps.MobileDeviceApps
-	MobileDeviceEnrollmentProfile       *mobile_device_enrollment_profile.MobileDeviceEnrollmentProfile
-	MobileDeviceExtensionAttributes     *mobile_device_extension_attributes.MobileDeviceExtensionAttributes
-	MobileDeviceGroups                  *mobile_device_groups.MobileDeviceGroups
-	MobileDevicePrestages               *mobile_device_prestages.MobileDevicePrestages
-	MobileDevices                       *mobile_devices.MobileDevices
-	Notifications                       *notifications.Notifications
-	Oauth2SessionTokens                 *oauth2_session_tokens.Oauth2SessionTokens
-	Oidc                                *oidc.Oidc
-	Onboarding                          *onboarding.Onboarding
-	Packages                            *packages.Packages
-	PatchManagement                     *patch_management.PatchManagement
-	PatchPolicies                       *patch_policies.PatchPolicies
-	PatchSoftwareTitleConfigurations    *patch_software_title_configurations.PatchSoftwareTitleConfigurations
-	PolicyProperties                    *policy_properties.PolicyProperties
-	Reenrollment                        *reenrollment.Reenrollment
-	ReturnToService                     *return_to_service.ReturnToService
-	Scripts                             *scripts.Scripts
-	SelfServiceBrandingIos              *self_service_branding_ios.SelfServiceBrandingIos
-	SelfServiceBrandingMacos            *self_service_branding_macos.SelfServiceBrandingMacos
-	SelfServiceBrandingUpload           *self_service_branding_upload.SelfServiceBrandingUpload
-	SelfServicePlusSettings             *self_service_plus_settings.SelfServicePlusSettings
-	SelfServiceSettings                 *self_service_settings.SelfServiceSettings
-	ServiceDiscoveryEnrollment          *service_discovery_enrollment.ServiceDiscoveryEnrollment
-	Sites                               *sites.Sites
-	Slasa                               *slasa.Slasa
-	SmartComputerGroups                 *smart_computer_groups.SmartComputerGroups
-	SmartMobileDeviceGroups             *smart_mobile_device_groups.SmartMobileDeviceGroups
-	SmtpServer                          *smtp_server.SmtpServer
-	SsoCertificate                      *sso_certificate.SsoCertificate
-	SsoFailover                         *sso_failover.SsoFailover
-	SsoSettings                         *sso_settings.SsoSettings
-	StartupStatus                       *startup_status.StartupStatus
-	StaticComputerGroups                *static_computer_groups.StaticComputerGroups
-	StaticMobileDeviceGroups            *static_mobile_device_groups.StaticMobileDeviceGroups
-	TimeZones                           *time_zones.TimeZones
-	TomcatSettings                      *tomcat_settings.TomcatSettings
-	User                                *user.User
-	UserSessions                        *user_sessions.UserSessions
-	UsersInventory                      *users_inventory.UsersInventory
-	Venafi                              *venafi.Venafi
-	VolumePurchasingLocations           *volume_purchasing_locations.VolumePurchasingLocations
-	VolumePurchasingSubscriptions       *volume_purchasing_subscriptions.VolumePurchasingSubscriptions
+	AccessManagementSettings            access_management_settings.Service
+	AccountGroups                       account_groups.Service
+	AccountPreferences                  account_preferences.Service
+	Accounts                            accounts.Service
+	ActivationCode                      activation_code.Service
+	AdcsSettings                        adcs_settings.Service
+	AdueSessionTokenSettings            adue_session_token_settings.Service
+	AdvancedMobileDeviceSearches        advanced_mobile_device_searches.Service
+	AdvancedUserContentSearches         advanced_user_content_searches.Service
+	ApiAuthorization                    api_authorization.Service
+	ApiIntegrations                     api_integrations.Service
+	ApiRolePrivileges                   api_role_privileges.Service
+	ApiRoles                            api_roles.Service
+	ApnsClientPushStatus                apns_client_push_status.Service
+	AppInstallers                       app_installers.Service
+	AppRequest                          app_request.Service
+	AppStoreCountryCodes                app_store_country_codes.Service
+	Bookmarks                           bookmarks.Service
+	Branding                            branding.Service
+	Buildings                           buildings.Service
+	CacheSettings                       cache_settings.Service
+	Categories                          categories.Service
+	CertificateAuthority                certificate_authority.Service
+	ClassicLdap                         classic_ldap.Service
+	ClientCheckin                       client_checkin.Service
+	CloudAzure                          cloud_azure.Service
+	CloudDistributionPoint              cloud_distribution_point.Service
+	CloudIdp                            cloud_idp.Service
+	CloudInformation                    cloud_information.Service
+	CloudLdap                           cloud_ldap.Service
+	CloudLdapKeystore                   cloud_ldap_keystore.Service
+	ComputerExtensionAttributes         computer_extension_attributes.Service
+	ComputerGroups                      computer_groups.Service
+	ComputerInventory                   computer_inventory.Service
+	ComputerInventoryCollectionSettings computer_inventory_collection_settings.Service
+	ComputerPrestages                   computer_prestages.Service
+	ConditionalAccess                   conditional_access.Service
+	Csa                                 csa.Service
+	Dashboard                           dashboard.Service
+	DeclarativeDeviceManagement         declarative_device_management.Service
+	Departments                         departments.Service
+	DeviceCommunicationSettings         device_communication_settings.Service
+	DeviceEnrollments                   device_enrollments.Service
+	Devices                             devices.Service
+	Digicert                            digicert.Service
+	DistributionPoint                   distribution_point.Service
+	DockItems                           dock_items.Service
+	DssDeclarations                     dss_declarations.Service
+	Ebooks                              ebooks.Service
+	Engage                              engage.Service
+	Enrollment                          enrollment.Service
+	EnrollmentCustomizationPreview      enrollment_customization_preview.Service
+	EnrollmentCustomizations            enrollment_customizations.Service
+	EnrollmentSettings                  enrollment_settings.Service
+	Groups                              groups.Service
+	GsxConnection                       gsx_connection.Service
+	HealthCheck                         health_check.Service
+	Icon                                icon.Service
+	ImpactAlertNotificationSettings     impact_alert_notification_settings.Service
+	InventoryInformation                inventory_information.Service
+	InventoryPreload                    inventory_preload.Service
+	JamfAccountPreferences              jamf_account_preferences.Service
+	JamfConnect                         jamf_connect.Service
+	JamfManagementFramework             jamf_management_framework.Service
+	JamfPackage                         jamf_package.Service
+	JamfProInformation                  jamf_pro_information.Service
+	JamfProNotifications                jamf_pro_notifications.Service
+	JamfProServerUrl                    jamf_pro_server_url.Service
+	JamfProSystemInitialization         jamf_pro_system_initialization.Service
+	JamfProUserAccountSettings          jamf_pro_user_account_settings.Service
+	JamfProVersion                      jamf_pro_version.Service
+	JamfProtect                         jamf_protect.Service
+	JamfRemoteAssist                    jamf_remote_assist.Service
+	LastLogin                           last_login.Service
+	M2M                                 m2m.Service
+	Jcds                                jcds.Service
+	Ldap                                ldap.Service
+	LocalAdminPassword                  local_admin_password.Service
+	Locales                             locales.Service
+	LogFlushing                         log_flushing.Service
+	LoginCustomization                  login_customization.Service
+	MacosConfigurationProfiles          macos_configuration_profiles.Service
+	ManagedSoftwareUpdates              managed_software_updates.Service
+	Mdm                                 mdm.Service
+	MdmRenewal                          mdm_renewal.Service
+	MobileDeviceApps                    mobile_device_apps.Service
+	MobileDeviceEnrollmentProfile       mobile_device_enrollment_profile.Service
+	MobileDeviceExtensionAttributes     mobile_device_extension_attributes.Service
+	MobileDeviceGroups                  mobile_device_groups.Service
+	MobileDevicePrestages               mobile_device_prestages.Service
+	MobileDevices                       mobile_devices.Service
+	Notifications                       notifications.Service
+	Oauth2SessionTokens                 oauth2_session_tokens.Service
+	Oidc                                oidc.Service
+	Onboarding                          onboarding.Service
+	Packages                            packages.Service
+	PackageUploader                     packages.PackageUploaderService
+	PackageDownloader                   packages.PackageDownloaderService
+	ParentApp                           parent_app.Service
+	PatchManagement                     patch_management.Service
+	PatchPolicies                       patch_policies.Service
+	PatchPolicyLogs                     patch_policy_logs.Service
+	PatchSoftwareTitleConfigurations    patch_software_title_configurations.Service
+	PolicyProperties                    policy_properties.Service
+	Reenrollment                        reenrollment.Service
+	RemoteAdministration                remote_administration.Service
+	ReturnToService                     return_to_service.Service
+	Scheduler                           scheduler.Service
+	Scripts                             scripts.Service
+	SelfServiceBrandingIos              self_service_branding_ios.Service
+	SelfServiceBrandingMacos            self_service_branding_macos.Service
+	SelfServiceBrandingUpload           self_service_branding_upload.Service
+	SelfServicePlusSettings             self_service_plus_settings.Service
+	SelfServiceSettings                 self_service_settings.Service
+	ServiceDiscoveryEnrollment          service_discovery_enrollment.Service
+	Sites                               sites.Service
+	Slasa                               slasa.Service
+	SmartComputerGroups                 smart_computer_groups.Service
+	SmartMobileDeviceGroups             smart_mobile_device_groups.Service
+	SmartUserGroups                     smart_user_groups.Service
+	SmtpServer                          smtp_server.Service
+	SsoCertificate                      sso_certificate.Service
+	SsoFailover                         sso_failover.Service
+	SsoSettings                         sso_settings.Service
+	StartupStatus                       startup_status.Service
+	StaticComputerGroups                static_computer_groups.Service
+	StaticMobileDeviceGroups            static_mobile_device_groups.Service
+	StaticUserGroups                    static_user_groups.Service
+	SupervisionIdentities               supervision_identities.Service
+	TeacherApp                          teacher_app.Service
+	TimeZones                           time_zones.Service
+	TomcatSettings                      tomcat_settings.Service
+	User                                user.Service
+	UserSession                         user_session.Service
+	UserSessions                        user_sessions.Service
+	UsersInventory                      users_inventory.Service
+	Venafi                              venafi.Service
+	VolumePurchasingLocations           volume_purchasing_locations.Service
+	VolumePurchasingSubscriptions       volume_purchasing_subscriptions.Service
 }
 
 // NewClient creates a new Jamf Pro API client.
@@ -479,6 +507,7 @@ func newJamfProAPIClient(transport *client.Transport) *JamfProAPIClient {
 		ComputerPrestages:                   computer_prestages.NewComputerPrestages(transport),
 		ConditionalAccess:                   conditional_access.NewConditionalAccess(transport),
 		Csa:                                 csa.NewCsa(transport),
+		Dashboard:                           dashboard.NewDashboard(transport),
 		DeclarativeDeviceManagement:         declarative_device_management.NewDeclarativeDeviceManagement(transport),
 		Departments:                         departments.NewDepartments(transport),
 		DeviceCommunicationSettings:         device_communication_settings.NewDeviceCommunicationSettings(transport),
@@ -536,12 +565,18 @@ func newJamfProAPIClient(transport *client.Transport) *JamfProAPIClient {
 		Oidc:                                oidc.NewOidc(transport),
 		Onboarding:                          onboarding.NewOnboarding(transport),
 		Packages:                            packages.NewPackages(transport),
+		PackageUploader:                     packages.NewPackageUploader(transport),
+		PackageDownloader:                   packages.NewPackageDownloader(transport),
+		ParentApp:                           parent_app.NewParentApp(transport),
 		PatchManagement:                     patch_management.NewPatchManagement(transport),
 		PatchPolicies:                       patch_policies.NewPatchPolicies(transport),
+		PatchPolicyLogs:                     patch_policy_logs.NewPatchPolicyLogs(transport),
 		PatchSoftwareTitleConfigurations:    patch_software_title_configurations.NewPatchSoftwareTitleConfigurations(transport),
 		PolicyProperties:                    policy_properties.NewPolicyProperties(transport),
 		Reenrollment:                        reenrollment.NewReenrollment(transport),
+		RemoteAdministration:                remote_administration.NewRemoteAdministration(transport),
 		ReturnToService:                     return_to_service.NewReturnToService(transport),
+		Scheduler:                           scheduler.NewScheduler(transport),
 		Scripts:                             scripts.NewScripts(transport),
 		SelfServiceBrandingIos:              self_service_branding_ios.NewSelfServiceBrandingIos(transport),
 		SelfServiceBrandingMacos:            self_service_branding_macos.NewSelfServiceBrandingMacos(transport),
@@ -553,6 +588,7 @@ func newJamfProAPIClient(transport *client.Transport) *JamfProAPIClient {
 		Slasa:                               slasa.NewSlasa(transport),
 		SmartComputerGroups:                 smart_computer_groups.NewSmartComputerGroups(transport),
 		SmartMobileDeviceGroups:             smart_mobile_device_groups.NewSmartMobileDeviceGroups(transport),
+		SmartUserGroups:                     smart_user_groups.NewSmartUserGroups(transport),
 		SmtpServer:                          smtp_server.NewSmtpServer(transport),
 		SsoCertificate:                      sso_certificate.NewSsoCertificate(transport),
 		SsoFailover:                         sso_failover.NewSsoFailover(transport),
@@ -560,9 +596,13 @@ func newJamfProAPIClient(transport *client.Transport) *JamfProAPIClient {
 		StartupStatus:                       startup_status.NewStartupStatus(transport),
 		StaticComputerGroups:                static_computer_groups.NewStaticComputerGroups(transport),
 		StaticMobileDeviceGroups:            static_mobile_device_groups.NewStaticMobileDeviceGroups(transport),
+		StaticUserGroups:                    static_user_groups.NewStaticUserGroups(transport),
+		SupervisionIdentities:               supervision_identities.NewSupervisionIdentities(transport),
+		TeacherApp:                          teacher_app.NewTeacherApp(transport),
 		TimeZones:                           time_zones.NewTimeZones(transport),
 		TomcatSettings:                      tomcat_settings.NewTomcatSettings(transport),
 		User:                                user.NewUser(transport),
+		UserSession:                         user_session.NewUserSession(transport),
 		UserSessions:                        user_sessions.NewUserSessions(transport),
 		UsersInventory:                      users_inventory.NewUsersInventory(transport),
 		Venafi:                              venafi.NewVenafi(transport),
@@ -575,6 +615,72 @@ func newJamfProAPIClient(transport *client.Transport) *JamfProAPIClient {
 // Build one with config.AuthConfigFromEnv() or config.LoadAuthConfigFromFile().
 type AuthConfig = config.AuthConfig
 
+// TokenSource supplies bearer tokens to the client. It is an alias for
+// config.TokenSource; pass one via AuthConfig.TokenSource or WithTokenSource.
+type TokenSource = config.TokenSource
+
+// TokenSourceFunc adapts an ordinary function to the TokenSource interface.
+type TokenSourceFunc = config.TokenSourceFunc
+
+// StaticTokenSource returns a TokenSource that always yields a pre-minted
+// bearer token until expiry (a zero expiry never expires).
+func StaticTokenSource(token string, expiry time.Time) TokenSource {
+	return config.StaticTokenSource(token, expiry)
+}
+
+// TokenCache persists bearer tokens for reuse until expiry. It is an alias
+// for client.TokenCache; pass one via WithTokenCache.
+type TokenCache = client.TokenCache
+
+// CachedToken is a bearer token as stored in a TokenCache.
+type CachedToken = client.CachedToken
+
+// NewMemoryTokenCache returns an in-process TokenCache that can be shared
+// between several clients.
+func NewMemoryTokenCache() TokenCache {
+	return client.NewMemoryTokenCache()
+}
+
+// NewFileTokenCache returns a TokenCache that persists tokens as 0600 files in
+// dir so they can be reused across processes. An empty dir uses the per-user
+// cache directory.
+func NewFileTokenCache(dir string) (TokenCache, error) {
+	return client.NewFileTokenCache(dir)
+}
+
+// RateLimiter is a token-bucket request budget shared by the clients it is
+// passed to via WithRateLimit. It is an alias for client.RateLimiter.
+type RateLimiter = client.RateLimiter
+
+// EndpointRateLimit limits requests whose path starts with Prefix, in
+// addition to the global budget of a RateLimiter.
+type EndpointRateLimit = client.EndpointRateLimit
+
+// NewRateLimiter returns a RateLimiter allowing requestsPerSecond across all
+// endpoints with bursts of up to burst, plus any per-prefix limits, e.g.:
+//
+//	limiter, err := jamfpro.NewRateLimiter(10, 5,
+//		jamfpro.EndpointRateLimit{Prefix: "/JSSResource", RequestsPerSecond: 2, Burst: 2})
+func NewRateLimiter(requestsPerSecond float64, burst int, endpoints ...EndpointRateLimit) (*RateLimiter, error) {
+	return client.NewRateLimiter(requestsPerSecond, burst, endpoints...)
+}
+
+// ProgressReporter receives progress events from file transfers. It is an
+// alias for client.ProgressReporter; pass one via WithProgressReporter.
+type ProgressReporter = client.ProgressReporter
+
+// ProgressEvent is one progress update from a file transfer.
+type ProgressEvent = client.ProgressEvent
+
+// ProgressReporterFunc adapts a function to ProgressReporter.
+type ProgressReporterFunc = client.ProgressReporterFunc
+
+// ContextWithProgressReporter returns a copy of ctx whose file transfers
+// report to reporter instead of the client's reporter.
+func ContextWithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return client.ContextWithProgressReporter(ctx, reporter)
+}
+
 // NewClientFromEnv creates a new client using environment variables.
 // Required: INSTANCE_DOMAIN, AUTH_METHOD; for oauth2: CLIENT_ID, CLIENT_SECRET; for basic: BASIC_AUTH_USERNAME, BASIC_AUTH_PASSWORD.
 func NewClientFromEnv(options ...ClientOption) (*Client, error) {
@@ -601,11 +707,57 @@ func (c *Client) GetTransport() *client.Transport {
 	return c.transport
 }
 
+// Close stops the background keep-alive started by WithAutoKeepAlive and
+// revokes the current bearer token so it does not linger after the process
+// exits. The client must not be used after Close.
+func (c *Client) Close(ctx context.Context) error {
+	return c.transport.Close(ctx)
+}
+
 // LoadAuthConfigFromFile loads authentication configuration from a JSON file.
 func LoadAuthConfigFromFile(path string) (*AuthConfig, error) {
 	return config.LoadAuthConfigFromFile(path)
 }
 
+// LoadAuthConfigsFromFile loads one AuthConfig per named instance from a JSON
+// file; see NewClientPoolFromFile.
+func LoadAuthConfigsFromFile(path string) (map[string]*AuthConfig, error) {
+	return config.LoadAuthConfigsFromFile(path)
+}
+
+// TransportProfile holds the transport settings of a configuration profile.
+// It is an alias for config.TransportProfile; apply one with
+// WithTransportProfile.
+type TransportProfile = config.TransportProfile
+
+// LoadProfile reads a multi-profile config file (JSON, or YAML for .yaml/.yml)
+// and resolves one profile, expanding env: and file: secret references. An
+// empty name selects $JAMFPRO_PROFILE, then the file's current_profile, then
+// its only profile. The returned options apply the profile's transport
+// settings.
+func LoadProfile(path, name string) (*AuthConfig, []ClientOption, error) {
+	pf, err := config.LoadProfilesFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	authConfig, transport, err := pf.Resolve(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return authConfig, []ClientOption{WithTransportProfile(transport)}, nil
+}
+
+// NewClientFromProfile creates a client from a profile in a multi-profile
+// config file; see LoadProfile. options are applied after the profile's
+// transport settings and so override them.
+func NewClientFromProfile(path, name string, options ...ClientOption) (*Client, error) {
+	authConfig, profileOptions, err := LoadProfile(path, name)
+	if err != nil {
+		return nil, fmt.Errorf("load profile: %w", err)
+	}
+	return NewClient(authConfig, append(profileOptions, options...)...)
+}
+
 // AuthConfigFromEnv builds AuthConfig from environment variables.
 func AuthConfigFromEnv() *AuthConfig {
 	return config.AuthConfigFromEnv()
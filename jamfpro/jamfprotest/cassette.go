@@ -0,0 +1,93 @@
+package jamfprotest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cassette is the on-disk record of the HTTP traffic of one test, replayed in
+// order by a Recorder in ModeReplay. Secrets are redacted before a cassette
+// is written; see Recorder for the rules.
+type Cassette struct {
+	// Values holds run-specific values (unique names, timestamps) captured
+	// with Recorder.Value, so that replayed requests carry the same ones.
+	Values       []string      `yaml:"values,omitempty"`
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction is one recorded request and the response it received.
+type Interaction struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+// RecordedRequest is the part of a request used to match it on replay. The
+// host is not recorded, so a cassette replays against any instance domain.
+type RecordedRequest struct {
+	Method       string            `yaml:"method"`
+	Path         string            `yaml:"path"`
+	Query        string            `yaml:"query,omitempty"`
+	Header       map[string]string `yaml:"header,omitempty"`
+	Body         string            `yaml:"body,omitempty"`
+	BodyEncoding string            `yaml:"body_encoding,omitempty"`
+}
+
+// RecordedResponse is the response served back on replay.
+type RecordedResponse struct {
+	StatusCode   int         `yaml:"status_code"`
+	Header       http.Header `yaml:"header,omitempty"`
+	Body         string      `yaml:"body,omitempty"`
+	BodyEncoding string      `yaml:"body_encoding,omitempty"`
+}
+
+// bodyEncodingBase64 marks a body that was not valid UTF-8 (package
+// downloads, certificates) and is stored base64 encoded.
+const bodyEncodingBase64 = "base64"
+
+func encodeBody(data []byte) (string, string) {
+	if utf8.Valid(data) {
+		return string(data), ""
+	}
+	return base64.StdEncoding.EncodeToString(data), bodyEncodingBase64
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	if encoding == bodyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+// LoadCassette reads the cassette at path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+	var c Cassette
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to path, creating parent directories as needed.
+func (c *Cassette) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package jamfprotest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+)
+
+// Latency draws a request delay from a distribution.
+type Latency func(r *rand.Rand) time.Duration
+
+// FixedLatency delays every request by d.
+func FixedLatency(d time.Duration) Latency {
+	return func(*rand.Rand) time.Duration { return d }
+}
+
+// UniformLatency delays requests by a duration drawn uniformly from [min, max).
+func UniformLatency(min, max time.Duration) Latency {
+	return func(r *rand.Rand) time.Duration {
+		if max <= min {
+			return min
+		}
+		return min + time.Duration(r.Int64N(int64(max-min)))
+	}
+}
+
+// NormalLatency delays requests by a normally distributed duration, clamped
+// at zero.
+func NormalLatency(mean, stddev time.Duration) Latency {
+	return func(r *rand.Rand) time.Duration {
+		return max(0, time.Duration(r.NormFloat64()*float64(stddev))+mean)
+	}
+}
+
+// ExponentialLatency delays requests by an exponentially distributed
+// duration: mostly short, with a long tail of slow requests.
+func ExponentialLatency(mean time.Duration) Latency {
+	return func(r *rand.Rand) time.Duration {
+		return time.Duration(r.ExpFloat64() * float64(mean))
+	}
+}
+
+// ChaosRule describes the faults a ChaosTransport injects into requests
+// whose path matches Path. Probabilities are in [0, 1].
+type ChaosRule struct {
+	// Path is a glob matched against the request path, where * matches any
+	// run of characters including /. Empty matches every path.
+	Path string
+	// Methods restricts the rule to these HTTP methods. Empty matches all.
+	Methods []string
+
+	// Latency delays each request before it is sent or failed. A delay is
+	// cut short when the request's context ends.
+	Latency Latency
+
+	// ServerErrorRate is the chance that a request starts a burst of
+	// BurstLength (default 1) consecutive requests answered with a status
+	// from StatusCodes (default 503) without reaching the server.
+	ServerErrorRate float64
+	BurstLength     int
+	StatusCodes     []int
+
+	// ResetRate is the chance that a request fails with a connection reset
+	// instead of being sent.
+	ResetRate float64
+
+	// TruncateRate is the chance that a response body is cut short at a
+	// random point; reading past it fails with io.ErrUnexpectedEOF.
+	TruncateRate float64
+
+	// SlowReadRate is the chance that a response body is trickled out
+	// SlowReadChunk bytes (default 512) at a time, SlowReadDelay apart.
+	SlowReadRate  float64
+	SlowReadChunk int
+	SlowReadDelay time.Duration
+
+	// MaxFaults stops the rule injecting faults once it has failed, reset,
+	// truncated or slowed this many requests. Zero means no limit. Use it to
+	// script "the first two attempts fail" scenarios.
+	MaxFaults int
+}
+
+// ChaosStats counts what a ChaosTransport has done.
+type ChaosStats struct {
+	Requests     int // requests matched by a rule
+	Delayed      int
+	TotalDelay   time.Duration
+	ServerErrors int
+	Resets       int
+	Truncated    int
+	SlowReads    int
+}
+
+// ChaosTransport is an http.RoundTripper that injects latency, 5xx bursts,
+// connection resets, truncated bodies and slow reads into requests, to see
+// how the SDK's retry, throttling and retry-budget settings hold up before
+// they meet a struggling tenant.
+//
+//	chaos := jamfprotest.NewChaosTransport(
+//	    jamfprotest.WithChaosRule(jamfprotest.ChaosRule{
+//	        Path:            "/api/v1/computers-inventory*",
+//	        Latency:         jamfprotest.ExponentialLatency(300 * time.Millisecond),
+//	        ServerErrorRate: 0.05,
+//	        BurstLength:     3,
+//	    }),
+//	)
+//	client, err := jamfpro.NewClient(cfg, jamfprotest.WithChaos(chaos))
+//
+// The first rule that matches a request applies. Token endpoints are never
+// faulted, so failures land on the requests under test rather than on
+// authentication.
+type ChaosTransport struct {
+	next  http.RoundTripper
+	rules []*chaosRule
+
+	mu    sync.Mutex
+	rng   *rand.Rand
+	stats ChaosStats
+}
+
+// chaosRule is a ChaosRule with its compiled pattern and running state.
+type chaosRule struct {
+	ChaosRule
+	pattern *regexp.Regexp
+	burst   int // synthetic 5xx responses left in the current burst
+	faults  int
+}
+
+// ChaosOption configures a ChaosTransport.
+type ChaosOption func(*ChaosTransport)
+
+// WithChaosRule adds a rule. Rules are tried in the order they were added.
+func WithChaosRule(rule ChaosRule) ChaosOption {
+	return func(c *ChaosTransport) {
+		c.rules = append(c.rules, &chaosRule{ChaosRule: rule, pattern: globPattern(rule.Path)})
+	}
+}
+
+// WithChaosSeed makes the injected faults reproducible.
+func WithChaosSeed(seed uint64) ChaosOption {
+	return func(c *ChaosTransport) {
+		c.rng = rand.New(rand.NewPCG(seed, seed))
+	}
+}
+
+// WithChaosTransport sets the transport that unfaulted requests are
+// forwarded to when the ChaosTransport is used directly. The default is
+// http.DefaultTransport. WithChaos ignores it.
+func WithChaosTransport(next http.RoundTripper) ChaosOption {
+	return func(c *ChaosTransport) {
+		c.next = next
+	}
+}
+
+// NewChaosTransport returns a ChaosTransport configured by opts. Without
+// WithChaosSeed, faults are randomly seeded.
+func NewChaosTransport(opts ...ChaosOption) *ChaosTransport {
+	c := &ChaosTransport{
+		next: http.DefaultTransport,
+		rng:  rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithChaos installs c as the client's HTTP transport. It wraps the
+// transport set by an earlier WithTransport; otherwise it wraps a clone of
+// http.DefaultTransport carrying the proxy and TLS options, which a custom
+// transport would bypass. Pass it after those options.
+func WithChaos(c *ChaosTransport) jamfpro.ClientOption {
+	return func(s *client.TransportSettings) error {
+		next := s.HTTPTransport
+		if next == nil {
+			base := http.DefaultTransport.(*http.Transport).Clone()
+			if s.InsecureSkipVerify {
+				base.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+			} else if s.TLSClientConfig != nil {
+				base.TLSClientConfig = s.TLSClientConfig
+			}
+			if s.ProxyURL != "" {
+				proxy, err := url.Parse(s.ProxyURL)
+				if err != nil {
+					return fmt.Errorf("invalid proxy URL %q: %w", s.ProxyURL, err)
+				}
+				base.Proxy = http.ProxyURL(proxy)
+			}
+			next = base
+		}
+		s.HTTPTransport = c.Wrap(next)
+		return nil
+	}
+}
+
+// Wrap returns a RoundTripper that applies c's rules, sharing its state and
+// statistics, and forwards unfaulted requests to next.
+func (c *ChaosTransport) Wrap(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return c.roundTrip(next, req)
+	})
+}
+
+// Stats returns what the transport has injected so far.
+func (c *ChaosTransport) Stats() ChaosStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *ChaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return c.roundTrip(c.next, req)
+}
+
+// chaosPlan is what will be done to one request, decided up front so the
+// random draws happen under the lock.
+type chaosPlan struct {
+	delay      time.Duration
+	reset      bool
+	status     int
+	truncate   float64 // fraction of the body kept; negative keeps all of it
+	slowChunk  int
+	slowDelay  time.Duration
+	slowReads  bool
+	bodyFaults bool
+}
+
+func (c *ChaosTransport) roundTrip(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	if isTokenEndpoint(req.URL.Path) {
+		return next.RoundTrip(req)
+	}
+	plan, ok := c.plan(req)
+	if !ok {
+		return next.RoundTrip(req)
+	}
+
+	if plan.delay > 0 {
+		if err := sleepContext(req.Context(), plan.delay); err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+	}
+	if plan.reset {
+		closeRequestBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}
+	if plan.status != 0 {
+		closeRequestBody(req)
+		return syntheticErrorResponse(req, plan.status), nil
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || !plan.bodyFaults {
+		return resp, err
+	}
+	if plan.truncate >= 0 {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		cut := int(float64(len(body)) * plan.truncate)
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body[:cut]), errReader{io.ErrUnexpectedEOF}))
+	}
+	if plan.slowReads {
+		resp.Body = &slowBody{ctx: req.Context(), body: resp.Body, chunk: plan.slowChunk, delay: plan.slowDelay}
+	}
+	return resp, nil
+}
+
+// plan picks the rule for req and rolls its faults. ok is false when no
+// rule matches.
+func (c *ChaosTransport) plan(req *http.Request) (plan chaosPlan, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := slices.IndexFunc(c.rules, func(r *chaosRule) bool { return r.matches(req) })
+	if i < 0 {
+		return chaosPlan{}, false
+	}
+	rule := c.rules[i]
+	c.stats.Requests++
+	plan.truncate = -1
+
+	if rule.Latency != nil {
+		if plan.delay = rule.Latency(c.rng); plan.delay > 0 {
+			c.stats.Delayed++
+			c.stats.TotalDelay += plan.delay
+		}
+	}
+	if rule.MaxFaults > 0 && rule.faults >= rule.MaxFaults {
+		return plan, true
+	}
+
+	switch {
+	case rule.burst > 0:
+		rule.burst--
+		plan.status = rule.pickStatus(c.rng)
+	case c.roll(rule.ResetRate):
+		plan.reset = true
+		c.stats.Resets++
+	case c.roll(rule.ServerErrorRate):
+		rule.burst = max(rule.BurstLength, 1) - 1
+		plan.status = rule.pickStatus(c.rng)
+	default:
+		if c.roll(rule.TruncateRate) {
+			plan.truncate = c.rng.Float64()
+			plan.bodyFaults = true
+			c.stats.Truncated++
+		}
+		if c.roll(rule.SlowReadRate) {
+			plan.slowReads = true
+			plan.slowChunk = rule.SlowReadChunk
+			if plan.slowChunk <= 0 {
+				plan.slowChunk = 512
+			}
+			plan.slowDelay = rule.SlowReadDelay
+			plan.bodyFaults = true
+			c.stats.SlowReads++
+		}
+		if !plan.bodyFaults {
+			return plan, true
+		}
+	}
+	if plan.status != 0 {
+		c.stats.ServerErrors++
+	}
+	rule.faults++
+	return plan, true
+}
+
+// roll reports whether an event with probability p happens. c.mu must be held.
+func (c *ChaosTransport) roll(p float64) bool {
+	return p > 0 && c.rng.Float64() < p
+}
+
+func (r *chaosRule) matches(req *http.Request) bool {
+	if len(r.Methods) > 0 && !slices.ContainsFunc(r.Methods, func(m string) bool { return strings.EqualFold(m, req.Method) }) {
+		return false
+	}
+	return r.pattern.MatchString(req.URL.Path)
+}
+
+func (r *chaosRule) pickStatus(rng *rand.Rand) int {
+	if len(r.StatusCodes) == 0 {
+		return http.StatusServiceUnavailable
+	}
+	return r.StatusCodes[rng.IntN(len(r.StatusCodes))]
+}
+
+// globPattern compiles a ChaosRule path glob.
+func globPattern(glob string) *regexp.Regexp {
+	if glob == "" {
+		return regexp.MustCompile(".*")
+	}
+	parts := strings.Split(glob, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// syntheticErrorResponse builds the error a struggling Jamf Pro returns: an
+// HTML status page for the Classic API, a JSON error body otherwise.
+func syntheticErrorResponse(req *http.Request, status int) *http.Response {
+	rec := httptest.NewRecorder()
+	if strings.HasPrefix(req.URL.Path, "/JSSResource") {
+		writeClassicError(rec, status, "Injected by jamfprotest.ChaosTransport")
+	} else {
+		writeJSONError(rec, status, "", "", "Injected by jamfprotest.ChaosTransport")
+	}
+	resp := rec.Result()
+	resp.Request = req
+	return resp
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// slowBody returns at most chunk bytes per Read, waiting delay before each.
+type slowBody struct {
+	ctx   context.Context
+	body  io.ReadCloser
+	chunk int
+	delay time.Duration
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	if b.delay > 0 {
+		if err := sleepContext(b.ctx, b.delay); err != nil {
+			return 0, err
+		}
+	}
+	if len(p) > b.chunk {
+		p = p[:b.chunk]
+	}
+	return b.body.Read(p)
+}
+
+func (b *slowBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,204 @@
+package jamfprotest
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/buildings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newChaosClient returns a client for srv behind chaos, retrying quickly.
+func newChaosClient(t *testing.T, srv *Server, chaos *ChaosTransport, opts ...jamfpro.ClientOption) *jamfpro.Client {
+	t.Helper()
+	opts = append([]jamfpro.ClientOption{
+		jamfpro.WithBaseURL(srv.URL),
+		jamfpro.WithRetryCount(3),
+		jamfpro.WithRetryWaitTime(time.Millisecond),
+		jamfpro.WithRetryMaxWaitTime(time.Millisecond),
+		jamfpro.WithLogger(zap.NewNop()),
+	}, opts...)
+	opts = append(opts, WithChaos(chaos))
+	c, err := jamfpro.NewClient(srv.AuthConfig(), opts...)
+	require.NoError(t, err)
+	return c
+}
+
+func TestChaos_ServerErrorBurstIsRetried(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	id := srv.Seed("/api/v1/buildings", map[string]any{"name": "HQ"})
+
+	chaos := NewChaosTransport(WithChaosSeed(1), WithChaosRule(ChaosRule{
+		Path:            "/api/v1/buildings/*",
+		ServerErrorRate: 1,
+		BurstLength:     2,
+		StatusCodes:     []int{http.StatusBadGateway},
+		MaxFaults:       2,
+	}))
+	c := newChaosClient(t, srv, chaos)
+
+	got, resp, err := c.JamfProAPI.Buildings.GetByIDV1(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, "HQ", got.Name)
+	assert.Equal(t, ChaosStats{Requests: 3, ServerErrors: 2}, chaos.Stats())
+}
+
+func TestChaos_ServerErrorIsNotRetriedForPost(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	chaos := NewChaosTransport(WithChaosRule(ChaosRule{
+		Methods:         []string{http.MethodPost},
+		ServerErrorRate: 1,
+	}))
+	c := newChaosClient(t, srv, chaos)
+
+	_, resp, err := c.JamfProAPI.Buildings.CreateV1(context.Background(), &buildings.RequestBuilding{Name: "HQ"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+	assert.Equal(t, 1, chaos.Stats().ServerErrors, "POST must not be retried")
+	assert.Empty(t, srv.Resources("/api/v1/buildings"), "the request never reached the server")
+}
+
+func TestChaos_ConnectionResetIsRetried(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	chaos := NewChaosTransport(WithChaosRule(ChaosRule{ResetRate: 1, MaxFaults: 1}))
+	c := newChaosClient(t, srv, chaos)
+
+	_, _, err := c.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, chaos.Stats().Resets)
+
+	chaos = NewChaosTransport(WithChaosRule(ChaosRule{ResetRate: 1}))
+	c = newChaosClient(t, srv, chaos)
+	_, _, err = c.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+	assert.Equal(t, 4, chaos.Stats().Resets, "one attempt plus three retries")
+}
+
+func TestChaos_LatencyIsBoundedByTotalRetryDuration(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	chaos := NewChaosTransport(WithChaosRule(ChaosRule{Latency: FixedLatency(5 * time.Second)}))
+	c := newChaosClient(t, srv, chaos, jamfpro.WithTotalRetryDuration(100*time.Millisecond))
+
+	start := time.Now()
+	_, _, err := c.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Less(t, time.Since(start), 2*time.Second, "the injected delay must honour the request context")
+}
+
+func TestChaos_TruncatedBody(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.Seed("/api/v1/buildings", map[string]any{"name": "HQ"})
+
+	chaos := NewChaosTransport(WithChaosRule(ChaosRule{TruncateRate: 1}))
+	c := newChaosClient(t, srv, chaos)
+
+	_, _, err := c.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.Error(t, err)
+	assert.Positive(t, chaos.Stats().Truncated)
+}
+
+func TestChaos_SlowReads(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	for _, name := range []string{"Alpha", "Bravo", "Charlie"} {
+		srv.Seed("/api/v1/buildings", map[string]any{"name": name})
+	}
+
+	chaos := NewChaosTransport(WithChaosRule(ChaosRule{
+		Path:          "/api/v1/buildings",
+		SlowReadRate:  1,
+		SlowReadChunk: 16,
+		SlowReadDelay: 2 * time.Millisecond,
+	}))
+	c := newChaosClient(t, srv, chaos)
+
+	start := time.Now()
+	list, _, err := c.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Results, 3)
+	assert.Equal(t, 1, chaos.Stats().SlowReads)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestChaos_RulesMatchPathAndMethod(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	chaos := NewChaosTransport(
+		WithChaosRule(ChaosRule{Path: "/api/v1/departments*", Methods: []string{"get"}, ServerErrorRate: 1}),
+		WithChaosRule(ChaosRule{Path: "/api/v1/buildings", Latency: FixedLatency(time.Millisecond)}),
+	)
+	c := newChaosClient(t, srv, chaos)
+	ctx := context.Background()
+
+	_, _, err := c.JamfProAPI.Buildings.ListV1(ctx, nil)
+	require.NoError(t, err)
+	_, _, err = c.JamfProAPI.Departments.ListV1(ctx, nil)
+	require.Error(t, err)
+
+	stats := chaos.Stats()
+	assert.Equal(t, 1, stats.Delayed)
+	assert.Equal(t, time.Millisecond, stats.TotalDelay)
+	assert.Equal(t, 4, stats.ServerErrors)
+	assert.Equal(t, 5, stats.Requests, "token requests are never matched")
+}
+
+func TestChaos_LatencyDistributions(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	const n = 2000
+
+	assert.Equal(t, 3*time.Millisecond, FixedLatency(3*time.Millisecond)(rng))
+	assert.Equal(t, time.Second, UniformLatency(time.Second, time.Second)(rng))
+
+	var sumUniform, sumNormal, sumExp time.Duration
+	for range n {
+		u := UniformLatency(10*time.Millisecond, 20*time.Millisecond)(rng)
+		assert.GreaterOrEqual(t, u, 10*time.Millisecond)
+		assert.Less(t, u, 20*time.Millisecond)
+		sumUniform += u
+
+		v := NormalLatency(time.Millisecond, 5*time.Millisecond)(rng)
+		assert.GreaterOrEqual(t, v, time.Duration(0), "normal latency is clamped at zero")
+		sumNormal += NormalLatency(100*time.Millisecond, 10*time.Millisecond)(rng)
+
+		sumExp += ExponentialLatency(50 * time.Millisecond)(rng)
+	}
+	assert.InDelta(t, float64(15*time.Millisecond), float64(sumUniform/n), float64(time.Millisecond))
+	assert.InDelta(t, float64(100*time.Millisecond), float64(sumNormal/n), float64(2*time.Millisecond))
+	assert.InDelta(t, float64(50*time.Millisecond), float64(sumExp/n), float64(5*time.Millisecond))
+}
+
+func TestChaos_GlobPattern(t *testing.T) {
+	for _, tc := range []struct {
+		glob, path string
+		want       bool
+	}{
+		{"", "/api/v1/buildings", true},
+		{"/api/v1/buildings", "/api/v1/buildings", true},
+		{"/api/v1/buildings", "/api/v1/buildings/1", false},
+		{"/api/v1/buildings*", "/api/v1/buildings/1/history", true},
+		{"/api/*/buildings", "/api/v1/buildings", true},
+		{"/JSSResource/policies/id/*", "/JSSResource/policies/name/x", false},
+		{"/api/v1/a.b", "/api/v1/axb", false},
+	} {
+		assert.Equal(t, tc.want, globPattern(tc.glob).MatchString(tc.path), "%q vs %q", tc.glob, tc.path)
+	}
+}
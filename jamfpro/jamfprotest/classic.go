@@ -0,0 +1,281 @@
+package jamfprotest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+)
+
+// xmlNode is a generic XML element, used to store Classic API resources
+// without knowing their schema.
+type xmlNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []*xmlNode `xml:",any"`
+}
+
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, c := range n.Nodes {
+		if c.XMLName.Local == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// identity returns the element holding a resource's id and name: the
+// <general> subset for resources that have one (policies, computers, ...),
+// otherwise the root element itself.
+func (n *xmlNode) identity() *xmlNode {
+	if general := n.child("general"); general != nil {
+		return general
+	}
+	return n
+}
+
+func (n *xmlNode) name() string {
+	if c := n.identity().child("name"); c != nil {
+		return strings.TrimSpace(c.Text)
+	}
+	return ""
+}
+
+// setID writes id into the resource, adding an <id> element ahead of the
+// other fields when the request body did not carry one.
+func (n *xmlNode) setID(id int) {
+	target := n.identity()
+	if c := target.child("id"); c != nil {
+		c.Text = strconv.Itoa(id)
+		return
+	}
+	target.Nodes = slices.Insert(target.Nodes, 0, &xmlNode{XMLName: xml.Name{Local: "id"}, Text: strconv.Itoa(id)})
+}
+
+// classicCollection holds the resources of one /JSSResource/{collection}.
+type classicCollection struct {
+	nextID int
+	ids    []int
+	items  map[int]*xmlNode
+}
+
+func (c *classicCollection) byName(name string) (int, bool) {
+	for _, id := range c.ids {
+		if strings.EqualFold(c.items[id].name(), name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// classicStore is the in-memory state behind the Classic API. Resources are
+// addressed as /JSSResource/{collection}/id/{id} or .../name/{name}; anything
+// after the identifier (e.g. /subset/General) is ignored and the whole
+// resource is served.
+type classicStore struct {
+	mu          sync.Mutex
+	collections map[string]*classicCollection
+}
+
+func newClassicStore() *classicStore {
+	return &classicStore{collections: make(map[string]*classicCollection)}
+}
+
+func (s *classicStore) collection(name string) *classicCollection {
+	coll := s.collections[name]
+	if coll == nil {
+		coll = &classicCollection{items: make(map[int]*xmlNode)}
+		s.collections[name] = coll
+	}
+	return coll
+}
+
+func (s *classicStore) serve(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/JSSResource/"), "/"), "/")
+	collName := parts[0]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(parts) == 1 {
+		if r.Method != http.MethodGet {
+			writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+			return
+		}
+		s.list(w, collName)
+		return
+	}
+	if len(parts) < 3 || (parts[1] != "id" && parts[1] != "name") {
+		writeClassicError(w, http.StatusBadRequest, "Unsupported Classic API path "+r.URL.Path)
+		return
+	}
+
+	coll := s.collection(collName)
+	if r.Method == http.MethodPost {
+		s.create(w, r, coll)
+		return
+	}
+
+	id, found := 0, false
+	if parts[1] == "id" {
+		id, _ = strconv.Atoi(parts[2])
+		_, found = coll.items[id]
+	} else {
+		id, found = coll.byName(parts[2])
+	}
+	if !found {
+		writeClassicError(w, http.StatusNotFound, "The server has not found anything matching the request URI")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeXML(w, http.StatusOK, coll.items[id])
+	case http.MethodPut:
+		node, ok := decodeXML(w, r)
+		if !ok {
+			return
+		}
+		if other, taken := coll.byName(node.name()); taken && other != id && node.name() != "" {
+			writeClassicError(w, http.StatusConflict, "Error: Duplicate name")
+			return
+		}
+		node.setID(id)
+		coll.items[id] = node
+		writeXML(w, http.StatusCreated, idOnly(node.XMLName.Local, id))
+	case http.MethodDelete:
+		root := coll.items[id].XMLName.Local
+		delete(coll.items, id)
+		coll.ids = slices.DeleteFunc(coll.ids, func(v int) bool { return v == id })
+		writeXML(w, http.StatusOK, idOnly(root, id))
+	default:
+		writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// create stores a new resource. Like Jamf Pro, any id in the URL (by
+// convention 0) is ignored and a new one is generated.
+func (s *classicStore) create(w http.ResponseWriter, r *http.Request, coll *classicCollection) {
+	node, ok := decodeXML(w, r)
+	if !ok {
+		return
+	}
+	if _, taken := coll.byName(node.name()); taken && node.name() != "" {
+		writeClassicError(w, http.StatusConflict, "Error: Duplicate name")
+		return
+	}
+	id := coll.insert(node)
+	writeXML(w, http.StatusCreated, idOnly(node.XMLName.Local, id))
+}
+
+func (c *classicCollection) insert(node *xmlNode) int {
+	c.nextID++
+	node.setID(c.nextID)
+	c.ids = append(c.ids, c.nextID)
+	c.items[c.nextID] = node
+	return c.nextID
+}
+
+// list serves the Classic list shape: <collection><size>N</size> followed by
+// one <singular><id/><name/></singular> per resource.
+func (s *classicStore) list(w http.ResponseWriter, collName string) {
+	root := &xmlNode{XMLName: xml.Name{Local: collName}}
+	coll := s.collections[collName]
+	size := 0
+	if coll != nil {
+		size = len(coll.ids)
+	}
+	root.Nodes = append(root.Nodes, &xmlNode{XMLName: xml.Name{Local: "size"}, Text: strconv.Itoa(size)})
+	if coll != nil {
+		for _, id := range coll.ids {
+			item := coll.items[id]
+			summary := idOnly(item.XMLName.Local, id)
+			summary.Nodes = append(summary.Nodes, &xmlNode{XMLName: xml.Name{Local: "name"}, Text: item.name()})
+			root.Nodes = append(root.Nodes, summary)
+		}
+	}
+	writeXML(w, http.StatusOK, root)
+}
+
+func idOnly(root string, id int) *xmlNode {
+	return &xmlNode{
+		XMLName: xml.Name{Local: root},
+		Nodes:   []*xmlNode{{XMLName: xml.Name{Local: "id"}, Text: strconv.Itoa(id)}},
+	}
+}
+
+func decodeXML(w http.ResponseWriter, r *http.Request) (*xmlNode, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Error reading request body")
+		return nil, false
+	}
+	node, err := parseXML(data)
+	if err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Error: Problem with XML: "+err.Error())
+		return nil, false
+	}
+	return node, true
+}
+
+func parseXML(data []byte) (*xmlNode, error) {
+	var node xmlNode
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&node); err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+func writeXML(w http.ResponseWriter, status int, node *xmlNode) {
+	w.Header().Set("Content-Type", constants.ApplicationXML)
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header)
+	_ = xml.NewEncoder(w).Encode(node)
+}
+
+// writeClassicError writes the HTML status page the Classic API returns on
+// failure, which client.ParseErrorResponse understands.
+func writeClassicError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<html><head><title>Status page</title></head><body><p>%s</p><p>%s</p></body></html>",
+		html.EscapeString(http.StatusText(status)), html.EscapeString(message))
+}
+
+// SeedClassic adds a Classic API resource, given as its XML representation,
+// to /JSSResource/{collection} and returns its generated ID. It panics if
+// resourceXML is not well-formed.
+func (s *Server) SeedClassic(collection, resourceXML string) int {
+	node, err := parseXML([]byte(resourceXML))
+	if err != nil {
+		panic(fmt.Sprintf("jamfprotest: seed classic %s: %v", collection, err))
+	}
+	s.classic.mu.Lock()
+	defer s.classic.mu.Unlock()
+	return s.classic.collection(collection).insert(node)
+}
+
+// ClassicResource returns the stored XML of the Classic API resource with id
+// in collection.
+func (s *Server) ClassicResource(collection string, id int) (string, bool) {
+	s.classic.mu.Lock()
+	defer s.classic.mu.Unlock()
+	coll := s.classic.collections[collection]
+	if coll == nil || coll.items[id] == nil {
+		return "", false
+	}
+	data, err := xml.Marshal(coll.items[id])
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
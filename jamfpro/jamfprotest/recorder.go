@@ -0,0 +1,397 @@
+package jamfprotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+)
+
+// Mode selects whether a Recorder talks to a real server or to cassettes.
+type Mode int
+
+const (
+	// ModeReplay serves responses from cassettes and never touches the
+	// network. A request with no matching recorded interaction fails.
+	ModeReplay Mode = iota
+	// ModeRecord forwards requests to the real server and records them.
+	ModeRecord
+)
+
+// Matcher reports whether a live request corresponds to a recorded one. body
+// is the request body after redaction, so recorded and live secrets compare
+// equal.
+type Matcher func(r *http.Request, body []byte, recorded RecordedRequest) bool
+
+// Recorder is an http.RoundTripper that records traffic to cassettes or
+// replays it from them. Install it with jamfpro.WithTransport; the SDK also
+// sends its token requests through it.
+//
+//	rec := jamfprotest.NewRecorder(jamfprotest.ModeReplay)
+//	eject, err := rec.Load("testdata/buildings.yaml")
+//	...
+//	defer eject()
+//	client, err := jamfpro.NewClient(cfg, jamfpro.WithTransport(rec))
+//
+// Token endpoints are never written to a cassette. In ModeReplay they are
+// answered with a placeholder token, so a replaying client needs an
+// AuthConfig that validates but no real credentials.
+//
+// Before an interaction is stored, the Authorization header is dropped,
+// cookies are dropped, and secrets are replaced with Redacted. Secrets are
+// found in JSON, XML and form bodies by field name: passwords (including
+// LAPS and recovery lock passwords), client secrets, tokens, PINs and
+// FileVault recovery keys.
+// WithRedactedFields adds names.
+type Recorder struct {
+	mode    Mode
+	next    http.RoundTripper
+	matcher Matcher
+	extra   map[string]bool
+
+	mu     sync.Mutex
+	loaded []*loadedCassette
+}
+
+// loadedCassette is a cassette in use together with its replay position.
+type loadedCassette struct {
+	path     string
+	cassette *Cassette
+	played   []bool
+	values   int
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithRecorderTransport sets the transport a recording Recorder forwards
+// requests to. The default is http.DefaultTransport.
+func WithRecorderTransport(next http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.next = next
+	}
+}
+
+// WithMatcher replaces DefaultMatcher for replay.
+func WithMatcher(m Matcher) RecorderOption {
+	return func(r *Recorder) {
+		r.matcher = m
+	}
+}
+
+// WithRedactedFields adds JSON keys, XML elements or form fields to redact,
+// on top of the built-in rules.
+func WithRedactedFields(names ...string) RecorderOption {
+	return func(r *Recorder) {
+		for _, n := range names {
+			r.extra[strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(n))] = true
+		}
+	}
+}
+
+// NewRecorder returns a Recorder in the given mode with no cassette loaded.
+func NewRecorder(mode Mode, opts ...RecorderOption) *Recorder {
+	r := &Recorder{
+		mode:    mode,
+		next:    http.DefaultTransport,
+		matcher: DefaultMatcher,
+		extra:   make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Mode returns the mode the Recorder was created with.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Load makes the cassette at path the active one. In ModeReplay the file
+// must exist; the error wraps fs.ErrNotExist when it does not, so callers
+// can skip unrecorded tests. In ModeRecord an empty cassette is started.
+//
+// Cassettes stack: the returned eject function restores the previously
+// active cassette and, in ModeRecord, writes this one to path.
+func (r *Recorder) Load(path string) (eject func() error, err error) {
+	lc := &loadedCassette{path: path, cassette: &Cassette{}}
+	if r.mode == ModeReplay {
+		if lc.cassette, err = LoadCassette(path); err != nil {
+			return nil, err
+		}
+		lc.played = make([]bool, len(lc.cassette.Interactions))
+	}
+
+	r.mu.Lock()
+	r.loaded = append(r.loaded, lc)
+	r.mu.Unlock()
+
+	return func() error {
+		r.mu.Lock()
+		r.loaded = slices.DeleteFunc(r.loaded, func(c *loadedCassette) bool { return c == lc })
+		r.mu.Unlock()
+		if r.mode == ModeRecord {
+			return lc.cassette.Save(lc.path)
+		}
+		return nil
+	}, nil
+}
+
+// Unplayed returns the interactions of the active cassette that have not
+// been replayed yet.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lc := r.active()
+	if lc == nil || r.mode != ModeReplay {
+		return nil
+	}
+	var out []Interaction
+	for i, played := range lc.played {
+		if !played {
+			out = append(out, lc.cassette.Interactions[i])
+		}
+	}
+	return out
+}
+
+// Value returns a run-specific value such as a unique resource name. In
+// ModeRecord it calls generate and stores the result in the active cassette;
+// in ModeReplay it returns the stored values in order, so replayed requests
+// carry the names that were recorded. Without a cassette, or once the
+// recorded values run out, generate is called.
+func (r *Recorder) Value(generate func() string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lc := r.active()
+	if lc == nil {
+		return generate()
+	}
+	if r.mode == ModeReplay {
+		if lc.values < len(lc.cassette.Values) {
+			lc.values++
+			return lc.cassette.Values[lc.values-1]
+		}
+		return generate()
+	}
+	v := generate()
+	lc.cassette.Values = append(lc.cassette.Values, v)
+	return v
+}
+
+// active returns the most recently loaded cassette. r.mu must be held.
+func (r *Recorder) active() *loadedCassette {
+	if len(r.loaded) == 0 {
+		return nil
+	}
+	return r.loaded[len(r.loaded)-1]
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	redacted := redactBody(req.Header.Get("Content-Type"), body, r.extra)
+
+	if isTokenEndpoint(req.URL.Path) {
+		if r.mode == ModeReplay {
+			return syntheticTokenResponse(req), nil
+		}
+		return r.next.RoundTrip(req)
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, redacted)
+	}
+	return r.record(req, redacted)
+}
+
+func (r *Recorder) record(req *http.Request, redactedBody []byte) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	reqBody, reqEncoding := encodeBody(redactedBody)
+	header := map[string]string{}
+	for _, k := range []string{"Accept", "Content-Type"} {
+		if v := req.Header.Get(k); v != "" {
+			header[k] = v
+		}
+	}
+
+	respHeader := resp.Header.Clone()
+	for _, k := range []string{"Set-Cookie", "Date", "Content-Length"} {
+		respHeader.Del(k)
+	}
+	storedBody, respEncoding := encodeBody(redactBody(resp.Header.Get("Content-Type"), respBody, r.extra))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lc := r.active(); lc != nil {
+		lc.cassette.Interactions = append(lc.cassette.Interactions, Interaction{
+			Request: RecordedRequest{
+				Method:       req.Method,
+				Path:         req.URL.Path,
+				Query:        req.URL.RawQuery,
+				Header:       header,
+				Body:         reqBody,
+				BodyEncoding: reqEncoding,
+			},
+			Response: RecordedResponse{
+				StatusCode:   resp.StatusCode,
+				Header:       respHeader,
+				Body:         storedBody,
+				BodyEncoding: respEncoding,
+			},
+		})
+	}
+	return resp, nil
+}
+
+// replay serves the first unplayed interaction that matches req, so repeated
+// identical requests (a GET before and after an update) get their responses
+// in recorded order.
+func (r *Recorder) replay(req *http.Request, redactedBody []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lc := r.active()
+	if lc == nil {
+		return nil, fmt.Errorf("jamfprotest: no cassette loaded for %s %s", req.Method, req.URL.Path)
+	}
+	for i, interaction := range lc.cassette.Interactions {
+		if lc.played[i] || !r.matcher(req, redactedBody, interaction.Request) {
+			continue
+		}
+		lc.played[i] = true
+		body, err := decodeBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("jamfprotest: cassette %s: %w", lc.path, err)
+		}
+		return newResponse(req, interaction.Response.StatusCode, interaction.Response.Header.Clone(), body), nil
+	}
+	return nil, fmt.Errorf("jamfprotest: cassette %s has no unplayed interaction matching %s %s", lc.path, req.Method, req.URL.RequestURI())
+}
+
+// DefaultMatcher matches on method, path, query parameters (in any order)
+// and body. JSON bodies are compared semantically; multipart bodies, whose
+// boundaries are random, are not compared.
+func DefaultMatcher(r *http.Request, body []byte, recorded RecordedRequest) bool {
+	if !MatchMethodAndURL(r, body, recorded) {
+		return false
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if strings.HasPrefix(mediaType, "multipart/") {
+		return true
+	}
+	recordedBody, err := decodeBody(recorded.Body, recorded.BodyEncoding)
+	if err != nil {
+		return false
+	}
+	if strings.HasSuffix(mediaType, "json") {
+		return jsonEqual(body, recordedBody)
+	}
+	return bytes.Equal(bytes.TrimSpace(body), bytes.TrimSpace(recordedBody))
+}
+
+// MatchMethodAndURL matches on method, path and query parameters only, for
+// suites whose request bodies legitimately differ between runs.
+func MatchMethodAndURL(r *http.Request, _ []byte, recorded RecordedRequest) bool {
+	if r.Method != recorded.Method || r.URL.Path != recorded.Path {
+		return false
+	}
+	recordedQuery, err := url.ParseQuery(recorded.Query)
+	if err != nil {
+		return false
+	}
+	return maps.EqualFunc(r.URL.Query(), recordedQuery, slices.Equal)
+}
+
+func jsonEqual(a, b []byte) bool {
+	if len(bytes.TrimSpace(a)) == 0 || len(bytes.TrimSpace(b)) == 0 {
+		return len(bytes.TrimSpace(a)) == len(bytes.TrimSpace(b))
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return bytes.Equal(a, b)
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
+
+// readRequestBody reads req's body and puts back an unread copy.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("jamfprotest: read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func isTokenEndpoint(path string) bool {
+	switch path {
+	case constants.EndpointOAuthToken, constants.EndpointBearerToken,
+		constants.EndpointKeepAliveToken, constants.EndpointInvalidateToken:
+		return true
+	}
+	return false
+}
+
+// syntheticTokenResponse answers a token endpoint during replay.
+func syntheticTokenResponse(req *http.Request) *http.Response {
+	header := http.Header{"Content-Type": {constants.ApplicationJSON}}
+	expires := time.Now().Add(time.Hour)
+	var body []byte
+	switch req.URL.Path {
+	case constants.EndpointOAuthToken:
+		body, _ = json.Marshal(map[string]any{"access_token": Redacted, "token_type": "Bearer", "expires_in": 3600})
+	case constants.EndpointInvalidateToken:
+		return newResponse(req, http.StatusNoContent, header, nil)
+	default:
+		body, _ = json.Marshal(map[string]any{"token": Redacted, "expires": expires.UTC().Format(time.RFC3339)})
+	}
+	return newResponse(req, http.StatusOK, header, body)
+}
+
+func newResponse(req *http.Request, status int, header http.Header, body []byte) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,189 @@
+package jamfprotest
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/buildings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newRecordingClient(t *testing.T, cfg *jamfpro.AuthConfig, rec *Recorder) *jamfpro.Client {
+	t.Helper()
+	c, err := jamfpro.NewClient(cfg,
+		jamfpro.WithTransport(rec),
+		jamfpro.WithRetryCount(1),
+		jamfpro.WithRetryWaitTime(time.Millisecond),
+		jamfpro.WithRetryMaxWaitTime(time.Millisecond),
+		jamfpro.WithLogger(zap.NewNop()),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestRecorder_RecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buildings.yaml")
+	ctx := context.Background()
+
+	srv := NewServer()
+	rec := NewRecorder(ModeRecord)
+	eject, err := rec.Load(path)
+	require.NoError(t, err)
+
+	name := rec.Value(func() string { return "HQ-recorded" })
+	c := newRecordingClient(t, srv.AuthConfig(), rec)
+	created, _, err := c.JamfProAPI.Buildings.CreateV1(ctx, &buildings.RequestBuilding{Name: name})
+	require.NoError(t, err)
+	_, _, err = c.JamfProAPI.Buildings.UpdateByIDV1(ctx, created.ID, &buildings.RequestBuilding{Name: name, City: "Leeds"})
+	require.NoError(t, err)
+	got, _, err := c.JamfProAPI.Buildings.GetByIDV1(ctx, created.ID)
+	require.NoError(t, err)
+	require.NoError(t, eject())
+	srv.Close()
+
+	// Replay with no server, a different domain and made-up credentials.
+	replay := NewRecorder(ModeReplay)
+	eject, err = replay.Load(path)
+	require.NoError(t, err)
+	defer eject()
+
+	name = replay.Value(func() string { return "HQ-replayed" })
+	assert.Equal(t, "HQ-recorded", name)
+
+	c = newRecordingClient(t, &jamfpro.AuthConfig{
+		InstanceDomain: "https://replay.invalid",
+		AuthMethod:     "oauth2",
+		ClientID:       "replay",
+		ClientSecret:   "replay",
+	}, replay)
+	replayedCreate, _, err := c.JamfProAPI.Buildings.CreateV1(ctx, &buildings.RequestBuilding{Name: name})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, replayedCreate.ID)
+	_, _, err = c.JamfProAPI.Buildings.UpdateByIDV1(ctx, created.ID, &buildings.RequestBuilding{Name: name, City: "Leeds"})
+	require.NoError(t, err)
+	replayedGet, _, err := c.JamfProAPI.Buildings.GetByIDV1(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, got, replayedGet)
+	assert.Empty(t, replay.Unplayed())
+
+	// Every interaction has been played, and nothing else was recorded.
+	_, _, err = c.JamfProAPI.Buildings.GetByIDV1(ctx, created.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no unplayed interaction matching GET /api/v1/buildings/1")
+}
+
+func TestRecorder_ReplayRejectsDifferentBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	srv := NewServer()
+	defer srv.Close()
+
+	rec := NewRecorder(ModeRecord)
+	eject, err := rec.Load(path)
+	require.NoError(t, err)
+	c := newRecordingClient(t, srv.AuthConfig(), rec)
+	_, _, err = c.JamfProAPI.Buildings.CreateV1(context.Background(), &buildings.RequestBuilding{Name: "A"})
+	require.NoError(t, err)
+	require.NoError(t, eject())
+
+	for _, tc := range []struct {
+		matcher Matcher
+		wantErr bool
+	}{
+		{DefaultMatcher, true},
+		{MatchMethodAndURL, false},
+	} {
+		replay := NewRecorder(ModeReplay, WithMatcher(tc.matcher))
+		eject, err := replay.Load(path)
+		require.NoError(t, err)
+		c := newRecordingClient(t, srv.AuthConfig(), replay)
+		_, _, err = c.JamfProAPI.Buildings.CreateV1(context.Background(), &buildings.RequestBuilding{Name: "B"})
+		assert.Equal(t, tc.wantErr, err != nil)
+		require.NoError(t, eject())
+	}
+}
+
+func TestRecorder_Redaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	srv := NewServer(WithOAuth2Client("client-id", "super-client-secret"))
+	defer srv.Close()
+
+	srv.Handle(http.MethodGet, "/api/v2/local-admin-password/abc/account/admin/password", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"password": "laps-password-value"})
+	})
+	srv.Handle(http.MethodGet, "/api/v1/computers-inventory/1/filevault", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"personalRecoveryKey":             "ABCD-EFGH-IJKL",
+			"institutionalRecoveryKeyPresent": true,
+			"passwordMinLength":               8,
+		})
+	})
+
+	rec := NewRecorder(ModeRecord, WithRedactedFields("serial_number"))
+	eject, err := rec.Load(path)
+	require.NoError(t, err)
+	c := newRecordingClient(t, srv.AuthConfig(), rec)
+	ctx := context.Background()
+
+	var laps, filevault map[string]any
+	_, err = c.GetTransport().NewRequest(ctx).SetResult(&laps).Get("/api/v2/local-admin-password/abc/account/admin/password")
+	require.NoError(t, err)
+	assert.Equal(t, "laps-password-value", laps["password"], "the caller still sees the real value")
+	_, err = c.GetTransport().NewRequest(ctx).SetResult(&filevault).Get("/api/v1/computers-inventory/1/filevault")
+	require.NoError(t, err)
+
+	_, err = c.GetTransport().NewRequest(ctx).
+		SetHeader("Content-Type", "application/xml").
+		SetBody(`<account><name>svc-user</name><password>classic-account-password</password></account>`).
+		Post("/JSSResource/accounts/id/0")
+	require.NoError(t, err)
+	require.NoError(t, eject())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	cassette := string(data)
+	for _, secret := range []string{"laps-password-value", "ABCD-EFGH-IJKL", "classic-account-password", "super-client-secret", "Bearer "} {
+		assert.NotContains(t, cassette, secret)
+	}
+	assert.Contains(t, cassette, Redacted)
+	assert.Contains(t, cassette, "passwordMinLength", "settings around secrets are kept")
+	assert.Contains(t, cassette, "svc-user")
+}
+
+func TestRecorder_LoadMissingCassette(t *testing.T) {
+	_, err := NewRecorder(ModeReplay).Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func TestRedactBody(t *testing.T) {
+	extra := map[string]bool{}
+
+	xmlBody := []byte(`<?xml version="1.0" encoding="UTF-8"?><distribution_point><name>DP</name><read_write_password>rw</read_write_password><password_sha256>abc</password_sha256><force_password_change>true</force_password_change></distribution_point>`)
+	out := string(redactBody("application/xml", xmlBody, extra))
+	assert.Contains(t, out, "<name>DP</name>")
+	assert.Contains(t, out, "<read_write_password>[REDACTED]</read_write_password>")
+	assert.Contains(t, out, "<password_sha256>[REDACTED]</password_sha256>")
+	assert.Contains(t, out, "<force_password_change>true</force_password_change>")
+
+	form := string(redactBody("application/x-www-form-urlencoded", []byte("client_id=a&client_secret=b&grant_type=client_credentials"), extra))
+	assert.Contains(t, form, "client_id=a")
+	assert.Contains(t, form, "client_secret=%5BREDACTED%5D")
+
+	jsonBody := []byte(`{"token":"t","expires":"2030-01-01T00:00:00Z","nested":[{"recoveryLockPassword":"x","changePasswordOnNextLogin":true}]}`)
+	out = string(redactBody("application/json; charset=utf-8", jsonBody, extra))
+	assert.NotContains(t, out, `"t"`)
+	assert.NotContains(t, out, `"x"`)
+	assert.Contains(t, out, `"changePasswordOnNextLogin":true`)
+
+	plain := []byte("password=secret")
+	assert.Equal(t, plain, redactBody("text/plain", plain, extra))
+}
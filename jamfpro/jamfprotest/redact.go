@@ -0,0 +1,155 @@
+package jamfprotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces every secret a Recorder removes from a cassette.
+const Redacted = "[REDACTED]"
+
+// sensitiveName reports whether a JSON key, XML element or form field
+// carries a secret. Names are compared lower-cased with _ and - removed, so
+// clientSecret, client_secret and client-secret are treated alike.
+//
+// Matching on suffixes catches the secret itself (password,
+// recoveryLockPassword, institutional_recovery_key) without catching the
+// settings around it (passwordMinLength, changePasswordOnNextLogin).
+func sensitiveName(name string, extra map[string]bool) bool {
+	n := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	if extra[n] {
+		return true
+	}
+	switch n {
+	case "token", "accesstoken", "refreshtoken", "pin", "authorization":
+		return true
+	}
+	for _, suffix := range []string{"password", "secret", "secretaccesskey", "recoverykey", "passcode"} {
+		if strings.HasSuffix(n, suffix) {
+			return true
+		}
+	}
+	return strings.Contains(n, "passwordsha")
+}
+
+// redactBody removes secrets from a JSON, XML or form body according to its
+// Content-Type. Other bodies, and bodies that fail to parse, are returned
+// unchanged.
+func redactBody(contentType string, body []byte, extra map[string]bool) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		return redactJSON(body, extra)
+	case strings.HasSuffix(mediaType, "xml"):
+		return redactXML(body, extra)
+	case mediaType == "application/x-www-form-urlencoded":
+		return redactForm(body, extra)
+	}
+	return body
+}
+
+func redactJSON(body []byte, extra map[string]bool) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if dec.Decode(&v) != nil {
+		return body
+	}
+	if !redactValue(v, extra) {
+		return body
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// redactValue replaces sensitive string values in v in place and reports
+// whether anything changed. Only strings are replaced, so a redacted body
+// still decodes into the SDK's models.
+func redactValue(v any, extra map[string]bool) bool {
+	changed := false
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if s, ok := child.(string); ok && s != "" && sensitiveName(k, extra) {
+				t[k] = Redacted
+				changed = true
+				continue
+			}
+			changed = redactValue(child, extra) || changed
+		}
+	case []any:
+		for _, child := range t {
+			changed = redactValue(child, extra) || changed
+		}
+	}
+	return changed
+}
+
+// redactXML replaces the text of sensitive leaf elements.
+func redactXML(body []byte, extra map[string]bool) []byte {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	var out bytes.Buffer
+	enc := xml.NewEncoder(&out)
+
+	var stack []string
+	changed := false
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return body
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 && len(bytes.TrimSpace(t)) > 0 && sensitiveName(stack[len(stack)-1], extra) {
+				tok = xml.CharData(Redacted)
+				changed = true
+			}
+		}
+		if err := enc.EncodeToken(xml.CopyToken(tok)); err != nil {
+			return body
+		}
+	}
+	if !changed || enc.Flush() != nil {
+		return body
+	}
+	return out.Bytes()
+}
+
+func redactForm(body []byte, extra map[string]bool) []byte {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return body
+	}
+	changed := false
+	for k, vs := range values {
+		if sensitiveName(k, extra) {
+			for i := range vs {
+				vs[i] = Redacted
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	return []byte(values.Encode())
+}
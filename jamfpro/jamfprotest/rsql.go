@@ -0,0 +1,367 @@
+package jamfprotest
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// rsqlNode is a parsed RSQL filter expression that can be evaluated against a
+// decoded JSON resource.
+type rsqlNode interface {
+	match(resource map[string]any) bool
+}
+
+type rsqlAnd []rsqlNode
+
+func (n rsqlAnd) match(r map[string]any) bool {
+	for _, c := range n {
+		if !c.match(r) {
+			return false
+		}
+	}
+	return true
+}
+
+type rsqlOr []rsqlNode
+
+func (n rsqlOr) match(r map[string]any) bool {
+	for _, c := range n {
+		if c.match(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// rsqlLiteral is one comparison argument. pattern is set when the value
+// contains an unescaped * wildcard.
+type rsqlLiteral struct {
+	text    string
+	pattern *regexp.Regexp
+}
+
+type rsqlComparison struct {
+	field  string
+	op     string
+	values []rsqlLiteral
+}
+
+// match reports whether the comparison holds for r. A field that resolves to
+// an array matches when any element does, which mirrors how Jamf Pro filters
+// on nested collections such as groupIds.
+func (c *rsqlComparison) match(r map[string]any) bool {
+	values := lookupField(r, c.field)
+	if c.op == "!=" || c.op == "=out=" {
+		return !slices.ContainsFunc(values, func(v string) bool { return c.matchOne(v, negate(c.op)) })
+	}
+	return slices.ContainsFunc(values, func(v string) bool { return c.matchOne(v, c.op) })
+}
+
+func negate(op string) string {
+	if op == "!=" {
+		return "=="
+	}
+	return "=in="
+}
+
+func (c *rsqlComparison) matchOne(v, op string) bool {
+	switch op {
+	case "==", "=in=":
+		return slices.ContainsFunc(c.values, func(l rsqlLiteral) bool { return l.equals(v) })
+	case "<", "=lt=":
+		return compareValues(v, c.values[0].text) < 0
+	case "<=", "=le=":
+		return compareValues(v, c.values[0].text) <= 0
+	case ">", "=gt=":
+		return compareValues(v, c.values[0].text) > 0
+	case ">=", "=ge=":
+		return compareValues(v, c.values[0].text) >= 0
+	}
+	return false
+}
+
+// equals compares case-insensitively, as Jamf Pro does for string fields.
+func (l rsqlLiteral) equals(v string) bool {
+	if l.pattern != nil {
+		return l.pattern.MatchString(v)
+	}
+	return strings.EqualFold(l.text, v)
+}
+
+// compareValues orders a and b numerically when both are numbers and
+// lexically otherwise, which also orders ISO 8601 timestamps correctly.
+func compareValues(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// lookupField resolves a dotted field path (e.g. general.name) in r and
+// returns the string forms of every value found. A missing field yields a
+// single empty string so that != and =out= match it.
+func lookupField(r map[string]any, field string) []string {
+	current := []any{r}
+	for _, part := range strings.Split(field, ".") {
+		var next []any
+		for _, c := range current {
+			switch v := c.(type) {
+			case map[string]any:
+				if child, ok := v[part]; ok {
+					next = append(next, child)
+				}
+			case []any:
+				for _, e := range v {
+					if m, ok := e.(map[string]any); ok {
+						if child, ok := m[part]; ok {
+							next = append(next, child)
+						}
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	var out []string
+	for _, c := range current {
+		if arr, ok := c.([]any); ok {
+			for _, e := range arr {
+				out = append(out, scalarString(e))
+			}
+			continue
+		}
+		out = append(out, scalarString(c))
+	}
+	if len(out) == 0 {
+		return []string{""}
+	}
+	return out
+}
+
+func scalarString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// parseRSQL parses a Jamf Pro RSQL filter. It accepts the operators produced
+// by client.RSQLFilterBuilder plus the =lt= style aliases, ; and "and" for
+// AND, , and "or" for OR, parentheses, and quoted or bare values.
+//
+// See: https://developer.jamf.com/jamf-pro/docs/filtering-with-rsql
+func parseRSQL(filter string) (rsqlNode, error) {
+	p := &rsqlParser{src: filter}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.src[p.pos:], p.pos)
+	}
+	return node, nil
+}
+
+type rsqlParser struct {
+	src string
+	pos int
+}
+
+func (p *rsqlParser) skipSpace() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// consumeKeyword consumes a word operator ("and"/"or") surrounded by spaces.
+func (p *rsqlParser) consumeKeyword(word string) bool {
+	rest := p.src[p.pos:]
+	if len(rest) > len(word) && strings.EqualFold(rest[:len(word)], word) && rest[len(word)] == ' ' {
+		p.pos += len(word)
+		return true
+	}
+	return false
+}
+
+func (p *rsqlParser) parseOr() (rsqlNode, error) {
+	var nodes rsqlOr
+	for {
+		n, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		if p.consumeKeyword("or") {
+			continue
+		}
+		break
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *rsqlParser) parseAnd() (rsqlNode, error) {
+	var nodes rsqlAnd
+	for {
+		n, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ';' {
+			p.pos++
+			continue
+		}
+		if p.consumeKeyword("and") {
+			continue
+		}
+		break
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *rsqlParser) parseTerm() (rsqlNode, error) {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == '(' {
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+		return n, nil
+	}
+	return p.parseComparison()
+}
+
+var rsqlOperators = []string{"=in=", "=out=", "=lt=", "=le=", "=gt=", "=ge=", "==", "!=", "<=", ">=", "<", ">"}
+
+func (p *rsqlParser) parseComparison() (rsqlNode, error) {
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune("=!<>;,() ", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	field := p.src[start:p.pos]
+	if field == "" {
+		return nil, fmt.Errorf("expected field name at position %d", start)
+	}
+
+	var op string
+	for _, candidate := range rsqlOperators {
+		if strings.HasPrefix(p.src[p.pos:], candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return nil, fmt.Errorf("expected comparison operator after %q", field)
+	}
+	p.pos += len(op)
+
+	cmp := &rsqlComparison{field: field, op: op}
+	if op == "=in=" || op == "=out=" {
+		if p.pos >= len(p.src) || p.src[p.pos] != '(' {
+			return nil, fmt.Errorf("%s requires a parenthesised value list", op)
+		}
+		p.pos++
+		for {
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			cmp.values = append(cmp.values, v)
+			if p.pos < len(p.src) && p.src[p.pos] == ',' {
+				p.pos++
+				continue
+			}
+			break
+		}
+		if p.pos >= len(p.src) || p.src[p.pos] != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis in %s list", op)
+		}
+		p.pos++
+		return cmp, nil
+	}
+
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	cmp.values = []rsqlLiteral{v}
+	return cmp, nil
+}
+
+// parseValue reads a quoted or bare value. Backslash escapes a quote, a
+// backslash or a literal asterisk; an unescaped asterisk is a wildcard.
+func (p *rsqlParser) parseValue() (rsqlLiteral, error) {
+	var text, pattern strings.Builder
+	wildcard := false
+
+	quote := byte(0)
+	if p.pos < len(p.src) && (p.src[p.pos] == '"' || p.src[p.pos] == '\'') {
+		quote = p.src[p.pos]
+		p.pos++
+	}
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		if quote != 0 && ch == quote {
+			p.pos++
+			quote = 0
+			break
+		}
+		if quote == 0 && strings.ContainsRune(";,() ", rune(ch)) {
+			break
+		}
+		switch {
+		case ch == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			text.WriteByte(p.src[p.pos])
+			pattern.WriteString(regexp.QuoteMeta(p.src[p.pos : p.pos+1]))
+		case ch == '*':
+			wildcard = true
+			text.WriteByte(ch)
+			pattern.WriteString(".*")
+		default:
+			text.WriteByte(ch)
+			pattern.WriteString(regexp.QuoteMeta(p.src[p.pos : p.pos+1]))
+		}
+		p.pos++
+	}
+	if quote != 0 {
+		return rsqlLiteral{}, fmt.Errorf("unterminated quoted value")
+	}
+
+	lit := rsqlLiteral{text: text.String()}
+	if wildcard {
+		lit.pattern = regexp.MustCompile("(?is)^" + pattern.String() + "$")
+	}
+	return lit, nil
+}
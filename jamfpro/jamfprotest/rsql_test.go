@@ -0,0 +1,78 @@
+package jamfprotest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRSQL_Match(t *testing.T) {
+	var resource map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "12",
+		"name": "Mac*Lab 04",
+		"enabled": true,
+		"general": {"platform": "Mac", "lastContact": "2024-05-01T10:00:00Z"},
+		"groupIds": [1, 3],
+		"sites": [{"name": "London"}, {"name": "Paris"}]
+	}`), &resource))
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`name=="mac*lab 04"`, true},
+		{`name=="Mac*"`, true},
+		{`name=="Mac\*Lab*"`, true},
+		{`name=="Mac\*X*"`, false},
+		{`name!="Mac*"`, false},
+		{`id>10;id<=12`, true},
+		{`id=gt=12`, false},
+		{`id=ge=12 and enabled==true`, true},
+		{`general.platform==Windows,general.platform==Mac`, true},
+		{`general.platform==Windows or enabled==false`, false},
+		{`general.lastContact>"2024-01-01T00:00:00Z"`, true},
+		{`groupIds=in=(2,3)`, true},
+		{`groupIds=out=(1)`, false},
+		{`sites.name==Paris`, true},
+		{`missing!=x`, true},
+		{`(id==1,id==12);(name==x,enabled==true)`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			node, err := parseRSQL(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, node.match(resource))
+		})
+	}
+}
+
+func TestParseRSQL_BuilderOutput(t *testing.T) {
+	filter := client.NewRSQLFilterBuilder().
+		OpenGroup().Contains("name", `a"b*`).Or().In("id", "1", "2").CloseGroup().
+		And().NotEqualTo("city", "Paris").
+		Build()
+	node, err := parseRSQL(filter)
+	require.NoError(t, err)
+	assert.True(t, node.match(map[string]any{"name": `xa"b*y`, "id": "9", "city": "London"}))
+	assert.False(t, node.match(map[string]any{"name": `xa"bcy`, "id": "9", "city": "London"}))
+	assert.True(t, node.match(map[string]any{"name": "", "id": "2", "city": "London"}))
+}
+
+func TestParseRSQL_Errors(t *testing.T) {
+	for _, filter := range []string{
+		`name`,
+		`==x`,
+		`name=="open`,
+		`(name==x`,
+		`id=in=1`,
+		`id=in=(1,2`,
+		`name==x)`,
+	} {
+		_, err := parseRSQL(filter)
+		assert.Error(t, err, filter)
+	}
+}
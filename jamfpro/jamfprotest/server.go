@@ -0,0 +1,324 @@
+// Package jamfprotest provides an in-memory, stateful fake of a Jamf Pro
+// server for offline integration tests, in the spirit of net/http/httptest.
+//
+// Unlike mocks.GenericMock, which returns canned fixtures per method and path,
+// the fake keeps what it is sent: a resource created with POST can be read
+// back, listed, filtered, updated and deleted. It speaks:
+//
+//   - the Jamf Pro API (/api/...) as generic JSON collections with generated
+//     string IDs, page/page-size/sort/filter handling and totalCount envelopes;
+//   - the Classic API (/JSSResource/...) as XML collections with generated
+//     integer IDs, addressable by id and by name;
+//   - the token endpoints used by the SDK's OAuth2 and basic auth flows,
+//     including keep-alive and invalidation.
+//
+// Point a real client at it with the config returned by AuthConfig:
+//
+//	srv := jamfprotest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := jamfpro.NewClient(srv.AuthConfig(), jamfpro.WithBaseURL(srv.URL))
+//
+// Endpoints whose behaviour cannot be inferred from the path (actions,
+// uploads, resources with numeric JSON IDs) can be overridden per method and
+// path with Handle.
+//
+// The package also provides Recorder, a transport that records traffic from a
+// real tenant to cassette files and replays it without network access.
+package jamfprotest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+)
+
+// Default credentials accepted by a Server created without WithOAuth2Client
+// or WithBasicAuth.
+const (
+	DefaultClientID     = "jamfprotest-client"
+	DefaultClientSecret = "jamfprotest-secret"
+	DefaultUsername     = "jamfprotest"
+	DefaultPassword     = "jamfprotest"
+	DefaultVersion      = "11.20.0-t1700000000000"
+)
+
+// Server is a fake Jamf Pro server listening on a local loopback address.
+// All methods are safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the form http://ipaddr:port with no trailing slash.
+	URL string
+
+	srv *httptest.Server
+
+	clientID      string
+	clientSecret  string
+	username      string
+	password      string
+	tokenLifetime time.Duration
+	version       string
+
+	mu       sync.Mutex
+	tokens   map[string]time.Time
+	handlers map[string]http.HandlerFunc
+	store    *jsonStore
+	classic  *classicStore
+}
+
+// Option configures a Server at construction time.
+type Option func(*Server)
+
+// WithOAuth2Client sets the client credentials accepted at /api/v1/oauth/token.
+func WithOAuth2Client(clientID, clientSecret string) Option {
+	return func(s *Server) {
+		s.clientID = clientID
+		s.clientSecret = clientSecret
+	}
+}
+
+// WithBasicAuth sets the username and password accepted at /api/v1/auth/token.
+func WithBasicAuth(username, password string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithTokenLifetime sets how long issued bearer tokens stay valid. The
+// default is 30 minutes.
+func WithTokenLifetime(d time.Duration) Option {
+	return func(s *Server) {
+		s.tokenLifetime = d
+	}
+}
+
+// WithVersion sets the version reported by GET /api/v1/jamf-pro-version.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		clientID:      DefaultClientID,
+		clientSecret:  DefaultClientSecret,
+		username:      DefaultUsername,
+		password:      DefaultPassword,
+		tokenLifetime: 30 * time.Minute,
+		version:       DefaultVersion,
+		tokens:        make(map[string]time.Time),
+		handlers:      make(map[string]http.HandlerFunc),
+		store:         newJSONStore(),
+		classic:       newClassicStore(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests
+// have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// AuthConfig returns an OAuth2 configuration for this server. Its
+// InstanceDomain is the server URL, which the SDK also uses for token
+// requests, so pair it with WithBaseURL(srv.URL) or use it on its own.
+func (s *Server) AuthConfig() *config.AuthConfig {
+	return &config.AuthConfig{
+		InstanceDomain: s.URL,
+		AuthMethod:     constants.AuthMethodOAuth2,
+		ClientID:       s.clientID,
+		ClientSecret:   s.clientSecret,
+	}
+}
+
+// BasicAuthConfig returns a basic auth configuration for this server.
+func (s *Server) BasicAuthConfig() *config.AuthConfig {
+	return &config.AuthConfig{
+		InstanceDomain: s.URL,
+		AuthMethod:     constants.AuthMethodBasic,
+		Username:       s.username,
+		Password:       s.password,
+	}
+}
+
+// Handle overrides the response for an exact method and path, taking
+// precedence over the built-in behaviour. Requests still need a valid bearer
+// token. Passing a nil handler removes the override.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if handler == nil {
+		delete(s.handlers, method+" "+path)
+		return
+	}
+	s.handlers[method+" "+path] = handler
+}
+
+// ExpireTokens invalidates every issued bearer token, so the next request
+// is rejected with 401 and the client has to re-authenticate.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.tokens)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case constants.EndpointOAuthToken:
+		s.handleOAuthToken(w, r)
+		return
+	case constants.EndpointBearerToken:
+		s.handleBasicToken(w, r)
+		return
+	}
+
+	token, ok := s.authorize(r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_TOKEN", "", "Unauthorized")
+		return
+	}
+
+	s.mu.Lock()
+	handler := s.handlers[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+	if handler != nil {
+		handler(w, r)
+		return
+	}
+
+	switch {
+	case r.URL.Path == constants.EndpointKeepAliveToken:
+		s.handleKeepAlive(w, r, token)
+	case r.URL.Path == constants.EndpointInvalidateToken:
+		s.revoke(token)
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == constants.EndpointJamfProJamfProVersionV1:
+		writeJSON(w, http.StatusOK, map[string]string{"version": s.version})
+	case strings.HasPrefix(r.URL.Path, "/JSSResource/"):
+		s.classic.serve(w, r)
+	case strings.HasPrefix(r.URL.Path, "/api/"):
+		s.store.serve(w, r, s.URL)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleOAuthToken implements the client credentials grant.
+func (s *Server) handleOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	if r.PostForm.Get("client_id") != s.clientID || r.PostForm.Get("client_secret") != s.clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	token, _ := s.issue()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"scope":        "api-role:1",
+		"token_type":   "Bearer",
+		"expires_in":   int64(s.tokenLifetime / time.Second),
+	})
+}
+
+// handleBasicToken exchanges basic credentials for a bearer token.
+func (s *Server) handleBasicToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok || username != s.username || password != s.password {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "", "Unauthorized")
+		return
+	}
+	token, expires := s.issue()
+	writeJSON(w, http.StatusOK, map[string]any{"token": token, "expires": expires.UTC().Format(time.RFC3339)})
+}
+
+// handleKeepAlive replaces the presented token with a fresh one.
+func (s *Server) handleKeepAlive(w http.ResponseWriter, r *http.Request, token string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.revoke(token)
+	next, expires := s.issue()
+	writeJSON(w, http.StatusOK, map[string]any{"token": next, "expires": expires.UTC().Format(time.RFC3339)})
+}
+
+func (s *Server) issue() (string, time.Time) {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	token := hex.EncodeToString(b)
+	expires := time.Now().Add(s.tokenLifetime)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token] = expires
+	return token, expires
+}
+
+func (s *Server) revoke(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, token)
+}
+
+// authorize returns the bearer token on r when it was issued by this server
+// and has not expired or been revoked.
+func (s *Server) authorize(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expires, ok := s.tokens[token]
+	if !ok || time.Now().After(expires) {
+		return "", false
+	}
+	return token, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", constants.ApplicationJSON)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeJSONError writes a Jamf Pro API error body with a single entry in its
+// errors array, the shape client.ParseErrorResponse decodes.
+func writeJSONError(w http.ResponseWriter, status int, code, field, description string) {
+	entry := map[string]any{"code": code, "description": description, "id": "0", "field": nil}
+	if field != "" {
+		entry["field"] = field
+	}
+	writeJSON(w, status, map[string]any{"httpStatus": status, "errors": []any{entry}})
+}
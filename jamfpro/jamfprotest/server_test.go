@@ -0,0 +1,244 @@
+package jamfprotest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/classic_api/sites"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/jamf_pro_api/buildings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, srv *Server) *jamfpro.Client {
+	t.Helper()
+	c, err := jamfpro.NewClient(srv.AuthConfig(), jamfpro.WithBaseURL(srv.URL), jamfpro.WithRetryCount(0), jamfpro.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+	return c
+}
+
+func TestServer_JamfProAPI_Lifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	svc := newTestClient(t, srv).JamfProAPI.Buildings
+	ctx := context.Background()
+
+	created, resp, err := svc.CreateV1(ctx, &buildings.RequestBuilding{Name: "HQ", City: "London"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.Equal(t, "1", created.ID)
+	assert.Equal(t, srv.URL+"/api/v1/buildings/1", created.Href)
+
+	got, _, err := svc.GetByIDV1(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "HQ", got.Name)
+	assert.Equal(t, "London", got.City)
+
+	updated, _, err := svc.UpdateByIDV1(ctx, created.ID, &buildings.RequestBuilding{Name: "HQ", City: "Leeds"})
+	require.NoError(t, err)
+	assert.Equal(t, "Leeds", updated.City)
+	assert.Equal(t, created.ID, updated.ID)
+
+	stored, ok := srv.Resource("/api/v1/buildings", created.ID)
+	require.True(t, ok)
+	assert.Equal(t, "Leeds", stored["city"])
+
+	_, err = svc.DeleteByIDV1(ctx, created.ID)
+	require.NoError(t, err)
+
+	_, resp, err = svc.GetByIDV1(ctx, created.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestServer_JamfProAPI_ListFilterSortAndPaging(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	for _, b := range []buildings.ResourceBuilding{
+		{Name: "Alpha", City: "London"},
+		{Name: "Bravo", City: "Paris"},
+		{Name: "Charlie", City: "London"},
+		{Name: "Delta", City: "Berlin"},
+		{Name: "Echo", City: "London"},
+	} {
+		srv.Seed("/api/v1/buildings", b)
+	}
+	svc := newTestClient(t, srv).JamfProAPI.Buildings
+	ctx := context.Background()
+
+	// page-size smaller than the match count exercises the client's page walk.
+	result, _, err := svc.ListV1(ctx, map[string]string{
+		"filter":    `city=="London"`,
+		"sort":      "name:desc",
+		"page-size": "2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, result.TotalCount)
+	assert.Equal(t, []string{"Echo", "Charlie", "Alpha"}, buildingNames(result.Results))
+
+	result, _, err = svc.ListV1(ctx, map[string]string{"filter": `name=="*o" or city=in=(Berlin)`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bravo", "Delta", "Echo"}, buildingNames(result.Results))
+
+	_, resp, err := svc.ListV1(ctx, map[string]string{"filter": `name==`})
+	require.NoError(t, err, "an empty value is valid RSQL")
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+
+	_, resp, err = svc.ListV1(ctx, map[string]string{"filter": `name=="unterminated`})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
+
+func buildingNames(items []buildings.ResourceBuilding) []string {
+	names := make([]string, len(items))
+	for i, b := range items {
+		names[i] = b.Name
+	}
+	return names
+}
+
+func TestServer_JamfProAPI_Conflicts(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	_, _, err := c.JamfProAPI.Buildings.CreateV1(ctx, &buildings.RequestBuilding{Name: "HQ"})
+	require.NoError(t, err)
+	_, _, err = c.JamfProAPI.Buildings.CreateV1(ctx, &buildings.RequestBuilding{Name: "hq"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrDuplicate))
+
+	id := srv.Seed("/api/v1/things", map[string]any{"name": "locked", "versionLock": 0})
+	path := "/api/v1/things/" + id
+	put := func(versionLock int) (*http.Response, error) {
+		var out map[string]any
+		resp, err := c.GetTransport().NewRequest(ctx).
+			SetBody(map[string]any{"name": "locked", "versionLock": versionLock}).
+			SetResult(&out).
+			Put(path)
+		if resp == nil {
+			return nil, err
+		}
+		return resp.RawResponse, err
+	}
+
+	resp, err := put(0)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = put(0)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.True(t, errors.Is(err, client.ErrOptimisticLock))
+
+	stored, _ := srv.Resource("/api/v1/things", id)
+	assert.Equal(t, "1", stored["versionLock"].(interface{ String() string }).String())
+}
+
+func TestServer_ClassicAPI_Lifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	svc := newTestClient(t, srv).ClassicAPI.Sites
+	ctx := context.Background()
+
+	created, resp, err := svc.Create(ctx, &sites.RequestSite{Name: "London"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.Equal(t, 1, created.ID)
+
+	_, _, err = svc.Create(ctx, &sites.RequestSite{Name: "Paris"})
+	require.NoError(t, err)
+
+	byName, _, err := svc.GetByName(ctx, "London")
+	require.NoError(t, err)
+	assert.Equal(t, 1, byName.ID)
+
+	list, _, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.Size)
+	require.Len(t, list.Results, 2)
+	assert.Equal(t, "Paris", list.Results[1].Name)
+
+	_, _, err = svc.UpdateByID(ctx, 1, &sites.RequestSite{Name: "Paris"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, client.ErrDuplicate))
+
+	_, _, err = svc.UpdateByID(ctx, 1, &sites.RequestSite{Name: "Leeds"})
+	require.NoError(t, err)
+	raw, ok := srv.ClassicResource("sites", 1)
+	require.True(t, ok)
+	assert.Equal(t, "<site><id>1</id><name>Leeds</name></site>", raw)
+
+	_, err = svc.DeleteByName(ctx, "Leeds")
+	require.NoError(t, err)
+	_, _, err = svc.GetByID(ctx, 1)
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestServer_ClassicAPI_GeneralSubset(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	id := srv.SeedClassic("policies", `<policy><general><name>Install Chrome</name><enabled>true</enabled></general></policy>`)
+	raw, ok := srv.ClassicResource("policies", id)
+	require.True(t, ok)
+	assert.Contains(t, raw, "<general><id>1</id><name>Install Chrome</name>")
+}
+
+func TestServer_Authentication(t *testing.T) {
+	srv := NewServer(WithOAuth2Client("id", "secret"), WithBasicAuth("admin", "pw"))
+	defer srv.Close()
+
+	_, err := jamfpro.NewClient(srv.AuthConfig(), jamfpro.WithBaseURL(srv.URL), jamfpro.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+
+	basic, err := jamfpro.NewClient(srv.BasicAuthConfig(), jamfpro.WithLogger(zap.NewNop()))
+	require.NoError(t, err)
+
+	wrong := srv.AuthConfig()
+	wrong.ClientSecret = "nope"
+	_, err = jamfpro.NewClient(wrong, jamfpro.WithLogger(zap.NewNop()))
+	require.Error(t, err)
+
+	// Expired tokens are rejected; the client re-authenticates and replays.
+	srv.ExpireTokens()
+	_, _, err = basic.JamfProAPI.Buildings.ListV1(context.Background(), nil)
+	require.NoError(t, err)
+
+	unauthenticated, err := http.Get(srv.URL + "/api/v1/buildings")
+	require.NoError(t, err)
+	defer unauthenticated.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, unauthenticated.StatusCode)
+}
+
+func TestServer_HandleAndDocuments(t *testing.T) {
+	srv := NewServer(WithVersion("11.25.0"))
+	defer srv.Close()
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	v, err := c.GetTransport().ServerVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "11.25.0", v.String())
+
+	srv.SetDocument("/api/v1/settings", map[string]any{"enabled": false})
+	var doc map[string]any
+	_, err = c.GetTransport().NewRequest(ctx).SetBody(map[string]any{"enabled": true}).SetResult(&doc).Patch("/api/v1/settings")
+	require.NoError(t, err)
+	assert.Equal(t, true, doc["enabled"])
+
+	srv.Handle(http.MethodGet, "/api/v1/reports/export", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("name\nHQ\n"))
+	})
+	_, data, err := c.GetTransport().NewRequest(ctx).SetHeader("Accept", "text/csv").GetBytes("/api/v1/reports/export")
+	require.NoError(t, err)
+	assert.Equal(t, "name\nHQ\n", string(data))
+}
@@ -0,0 +1,431 @@
+package jamfprotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
+)
+
+// defaultPageSize is the page size Jamf Pro applies when page-size is omitted.
+const defaultPageSize = 100
+
+// jsonCollection holds the resources POSTed to one Jamf Pro API path, in
+// creation order.
+type jsonCollection struct {
+	nextID int
+	ids    []string
+	items  map[string]map[string]any
+}
+
+// jsonStore is the in-memory state behind the Jamf Pro API.
+//
+// A path that has been POSTed to is a collection and {path}/{id} addresses
+// its items. A path that has been PUT or PATCHed without being an item of a
+// collection is a singleton document, which is how settings endpoints
+// such as /api/v1/sso behave.
+type jsonStore struct {
+	mu          sync.Mutex
+	collections map[string]*jsonCollection
+	documents   map[string]map[string]any
+}
+
+func newJSONStore() *jsonStore {
+	return &jsonStore{
+		collections: make(map[string]*jsonCollection),
+		documents:   make(map[string]map[string]any),
+	}
+}
+
+func (s *jsonStore) serve(w http.ResponseWriter, r *http.Request, baseURL string) {
+	p := strings.TrimSuffix(r.URL.Path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if doc, ok := s.documents[p]; ok {
+		s.serveDocument(w, r, p, doc)
+		return
+	}
+
+	parent, last := path.Split(p)
+	parent = strings.TrimSuffix(parent, "/")
+	if coll := s.collections[parent]; coll != nil {
+		switch {
+		case r.Method == http.MethodPost && last == "delete-multiple":
+			s.deleteMultiple(w, r, coll)
+			return
+		case r.Method != http.MethodPost:
+			s.serveItem(w, r, coll, last)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, s.collections[p])
+	case http.MethodPost:
+		s.create(w, r, p, baseURL)
+	case http.MethodPut, http.MethodPatch:
+		s.serveDocument(w, r, p, nil)
+	default:
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "", "Resource not found")
+	}
+}
+
+func (s *jsonStore) collection(p string) *jsonCollection {
+	coll := s.collections[p]
+	if coll == nil {
+		coll = &jsonCollection{items: make(map[string]map[string]any)}
+		s.collections[p] = coll
+	}
+	return coll
+}
+
+// insert stores resource under a newly generated ID and returns the ID.
+func (s *jsonStore) insert(p string, resource map[string]any) string {
+	coll := s.collection(p)
+	coll.nextID++
+	id := strconv.Itoa(coll.nextID)
+	resource["id"] = id
+	coll.ids = append(coll.ids, id)
+	coll.items[id] = resource
+	return id
+}
+
+func (s *jsonStore) create(w http.ResponseWriter, r *http.Request, p, baseURL string) {
+	body, ok := decodeObject(w, r)
+	if !ok {
+		return
+	}
+	if coll := s.collections[p]; coll != nil && coll.nameTaken(body, "") {
+		writeJSONError(w, http.StatusConflict, client.ErrorCodeDuplicateField, "name", "Name already in use")
+		return
+	}
+	id := s.insert(p, body)
+	writeJSON(w, http.StatusCreated, map[string]string{"id": id, "href": baseURL + p + "/" + id})
+}
+
+func (s *jsonStore) serveItem(w http.ResponseWriter, r *http.Request, coll *jsonCollection, id string) {
+	item, ok := coll.items[id]
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "id", fmt.Sprintf("Resource with id %s not found", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodPut, http.MethodPatch:
+		body, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if coll.nameTaken(body, id) {
+			writeJSONError(w, http.StatusConflict, client.ErrorCodeDuplicateField, "name", "Name already in use")
+			return
+		}
+		updated, ok := applyUpdate(w, r.Method, item, body)
+		if !ok {
+			return
+		}
+		updated["id"] = id
+		coll.items[id] = updated
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		coll.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *jsonStore) serveDocument(w http.ResponseWriter, r *http.Request, p string, doc map[string]any) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, doc)
+	case http.MethodPut, http.MethodPatch:
+		body, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if doc == nil {
+			doc = map[string]any{}
+		}
+		updated, ok := applyUpdate(w, r.Method, doc, body)
+		if !ok {
+			return
+		}
+		s.documents[p] = updated
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		delete(s.documents, p)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *jsonStore) deleteMultiple(w http.ResponseWriter, r *http.Request, coll *jsonCollection) {
+	var req struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, client.ErrorCodeInvalidField, "ids", err.Error())
+		return
+	}
+	for _, id := range req.IDs {
+		coll.remove(id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// list serves a paginated, filtered and sorted view of coll in the standard
+// {totalCount, results} envelope. totalCount counts every match, not just
+// the returned page.
+func (s *jsonStore) list(w http.ResponseWriter, r *http.Request, coll *jsonCollection) {
+	q := r.URL.Query()
+
+	page, err := queryInt(q.Get("page"), 0)
+	if err != nil || page < 0 {
+		writeJSONError(w, http.StatusBadRequest, client.ErrorCodeInvalidField, "page", "page must be a non-negative integer")
+		return
+	}
+	pageSize, err := queryInt(q.Get("page-size"), defaultPageSize)
+	if err != nil || pageSize <= 0 {
+		writeJSONError(w, http.StatusBadRequest, client.ErrorCodeInvalidField, "page-size", "page-size must be a positive integer")
+		return
+	}
+
+	var filter rsqlNode
+	if f := q.Get("filter"); f != "" {
+		if filter, err = parseRSQL(f); err != nil {
+			writeJSONError(w, http.StatusBadRequest, client.ErrorCodeInvalidField, "filter", "Invalid RSQL filter: "+err.Error())
+			return
+		}
+	}
+
+	results := []map[string]any{}
+	if coll != nil {
+		for _, id := range coll.ids {
+			if item := coll.items[id]; filter == nil || filter.match(item) {
+				results = append(results, item)
+			}
+		}
+	}
+	sortResources(results, strings.Join(q["sort"], ","))
+
+	total := len(results)
+	start := min(page*pageSize, total)
+	end := min(start+pageSize, total)
+	writeJSON(w, http.StatusOK, map[string]any{"totalCount": total, "results": results[start:end]})
+}
+
+func (c *jsonCollection) remove(id string) {
+	if _, ok := c.items[id]; !ok {
+		return
+	}
+	delete(c.items, id)
+	c.ids = slices.DeleteFunc(c.ids, func(v string) bool { return v == id })
+}
+
+// nameTaken reports whether another resource than exceptID already uses the
+// name in body. Jamf Pro rejects duplicate names on most named resources.
+func (c *jsonCollection) nameTaken(body map[string]any, exceptID string) bool {
+	name, ok := body["name"].(string)
+	if !ok || name == "" {
+		return false
+	}
+	for id, item := range c.items {
+		if other, _ := item["name"].(string); id != exceptID && strings.EqualFold(other, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyUpdate returns current updated with body, replacing it for PUT and
+// merging into it for PATCH. Resources that carry a versionLock are
+// optimistically locked: a body with a different versionLock is rejected
+// with 409 OPTIMISTIC_LOCK_FAILED, and each successful update increments it.
+func applyUpdate(w http.ResponseWriter, method string, current, body map[string]any) (map[string]any, bool) {
+	lock, locked := current["versionLock"].(json.Number)
+	if locked {
+		if sent, ok := body["versionLock"]; ok && fmt.Sprint(sent) != lock.String() {
+			writeJSONError(w, http.StatusConflict, client.ErrorCodeOptimisticLockFailed, "versionLock", "Optimistic lock failed")
+			return nil, false
+		}
+	}
+
+	var updated map[string]any
+	if method == http.MethodPatch {
+		updated = mergeObjects(deepCopy(current), body)
+	} else {
+		updated = body
+	}
+
+	if locked {
+		n, _ := lock.Int64()
+		updated["versionLock"] = json.Number(strconv.FormatInt(n+1, 10))
+	}
+	return updated, true
+}
+
+// mergeObjects applies patch to dst recursively, the JSON merge-patch
+// semantics Jamf Pro's PATCH endpoints follow.
+func mergeObjects(dst, patch map[string]any) map[string]any {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if pv, ok := v.(map[string]any); ok {
+			if dv, ok := dst[k].(map[string]any); ok {
+				dst[k] = mergeObjects(dv, pv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+	return dst
+}
+
+// sortResources orders results by a Jamf Pro sort expression such as
+// "name:asc,id:desc". An empty expression keeps creation order.
+func sortResources(results []map[string]any, expr string) {
+	type key struct {
+		field string
+		desc  bool
+	}
+	var keys []key
+	for part := range strings.SplitSeq(expr, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		field, dir, _ := strings.Cut(part, ":")
+		keys = append(keys, key{field: field, desc: strings.EqualFold(dir, "desc")})
+	}
+	if len(keys) == 0 {
+		return
+	}
+	slices.SortStableFunc(results, func(a, b map[string]any) int {
+		for _, k := range keys {
+			c := compareValues(lookupField(a, k.field)[0], lookupField(b, k.field)[0])
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// decodeObject decodes the request body as a JSON object, keeping numbers as
+// json.Number so they round-trip unchanged.
+func decodeObject(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	var body map[string]any
+	if err := dec.Decode(&body); err != nil || body == nil {
+		writeJSONError(w, http.StatusBadRequest, client.ErrorCodeInvalidField, "", "Request body must be a JSON object")
+		return nil, false
+	}
+	return body, true
+}
+
+func queryInt(v string, def int) (int, error) {
+	if v == "" {
+		return def, nil
+	}
+	return strconv.Atoi(v)
+}
+
+// toObject converts v to its decoded JSON object form, as if it had been sent
+// in a request body.
+func toObject(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("resource must encode to a JSON object")
+	}
+	return obj, nil
+}
+
+func deepCopy(v map[string]any) map[string]any {
+	out, _ := toObject(v)
+	return out
+}
+
+// Seed adds resource to the Jamf Pro API collection at path (e.g.
+// "/api/v1/buildings") as if it had been POSTed, and returns its generated
+// ID. It panics if resource does not encode to a JSON object.
+func (s *Server) Seed(path string, resource any) string {
+	obj, err := toObject(resource)
+	if err != nil {
+		panic(fmt.Sprintf("jamfprotest: seed %s: %v", path, err))
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	return s.store.insert(strings.TrimSuffix(path, "/"), obj)
+}
+
+// SetDocument stores v as the singleton document served at path, for
+// settings-style endpoints that are read with GET and written with PUT.
+// It panics if v does not encode to a JSON object.
+func (s *Server) SetDocument(path string, v any) {
+	obj, err := toObject(v)
+	if err != nil {
+		panic(fmt.Sprintf("jamfprotest: set document %s: %v", path, err))
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.documents[strings.TrimSuffix(path, "/")] = obj
+}
+
+// Resource returns a copy of the resource with id in the collection at path.
+func (s *Server) Resource(path, id string) (map[string]any, bool) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	coll := s.store.collections[strings.TrimSuffix(path, "/")]
+	if coll == nil {
+		return nil, false
+	}
+	item, ok := coll.items[id]
+	if !ok {
+		return nil, false
+	}
+	return deepCopy(item), true
+}
+
+// Resources returns copies of every resource in the collection at path, in
+// creation order.
+func (s *Server) Resources(path string) []map[string]any {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	coll := s.store.collections[strings.TrimSuffix(path, "/")]
+	if coll == nil {
+		return nil
+	}
+	out := make([]map[string]any, 0, len(coll.ids))
+	for _, id := range coll.ids {
+		out = append(out, deepCopy(coll.items[id]))
+	}
+	return out
+}
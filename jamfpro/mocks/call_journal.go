@@ -0,0 +1,200 @@
+package mocks
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+)
+
+// Call is one request received by a GenericMock.
+type Call struct {
+	// Ordinal is the call's 1-based position among all calls to the mock.
+	Ordinal int
+	Method  string
+	Path    string
+	Header  http.Header
+	Query   map[string]string
+	// Body is the value the service passed as the request body. Services
+	// often reuse a request across retries, so it may have changed since the
+	// call; RawBody and DecodeBody see it as it was sent. Form posts record
+	// their fields as a map[string]string.
+	Body any
+
+	contentType ContentType
+	rawBody     []byte
+	rawErr      error
+}
+
+// RawBody returns Body as it was serialized when the call was made: as XML
+// when the call's Content-Type (or the mock's, when none was set) is XML,
+// otherwise as JSON. String and byte slice bodies are returned unchanged.
+func (c Call) RawBody() ([]byte, error) {
+	return c.rawBody, c.rawErr
+}
+
+// encodeBody snapshots Body for RawBody.
+func (c Call) encodeBody() ([]byte, error) {
+	switch b := c.Body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return append([]byte(nil), b...), nil
+	case string:
+		return []byte(b), nil
+	}
+	if c.isXML() {
+		return xml.Marshal(c.Body)
+	}
+	return json.Marshal(c.Body)
+}
+
+// DecodeBody serializes Body with RawBody and decodes it into v, so a test
+// can inspect what would have gone on the wire — for example the XML shape
+// of a Classic API payload, or a versionLock injected into a JSON request.
+func (c Call) DecodeBody(v any) error {
+	raw, err := c.RawBody()
+	if err != nil {
+		return fmt.Errorf("encode body of call %d (%s %s): %w", c.Ordinal, c.Method, c.Path, err)
+	}
+	if len(raw) == 0 {
+		return fmt.Errorf("call %d (%s %s) has no body", c.Ordinal, c.Method, c.Path)
+	}
+	if c.isXML() {
+		return xml.Unmarshal(raw, v)
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func (c Call) isXML() bool {
+	if ct := c.Header.Get("Content-Type"); ct != "" {
+		return strings.Contains(ct, "xml")
+	}
+	return c.contentType == constants.ApplicationXML
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("#%d %s %s", c.Ordinal, c.Method, c.Path)
+}
+
+// TestingT is the subset of *testing.T used by the assertion helpers.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Calls returns every call the mock has received, in order.
+func (m *GenericMock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls made to path with any method, in order.
+func (m *GenericMock) CallsTo(path string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Call
+	for _, c := range m.calls {
+		if c.Path == path {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// AssertCalled reports a test error unless method and path were called, and
+// returns the most recent matching call.
+func (m *GenericMock) AssertCalled(t TestingT, method, path string) Call {
+	t.Helper()
+	for _, c := range slices.Backward(m.CallsTo(path)) {
+		if c.Method == method {
+			return c
+		}
+	}
+	t.Errorf("%s: expected a call to %s %s; calls received:%s", m.name, method, path, m.describeCalls())
+	return Call{}
+}
+
+// AssertNotCalled reports a test error if method and path were called.
+func (m *GenericMock) AssertNotCalled(t TestingT, method, path string) {
+	t.Helper()
+	for _, c := range m.CallsTo(path) {
+		if c.Method == method {
+			t.Errorf("%s: unexpected call %s", m.name, c)
+			return
+		}
+	}
+}
+
+// ResetCalls clears the call journal and rewinds every registered sequence.
+func (m *GenericMock) ResetCalls() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+	for _, seq := range m.sequences {
+		seq.served = 0
+	}
+}
+
+// newCall builds a journal entry for the mock's direct client methods.
+func (m *GenericMock) newCall(method, path string, headers, query map[string]string, body any) Call {
+	h := make(http.Header, len(headers))
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return Call{Method: method, Path: path, Header: h, Query: query, Body: body}
+}
+
+// record appends call to the journal and returns the response registered
+// for it, advancing the endpoint's sequence if it has one.
+func (m *GenericMock) record(call Call) (registeredResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	call.Ordinal = len(m.calls) + 1
+	call.contentType = m.contentType
+	if call.Header == nil {
+		call.Header = http.Header{}
+	}
+	call.rawBody, call.rawErr = call.encodeBody()
+	m.calls = append(m.calls, call)
+
+	key := call.Method + ":" + call.Path
+	if seq, ok := m.sequences[key]; ok {
+		r := seq.steps[min(seq.served, len(seq.steps)-1)]
+		seq.served++
+		return r, true
+	}
+	r, ok := m.responses[key]
+	return r, ok
+}
+
+func (m *GenericMock) describeCalls() string {
+	calls := m.Calls()
+	if len(calls) == 0 {
+		return " none"
+	}
+	var b strings.Builder
+	for _, c := range calls {
+		b.WriteString("\n\t")
+		b.WriteString(c.String())
+	}
+	return b.String()
+}
+
+// firstValues flattens url.Values to their first value per key.
+func firstValues(values url.Values) map[string]string {
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		if len(v) > 0 {
+			out[k] = v[0]
+		}
+	}
+	return out
+}
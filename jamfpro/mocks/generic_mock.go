@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"slices"
+	"sync"
 
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
@@ -29,11 +30,23 @@ type registeredResponse struct {
 	errMsg     string
 }
 
+// responseSequence holds scripted responses for a single endpoint and how
+// many of them have been served.
+type responseSequence struct {
+	steps  []registeredResponse
+	served int
+}
+
 // GenericMock is a reusable test double implementing client.Client.
 // It can be configured for JSON (Jamf Pro API) or XML (Classic API) responses.
+//
+// Every request is recorded in a call journal (see Calls, CallsTo and
+// AssertCalled), so tests can check what a service sent as well as how it
+// handled the response.
 type GenericMock struct {
 	name          string
 	responses     map[string]registeredResponse
+	sequences     map[string]*responseSequence
 	logger        *zap.Logger
 	contentType   ContentType
 	LastRSQLQuery map[string]string
@@ -45,6 +58,9 @@ type GenericMock struct {
 	// ServerVersionError, when non-nil, is returned by ServerVersion to simulate
 	// a version-endpoint failure (used to exercise the removal guard's fail-open path).
 	ServerVersionError error
+
+	mu    sync.Mutex
+	calls []Call
 }
 
 // GenericMockConfig configures a GenericMock instance.
@@ -84,6 +100,7 @@ func NewGenericMock(config GenericMockConfig) *GenericMock {
 	return &GenericMock{
 		name:        config.Name,
 		responses:   make(map[string]registeredResponse),
+		sequences:   make(map[string]*responseSequence),
 		logger:      zap.NewNop(),
 		contentType: config.ContentType,
 		fixtureDir:  config.FixtureDir,
@@ -109,6 +126,75 @@ func NewXMLMock(name string) *GenericMock {
 // Register registers a mock response for the given method and path.
 // If fixture is empty, no body is set.
 func (m *GenericMock) Register(method, path string, statusCode int, fixture string) {
+	m.setResponse(method, path, m.fixtureResponse(statusCode, fixture))
+}
+
+// RegisterError registers a mock error response.
+// For JSON responses, it attempts to parse error details from the fixture.
+func (m *GenericMock) RegisterError(method, path string, statusCode int, fixture string, errMsg string) {
+	m.setResponse(method, path, m.errorResponse(statusCode, fixture, errMsg))
+}
+
+// RegisterRawBody registers a mock response with raw body bytes.
+// Useful for testing error paths with malformed responses.
+func (m *GenericMock) RegisterRawBody(method, path string, statusCode int, body []byte) {
+	m.setResponse(method, path, registeredResponse{statusCode: statusCode, rawBody: body})
+}
+
+// MockStep is one scripted response in a sequence registered with
+// RegisterSequence. A status code of 400 or above produces an error, as
+// RegisterError does; ErrMsg overrides the message.
+type MockStep struct {
+	StatusCode int
+	Fixture    string // loaded like Register's fixture; ignored when Body is set
+	Body       []byte
+	ErrMsg     string
+}
+
+// RegisterSequence registers responses that are returned one per call, in
+// order, for the given method and path — for example a 409 followed by a 200
+// to exercise conflict retries. Once the sequence is used up, its last step
+// is returned for every further call. It replaces any response registered
+// for the same method and path.
+func (m *GenericMock) RegisterSequence(method, path string, steps ...MockStep) {
+	if len(steps) == 0 {
+		panic(fmt.Sprintf("%s: RegisterSequence %s %s needs at least one step", m.name, method, path))
+	}
+	seq := make([]registeredResponse, 0, len(steps))
+	for _, step := range steps {
+		var r registeredResponse
+		switch {
+		case step.Body != nil && step.StatusCode >= http.StatusBadRequest:
+			r = m.errorResponse(step.StatusCode, "", step.ErrMsg)
+			r.rawBody = step.Body
+		case step.Body != nil:
+			r = registeredResponse{statusCode: step.StatusCode, rawBody: step.Body, errMsg: step.ErrMsg}
+		case step.StatusCode >= http.StatusBadRequest:
+			r = m.errorResponse(step.StatusCode, step.Fixture, step.ErrMsg)
+		default:
+			r = m.fixtureResponse(step.StatusCode, step.Fixture)
+			r.errMsg = step.ErrMsg
+		}
+		seq = append(seq, r)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.responses, method+":"+path)
+	m.sequences[method+":"+path] = &responseSequence{steps: seq}
+}
+
+// setResponse registers r for every call to method and path, replacing any
+// sequence registered for them.
+func (m *GenericMock) setResponse(method, path string, r registeredResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sequences, method+":"+path)
+	m.responses[method+":"+path] = r
+}
+
+// fixtureResponse builds a response whose body is loaded from fixture.
+func (m *GenericMock) fixtureResponse(statusCode int, fixture string) registeredResponse {
 	var body []byte
 	if fixture != "" {
 		data, err := m.loadFixture(fixture)
@@ -117,12 +203,12 @@ func (m *GenericMock) Register(method, path string, statusCode int, fixture stri
 		}
 		body = data
 	}
-	m.responses[method+":"+path] = registeredResponse{statusCode: statusCode, rawBody: body}
+	return registeredResponse{statusCode: statusCode, rawBody: body}
 }
 
-// RegisterError registers a mock error response.
-// For JSON responses, it attempts to parse error details from the fixture.
-func (m *GenericMock) RegisterError(method, path string, statusCode int, fixture string, errMsg string) {
+// errorResponse builds an error response. For JSON responses without a
+// custom message, the message is taken from the fixture's code and message.
+func (m *GenericMock) errorResponse(statusCode int, fixture string, errMsg string) registeredResponse {
 	var body []byte
 	if fixture != "" {
 		var err error
@@ -147,13 +233,7 @@ func (m *GenericMock) RegisterError(method, path string, statusCode int, fixture
 		errMsg = fmt.Sprintf("%s: error response %d", m.name, statusCode)
 	}
 
-	m.responses[method+":"+path] = registeredResponse{statusCode: statusCode, rawBody: body, errMsg: errMsg}
-}
-
-// RegisterRawBody registers a mock response with raw body bytes.
-// Useful for testing error paths with malformed responses.
-func (m *GenericMock) RegisterRawBody(method, path string, statusCode int, body []byte) {
-	m.responses[method+":"+path] = registeredResponse{statusCode: statusCode, rawBody: body}
+	return registeredResponse{statusCode: statusCode, rawBody: body, errMsg: errMsg}
 }
 
 // loadFixture loads a fixture file from the configured fixture directory.
@@ -189,11 +269,12 @@ func isCommonErrorFixture(filename string) bool {
 	return slices.Contains(commonErrors, filename)
 }
 
-// dispatch is the core routing logic for all HTTP methods.
-func (m *GenericMock) dispatch(method, path string, result any) (*resty.Response, error) {
-	r, ok := m.responses[method+":"+path]
+// dispatch is the core routing logic for all HTTP methods. It records the
+// call in the journal before resolving its response.
+func (m *GenericMock) dispatch(call Call, result any) (*resty.Response, error) {
+	r, ok := m.record(call)
 	if !ok {
-		return nil, fmt.Errorf("%s: no response registered for %s %s", m.name, method, path)
+		return nil, fmt.Errorf("%s: no response registered for %s %s", m.name, call.Method, call.Path)
 	}
 
 	headers := http.Header{"Content-Type": {string(m.contentType)}}
@@ -229,55 +310,55 @@ func (m *GenericMock) dispatch(method, path string, result any) (*resty.Response
 // client.Client Interface Implementation
 // -----------------------------------------------------------------------------
 
-func (m *GenericMock) Get(ctx context.Context, path string, rsqlQuery map[string]string, _ map[string]string, result any) (*resty.Response, error) {
+func (m *GenericMock) Get(ctx context.Context, path string, rsqlQuery map[string]string, headers map[string]string, result any) (*resty.Response, error) {
 	m.LastRSQLQuery = rsqlQuery
-	return m.dispatch("GET", path, result)
+	return m.dispatch(m.newCall("GET", path, headers, rsqlQuery, nil), result)
 }
 
-func (m *GenericMock) Post(ctx context.Context, path string, _ any, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("POST", path, result)
+func (m *GenericMock) Post(ctx context.Context, path string, body any, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("POST", path, headers, nil, body), result)
 }
 
-func (m *GenericMock) PostWithQuery(ctx context.Context, path string, _ map[string]string, _ any, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("POST", path, result)
+func (m *GenericMock) PostWithQuery(ctx context.Context, path string, rsqlQuery map[string]string, body any, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("POST", path, headers, rsqlQuery, body), result)
 }
 
-func (m *GenericMock) PostForm(ctx context.Context, path string, _ map[string]string, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("POST", path, result)
+func (m *GenericMock) PostForm(ctx context.Context, path string, formData map[string]string, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("POST", path, headers, nil, formData), result)
 }
 
-func (m *GenericMock) PostMultipart(ctx context.Context, path string, _ string, _ string, _ io.Reader, _ int64, _ map[string]string, _ map[string]string, _ client.MultipartProgressCallback, result any) (*resty.Response, error) {
-	return m.dispatch("POST", path, result)
+func (m *GenericMock) PostMultipart(ctx context.Context, path string, _ string, _ string, _ io.Reader, _ int64, _ map[string]string, headers map[string]string, _ client.MultipartProgressCallback, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("POST", path, headers, nil, nil), result)
 }
 
-func (m *GenericMock) Put(ctx context.Context, path string, _ any, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("PUT", path, result)
+func (m *GenericMock) Put(ctx context.Context, path string, body any, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("PUT", path, headers, nil, body), result)
 }
 
-func (m *GenericMock) Patch(ctx context.Context, path string, _ any, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("PATCH", path, result)
+func (m *GenericMock) Patch(ctx context.Context, path string, body any, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("PATCH", path, headers, nil, body), result)
 }
 
-func (m *GenericMock) Delete(ctx context.Context, path string, _ map[string]string, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("DELETE", path, result)
+func (m *GenericMock) Delete(ctx context.Context, path string, rsqlQuery map[string]string, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("DELETE", path, headers, rsqlQuery, nil), result)
 }
 
-func (m *GenericMock) DeleteWithBody(ctx context.Context, path string, _ any, _ map[string]string, result any) (*resty.Response, error) {
-	return m.dispatch("DELETE", path, result)
+func (m *GenericMock) DeleteWithBody(ctx context.Context, path string, body any, headers map[string]string, result any) (*resty.Response, error) {
+	return m.dispatch(m.newCall("DELETE", path, headers, nil, body), result)
 }
 
-func (m *GenericMock) GetBytes(ctx context.Context, path string, rsqlQuery map[string]string, _ map[string]string) (*resty.Response, []byte, error) {
+func (m *GenericMock) GetBytes(ctx context.Context, path string, rsqlQuery map[string]string, headers map[string]string) (*resty.Response, []byte, error) {
 	m.LastRSQLQuery = rsqlQuery
-	resp, err := m.dispatch("GET", path, nil)
+	resp, err := m.dispatch(m.newCall("GET", path, headers, rsqlQuery, nil), nil)
 	if err != nil {
 		return resp, nil, err
 	}
 	return resp, resp.Bytes(), nil
 }
 
-func (m *GenericMock) GetPaginated(ctx context.Context, path string, rsqlQuery map[string]string, _ map[string]string, mergePage func([]byte) error) (*resty.Response, error) {
+func (m *GenericMock) GetPaginated(ctx context.Context, path string, rsqlQuery map[string]string, headers map[string]string, mergePage func([]byte) error) (*resty.Response, error) {
 	m.LastRSQLQuery = rsqlQuery
-	resp, err := m.dispatch("GET", path, nil)
+	resp, err := m.dispatch(m.newCall("GET", path, headers, rsqlQuery, nil), nil)
 	if err != nil {
 		return resp, err
 	}
@@ -291,9 +372,23 @@ func (m *GenericMock) GetPaginated(ctx context.Context, path string, rsqlQuery m
 }
 
 func (m *GenericMock) NewRequest(ctx context.Context) *client.RequestBuilder {
-	return client.NewMockRequestBuilderWithQueryCapture(ctx, func(method, path string, result any) (*resty.Response, error) {
-		return m.dispatch(method, path, result)
-	}, &m.LastRSQLQuery)
+	return client.NewMockRequestBuilderWithCapture(ctx, func(req client.MockRequest, result any) (*resty.Response, error) {
+		query := firstValues(req.Query)
+		if len(query) > 0 {
+			m.LastRSQLQuery = query
+		}
+		body := req.Body
+		if body == nil && len(req.FormData) > 0 {
+			body = firstValues(req.FormData)
+		}
+		return m.dispatch(Call{
+			Method: req.Method,
+			Path:   req.Path,
+			Header: req.Header,
+			Query:  query,
+			Body:   body,
+		}, result)
+	})
 }
 
 func (m *GenericMock) RSQLBuilder() client.RSQLFilterBuilder { return nil }
@@ -0,0 +1,155 @@
+package mocks
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingT captures assertion failures so the helpers themselves can be tested.
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestUnit_GenericMock_JournalRecordsRequestBuilderCalls(t *testing.T) {
+	m := NewJSONMock("TestMock")
+	m.RegisterRawBody("PUT", "/api/v1/things/1", http.StatusOK, []byte(`{"id":"1"}`))
+	m.RegisterRawBody("GET", "/api/v1/things", http.StatusOK, []byte(`{"totalCount":0,"results":[]}`))
+
+	type thing struct {
+		Name        string `json:"name"`
+		VersionLock int    `json:"versionLock"`
+	}
+	ctx := context.Background()
+	_, err := m.NewRequest(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(&thing{Name: "A", VersionLock: 3}).
+		Put("/api/v1/things/1")
+	require.NoError(t, err)
+	_, err = m.NewRequest(ctx).SetQueryParam("filter", `name=="A"`).Get("/api/v1/things")
+	require.NoError(t, err)
+
+	calls := m.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, 1, calls[0].Ordinal)
+	assert.Equal(t, 2, calls[1].Ordinal)
+	assert.Equal(t, map[string]string{"filter": `name=="A"`}, calls[1].Query)
+	assert.Equal(t, map[string]string{"filter": `name=="A"`}, m.LastRSQLQuery)
+
+	put := m.AssertCalled(t, "PUT", "/api/v1/things/1")
+	assert.Equal(t, constants.ApplicationJSON, put.Header.Get("Content-Type"))
+	var sent thing
+	require.NoError(t, put.DecodeBody(&sent))
+	assert.Equal(t, thing{Name: "A", VersionLock: 3}, sent)
+
+	raw, err := put.RawBody()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"A","versionLock":3}`, string(raw))
+}
+
+func TestUnit_GenericMock_JournalDecodesXMLBodies(t *testing.T) {
+	m := NewXMLMock("TestXMLMock")
+	m.RegisterRawBody("POST", "/JSSResource/buildings/id/0", http.StatusCreated, []byte(`<building><id>5</id></building>`))
+
+	type building struct {
+		XMLName xml.Name `xml:"building"`
+		Name    string   `xml:"name"`
+	}
+	_, err := m.Post(context.Background(), "/JSSResource/buildings/id/0", &building{Name: "HQ"}, nil, nil)
+	require.NoError(t, err)
+
+	call := m.AssertCalled(t, "POST", "/JSSResource/buildings/id/0")
+	raw, err := call.RawBody()
+	require.NoError(t, err)
+	assert.Equal(t, `<building><name>HQ</name></building>`, string(raw))
+
+	var sent building
+	require.NoError(t, call.DecodeBody(&sent))
+	assert.Equal(t, "HQ", sent.Name)
+}
+
+func TestUnit_GenericMock_CallsToAndAssertions(t *testing.T) {
+	m := NewJSONMock("TestMock")
+	m.RegisterRawBody("GET", "/api/v1/things/1", http.StatusOK, []byte(`{}`))
+	m.RegisterRawBody("DELETE", "/api/v1/things/1", http.StatusNoContent, nil)
+
+	ctx := context.Background()
+	_, _ = m.NewRequest(ctx).Get("/api/v1/things/1")
+	_, _ = m.NewRequest(ctx).Delete("/api/v1/things/1")
+	_, _ = m.NewRequest(ctx).Get("/api/v1/unregistered")
+
+	calls := m.CallsTo("/api/v1/things/1")
+	require.Len(t, calls, 2)
+	assert.Equal(t, "GET", calls[0].Method)
+	assert.Equal(t, "DELETE", calls[1].Method)
+	assert.Len(t, m.CallsTo("/api/v1/unregistered"), 1, "unregistered calls are journaled too")
+
+	rt := &recordingT{}
+	m.AssertCalled(rt, "POST", "/api/v1/things")
+	m.AssertNotCalled(rt, "DELETE", "/api/v1/things/1")
+	require.Len(t, rt.errors, 2)
+	assert.Contains(t, rt.errors[0], "expected a call to POST /api/v1/things")
+	assert.Contains(t, rt.errors[0], "#2 DELETE /api/v1/things/1")
+	assert.Contains(t, rt.errors[1], "unexpected call #2 DELETE /api/v1/things/1")
+
+	m.ResetCalls()
+	assert.Empty(t, m.Calls())
+}
+
+func TestUnit_GenericMock_RegisterSequence(t *testing.T) {
+	m := NewJSONMock("TestMock")
+	path := "/api/v1/things/1"
+	m.RegisterSequence("PUT", path,
+		MockStep{StatusCode: http.StatusConflict, Body: []byte(`{"httpStatus":409,"errors":[{"code":"OPTIMISTIC_LOCK_FAILED"}]}`)},
+		MockStep{StatusCode: http.StatusOK, Body: []byte(`{"name":"second"}`)},
+	)
+
+	put := func() (int, string, error) {
+		var result struct {
+			Name string `json:"name"`
+		}
+		resp, err := m.NewRequest(context.Background()).SetResult(&result).Put(path)
+		return resp.StatusCode(), result.Name, err
+	}
+
+	status, _, err := put()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, status)
+
+	status, name, err := put()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "second", name)
+
+	status, name, err = put()
+	require.NoError(t, err, "the last step repeats once the sequence is used up")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "second", name)
+
+	m.ResetCalls()
+	status, _, err = put()
+	require.Error(t, err, "ResetCalls rewinds the sequence")
+	assert.Equal(t, http.StatusConflict, status)
+
+	m.RegisterRawBody("PUT", path, http.StatusAccepted, nil)
+	status, _, err = put()
+	require.NoError(t, err, "a plain registration replaces the sequence")
+	assert.Equal(t, http.StatusAccepted, status)
+}
+
+func TestUnit_GenericMock_RegisterSequencePanicsWithoutSteps(t *testing.T) {
+	m := NewJSONMock("TestMock")
+	assert.Panics(t, func() { m.RegisterSequence("GET", "/api/v1/things") })
+}
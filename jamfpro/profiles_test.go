@@ -0,0 +1,51 @@
+package jamfpro
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewClientFromProfile_AppliesTransportSettings(t *testing.T) {
+	srv := newTenantServer(t, false)
+	t.Setenv(config.ProfileEnvVar, "staging")
+	t.Setenv("TEST_JAMF_STAGING_SECRET", "s")
+
+	path := filepath.Join(t.TempDir(), "profiles.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+profiles:
+  staging:
+    instance_domain: `+srv.URL+`
+    auth_method: oauth2
+    client_id: c
+    client_secret: env:TEST_JAMF_STAGING_SECRET
+    transport:
+      timeout_seconds: 45
+      retry_count: 7
+      proxy: `+srv.URL+`
+`), 0o600))
+
+	jamfClient, err := NewClientFromProfile(path, "", WithLogger(zap.NewNop()), WithRetryCount(2))
+	require.NoError(t, err)
+
+	restyClient := jamfClient.GetTransport().GetHTTPClient()
+	assert.Equal(t, 45*time.Second, restyClient.Timeout())
+	assert.Equal(t, 2, restyClient.RetryCount(), "caller options override the profile")
+	require.NotNil(t, restyClient.ProxyURL())
+	assert.Equal(t, srv.URL, restyClient.ProxyURL().String())
+}
+
+func TestLoadProfile_UnknownProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"profiles": {"dev": {}}}`), 0o600))
+
+	_, _, err := LoadProfile(path, "prod")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `profile "prod" not found`)
+}
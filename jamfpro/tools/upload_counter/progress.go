@@ -1,4 +1,4 @@
-// Package upload_counter provides a terminal progress bar for multipart file uploads.
+// Package upload_counter provides a terminal progress bar for file transfers.
 package upload_counter
 
 import (
@@ -7,12 +7,16 @@ import (
 	"os"
 	"strings"
 	"sync"
+
+	"github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/client"
 )
 
 const barWidth = 30
 
-// Bar writes an upload progress bar to w (defaults to os.Stderr when nil).
-// Call NewBar once per upload, then pass Bar.Callback to SetMultipartFile.
+// Bar writes a transfer progress bar to w (defaults to os.Stderr when nil).
+// It is a client.ProgressReporter, so it can be passed to
+// jamfpro.WithProgressReporter to show progress for every upload and
+// download, or its Callback can be passed directly to SetMultipartFile.
 //
 // Example output (overwrites same line):
 //
@@ -20,9 +24,12 @@ const barWidth = 30
 type Bar struct {
 	w    io.Writer
 	mu   sync.Mutex
+	file string
 	done bool
 }
 
+var _ client.ProgressReporter = (*Bar)(nil)
+
 // New creates a new Bar that writes to w. Pass nil to write to os.Stderr.
 func New(w io.Writer) *Bar {
 	if w == nil {
@@ -34,12 +41,36 @@ func New(w io.Writer) *Bar {
 // Callback satisfies client.MultipartProgressCallback.
 // Pass this to SetMultipartFile as the progress callback.
 func (b *Bar) Callback(fieldName, fileName string, written, total int64) {
+	b.draw("Uploading", fileName, written, total)
+}
+
+// Report implements client.ProgressReporter. Transfer phases are drawn as a
+// bar; other phases are ignored.
+func (b *Bar) Report(event client.ProgressEvent) {
+	switch event.Phase {
+	case client.ProgressPhaseUploading:
+		b.draw("Uploading", event.File, event.BytesTransferred, event.TotalBytes)
+	case client.ProgressPhaseDownloading:
+		b.draw("Downloading", event.File, event.BytesTransferred, event.TotalBytes)
+	}
+}
+
+// draw renders one line of the bar, starting a new bar when the file changes.
+func (b *Bar) draw(verb, fileName string, written, total int64) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	if total <= 0 {
 		return
 	}
+	// A new file, or the same file starting over, begins a fresh bar.
+	if fileName != b.file || written < total {
+		b.file = fileName
+		b.done = false
+	}
+	if b.done {
+		return
+	}
 
 	pct := float64(written) / float64(total)
 	filled := int(pct * barWidth)
@@ -52,8 +83,8 @@ func (b *Bar) Callback(fieldName, fileName string, written, total int64) {
 	writtenMB := float64(written) / (1024 * 1024)
 	totalMB := float64(total) / (1024 * 1024)
 
-	fmt.Fprintf(b.w, "\rUploading %-30s [%s]  %6.1f MB / %.1f MB  %5.1f%%",
-		fileName, bar, writtenMB, totalMB, pct*100)
+	fmt.Fprintf(b.w, "\r%s %-30s [%s]  %6.1f MB / %.1f MB  %5.1f%%",
+		verb, fileName, bar, writtenMB, totalMB, pct*100)
 
 	if written >= total && !b.done {
 		b.done = true
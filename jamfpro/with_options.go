@@ -156,6 +156,21 @@ func WithMandatoryRequestDelay(d time.Duration) ClientOption {
 	}
 }
 
+// WithRateLimit paces every request through limiter, a token-bucket budget
+// with optional stricter limits per endpoint prefix (see NewRateLimiter).
+// Pass the same limiter to every client that talks to one tenant so they
+// share a single requests-per-second budget. Returns an error if limiter is
+// nil.
+func WithRateLimit(limiter *RateLimiter) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if limiter == nil {
+			return fmt.Errorf("rate limiter cannot be nil")
+		}
+		s.RateLimiter = limiter
+		return nil
+	}
+}
+
 // WithTotalRetryDuration sets a maximum wall-clock budget for a request including
 // all retry attempts. Requests exceeding this duration are cancelled.
 func WithTotalRetryDuration(d time.Duration) ClientOption {
@@ -164,3 +179,110 @@ func WithTotalRetryDuration(d time.Duration) ClientOption {
 		return nil
 	}
 }
+
+// WithParallelPagination fetches the remaining pages of paginated list calls
+// concurrently once the first page has reported totalCount, instead of one
+// page at a time. Fan-out is bounded by WithMaxConcurrentRequests (or 5 when
+// unset) and results are reassembled in page order.
+//
+// Jamf Pro offers no snapshot isolation across pages, so parallel fetching is
+// only applied to requests that set a sort key (e.g. rsqlQuery["sort"] =
+// "id:asc"); unsorted requests fall back to sequential pagination.
+func WithParallelPagination() ClientOption {
+	return func(s *client.TransportSettings) error {
+		s.ParallelPagination = true
+		return nil
+	}
+}
+
+// WithProgressReporter sends progress events from file uploads and downloads
+// (JCDS, packages, Classic API file uploads, inventory preload CSV, branding
+// images) to reporter. Transfers are silent by default; pass
+// upload_counter.New(nil) for a terminal progress bar. A reporter set on a
+// call's context with ContextWithProgressReporter takes precedence. Returns an
+// error if reporter is nil.
+func WithProgressReporter(reporter ProgressReporter) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if reporter == nil {
+			return fmt.Errorf("progress reporter cannot be nil")
+		}
+		s.ProgressReporter = reporter
+		return nil
+	}
+}
+
+// WithTokenSource supplies bearer tokens from a custom TokenSource instead of
+// the built-in OAuth2 or basic auth flows, e.g. a token broker sidecar,
+// credentials pulled from a secrets manager, or a pre-minted CI token via
+// StaticTokenSource. Takes precedence over AuthConfig.TokenSource; only
+// AuthConfig.InstanceDomain is then required. Returns an error if ts is nil.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if ts == nil {
+			return fmt.Errorf("token source cannot be nil")
+		}
+		s.TokenSource = ts
+		return nil
+	}
+}
+
+// WithTokenCache stores bearer tokens from the built-in OAuth2 and basic auth
+// flows in cache instead of the default per-client in-memory cache. Use
+// NewFileTokenCache so that short-lived processes (CLI runs, cron jobs) reuse
+// a still-valid token rather than re-authenticating every time. Tokens the
+// API rejects with 401 are evicted. Returns an error if cache is nil.
+func WithTokenCache(cache TokenCache) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if cache == nil {
+			return fmt.Errorf("token cache cannot be nil")
+		}
+		s.TokenCache = cache
+		return nil
+	}
+}
+
+// WithAutoKeepAlive starts a background goroutine that extends the bearer
+// token every interval (or sooner, before it enters the refresh buffer) and
+// fetches a new one if keep-alive fails. Intended for long-running daemons;
+// call Client.Close on shutdown to stop it and revoke the token. Returns an
+// error if interval is not positive.
+func WithAutoKeepAlive(interval time.Duration) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if interval <= 0 {
+			return fmt.Errorf("auto keep-alive interval must be positive, got %s", interval)
+		}
+		s.AutoKeepAliveInterval = interval
+		return nil
+	}
+}
+
+// WithTransportProfile applies the transport settings of a configuration
+// profile: timeout, retry count and waits, max concurrent requests, and proxy.
+// Zero fields leave the defaults (or earlier options) in place. Options
+// passed after it override it.
+func WithTransportProfile(tp *TransportProfile) ClientOption {
+	return func(s *client.TransportSettings) error {
+		if tp == nil {
+			return nil
+		}
+		if tp.TimeoutSeconds > 0 {
+			s.Timeout = tp.Timeout()
+		}
+		if tp.RetryCount > 0 {
+			s.RetryCount = tp.RetryCount
+		}
+		if tp.RetryWaitSeconds > 0 {
+			s.RetryWaitTime = tp.RetryWait()
+		}
+		if tp.RetryMaxWaitSeconds > 0 {
+			s.RetryMaxWaitTime = tp.RetryMaxWait()
+		}
+		if tp.MaxConcurrentRequests > 0 {
+			s.MaxConcurrentRequests = tp.MaxConcurrentRequests
+		}
+		if tp.Proxy != "" {
+			s.ProxyURL = tp.Proxy
+		}
+		return nil
+	}
+}
@@ -0,0 +1,634 @@
+// Command service_interfaces generates a Service interface for every service
+// registered on the aggregate clients in jamfpro/jamfpro.go, together with a
+// hand-controllable fake implementing it.
+//
+// For each service package it writes:
+//
+//	<service>/service.go     type Service interface { ... } covering the exported methods
+//	<service>/fakes/fake.go  type <Struct>Fake struct { <Method>Func ...; fakes.Journal }
+//
+// Services are discovered from the constructors called by newClassicAPIClient
+// and newJamfProAPIClient, and method sets are read with go/types from the
+// compiler's export data, so methods promoted from embedded fields are
+// included. Run it from the jamfpro directory:
+//
+//	go generate ./jamfpro
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	generatedHeader = "// Code generated by tools/service_interfaces; DO NOT EDIT.\n\n"
+	fakesImportPath = "github.com/deploymenttheory/go-sdk-jamfpro-v2/jamfpro/fakes"
+	interfaceFile   = "service.go"
+	fakeDir         = "fakes"
+	fakeFile        = "fake.go"
+)
+
+// aggregateClients maps the constructor of each aggregate client to the
+// client type it builds.
+var aggregateClients = map[string]string{
+	"newClassicAPIClient": "ClassicAPIClient",
+	"newJamfProAPIClient": "JamfProAPIClient",
+}
+
+// journalMembers are promoted from fakes.Journal and must not be shadowed by
+// a service method.
+var journalMembers = map[string]bool{
+	"Journal": true, "Record": true, "Calls": true, "CallsTo": true, "CallCount": true, "ResetCalls": true,
+}
+
+// servicePackage is a package holding one or more services registered on an
+// aggregate client.
+type servicePackage struct {
+	importPath string
+	dir        string
+	pkg        *types.Package
+	services   []*service
+}
+
+// service is one concrete type registered on an aggregate client.
+type service struct {
+	client  string
+	ctor    string
+	iface   string
+	named   *types.Named
+	methods []*types.Func
+}
+
+// listedPackage is the subset of `go list -json` output used here.
+type listedPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	Export     string
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory containing jamfpro.go")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("service_interfaces: ")
+
+	pkgs, err := discoverServices(filepath.Join(*dir, "jamfpro.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := loadMethodSets(*dir, pkgs); err != nil {
+		log.Fatal(err)
+	}
+	count := 0
+	for _, sp := range pkgs {
+		if err := writePackage(sp); err != nil {
+			log.Fatalf("%s: %v", sp.importPath, err)
+		}
+		count += len(sp.services)
+	}
+	log.Printf("generated interfaces and fakes for %d services in %d packages", count, len(pkgs))
+}
+
+// discoverServices finds the service constructors called by the aggregate
+// client constructors in path. The first service registered from a package
+// gets the interface name Service; any others are named after their type,
+// such as packages.PackageUploaderService.
+func discoverServices(path string) ([]*servicePackage, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string) // local name or path base -> import path
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+
+	var pkgs []*servicePackage
+	byPath := make(map[string]*servicePackage)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		clientType, ok := aggregateClients[fn.Name.Name]
+		if !ok {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			ident, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			importPath, ok := imports[ident.Name]
+			if !ok || !strings.HasPrefix(sel.Sel.Name, "New") {
+				return true
+			}
+			sp, ok := byPath[importPath]
+			if !ok {
+				sp = &servicePackage{importPath: importPath}
+				byPath[importPath] = sp
+				pkgs = append(pkgs, sp)
+			}
+			sp.services = append(sp.services, &service{client: clientType, ctor: sel.Sel.Name})
+			return true
+		})
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no service constructors found in %s", path)
+	}
+	return pkgs, nil
+}
+
+// loadMethodSets resolves each service's concrete type and exported methods.
+// Previously generated interface files are removed first so that a method
+// that no longer exists cannot break the build being inspected.
+func loadMethodSets(dir string, pkgs []*servicePackage) error {
+	paths := make([]string, len(pkgs))
+	for i, sp := range pkgs {
+		paths[i] = sp.importPath
+	}
+
+	listed, err := goList(dir, paths, false)
+	if err != nil {
+		return err
+	}
+	for _, sp := range pkgs {
+		sp.dir = listed[sp.importPath].Dir
+		if err := removeGenerated(filepath.Join(sp.dir, interfaceFile)); err != nil {
+			return err
+		}
+	}
+
+	listed, err = goList(dir, paths, true)
+	if err != nil {
+		return err
+	}
+	imp := importer.ForCompiler(token.NewFileSet(), "gc", func(path string) (io.ReadCloser, error) {
+		p, ok := listed[path]
+		if !ok || p.Export == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(p.Export)
+	})
+
+	for _, sp := range pkgs {
+		pkg, err := imp.Import(sp.importPath)
+		if err != nil {
+			return err
+		}
+		sp.pkg = pkg
+		for i, svc := range sp.services {
+			if err := resolveService(pkg, svc); err != nil {
+				return fmt.Errorf("%s: %w", sp.importPath, err)
+			}
+			svc.iface = "Service"
+			if i > 0 {
+				svc.iface = svc.named.Obj().Name() + "Service"
+			}
+		}
+	}
+	return nil
+}
+
+// resolveService finds the type returned by svc's constructor in pkg and
+// collects its exported methods, including promoted ones.
+func resolveService(pkg *types.Package, svc *service) error {
+	ctor, ok := pkg.Scope().Lookup(svc.ctor).(*types.Func)
+	if !ok {
+		return fmt.Errorf("%s is not a function", svc.ctor)
+	}
+	results := ctor.Type().(*types.Signature).Results()
+	if results.Len() == 0 {
+		return fmt.Errorf("%s returns nothing", svc.ctor)
+	}
+	ptr, ok := results.At(0).Type().(*types.Pointer)
+	if !ok {
+		return fmt.Errorf("%s does not return a pointer", svc.ctor)
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok {
+		return fmt.Errorf("%s does not return a named type", svc.ctor)
+	}
+	svc.named = named
+
+	mset := types.NewMethodSet(ptr)
+	for i := range mset.Len() {
+		fn := mset.At(i).Obj().(*types.Func)
+		if fn.Exported() {
+			svc.methods = append(svc.methods, fn)
+		}
+	}
+	if len(svc.methods) == 0 {
+		return fmt.Errorf("%s has no exported methods", named.Obj().Name())
+	}
+	return nil
+}
+
+// goList runs `go list -json` on paths, optionally building export data for
+// them and their dependencies.
+func goList(dir string, paths []string, export bool) (map[string]listedPackage, error) {
+	args := []string{"list", "-json=ImportPath,Name,Dir,Export"}
+	if export {
+		args = append(args, "-export", "-deps")
+	}
+	cmd := exec.Command("go", append(args, paths...)...)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w", err)
+	}
+
+	listed := make(map[string]listedPackage)
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var p listedPackage
+		if err := dec.Decode(&p); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("decode go list output: %w", err)
+		}
+		listed[p.ImportPath] = p
+	}
+	return listed, nil
+}
+
+// removeGenerated deletes path if it exists and was written by this tool.
+func removeGenerated(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(data, []byte(generatedHeader)) {
+		return fmt.Errorf("refusing to replace %s: it was not generated by this tool", path)
+	}
+	return os.Remove(path)
+}
+
+func writePackage(sp *servicePackage) error {
+	for _, svc := range sp.services {
+		for _, fn := range svc.methods {
+			if err := checkExported(fn.Type(), fn.Name()); err != nil {
+				return err
+			}
+			if journalMembers[fn.Name()] {
+				return fmt.Errorf("method %s.%s collides with fakes.Journal", svc.named.Obj().Name(), fn.Name())
+			}
+		}
+	}
+
+	iface, err := renderInterfaces(sp)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(sp.dir, interfaceFile), iface, 0o644); err != nil {
+		return err
+	}
+
+	fake, err := renderFakes(sp)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(sp.dir, fakeDir), 0o755); err != nil {
+		return err
+	}
+	path := filepath.Join(sp.dir, fakeDir, fakeFile)
+	if err := removeGenerated(path); err != nil {
+		return err
+	}
+	return os.WriteFile(path, fake, 0o644)
+}
+
+func renderInterfaces(sp *servicePackage) ([]byte, error) {
+	imports := newImportSet(sp.pkg)
+
+	var body bytes.Buffer
+	for i, svc := range sp.services {
+		structName := svc.named.Obj().Name()
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "// %s is the method set of %s.\n", svc.iface, structName)
+		fmt.Fprintf(&body, "// jamfpro.%s exposes the service through it, and\n", svc.client)
+		fmt.Fprintf(&body, "// fakes.%sFake implements it for tests.\n", structName)
+		fmt.Fprintf(&body, "type %s interface {\n", svc.iface)
+		for _, fn := range svc.methods {
+			sig := fn.Type().(*types.Signature)
+			fmt.Fprintf(&body, "\t%s%s\n", fn.Name(), signature(sig, imports.qualify))
+		}
+		body.WriteString("}\n\n")
+		fmt.Fprintf(&body, "var _ %s = (*%s)(nil)\n", svc.iface, structName)
+	}
+
+	return assemble("", sp.pkg.Name(), imports, body.Bytes())
+}
+
+func renderFakes(sp *servicePackage) ([]byte, error) {
+	imports := newImportSet(nil)
+	sharedName := imports.add(fakesImportPath, "fakes")
+	svcName := imports.add(sp.pkg.Path(), sp.pkg.Name())
+
+	var body bytes.Buffer
+	for i, svc := range sp.services {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		if err := renderFake(&body, svc, sharedName, svcName, imports.qualify); err != nil {
+			return nil, err
+		}
+	}
+
+	doc := fmt.Sprintf("// Package fakes provides hand-controllable implementations of the %s\n// service interfaces for tests.\n", svcName)
+	return assemble(doc, "fakes", imports, body.Bytes())
+}
+
+func renderFake(body *bytes.Buffer, svc *service, sharedName, svcName string, qualify types.Qualifier) error {
+	fakeName := svc.named.Obj().Name() + "Fake"
+
+	methodNames := make(map[string]bool, len(svc.methods))
+	for _, fn := range svc.methods {
+		methodNames[fn.Name()] = true
+	}
+
+	fmt.Fprintf(body, "// %s is a %s.%s whose methods are controlled by its Func\n", fakeName, svcName, svc.iface)
+	fmt.Fprintf(body, "// fields. A method whose Func field is nil returns %s.ErrNotStubbed, and\n", sharedName)
+	body.WriteString("// every call is recorded in the embedded Journal.\n")
+	fmt.Fprintf(body, "type %s struct {\n\t%s.Journal\n\n", fakeName, sharedName)
+	for _, fn := range svc.methods {
+		field := fn.Name() + "Func"
+		if methodNames[field] {
+			return fmt.Errorf("field %s.%s collides with a method", fakeName, field)
+		}
+		sig := fn.Type().(*types.Signature)
+		fmt.Fprintf(body, "\t%s func%s\n", field, signature(sig, qualify))
+	}
+	body.WriteString("}\n\n")
+	fmt.Fprintf(body, "var _ %s.%s = (*%s)(nil)\n", svcName, svc.iface, fakeName)
+
+	for _, fn := range svc.methods {
+		sig := fn.Type().(*types.Signature)
+		names := paramNames(sig)
+		args := strings.Join(names, ", ")
+		callArgs := args
+		if sig.Variadic() {
+			callArgs += "..."
+		}
+		recordArgs := strconv.Quote(fn.Name())
+		if args != "" {
+			recordArgs += ", " + args
+		}
+
+		fmt.Fprintf(body, "\n// %s records the call and delegates to %sFunc.\n", fn.Name(), fn.Name())
+		fmt.Fprintf(body, "func (f *%s) %s%s {\n", fakeName, fn.Name(), signature(sig, qualify))
+		fmt.Fprintf(body, "\tf.Record(%s)\n", recordArgs)
+		if sig.Results().Len() == 0 {
+			fmt.Fprintf(body, "\tif f.%sFunc != nil {\n\t\tf.%sFunc(%s)\n\t}\n}\n", fn.Name(), fn.Name(), callArgs)
+			continue
+		}
+		zeros := make([]string, sig.Results().Len())
+		for i := range zeros {
+			t := sig.Results().At(i).Type()
+			if i == len(zeros)-1 && types.Identical(t, types.Universe.Lookup("error").Type()) {
+				zeros[i] = fmt.Sprintf("%s.NotStubbed(%q)", sharedName, fakeName+"."+fn.Name())
+				continue
+			}
+			zeros[i] = zeroValue(t, qualify)
+		}
+		fmt.Fprintf(body, "\tif f.%sFunc == nil {\n\t\treturn %s\n\t}\n", fn.Name(), strings.Join(zeros, ", "))
+		fmt.Fprintf(body, "\treturn f.%sFunc(%s)\n}\n", fn.Name(), callArgs)
+	}
+	return nil
+}
+
+// assemble prefixes body with the generated header, package clause and
+// imports, and formats the result.
+func assemble(doc, pkgName string, imports *importSet, body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString(doc)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	if len(imports.names) > 0 {
+		paths := make([]string, 0, len(imports.names))
+		for path := range imports.names {
+			paths = append(paths, path)
+		}
+		// Standard library imports first, then everything else, as goimports
+		// would group them.
+		sort.Slice(paths, func(i, j int) bool {
+			si, sj := isStdlib(paths[i]), isStdlib(paths[j])
+			if si != sj {
+				return si
+			}
+			return paths[i] < paths[j]
+		})
+		buf.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && isStdlib(paths[i-1]) && !isStdlib(path) {
+				buf.WriteString("\n")
+			}
+			name := imports.names[path]
+			if name == imports.declared[path] {
+				fmt.Fprintf(&buf, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body)
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return out, nil
+}
+
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// importSet assigns a unique local name to every package a generated file
+// refers to.
+type importSet struct {
+	self     *types.Package
+	names    map[string]string // import path -> local name
+	declared map[string]string // import path -> package name
+	taken    map[string]string // local name -> import path
+}
+
+func newImportSet(self *types.Package) *importSet {
+	return &importSet{
+		self:     self,
+		names:    make(map[string]string),
+		declared: make(map[string]string),
+		taken:    make(map[string]string),
+	}
+}
+
+func (s *importSet) add(path, name string) string {
+	if local, ok := s.names[path]; ok {
+		return local
+	}
+	local := name
+	for i := 2; s.taken[local] != ""; i++ {
+		local = fmt.Sprintf("%s%d", name, i)
+	}
+	s.names[path] = local
+	s.declared[path] = name
+	s.taken[local] = path
+	return local
+}
+
+func (s *importSet) qualify(pkg *types.Package) string {
+	if pkg == s.self {
+		return ""
+	}
+	return s.add(pkg.Path(), pkg.Name())
+}
+
+// signature renders sig's parameters and results with stable parameter names.
+func signature(sig *types.Signature, qualify types.Qualifier) string {
+	names := paramNames(sig)
+	params := make([]string, len(names))
+	for i, name := range names {
+		t := sig.Params().At(i).Type()
+		if sig.Variadic() && i == len(names)-1 {
+			params[i] = name + " ..." + types.TypeString(t.(*types.Slice).Elem(), qualify)
+			continue
+		}
+		params[i] = name + " " + types.TypeString(t, qualify)
+	}
+
+	out := "(" + strings.Join(params, ", ") + ")"
+	results := make([]string, sig.Results().Len())
+	for i := range results {
+		results[i] = types.TypeString(sig.Results().At(i).Type(), qualify)
+	}
+	switch len(results) {
+	case 0:
+	case 1:
+		out += " " + results[0]
+	default:
+		out += " (" + strings.Join(results, ", ") + ")"
+	}
+	return out
+}
+
+// paramNames returns sig's parameter names, inventing one for unnamed and
+// blank parameters and for any that would shadow an identifier used in a
+// fake's method body.
+func paramNames(sig *types.Signature) []string {
+	names := make([]string, sig.Params().Len())
+	for i := range names {
+		name := sig.Params().At(i).Name()
+		if name == "" || name == "_" || name == "f" || name == "fakes" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		names[i] = name
+	}
+	return names
+}
+
+// zeroValue returns a Go expression for the zero value of t.
+func zeroValue(t types.Type, qualify types.Qualifier) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		}
+		return "nil"
+	case *types.Struct, *types.Array:
+		return types.TypeString(t, qualify) + "{}"
+	}
+	return "nil"
+}
+
+// checkExported reports an error if t refers to an unexported named type,
+// which a fake in another package could not name.
+func checkExported(t types.Type, method string) error {
+	switch t := t.(type) {
+	case *types.Named:
+		if obj := t.Obj(); obj.Pkg() != nil && !obj.Exported() {
+			return fmt.Errorf("method %s refers to unexported type %s", method, obj.Name())
+		}
+		for i := range t.TypeArgs().Len() {
+			if err := checkExported(t.TypeArgs().At(i), method); err != nil {
+				return err
+			}
+		}
+	case *types.Alias:
+		if obj := t.Obj(); obj.Pkg() != nil && !obj.Exported() {
+			return fmt.Errorf("method %s refers to unexported type %s", method, obj.Name())
+		}
+	case *types.Pointer:
+		return checkExported(t.Elem(), method)
+	case *types.Slice:
+		return checkExported(t.Elem(), method)
+	case *types.Array:
+		return checkExported(t.Elem(), method)
+	case *types.Chan:
+		return checkExported(t.Elem(), method)
+	case *types.Map:
+		if err := checkExported(t.Key(), method); err != nil {
+			return err
+		}
+		return checkExported(t.Elem(), method)
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := range tuple.Len() {
+				if err := checkExported(tuple.At(i).Type(), method); err != nil {
+					return err
+				}
+			}
+		}
+	case *types.Struct:
+		for i := range t.NumFields() {
+			if err := checkExported(t.Field(i).Type(), method); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}